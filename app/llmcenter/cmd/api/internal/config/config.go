@@ -1,6 +1,12 @@
 package config
 
 import (
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/filecleaner"
+	"document_agent/pkg/health"
+	"document_agent/pkg/ratelimit"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -18,21 +24,21 @@ type Config struct {
 		DataSource string
 	}
 
+	// 文件清理使用 etcd 主节点锁时连接的 etcd
 	Etcd struct {
 		Hosts []string
 		Key   string
 	}
-	FileCleaner struct {
-		Enable          bool
-		Dir             string
-		RetentionDays   int
-		IntervalMinutes int
-		MaxSizeMB       int64
-		UseEtcdLock     bool
-		LockKey         string
-	}
+	// 上传文件清理，多个副本通过主节点锁只由一个实例执行
+	FileCleaner    filecleaner.Config
+	Redis          redis.RedisConf // 会话吊销列表、生成接口限流、文件清理与协同编辑连接凭证
 	PublicDownload struct {
 		SignKey string
 	}
-	LlmCenterRpcConf zrpc.RpcClientConf
+	LlmCenterRpcConf  zrpc.RpcClientConf
+	UsercenterRpcConf zrpc.RpcClientConf // 文件清理时查询用户头像
+	// 生成接口（completions / resume / edit）的并发与频率限制
+	GenerationLimit ratelimit.Config
+	HealthCheck     health.Config     `json:",optional"` // /readyz 依赖检查
+	ClientInfo      clientinfo.Config `json:",optional"` // 客户端 IP 解析（可信反向代理）
 }
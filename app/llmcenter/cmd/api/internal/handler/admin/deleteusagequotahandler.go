@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/logic/admin"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 删除用量配额
+func DeleteUsageQuotaHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DeleteUsageQuotaRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewDeleteUsageQuotaLogic(r.Context(), svcCtx)
+		resp, err := l.DeleteUsageQuota(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
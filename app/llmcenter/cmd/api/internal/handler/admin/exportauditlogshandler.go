@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/logic/admin"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 按条件将文档操作审计记录导出为 CSV
+func ExportAuditLogsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExportAuditLogsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewExportAuditLogsLogic(r.Context(), svcCtx)
+		resp, err := l.ExportAuditLogs(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", resp.ContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+resp.Filename+`"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(resp.Data)
+	}
+}
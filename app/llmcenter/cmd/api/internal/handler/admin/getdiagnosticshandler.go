@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/logic/admin"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 查看 API 与 RPC 服务的构建版本、依赖检查结果与配置问题
+func GetDiagnosticsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DiagnosticsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewGetDiagnosticsLogic(r.Context(), svcCtx)
+		resp, err := l.GetDiagnostics(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/logic/admin"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 按用户、会话、操作类型和时间范围分页查询文档操作审计记录
+func ListAuditLogsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListAuditLogsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewListAuditLogsLogic(r.Context(), svcCtx)
+		resp, err := l.ListAuditLogs(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
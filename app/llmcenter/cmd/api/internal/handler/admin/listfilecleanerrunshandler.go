@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/logic/admin"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 查询上传文件清理的最近运行记录
+func ListFileCleanerRunsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListFileCleanerRunsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewListFileCleanerRunsLogic(r.Context(), svcCtx)
+		resp, err := l.ListFileCleanerRuns(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
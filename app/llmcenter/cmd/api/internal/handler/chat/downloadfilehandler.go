@@ -2,6 +2,7 @@ package chat
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
 	logicfiles "document_agent/app/llmcenter/cmd/api/internal/logic/chat"
@@ -31,6 +32,10 @@ func DownloadFileHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 		w.Header().Set("Content-Type", ct)
 		w.Header().Set("Content-Disposition", `attachment; filename="`+resp.Filename+`"`)
+		if resp.DocNo != "" {
+			// 发文字号含中文，URL 编码后放入响应头
+			w.Header().Set("X-Doc-No", url.PathEscape(resp.DocNo))
+		}
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write(resp.Data)
 	}
@@ -6,16 +6,105 @@ package handler
 import (
 	"net/http"
 
+	admin "document_agent/app/llmcenter/cmd/api/internal/handler/admin"
 	agent "document_agent/app/llmcenter/cmd/api/internal/handler/agent"
+	approval "document_agent/app/llmcenter/cmd/api/internal/handler/approval"
 	chat "document_agent/app/llmcenter/cmd/api/internal/handler/chat"
+	collab "document_agent/app/llmcenter/cmd/api/internal/handler/collab"
+	comment "document_agent/app/llmcenter/cmd/api/internal/handler/comment"
 	conversation "document_agent/app/llmcenter/cmd/api/internal/handler/conversation"
+	docno "document_agent/app/llmcenter/cmd/api/internal/handler/docno"
 	file "document_agent/app/llmcenter/cmd/api/internal/handler/file"
+	share "document_agent/app/llmcenter/cmd/api/internal/handler/share"
+	usage "document_agent/app/llmcenter/cmd/api/internal/handler/usage"
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuditRead},
+			[]rest.Route{
+				{
+					// 按条件将文档操作审计记录导出为 CSV
+					Method:  http.MethodGet,
+					Path:    "/audit/export",
+					Handler: admin.ExportAuditLogsHandler(serverCtx),
+				},
+				{
+					// 按用户、会话、操作类型和时间范围分页查询文档操作审计记录
+					Method:  http.MethodGet,
+					Path:    "/audit/logs",
+					Handler: admin.ListAuditLogsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.QuotaManage},
+			[]rest.Route{
+				{
+					// 查询全部用量配额
+					Method:  http.MethodGet,
+					Path:    "/quotas",
+					Handler: admin.ListUsageQuotasHandler(serverCtx),
+				},
+				{
+					// 新增或覆盖用户级、角色级用量配额
+					Method:  http.MethodPost,
+					Path:    "/quotas",
+					Handler: admin.SetUsageQuotaHandler(serverCtx),
+				},
+				{
+					// 删除用量配额
+					Method:  http.MethodPost,
+					Path:    "/quotas/delete",
+					Handler: admin.DeleteUsageQuotaHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.FileManage},
+			[]rest.Route{
+				{
+					// 查询上传文件清理的最近运行记录
+					Method:  http.MethodGet,
+					Path:    "/filecleaner/runs",
+					Handler: admin.ListFileCleanerRunsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.SystemDiagnose},
+			[]rest.Route{
+				{
+					// 查看 API 与 RPC 服务的构建版本、依赖检查结果与配置问题
+					Method:  http.MethodGet,
+					Path:    "/diagnostics",
+					Handler: admin.GetDiagnosticsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1/admin"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -30,6 +119,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/public/file",
 				Handler: agent.PublicDownloadHandler(serverCtx),
 			},
+			{
+				// 文档分享页（免登录，签名校验），返回只读 HTML 页面
+				Method:  http.MethodGet,
+				Path:    "/public/share",
+				Handler: agent.PublicShareHandler(serverCtx),
+			},
+			{
+				// 提交分享链接的访问密码
+				Method:  http.MethodPost,
+				Path:    "/public/share",
+				Handler: agent.PublicShareUnlockHandler(serverCtx),
+			},
+			{
+				// 通过分享链接下载 DOCX/PDF
+				Method:  http.MethodGet,
+				Path:    "/public/share/download",
+				Handler: agent.PublicShareDownloadHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/llmcenter/v1"),
 	)
@@ -37,22 +144,69 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	server.AddRoutes(
 		[]rest.Route{
 			{
-				// 发起新对话或在现有对话中发送消息 (SSE 流式响应)
+				// 查询文档的审批状态、可执行的操作与流转记录
+				Method:  http.MethodGet,
+				Path:    "/approvals",
+				Handler: approval.GetApprovalHandler(serverCtx),
+			},
+			{
+				// 查询等待当前用户审批的文档
+				Method:  http.MethodGet,
+				Path:    "/approvals/tasks",
+				Handler: approval.ListApprovalTasksHandler(serverCtx),
+			},
+			{
+				// 执行审批流转: 提交、撤回、核稿通过、退回、签发等
 				Method:  http.MethodPost,
-				Path:    "/chat/completions",
-				Handler: chat.ChatCompletionsHandler(serverCtx),
+				Path:    "/approvals/transition",
+				Handler: approval.TransitionApprovalHandler(serverCtx),
 			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.GenerationLimit},
+			[]rest.Route{
+				{
+					// 发起新对话或在现有对话中发送消息 (SSE 流式响应)
+					Method:  http.MethodPost,
+					Path:    "/chat/completions",
+					Handler: chat.ChatCompletionsHandler(serverCtx),
+				},
+				{
+					// 根据修改提示编辑现有文章 (SSE 流式响应)
+					Method:  http.MethodPost,
+					Path:    "/chat/edit",
+					Handler: chat.EditDocumentHandler(serverCtx),
+				},
+				{
+					// 在工作流中断后, 发送用户编辑好的内容以继续流程 (SSE 流式响应)
+					Method:  http.MethodPost,
+					Path:    "/chat/resume",
+					Handler: chat.ChatResumeHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
 			{
-				// 根据修改提示编辑现有文章 (SSE 流式响应)
+				// 按 GB/T 9704 检查公文格式, 返回问题位置与修改建议
 				Method:  http.MethodPost,
-				Path:    "/chat/edit",
-				Handler: chat.EditDocumentHandler(serverCtx),
+				Path:    "/chat/check",
+				Handler: chat.CheckDocumentHandler(serverCtx),
 			},
 			{
-				// 在工作流中断后, 发送用户编辑好的内容以继续流程 (SSE 流式响应)
+				// 删除指定文档
 				Method:  http.MethodPost,
-				Path:    "/chat/resume",
-				Handler: chat.ChatResumeHandler(serverCtx),
+				Path:    "/chat/delete",
+				Handler: chat.DeleteDocumentHandler(serverCtx),
 			},
 			{
 				// 手动修改公文内容
@@ -71,6 +225,62 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/llmcenter/v1"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 获取协同编辑 WebSocket 连接凭证, 一次有效
+				Method:  http.MethodPost,
+				Path:    "/collab/ticket",
+				Handler: collab.CreateCollabTicketHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 建立协同编辑 WebSocket 连接
+				Method:  http.MethodGet,
+				Path:    "/collab/ws",
+				Handler: collab.CollabConnectHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 对文档中的一段文本发表批注, 或回复已有批注
+				Method:  http.MethodPost,
+				Path:    "/comments",
+				Handler: comment.CreateCommentHandler(serverCtx),
+			},
+			{
+				// 查询文档的批注及回复
+				Method:  http.MethodGet,
+				Path:    "/comments",
+				Handler: comment.ListCommentsHandler(serverCtx),
+			},
+			{
+				// 查询提及当前用户的批注
+				Method:  http.MethodGet,
+				Path:    "/comments/mentions",
+				Handler: comment.ListMentionedCommentsHandler(serverCtx),
+			},
+			{
+				// 标记批注已解决或重新打开
+				Method:  http.MethodPost,
+				Path:    "/comments/resolve",
+				Handler: comment.ResolveCommentHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -102,6 +312,37 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/llmcenter/v1"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 为文档分配当年的下一个发文字号, 文档已预留该代字的字号时直接返回
+				Method:  http.MethodPost,
+				Path:    "/docnos",
+				Handler: docno.AllocateDocNoHandler(serverCtx),
+			},
+			{
+				// 分页查询团队的发文字号
+				Method:  http.MethodGet,
+				Path:    "/docnos",
+				Handler: docno.ListDocNosHandler(serverCtx),
+			},
+			{
+				// 将预留的发文字号标记为已使用, 已使用的字号不能释放
+				Method:  http.MethodPost,
+				Path:    "/docnos/issue",
+				Handler: docno.IssueDocNoHandler(serverCtx),
+			},
+			{
+				// 释放未使用的发文字号
+				Method:  http.MethodPost,
+				Path:    "/docnos/release",
+				Handler: docno.ReleaseDocNoHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -120,4 +361,42 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/llmcenter/v1"),
 	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 为文档创建只读分享链接
+				Method:  http.MethodPost,
+				Path:    "/shares",
+				Handler: share.CreateShareHandler(serverCtx),
+			},
+			{
+				// 查询文档的分享链接及访问次数
+				Method:  http.MethodGet,
+				Path:    "/shares",
+				Handler: share.ListSharesHandler(serverCtx),
+			},
+			{
+				// 撤销分享链接
+				Method:  http.MethodPost,
+				Path:    "/shares/revoke",
+				Handler: share.RevokeShareHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 查询当前用户的大模型调用用量及配额
+				Method:  http.MethodGet,
+				Path:    "/usage/summary",
+				Handler: usage.GetUsageSummaryHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/llmcenter/v1"),
+	)
 }
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteUsageQuotaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除用量配额
+func NewDeleteUsageQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteUsageQuotaLogic {
+	return &DeleteUsageQuotaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteUsageQuotaLogic) DeleteUsageQuota(req *types.DeleteUsageQuotaRequest) (*types.DeleteUsageQuotaResponse, error) {
+	rpcResp, err := l.svcCtx.LLMCenterRpc.DeleteUsageQuota(l.ctx, &pb.DeleteUsageQuotaRequest{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DeleteUsageQuotaResponse{Success: rpcResp.Success}, nil
+}
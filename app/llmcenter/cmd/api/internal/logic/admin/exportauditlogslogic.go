@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportAuditLogsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 按条件将文档操作审计记录导出为 CSV
+func NewExportAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportAuditLogsLogic {
+	return &ExportAuditLogsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+type ExportAuditLogsResp struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+func (l *ExportAuditLogsLogic) ExportAuditLogs(req *types.ExportAuditLogsRequest) (*ExportAuditLogsResp, error) {
+	rpcResp, err := l.svcCtx.LLMCenterRpc.ExportAuditLogs(l.ctx, &pb.ExportAuditLogsRequest{
+		Query: &pb.AuditLogQuery{
+			UserId:         req.UserID,
+			ConversationId: req.ConversationID,
+			Action:         req.Action,
+			StartTime:      req.StartTime,
+			EndTime:        req.EndTime,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportAuditLogsResp{
+		Filename:    rpcResp.Filename,
+		ContentType: rpcResp.ContentType,
+		Data:        rpcResp.Data,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/config"
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/llmcenter"
+	"document_agent/pkg/health"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDiagnosticsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查看 API 与 RPC 服务的构建版本、依赖检查结果与配置问题
+func NewGetDiagnosticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDiagnosticsLogic {
+	return &GetDiagnosticsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetDiagnosticsLogic) GetDiagnostics(req *types.DiagnosticsRequest) (*types.DiagnosticsResponse, error) {
+	build := health.ReadBuildInfo()
+	report := l.svcCtx.Health.Run(l.ctx)
+	api := types.ServiceDiagnostics{
+		Name:         l.svcCtx.Config.Name,
+		GoVersion:    build.GoVersion,
+		Revision:     build.Revision,
+		BuildTime:    build.Time,
+		Status:       report.Status,
+		Checks:       make([]types.HealthCheck, 0, len(report.Checks)),
+		ConfigIssues: []types.ConfigIssue{},
+	}
+	for _, r := range report.Checks {
+		api.Checks = append(api.Checks, types.HealthCheck{
+			Name:       r.Name,
+			Status:     r.Status,
+			Detail:     r.Detail,
+			Error:      r.Error,
+			DurationMs: r.DurationMs,
+		})
+	}
+	for _, issue := range configIssues(l.svcCtx.Config) {
+		api.ConfigIssues = append(api.ConfigIssues, types.ConfigIssue{Field: issue.Field, Message: issue.Message})
+	}
+
+	// RPC 不可用时仍返回 API 自身的诊断信息，便于定位
+	rpc := types.ServiceDiagnostics{Name: "llmcenter-rpc", Checks: []types.HealthCheck{}, ConfigIssues: []types.ConfigIssue{}}
+	resp, err := l.svcCtx.LLMCenterRpc.GetDiagnostics(l.ctx, &llmcenter.GetDiagnosticsRequest{})
+	if err != nil {
+		l.Errorf("GetDiagnostics rpc err:%v", err)
+		rpc.Status, rpc.Error = health.StatusFail, err.Error()
+	} else {
+		rpc.Name = resp.Name
+		rpc.GoVersion, rpc.Revision, rpc.BuildTime, rpc.Status = resp.GoVersion, resp.Revision, resp.BuildTime, resp.Status
+		for _, c := range resp.Checks {
+			rpc.Checks = append(rpc.Checks, types.HealthCheck{
+				Name:       c.Name,
+				Status:     c.Status,
+				Detail:     c.Detail,
+				Error:      c.Error,
+				DurationMs: c.DurationMs,
+			})
+		}
+		for _, issue := range resp.ConfigIssues {
+			rpc.ConfigIssues = append(rpc.ConfigIssues, types.ConfigIssue{Field: issue.Field, Message: issue.Message})
+		}
+	}
+
+	return &types.DiagnosticsResponse{Services: []types.ServiceDiagnostics{api, rpc}}, nil
+}
+
+// configIssues 检查启动时不会报错、但会在调用时才失败的配置
+func configIssues(c config.Config) []health.Issue {
+	var issues []health.Issue
+	if len(c.Auth.AccessSecret) < 32 {
+		issues = append(issues, health.Issue{Field: "Auth.AccessSecret", Message: "shorter than 32 bytes, tokens are easy to forge"})
+	}
+	if c.PublicDownload.SignKey == "" {
+		issues = append(issues, health.Issue{Field: "PublicDownload.SignKey", Message: "empty, download links can be forged"})
+	}
+	if c.FileCleaner.Enable && c.FileCleaner.UseEtcdLock && len(c.Etcd.Hosts) == 0 {
+		issues = append(issues, health.Issue{Field: "FileCleaner.UseEtcdLock", Message: "enabled but Etcd.Hosts is empty"})
+	}
+	if c.FileCleaner.Enable && c.FileCleaner.DryRun {
+		issues = append(issues, health.Issue{Field: "FileCleaner.DryRun", Message: "enabled, expired files are only reported and never deleted"})
+	}
+	return issues
+}
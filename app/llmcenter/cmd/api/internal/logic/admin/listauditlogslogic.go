@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAuditLogsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 按用户、会话、操作类型和时间范围分页查询文档操作审计记录
+func NewListAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuditLogsLogic {
+	return &ListAuditLogsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListAuditLogsLogic) ListAuditLogs(req *types.ListAuditLogsRequest) (*types.ListAuditLogsResponse, error) {
+	rpcResp, err := l.svcCtx.LLMCenterRpc.ListAuditLogs(l.ctx, &pb.ListAuditLogsRequest{
+		Query: &pb.AuditLogQuery{
+			UserId:         req.UserID,
+			ConversationId: req.ConversationID,
+			Action:         req.Action,
+			StartTime:      req.StartTime,
+			EndTime:        req.EndTime,
+		},
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.AuditLog, 0, len(rpcResp.Items))
+	for _, it := range rpcResp.Items {
+		items = append(items, types.AuditLog{
+			ID:             it.Id,
+			UserID:         it.UserId,
+			Action:         it.Action,
+			ConversationID: it.ConversationId,
+			TargetID:       it.TargetId,
+			ClientIP:       it.ClientIp,
+			UserAgent:      it.UserAgent,
+			HashBefore:     it.HashBefore,
+			HashAfter:      it.HashAfter,
+			Detail:         it.Detail,
+			CreatedAt:      it.CreatedAt,
+		})
+	}
+
+	return &types.ListAuditLogsResponse{Total: rpcResp.Total, Items: items}, nil
+}
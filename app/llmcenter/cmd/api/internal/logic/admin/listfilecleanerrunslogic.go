@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/pkg/xerr"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFileCleanerRunsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询上传文件清理的最近运行记录
+func NewListFileCleanerRunsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFileCleanerRunsLogic {
+	return &ListFileCleanerRunsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFileCleanerRunsLogic) ListFileCleanerRuns(req *types.ListFileCleanerRunsRequest) (*types.ListFileCleanerRunsResponse, error) {
+	runs, err := l.svcCtx.FileCleaner.RecentRuns(l.ctx, int(req.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("load file cleaner runs err:%v: %w", err, xerr.ErrServerCommon)
+	}
+
+	items := make([]types.FileCleanerRun, 0, len(runs))
+	_ = copier.Copy(&items, runs)
+
+	cfg := l.svcCtx.FileCleaner.Config()
+	return &types.ListFileCleanerRunsResponse{
+		Enabled: cfg.Enable,
+		DryRun:  cfg.DryRun,
+		Runs:    items,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUsageQuotasLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询全部用量配额
+func NewListUsageQuotasLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUsageQuotasLogic {
+	return &ListUsageQuotasLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListUsageQuotasLogic) ListUsageQuotas(req *types.ListUsageQuotasRequest) (*types.ListUsageQuotasResponse, error) {
+	rpcResp, err := l.svcCtx.LLMCenterRpc.ListUsageQuotas(l.ctx, &pb.ListUsageQuotasRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.UsageQuota, 0, len(rpcResp.Items))
+	for _, it := range rpcResp.Items {
+		items = append(items, toUsageQuota(it))
+	}
+	return &types.ListUsageQuotasResponse{Items: items}, nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetUsageQuotaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 新增或覆盖用户级、角色级用量配额
+func NewSetUsageQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUsageQuotaLogic {
+	return &SetUsageQuotaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetUsageQuotaLogic) SetUsageQuota(req *types.SetUsageQuotaRequest) (*types.SetUsageQuotaResponse, error) {
+	rpcResp, err := l.svcCtx.LLMCenterRpc.SetUsageQuota(l.ctx, &pb.SetUsageQuotaRequest{
+		Quota: &pb.UsageQuota{
+			SubjectType:     req.Quota.SubjectType,
+			Subject:         req.Quota.Subject,
+			DailyRequests:   req.Quota.DailyRequests,
+			MonthlyRequests: req.Quota.MonthlyRequests,
+			DailyChars:      req.Quota.DailyChars,
+			MonthlyChars:    req.Quota.MonthlyChars,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetUsageQuotaResponse{Quota: toUsageQuota(rpcResp.Quota)}, nil
+}
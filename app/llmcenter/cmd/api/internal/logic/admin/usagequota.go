@@ -0,0 +1,22 @@
+package admin
+
+import (
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+)
+
+func toUsageQuota(q *pb.UsageQuota) types.UsageQuota {
+	if q == nil {
+		return types.UsageQuota{}
+	}
+	return types.UsageQuota{
+		ID:              q.Id,
+		SubjectType:     q.SubjectType,
+		Subject:         q.Subject,
+		DailyRequests:   q.DailyRequests,
+		MonthlyRequests: q.MonthlyRequests,
+		DailyChars:      q.DailyChars,
+		MonthlyChars:    q.MonthlyChars,
+		UpdatedAt:       q.UpdatedAt,
+	}
+}
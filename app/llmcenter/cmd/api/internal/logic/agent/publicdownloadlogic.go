@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"mime"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/pkg/audit"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -35,21 +37,14 @@ func NewPublicDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Pu
 }
 
 func (l *PublicDownloadLogic) PublicDownload(w http.ResponseWriter, r *http.Request, pathParam string, exp int64, sig string) error {
-	// 1) 过期校验
-	if time.Now().Unix() > exp {
-		http.Error(w, "link expired", http.StatusForbidden)
-		return nil
-	}
-
-	// 2) 签名校验
+	// 1) 过期与签名校验
 	toSign := fmt.Sprintf("%s|%d", pathParam, exp)
-	want := signHMAC(toSign, l.svcCtx.Config.PublicDownload.SignKey)
-	if !hmac.Equal([]byte(want), []byte(sig)) {
-		http.Error(w, "invalid signature", http.StatusForbidden)
+	if err := verifySignedLink(toSign, exp, sig, l.svcCtx.Config.PublicDownload.SignKey); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
 		return nil
 	}
 
-	// 3) 路径清理 + 防目录穿越（只允许纯文件名）
+	// 2) 路径清理 + 防目录穿越（只允许纯文件名）
 	name := filepath.Base(filepath.Clean(pathParam))
 	if name != pathParam || strings.ContainsAny(name, `/\`) {
 		http.Error(w, "invalid path", http.StatusBadRequest)
@@ -63,6 +58,17 @@ func (l *PublicDownloadLogic) PublicDownload(w http.ResponseWriter, r *http.Requ
 	}
 	defer f.Close()
 
+	// 3) 记录审计日志（匿名访问，目标为文件名，摘要取导出时记录的值）
+	hash, err := l.svcCtx.Auditor.ExportHash(l.ctx, name)
+	if err != nil {
+		l.Errorf("query export hash failed, name: %s, err: %v", name, err)
+	}
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		Action:    audit.ActionPublicDownload,
+		TargetId:  name,
+		HashAfter: hash,
+	})
+
 	// 4) Content-Type & 强制下载
 	ext := filepath.Ext(name)
 	ctype := mime.TypeByExtension(ext)
@@ -84,6 +90,24 @@ func (l *PublicDownloadLogic) PublicDownload(w http.ResponseWriter, r *http.Requ
 	return nil
 }
 
+// 公开链接校验失败的原因
+var (
+	errLinkExpired      = errors.New("link expired")
+	errInvalidSignature = errors.New("invalid signature")
+)
+
+// verifySignedLink 校验公开链接（下载链接、分享链接）的过期时间与签名，data 为签名内容
+func verifySignedLink(data string, exp int64, sig, key string) error {
+	if time.Now().Unix() > exp {
+		return errLinkExpired
+	}
+	want := signHMAC(data, key)
+	if !hmac.Equal([]byte(want), []byte(sig)) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
 func signHMAC(data, key string) string {
 	mac := hmac.New(sha256.New, []byte(key))
 	mac.Write([]byte(data))
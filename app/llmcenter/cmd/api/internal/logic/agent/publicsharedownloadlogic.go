@@ -0,0 +1,71 @@
+package agent
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+type PublicShareDownloadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 通过分享链接下载 DOCX/PDF
+func NewPublicShareDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublicShareDownloadLogic {
+	return &PublicShareDownloadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PublicShareDownloadLogic) PublicShareDownload(w http.ResponseWriter, r *http.Request, req *types.PublicShareDownloadRequest) error {
+	// 1) 校验 type 与链接签名
+	t := strings.ToLower(strings.TrimSpace(req.Type))
+	if t != "pdf" && t != "docx" {
+		http.Error(w, "type must be pdf or docx", http.StatusBadRequest)
+		return nil
+	}
+	if err := verifySignedLink(shareSignData(req.Token, req.Exp), req.Exp, req.Sig, l.svcCtx.Config.PublicDownload.SignKey); err != nil {
+		writeShareError(w, err)
+		return nil
+	}
+
+	// 2) 实时导出，有访问密码的分享使用分享页保存的凭证
+	resp, err := l.svcCtx.LLMCenterRpc.OpenDocumentShare(l.ctx, &pb.OpenDocumentShareRequest{
+		Token:  req.Token,
+		Format: t,
+		Unlock: shareUnlock(r, req.Token),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if int(st.Code()) == xerr.Code(xerr.ErrSharePasswordRequired) {
+			// 凭证缺失或失效时回到分享页输入密码
+			http.Redirect(w, r, sharePagePath+"?"+shareLinkQuery(req.Token, req.Exp, req.Sig).Encode(), http.StatusSeeOther)
+			return nil
+		}
+		l.Infof("download share failed: %v", err)
+		writeShareError(w, err)
+		return nil
+	}
+
+	// 3) 强制下载
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Data)))
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(resp.Data)
+	return nil
+}
@@ -0,0 +1,84 @@
+package agent
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+type PublicShareLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 文档分享页（免登录，签名校验），返回只读 HTML 页面
+func NewPublicShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublicShareLogic {
+	return &PublicShareLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PublicShare 查看分享页；访问密码只从 POST 表单提交，不从链接参数读取
+func (l *PublicShareLogic) PublicShare(w http.ResponseWriter, r *http.Request, req *types.PublicShareRequest) error {
+	return l.render(w, r, req, "")
+}
+
+func (l *PublicShareLogic) render(w http.ResponseWriter, r *http.Request, req *types.PublicShareRequest, password string) error {
+	// 1) 过期与签名校验
+	if err := verifySignedLink(shareSignData(req.Token, req.Exp), req.Exp, req.Sig, l.svcCtx.Config.PublicDownload.SignKey); err != nil {
+		writeShareError(w, err)
+		return nil
+	}
+
+	// 2) 由 RPC 校验分享状态与访问密码并渲染文档
+	resp, err := l.svcCtx.LLMCenterRpc.OpenDocumentShare(l.ctx, &pb.OpenDocumentShareRequest{
+		Token:    req.Token,
+		Format:   "html",
+		Password: password,
+		Unlock:   shareUnlock(r, req.Token),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch int(st.Code()) {
+		case xerr.Code(xerr.ErrSharePasswordRequired):
+			writeSharePage(w, http.StatusOK, sharePage{Password: true})
+		case xerr.Code(xerr.ErrSharePasswordIncorrect):
+			writeSharePage(w, http.StatusForbidden, sharePage{Password: true, Message: st.Message()})
+		default:
+			l.Infof("open share failed: %v", err)
+			writeShareError(w, err)
+		}
+		return nil
+	}
+	if resp.Unlock != "" {
+		setShareUnlock(w, r, req.Token, resp.Unlock, req.Exp)
+	}
+
+	// 3) 输出页面，允许下载时附带 DOCX/PDF 下载地址
+	page := sharePage{
+		Title:    resp.Title,
+		Body:     template.HTML(resp.Html),
+		ExpireAt: time.Unix(resp.ExpireAt, 0).Format("2006-01-02 15:04"),
+	}
+	if resp.AllowDownload {
+		q := shareLinkQuery(req.Token, req.Exp, req.Sig)
+		q.Set("type", "docx")
+		page.DocxURL = "share/download?" + q.Encode()
+		q.Set("type", "pdf")
+		page.PdfURL = "share/download?" + q.Encode()
+	}
+	writeSharePage(w, http.StatusOK, page)
+	return nil
+}
@@ -0,0 +1,31 @@
+package agent
+
+import (
+	"context"
+	"net/http"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublicShareUnlockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 提交分享链接的访问密码
+func NewPublicShareUnlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublicShareUnlockLogic {
+	return &PublicShareUnlockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PublicShareUnlock 校验访问密码，通过后保存凭证并直接显示分享页
+func (l *PublicShareUnlockLogic) PublicShareUnlock(w http.ResponseWriter, r *http.Request, req *types.PublicShareRequest) error {
+	return NewPublicShareLogic(l.ctx, l.svcCtx).render(w, r, req, req.Password)
+}
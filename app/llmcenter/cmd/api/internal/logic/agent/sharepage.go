@@ -0,0 +1,145 @@
+package agent
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+
+	"document_agent/pkg/ratelimit"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+// shareSignData 分享链接的签名内容，与 RPC 端生成分享链接时的格式一致
+func shareSignData(token string, exp int64) string {
+	return fmt.Sprintf("share:%s|%d", token, exp)
+}
+
+// shareUnlockCookie 访问密码校验通过后保存凭证的 Cookie，按分享令牌区分，作用于分享页及其下载地址
+func shareUnlockCookie(token string) string {
+	return "share_" + token
+}
+
+// sharePagePath 分享页地址，同时作为访问密码凭证 Cookie 的作用路径
+const sharePagePath = "/llmcenter/v1/public/share"
+
+// shareContentSecurityPolicy 分享页不加载任何脚本，文档内容中的链接与事件属性即使被渲染也无法执行
+const shareContentSecurityPolicy = "default-src 'none'; style-src 'unsafe-inline'; img-src data: https:; form-action 'self'; base-uri 'none'; frame-ancestors 'none'"
+
+// sharePage 分享页模板数据
+type sharePage struct {
+	Title     string
+	Body      template.HTML // pandoc 渲染结果，RPC 端已禁用原始 HTML
+	ExpireAt  string
+	DocxURL   string
+	PdfURL    string
+	Password  bool   // 显示访问密码表单
+	Message   string // 错误提示
+	ErrorOnly bool   // 只显示错误提示
+}
+
+var sharePageTmpl = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex, nofollow">
+<title>{{if .Title}}{{.Title}}{{else}}文档分享{{end}}</title>
+<style>
+body{margin:0;background:#f4f4f4;color:#222;font-family:"FangSong","STFangsong","仿宋",serif;line-height:1.8}
+main{max-width:800px;margin:0 auto;padding:24px 20px;background:#fff;min-height:100vh;box-sizing:border-box}
+article{font-size:17px;word-break:break-word}
+article table{border-collapse:collapse;width:100%;overflow-x:auto;display:block}
+article td,article th{border:1px solid #999;padding:4px 8px}
+article img{max-width:100%}
+[data-align=center]{text-align:center}
+[data-align=right]{text-align:right}
+.bar{display:flex;flex-wrap:wrap;gap:12px;align-items:center;margin-bottom:16px;padding-bottom:12px;border-bottom:1px solid #ddd;font-family:sans-serif;font-size:14px;color:#666}
+.bar a{color:#fff;background:#b3261e;padding:6px 14px;border-radius:4px;text-decoration:none}
+.msg{font-family:sans-serif;color:#b3261e;margin:16px 0}
+form{font-family:sans-serif;display:flex;gap:8px;flex-wrap:wrap}
+input[type=password]{flex:1;min-width:0;padding:8px;font-size:16px;border:1px solid #ccc;border-radius:4px}
+button{padding:8px 16px;font-size:16px;border:0;border-radius:4px;background:#b3261e;color:#fff}
+</style>
+</head>
+<body>
+<main>
+{{if .ErrorOnly}}<p class="msg">{{.Message}}</p>
+{{else if .Password}}<p>该文档需要访问密码</p>
+{{if .Message}}<p class="msg">{{.Message}}</p>{{end}}
+<form method="post"><input type="password" name="password" autocomplete="off" autofocus required><button type="submit">查看</button></form>
+{{else}}<div class="bar"><span>只读分享，有效期至 {{.ExpireAt}}</span>{{if .DocxURL}}<a href="{{.DocxURL}}">下载 DOCX</a><a href="{{.PdfURL}}">下载 PDF</a>{{end}}</div>
+<article>{{.Body}}</article>
+{{end}}</main>
+</body>
+</html>
+`))
+
+// writeSharePage 输出分享页，禁止缓存，避免撤销后仍能从缓存中查看
+func writeSharePage(w http.ResponseWriter, code int, page sharePage) {
+	h := w.Header()
+	h.Set("Content-Type", "text/html; charset=utf-8")
+	h.Set("Content-Security-Policy", shareContentSecurityPolicy)
+	h.Set("Cache-Control", "no-store")
+	h.Set("Referrer-Policy", "no-referrer")
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	if err := sharePageTmpl.Execute(w, page); err != nil {
+		logx.Errorf("render share page err: %v", err)
+	}
+}
+
+// writeShareError 将链接校验或 RPC 返回的错误转换为对应的 HTTP 状态与提示页
+func writeShareError(w http.ResponseWriter, err error) {
+	code, msg := http.StatusInternalServerError, "服务暂时不可用，请稍后再试"
+	switch err {
+	case errLinkExpired:
+		code, msg = http.StatusGone, "分享链接已过期"
+	case errInvalidSignature:
+		code, msg = http.StatusForbidden, "分享链接无效"
+	default:
+		st, _ := status.FromError(err)
+		switch int(st.Code()) {
+		case xerr.Code(xerr.ErrShareNotFound):
+			code, msg = http.StatusNotFound, st.Message()
+		case xerr.Code(xerr.ErrShareExpired), xerr.Code(xerr.ErrShareRevoked):
+			code, msg = http.StatusGone, st.Message()
+		case xerr.Code(xerr.ErrShareDownloadDisabled):
+			code, msg = http.StatusForbidden, st.Message()
+		case xerr.Code(xerr.ErrSharePasswordLocked), xerr.Code(xerr.ErrShareTooFrequent):
+			code, msg = http.StatusTooManyRequests, st.Message()
+			ratelimit.SetRetryAfter(w, err)
+		}
+	}
+	writeSharePage(w, code, sharePage{Message: msg, ErrorOnly: true})
+}
+
+// shareLinkQuery 分享链接的查询参数，下载地址在此基础上追加 type
+func shareLinkQuery(token string, exp int64, sig string) url.Values {
+	return url.Values{"token": {token}, "exp": {fmt.Sprint(exp)}, "sig": {sig}}
+}
+
+// shareUnlock 读取之前保存的访问密码凭证
+func shareUnlock(r *http.Request, token string) string {
+	if c, err := r.Cookie(shareUnlockCookie(token)); err == nil {
+		return c.Value
+	}
+	return ""
+}
+
+// setShareUnlock 保存访问密码凭证，有效期与分享链接一致
+func setShareUnlock(w http.ResponseWriter, r *http.Request, token, unlock string, exp int64) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     shareUnlockCookie(token),
+		Value:    unlock,
+		Path:     sharePagePath,
+		Expires:  time.Unix(exp, 0),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
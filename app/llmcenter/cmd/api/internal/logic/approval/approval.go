@@ -0,0 +1,62 @@
+package approval
+
+import (
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+)
+
+func toDocumentApproval(a *pb.DocumentApproval) types.DocumentApproval {
+	if a == nil {
+		return types.DocumentApproval{Actions: []types.ApprovalAction{}, Signers: []types.ApprovalSigner{}}
+	}
+	item := types.DocumentApproval{
+		MessageID:      a.MessageId,
+		ConversationID: a.ConversationId,
+		State:          a.State,
+		StateLabel:     a.StateLabel,
+		Locked:         a.Locked,
+		AssigneeID:     a.AssigneeId,
+		SubmitterID:    a.SubmitterId,
+		Version:        a.Version,
+		UpdatedAt:      a.UpdatedAt,
+		Actions:        make([]types.ApprovalAction, 0, len(a.Actions)),
+		Signers:        make([]types.ApprovalSigner, 0, len(a.Signers)),
+	}
+	for _, act := range a.Actions {
+		item.Actions = append(item.Actions, types.ApprovalAction{
+			Action:          act.Action,
+			Label:           act.Label,
+			ToState:         act.ToState,
+			NeedAssignee:    act.NeedAssignee,
+			CommentRequired: act.CommentRequired,
+		})
+	}
+	for _, s := range a.Signers {
+		item.Signers = append(item.Signers, types.ApprovalSigner{
+			Label:    s.Label,
+			UserID:   s.UserId,
+			UserName: s.UserName,
+			SignedAt: s.SignedAt,
+		})
+	}
+	return item
+}
+
+func toApprovalRecords(list []*pb.ApprovalRecord) []types.ApprovalRecord {
+	items := make([]types.ApprovalRecord, 0, len(list))
+	for _, r := range list {
+		items = append(items, types.ApprovalRecord{
+			ID:          r.Id,
+			Action:      r.Action,
+			ActionLabel: r.ActionLabel,
+			FromState:   r.FromState,
+			ToState:     r.ToState,
+			UserID:      r.UserId,
+			UserName:    r.UserName,
+			AssigneeID:  r.AssigneeId,
+			Comment:     r.Comment,
+			CreatedAt:   r.CreatedAt,
+		})
+	}
+	return items
+}
@@ -0,0 +1,45 @@
+package approval
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetApprovalLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询文档的审批状态、可执行的操作与流转记录
+func NewGetApprovalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetApprovalLogic {
+	return &GetApprovalLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetApprovalLogic) GetApproval(req *types.GetApprovalRequest) (*types.GetApprovalResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.GetDocumentApproval(l.ctx, &pb.GetDocumentApprovalRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetApprovalResponse{Approval: toDocumentApproval(resp.Approval), History: toApprovalRecords(resp.History)}, nil
+}
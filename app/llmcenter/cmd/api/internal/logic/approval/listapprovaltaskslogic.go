@@ -0,0 +1,48 @@
+package approval
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListApprovalTasksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询等待当前用户审批的文档
+func NewListApprovalTasksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListApprovalTasksLogic {
+	return &ListApprovalTasksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListApprovalTasksLogic) ListApprovalTasks(req *types.ListApprovalTasksRequest) (*types.ListApprovalTasksResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ListApprovalTasks(l.ctx, &pb.ListApprovalTasksRequest{
+		UserId: userID,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.DocumentApproval, 0, len(resp.Items))
+	for _, a := range resp.Items {
+		items = append(items, toDocumentApproval(a))
+	}
+	return &types.ListApprovalTasksResponse{Items: items}, nil
+}
@@ -0,0 +1,49 @@
+package approval
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TransitionApprovalLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 执行审批流转: 提交、撤回、核稿通过、退回、签发等
+func NewTransitionApprovalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransitionApprovalLogic {
+	return &TransitionApprovalLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *TransitionApprovalLogic) TransitionApproval(req *types.TransitionApprovalRequest) (*types.TransitionApprovalResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.TransitionDocumentApproval(l.ctx, &pb.TransitionDocumentApprovalRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+		Action:         req.Action,
+		AssigneeId:     req.AssigneeID,
+		Comment:        req.Comment,
+		Version:        req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TransitionApprovalResponse{Approval: toDocumentApproval(resp.Approval)}, nil
+}
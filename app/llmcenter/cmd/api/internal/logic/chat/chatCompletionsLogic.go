@@ -11,6 +11,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/tracing"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/status"
@@ -23,8 +24,9 @@ type ChatCompletionsLogic struct {
 	// NOTE: 在 go-zero 的 http handler 中，直接操作 http.ResponseWriter 和 http.Request
 	// 会更方便，因此我们将其添加到 Logic 结构体中。
 	// 你需要在 handler 层将这两个对象传递过来。
-	w http.ResponseWriter
-	r *http.Request
+	w         http.ResponseWriter
+	r         *http.Request
+	firstSent bool // 是否已推送首个内容块，用于在请求 span 上标记首包时间
 }
 
 // NewChatCompletionsLogic 创建一个新的聊天逻辑实例
@@ -71,8 +73,12 @@ func (l *ChatCompletionsLogic) ChatCompletions(req *types.ChatCompletionsRequest
 		UseKnowledgeBase: req.UseKnowledgeBase,
 		KnowledgeBaseId:  req.KnowledgeBaseID,
 		References:       rpcReferences,
+		WorkspaceId:      req.WorkspaceID,
 	}
 
+	// 会话属性记录在当前请求的 span 上，trace 上下文随 zrpc 流传递到 RPC
+	tracing.SetConversation(l.ctx, req.ConversationID, req.Documenttype)
+
 	// --- 3. 调用 RPC 层的流式方法 ---
 	stream, err := l.svcCtx.LLMCenterRpc.ChatCompletions(l.ctx, rpcReq)
 	if err != nil {
@@ -163,6 +169,11 @@ func (l *ChatCompletionsLogic) ChatCompletions(req *types.ChatCompletionsRequest
 
 // sendSSE 是一个辅助函数，用于格式化并发送 SSE 事件
 func (l *ChatCompletionsLogic) sendSSE(event string, data interface{}) error {
+	if event == "message" && !l.firstSent {
+		l.firstSent = true
+		tracing.AddEvent(l.ctx, "sse.first_message")
+	}
+
 	// 将数据结构序列化为 JSON
 	jsonData, err := json.Marshal(data)
 	if err != nil {
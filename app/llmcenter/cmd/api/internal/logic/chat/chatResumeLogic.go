@@ -11,6 +11,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/tracing"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/status"
@@ -18,10 +19,11 @@ import (
 
 type ChatResumeLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
-	w      http.ResponseWriter
-	r      *http.Request
+	ctx       context.Context
+	svcCtx    *svc.ServiceContext
+	w         http.ResponseWriter
+	r         *http.Request
+	firstSent bool // 是否已推送首个内容块，用于在请求 span 上标记首包时间
 }
 
 // [建议] 修改 handler 层，在创建 Logic 实例时传入 w 和 r
@@ -64,8 +66,12 @@ func (l *ChatResumeLogic) ChatResume(req *types.ChatResumeRequest) error {
 		TemplateId:     req.TemplateID,
 		Documenttype:   req.Documenttype, // ✅ 新增
 		References:     pbRefs,           // ✅ 新增
+		FormatCheck:    req.FormatCheck,
 	}
 
+	// 会话属性记录在当前请求的 span 上，trace 上下文随 zrpc 流传递到 RPC
+	tracing.SetConversation(l.ctx, req.ConversationID, req.Documenttype)
+
 	// 3. 调用 RPC 层的流式方法（保持不变）
 	stream, err := l.svcCtx.LLMCenterRpc.ChatResume(l.r.Context(), rpcReq)
 	if err != nil {
@@ -109,6 +115,11 @@ func (l *ChatResumeLogic) ChatResume(req *types.ChatResumeRequest) error {
 				l.Errorf("Failed to send message event: %v", err)
 				return nil
 			}
+		case *pb.ChatResumeResponse_Check:
+			if err := l.sendSSE("check", event.Check); err != nil {
+				l.Errorf("Failed to send check event: %v", err)
+				return nil
+			}
 		case *pb.ChatResumeResponse_End:
 			if err := l.sendSSE("end", event.End); err != nil {
 				l.Errorf("Failed to send end event: %v", err)
@@ -124,6 +135,11 @@ func (l *ChatResumeLogic) ChatResume(req *types.ChatResumeRequest) error {
 // sendSSE 是一个辅助函数，用于格式化并发送 SSE 事件
 // [建议] 你可以将这个函数提取到一个公共的 `sse` 包中，与 ChatCompletionsLogic 共享。
 func (l *ChatResumeLogic) sendSSE(event string, data interface{}) error {
+	if event == "message" && !l.firstSent {
+		l.firstSent = true
+		tracing.AddEvent(l.ctx, "sse.first_message")
+	}
+
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("failed to marshal data for SSE: %w", err)
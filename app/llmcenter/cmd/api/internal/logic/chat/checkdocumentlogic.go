@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckDocumentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 按 GB/T 9704 检查公文格式, 返回问题位置与修改建议
+func NewCheckDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckDocumentLogic {
+	return &CheckDocumentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CheckDocumentLogic) CheckDocument(req *types.CheckDocumentRequest) (*types.CheckDocumentResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.LLMCenterRpc.CheckDocument(l.ctx, &pb.CheckDocumentRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		l.Logger.Errorf("调用 CheckDocument RPC 失败: %v", err)
+		return nil, err
+	}
+
+	findings := make([]types.FormatFinding, 0, len(rpcResp.Findings))
+	for _, f := range rpcResp.Findings {
+		findings = append(findings, types.FormatFinding{
+			Rule:       f.Rule,
+			Severity:   f.Severity,
+			Line:       f.Line,
+			Column:     f.Column,
+			Excerpt:    f.Excerpt,
+			Message:    f.Message,
+			Suggestion: f.Suggestion,
+		})
+	}
+
+	return &types.CheckDocumentResponse{
+		MessageID:    rpcResp.MessageId,
+		Passed:       rpcResp.Passed,
+		ErrorCount:   rpcResp.ErrorCount,
+		WarningCount: rpcResp.WarningCount,
+		InfoCount:    rpcResp.InfoCount,
+		Findings:     findings,
+	}, nil
+}
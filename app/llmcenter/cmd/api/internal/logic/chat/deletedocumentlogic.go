@@ -0,0 +1,45 @@
+package chat
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteDocumentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除指定文档
+func NewDeleteDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteDocumentLogic {
+	return &DeleteDocumentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteDocumentLogic) DeleteDocument(req *types.DeleteDocumentRequest) (*types.DeleteDocumentResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.DeleteDocument(l.ctx, &pb.DeleteDocumentRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DeleteDocumentResponse{Success: resp.Success}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -27,6 +28,7 @@ type DownloadFileResp struct {
 	Filename    string
 	ContentType string
 	Data        []byte
+	DocNo       string // 从发文字号登记中分配的字号，未分配时为空
 }
 
 func (l *DownloadFileLogic) DownloadFile(req *types.DownloadFileRequest) (*DownloadFileResp, error) {
@@ -53,11 +55,21 @@ func (l *DownloadFileLogic) DownloadFile(req *types.DownloadFileRequest) (*Downl
 		}
 	}
 
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 4) 调 RPC（新版字段：Prompt / Type / Information）
 	rpcResp, err := l.svcCtx.LLMCenterRpc.ConvertMarkdown(l.ctx, &pb.ConvertMarkdownRequest{
-		Markdown:    req.Prompt, // ✅ 新字段
-		Type:        t,
-		Information: infos, // ✅ 新字段
+		Markdown:         req.Prompt, // ✅ 新字段
+		Type:             t,
+		Information:      infos, // ✅ 新字段
+		UserId:           userID,
+		ConversationId:   req.ConversationID,
+		MessageId:        req.MessageID,
+		DocNoWorkspaceId: req.DocNoWorkspaceID,
+		DocNoPrefix:      req.DocNoPrefix,
 		// Markdown: 仍可不传；RPC 端已兼容 prompt 优先、fallback markdown
 	})
 	if err != nil {
@@ -68,5 +80,6 @@ func (l *DownloadFileLogic) DownloadFile(req *types.DownloadFileRequest) (*Downl
 		Filename:    rpcResp.Filename,
 		ContentType: rpcResp.ContentType,
 		Data:        rpcResp.Data,
+		DocNo:       rpcResp.DocNo,
 	}, nil
 }
@@ -11,6 +11,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/tracing"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/status"
@@ -18,10 +19,11 @@ import (
 
 type EditDocumentLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
-	w      http.ResponseWriter
-	r      *http.Request
+	ctx       context.Context
+	svcCtx    *svc.ServiceContext
+	w         http.ResponseWriter
+	r         *http.Request
+	firstSent bool // 是否已推送首个内容块，用于在请求 span 上标记首包时间
 }
 
 func NewEditDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext, w http.ResponseWriter, r *http.Request) *EditDocumentLogic {
@@ -48,8 +50,13 @@ func (l *EditDocumentLogic) EditDocument(req *types.EditDocumentRequest) error {
 		Prompt:           req.Prompt,
 		UseKnowledgeBase: req.UseKnowledgeBase,
 		KnowledgeBaseId:  req.KnowledgeBaseID,
+		AddressComments:  req.AddressComments,
+		FormatCheck:      req.FormatCheck,
 	}
 
+	// 会话属性记录在当前请求的 span 上，trace 上下文随 zrpc 流传递到 RPC
+	tracing.SetConversation(l.ctx, req.ConversationID, "")
+
 	// ✅ 1) 用 l.r.Context()，让客户端断开能级联取消
 	stream, err := l.svcCtx.LLMCenterRpc.EditDocument(l.r.Context(), rpcReq)
 	if err != nil {
@@ -92,6 +99,8 @@ func (l *EditDocumentLogic) EditDocument(req *types.EditDocumentRequest) error {
 		switch event := resp.Event.(type) {
 		case *pb.EditDocumentResponse_Message:
 			_ = l.sendSSE("message", event.Message)
+		case *pb.EditDocumentResponse_Check:
+			_ = l.sendSSE("check", event.Check)
 		case *pb.EditDocumentResponse_End:
 			_ = l.sendSSE("end", event.End)
 			return nil
@@ -102,6 +111,11 @@ func (l *EditDocumentLogic) EditDocument(req *types.EditDocumentRequest) error {
 }
 
 func (l *EditDocumentLogic) sendSSE(event string, data interface{}) error {
+	if event == "message" && !l.firstSent {
+		l.firstSent = true
+		tracing.AddEvent(l.ctx, "sse.first_message")
+	}
+
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return err
@@ -3,11 +3,11 @@ package chat
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -28,14 +28,21 @@ func NewUpdateDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Up
 }
 
 func (l *UpdateDocumentLogic) UpdateDocument(req *types.UpdateDocumentRequest) (*types.UpdateDocumentResponse, error) {
-	_, err := l.svcCtx.LLMCenterRpc.UpdateDocument(l.ctx, &pb.UpdateDocumentRequest{
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = l.svcCtx.LLMCenterRpc.UpdateDocument(l.ctx, &pb.UpdateDocumentRequest{
+		UserId:         userID,
 		ConversationId: req.Conversation_id,
 		MessageId:      req.Message_id,
 		Prompt:         req.Prompt,
+		Base:           req.Base,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("调用 RPC 更新文档失败: %v, ConversationId: %s, MessageId: %s: %w",
-			err, req.Conversation_id, req.Message_id, http.StatusInternalServerError)
+		return nil, fmt.Errorf("调用 RPC 更新文档失败, ConversationId: %s, MessageId: %s: %w",
+			req.Conversation_id, req.Message_id, err)
 	}
 
 	return &types.UpdateDocumentResponse{Success: true}, nil
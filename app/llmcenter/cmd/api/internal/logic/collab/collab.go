@@ -0,0 +1,188 @@
+package collab
+
+import (
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ot"
+	"document_agent/pkg/xerr"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	collabTicketPrefix = "collab:ticket:"
+	collabTicketTTL    = 60 // 凭证有效期，单位秒
+)
+
+// collabTicket 连接凭证对应的用户与文档，建立连接时取出并删除
+type collabTicket struct {
+	UserID         int64  `json:"user_id"`
+	ConversationID string `json:"conversation_id"`
+	MessageID      string `json:"message_id"`
+}
+
+func collabTicketKey(ticket string) string {
+	return collabTicketPrefix + ticket
+}
+
+// WebSocket 上的 JSON 消息。操作使用 ot.js 的 TextOperation 格式，如 [3, "abc", -2]，
+// 服务端发送的消息与 RPC 的 CollabResponse 一一对应，另有 error 消息在连接关闭前说明原因
+
+type collabSelection struct {
+	Anchor int64 `json:"anchor"`
+	Head   int64 `json:"head"`
+}
+
+type collabUser struct {
+	ClientID  string           `json:"client_id"`
+	UserID    int64            `json:"user_id"`
+	UserName  string           `json:"user_name"`
+	CanWrite  bool             `json:"can_write"`
+	Selection *collabSelection `json:"selection"`
+}
+
+// collabClientMessage 客户端发送的消息
+type collabClientMessage struct {
+	Type      string           `json:"type"`      // operation | selection
+	Revision  int64            `json:"revision"`  // operation: 操作基于的版本
+	Operation ot.Operation     `json:"operation"` // operation
+	Selection *collabSelection `json:"selection"` // operation 提交后的光标（可选）或 selection 的光标
+}
+
+type collabInitMessage struct {
+	Type     string       `json:"type"` // init
+	ClientID string       `json:"client_id"`
+	Revision int64        `json:"revision"`
+	Content  string       `json:"content"`
+	CanWrite bool         `json:"can_write"`
+	Locked   bool         `json:"locked"`
+	Clients  []collabUser `json:"clients"`
+}
+
+type collabAckMessage struct {
+	Type     string `json:"type"` // ack
+	Revision int64  `json:"revision"`
+}
+
+type collabOperationMessage struct {
+	Type      string       `json:"type"` // operation
+	Revision  int64        `json:"revision"`
+	Operation ot.Operation `json:"operation"`
+	ClientID  string       `json:"client_id"` // 服务端修改时为空
+	UserID    int64        `json:"user_id"`
+	Source    string       `json:"source"` // collab | edit | update | sync
+}
+
+type collabPresenceMessage struct {
+	Type   string     `json:"type"` // presence
+	Client collabUser `json:"client"`
+	Left   bool       `json:"left"`
+}
+
+type collabStateMessage struct {
+	Type          string `json:"type"` // state
+	Locked        bool   `json:"locked"`
+	SavedRevision int64  `json:"saved_revision"`
+}
+
+type collabResetMessage struct {
+	Type     string `json:"type"` // reset
+	Revision int64  `json:"revision"`
+	Content  string `json:"content"`
+}
+
+type collabErrorMessage struct {
+	Type    string `json:"type"` // error
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// toCollabRequest 将客户端消息转换为 RPC 请求
+func toCollabRequest(msg *collabClientMessage) (*pb.CollabRequest, error) {
+	switch msg.Type {
+	case "operation":
+		return &pb.CollabRequest{Event: &pb.CollabRequest_Operation{Operation: &pb.CollabOperation{
+			Revision:  msg.Revision,
+			Ops:       toTextOps(msg.Operation),
+			Selection: toPbSelection(msg.Selection),
+		}}}, nil
+	case "selection":
+		if msg.Selection == nil {
+			return nil, fmt.Errorf("selection message without selection: %w", xerr.ErrRequestParam)
+		}
+		return &pb.CollabRequest{Event: &pb.CollabRequest_Selection{Selection: toPbSelection(msg.Selection)}}, nil
+	default:
+		return nil, fmt.Errorf("unknown message type %q: %w", msg.Type, xerr.ErrRequestParam)
+	}
+}
+
+// toCollabMessage 将 RPC 推送的消息转换为客户端消息
+func toCollabMessage(resp *pb.CollabResponse) any {
+	switch ev := resp.Event.(type) {
+	case *pb.CollabResponse_Init:
+		clients := make([]collabUser, 0, len(ev.Init.Clients))
+		for _, c := range ev.Init.Clients {
+			clients = append(clients, toCollabUser(c))
+		}
+		return collabInitMessage{Type: "init", ClientID: ev.Init.ClientId, Revision: ev.Init.Revision, Content: ev.Init.Content,
+			CanWrite: ev.Init.CanWrite, Locked: ev.Init.Locked, Clients: clients}
+	case *pb.CollabResponse_Ack:
+		return collabAckMessage{Type: "ack", Revision: ev.Ack.Revision}
+	case *pb.CollabResponse_Operation:
+		op := ev.Operation
+		return collabOperationMessage{Type: "operation", Revision: op.Revision, Operation: fromTextOps(op.Ops),
+			ClientID: op.ClientId, UserID: op.UserId, Source: op.Source}
+	case *pb.CollabResponse_Presence:
+		return collabPresenceMessage{Type: "presence", Client: toCollabUser(ev.Presence.Client), Left: ev.Presence.Left}
+	case *pb.CollabResponse_State:
+		return collabStateMessage{Type: "state", Locked: ev.State.Locked, SavedRevision: ev.State.SavedRevision}
+	case *pb.CollabResponse_Reset_:
+		return collabResetMessage{Type: "reset", Revision: ev.Reset_.Revision, Content: ev.Reset_.Content}
+	default:
+		return nil
+	}
+}
+
+// toCollabError RPC 返回的业务错误按错误码与提示发送，其他错误（gRPC 自身的状态码小于业务错误码）统一提示稍后重试
+func toCollabError(err error) collabErrorMessage {
+	msg := collabErrorMessage{Type: "error", Code: xerr.Code(xerr.ErrServerCommon), Message: "服务器开小差啦,稍后再来试一试"}
+	if st, ok := status.FromError(err); ok && int(st.Code()) > xerr.Code(xerr.ErrServerCommon) {
+		msg.Code, msg.Message = int(st.Code()), st.Message()
+	}
+	return msg
+}
+
+func toCollabUser(c *pb.CollabClient) collabUser {
+	if c == nil {
+		return collabUser{}
+	}
+	u := collabUser{ClientID: c.ClientId, UserID: c.UserId, UserName: c.UserName, CanWrite: c.CanWrite}
+	if c.Selection != nil {
+		u.Selection = &collabSelection{Anchor: c.Selection.Anchor, Head: c.Selection.Head}
+	}
+	return u
+}
+
+func toPbSelection(s *collabSelection) *pb.CollabSelection {
+	if s == nil {
+		return nil
+	}
+	return &pb.CollabSelection{Anchor: s.Anchor, Head: s.Head}
+}
+
+func toTextOps(o ot.Operation) []*pb.TextOp {
+	ops := make([]*pb.TextOp, 0, len(o))
+	for _, op := range o {
+		ops = append(ops, &pb.TextOp{Retain: int64(op.Retain), Insert: op.Insert, Delete: int64(op.Delete)})
+	}
+	return ops
+}
+
+func fromTextOps(ops []*pb.TextOp) ot.Operation {
+	var o ot.Operation
+	for _, op := range ops {
+		o = o.Retain(int(op.Retain)).Insert(op.Insert).Delete(int(op.Delete))
+	}
+	return o
+}
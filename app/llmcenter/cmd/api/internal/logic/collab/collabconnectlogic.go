@@ -0,0 +1,170 @@
+package collab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/xerr"
+
+	"github.com/gorilla/websocket"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	collabMaxMessageBytes = 1 << 20          // 单条客户端消息的最大字节数
+	collabWriteWait       = 10 * time.Second // 单条消息的写入超时
+	collabPongWait        = 60 * time.Second // 超过该时间未收到消息或 pong 时断开
+	collabPingPeriod      = 30 * time.Second
+)
+
+// collabUpgrader 跨域策略与 CORS 配置（"*"）一致，连接凭证由 JWT 接口签发，不依赖 Cookie
+var collabUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+type CollabConnectLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 建立协同编辑 WebSocket 连接
+func NewCollabConnectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollabConnectLogic {
+	return &CollabConnectLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CollabConnect 校验一次性凭证后升级为 WebSocket，并在 WebSocket 与 RPC 的 CollabDocument 双向流之间转发消息。
+// 连接只在本协程读取、在转发 RPC 消息的协程写入，心跳使用可以并发调用的 WriteControl
+func (l *CollabConnectLogic) CollabConnect(w http.ResponseWriter, r *http.Request, req *types.CollabConnectRequest) error {
+	// 1) 取出并删除凭证，凭证只能使用一次
+	raw, err := l.svcCtx.Redis.GetDelCtx(l.ctx, collabTicketKey(req.Ticket))
+	if err != nil {
+		return fmt.Errorf("get collab ticket: %v: %w", err, xerr.ErrServerCommon)
+	}
+	var ticket collabTicket
+	if raw == "" || json.Unmarshal([]byte(raw), &ticket) != nil || ticket.UserID == 0 {
+		return xerr.ErrCollabTicketInvalid
+	}
+
+	// 2) 升级连接，失败时 Upgrader 已写入错误响应
+	conn, err := collabUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		l.Infof("collab upgrade failed: %v", err)
+		return nil
+	}
+	defer conn.Close()
+	conn.SetReadLimit(collabMaxMessageBytes)
+
+	ctx, cancel := context.WithCancel(l.ctx)
+	defer cancel()
+	stream, err := l.svcCtx.LLMCenterRpc.CollabDocument(ctx)
+	if err == nil {
+		err = stream.Send(&pb.CollabRequest{Event: &pb.CollabRequest_Join{Join: &pb.CollabJoin{
+			UserId:         ticket.UserID,
+			ConversationId: ticket.ConversationID,
+			MessageId:      ticket.MessageID,
+		}}})
+	}
+	if err != nil {
+		l.Errorf("collab open stream failed, messageId: %s, err: %v", ticket.MessageID, err)
+		closeCollab(conn, toCollabError(err))
+		return nil
+	}
+
+	// 3) RPC → WebSocket，RPC 结束时发送原因并关闭连接，使读取返回
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) || ctx.Err() != nil {
+					_ = conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(collabWriteWait))
+				} else {
+					closeCollab(conn, toCollabError(err))
+				}
+				_ = conn.Close()
+				return
+			}
+			msg := toCollabMessage(resp)
+			if msg == nil {
+				continue
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(collabWriteWait))
+			if err := conn.WriteJSON(msg); err != nil {
+				cancel()
+				_ = conn.Close()
+				return
+			}
+		}
+	}()
+
+	// 4) 心跳
+	go func() {
+		ticker := time.NewTicker(collabPingPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(collabWriteWait)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	// 5) WebSocket → RPC，客户端关闭连接后结束发送，RPC 随即保存并离开会话
+	_ = conn.SetReadDeadline(time.Now().Add(collabPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(collabPongWait))
+	})
+	for {
+		var msg collabClientMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			break
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(collabPongWait))
+		pbReq, err := toCollabRequest(&msg)
+		if err != nil {
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseUnsupportedData, err.Error()), time.Now().Add(collabWriteWait))
+			break
+		}
+		if err := stream.Send(pbReq); err != nil {
+			break
+		}
+	}
+	_ = stream.CloseSend()
+	select {
+	case <-done:
+	case <-time.After(collabWriteWait):
+		cancel()
+		<-done
+	}
+	return nil
+}
+
+// closeCollab 发送 error 消息说明原因后关闭连接
+func closeCollab(conn *websocket.Conn, msg collabErrorMessage) {
+	_ = conn.SetWriteDeadline(time.Now().Add(collabWriteWait))
+	_ = conn.WriteJSON(msg)
+	_ = conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(collabWriteWait))
+}
@@ -0,0 +1,59 @@
+package collab
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCollabTicketLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取协同编辑 WebSocket 连接凭证, 一次有效
+func NewCreateCollabTicketLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCollabTicketLogic {
+	return &CreateCollabTicketLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CreateCollabTicket 凭证只记录用户与文档，文档权限在建立连接时由 RPC 校验
+func (l *CreateCollabTicketLogic) CreateCollabTicket(req *types.CollabTicketRequest) (*types.CollabTicketResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	messageID := strings.TrimSpace(req.MessageID)
+	if messageID == "" {
+		return nil, xerr.ErrRequestParam
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generate collab ticket: %v: %w", err, xerr.ErrServerCommon)
+	}
+	ticket := hex.EncodeToString(b)
+	data, err := json.Marshal(collabTicket{UserID: userID, ConversationID: req.ConversationID, MessageID: messageID})
+	if err != nil {
+		return nil, fmt.Errorf("marshal collab ticket: %v: %w", err, xerr.ErrServerCommon)
+	}
+	if err := l.svcCtx.Redis.SetexCtx(l.ctx, collabTicketKey(ticket), string(data), collabTicketTTL); err != nil {
+		return nil, fmt.Errorf("save collab ticket: %v: %w", err, xerr.ErrServerCommon)
+	}
+
+	return &types.CollabTicketResponse{Ticket: ticket, ExpiresIn: collabTicketTTL}, nil
+}
@@ -0,0 +1,47 @@
+package comment
+
+import (
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+)
+
+func toDocumentComment(c *pb.DocumentComment) types.DocumentComment {
+	if c == nil {
+		return types.DocumentComment{}
+	}
+	item := types.DocumentComment{
+		ID:             c.Id,
+		MessageID:      c.MessageId,
+		ConversationID: c.ConversationId,
+		ParentID:       c.ParentId,
+		UserID:         c.UserId,
+		Content:        c.Content,
+		Quote:          c.Quote,
+		MentionUserIDs: c.MentionUserIds,
+		Status:         c.Status,
+		ResolvedBy:     c.ResolvedBy,
+		ResolvedAt:     c.ResolvedAt,
+		CreatedAt:      c.CreatedAt,
+		Replies:        toDocumentComments(c.Replies),
+	}
+	if item.MentionUserIDs == nil {
+		item.MentionUserIDs = []int64{}
+	}
+	if c.Anchor != nil {
+		item.Anchor = &types.CommentAnchor{
+			Start:  c.Anchor.Start,
+			End:    c.Anchor.End,
+			Text:   c.Anchor.Text,
+			Status: c.Anchor.Status,
+		}
+	}
+	return item
+}
+
+func toDocumentComments(list []*pb.DocumentComment) []types.DocumentComment {
+	items := make([]types.DocumentComment, 0, len(list))
+	for _, c := range list {
+		items = append(items, toDocumentComment(c))
+	}
+	return items
+}
@@ -0,0 +1,51 @@
+package comment
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 对文档中的一段文本发表批注, 或回复已有批注
+func NewCreateCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCommentLogic {
+	return &CreateCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCommentLogic) CreateComment(req *types.CreateCommentRequest) (*types.CreateCommentResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.CreateDocumentComment(l.ctx, &pb.CreateDocumentCommentRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+		ParentId:       req.ParentID,
+		Content:        req.Content,
+		AnchorStart:    req.AnchorStart,
+		AnchorEnd:      req.AnchorEnd,
+		Quote:          req.Quote,
+		MentionUserIds: req.MentionUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CreateCommentResponse{Comment: toDocumentComment(resp.Comment)}, nil
+}
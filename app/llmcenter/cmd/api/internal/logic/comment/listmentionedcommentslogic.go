@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMentionedCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询提及当前用户的批注
+func NewListMentionedCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMentionedCommentsLogic {
+	return &ListMentionedCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListMentionedCommentsLogic) ListMentionedComments(req *types.ListMentionedCommentsRequest) (*types.ListMentionedCommentsResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ListMentionedComments(l.ctx, &pb.ListMentionedCommentsRequest{
+		UserId:   userID,
+		OpenOnly: req.OpenOnly,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ListMentionedCommentsResponse{Items: toDocumentComments(resp.Items)}, nil
+}
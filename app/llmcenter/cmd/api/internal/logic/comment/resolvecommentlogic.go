@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 标记批注已解决或重新打开
+func NewResolveCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveCommentLogic {
+	return &ResolveCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResolveCommentLogic) ResolveComment(req *types.ResolveCommentRequest) (*types.ResolveCommentResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ResolveDocumentComment(l.ctx, &pb.ResolveDocumentCommentRequest{
+		UserId:   userID,
+		Id:       req.ID,
+		Resolved: req.Resolved,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ResolveCommentResponse{Comment: toDocumentComment(resp.Comment)}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	rpcpb "document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -26,8 +27,10 @@ func NewGetConversationDetailLogic(ctx context.Context, svcCtx *svc.ServiceConte
 }
 
 func (l *GetConversationDetailLogic) GetConversationDetail(req *types.GetConversationDetailRequest) (*types.GetConversationDetailResponse, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
 	rpcResp, err := l.svcCtx.LLMCenterRpc.GetConversationDetail(l.ctx, &rpcpb.GetConversationDetailRequest{
 		ConversationId: req.ConversationID,
+		UserId:         userId,
 	})
 	if err != nil {
 		l.Logger.Errorf("RPC GetConversationDetail failed: %v", err)
@@ -29,7 +29,10 @@ func NewGetConversationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 func (l *GetConversationsLogic) GetConversations(req *types.GetConversationsRequest) (*types.GetConversationsResponse, error) {
 	// 调用后端 RPC
 	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
-	rpcResp, err := l.svcCtx.LLMCenterRpc.GetConversations(l.ctx, &rpcpb.GetConversationsRequest{UserId: userId})
+	rpcResp, err := l.svcCtx.LLMCenterRpc.GetConversations(l.ctx, &rpcpb.GetConversationsRequest{
+		UserId:      userId,
+		WorkspaceId: req.WorkspaceID,
+	})
 	if err != nil {
 		l.Logger.Error("RPC GetConversations failed:", err)
 		return nil, err
@@ -42,6 +45,7 @@ func (l *GetConversationsLogic) GetConversations(req *types.GetConversationsRequ
 			ConversationID: c.ConversationId,
 			Title:          c.Title,
 			UpdatedAt:      c.UpdatedAt,
+			UserID:         c.UserId,
 		})
 	}
 
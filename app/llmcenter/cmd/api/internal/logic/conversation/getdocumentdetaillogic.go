@@ -6,6 +6,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	rpcpb "document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -26,8 +27,10 @@ func NewGetDocumentDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *GetDocumentDetailLogic) GetDocumentDetail(req *types.GetDocumentDetailRequest) (*types.GetDocumentDetailResponse, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
 	rpcResp, err := l.svcCtx.LLMCenterRpc.GetDocumentDetail(l.ctx, &rpcpb.GetDocumentDetailRequest{
 		ConversationId: req.ConversationID,
+		UserId:         userId,
 	})
 	if err != nil {
 		l.Logger.Errorf("调用 GetDocumentDetail RPC 失败: %v", err)
@@ -6,6 +6,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	rpcpb "document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -26,8 +27,10 @@ func NewGetHistoryDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 }
 
 func (l *GetHistoryDataLogic) GetHistoryData(req *types.GetHistoryDataRequest) (*types.GetHistoryDataResponse, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
 	rpcResp, err := l.svcCtx.LLMCenterRpc.GetHistoryData(l.ctx, &rpcpb.GetHistoryDataRequest{
 		ConversationId: req.ConversationID,
+		UserId:         userId,
 	})
 	if err != nil {
 		l.Logger.Errorf("调用 GetHistoryData RPC 失败: %v", err)
@@ -0,0 +1,47 @@
+package docno
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AllocateDocNoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 为文档分配当年的下一个发文字号, 文档已预留该代字的字号时直接返回
+func NewAllocateDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AllocateDocNoLogic {
+	return &AllocateDocNoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AllocateDocNoLogic) AllocateDocNo(req *types.AllocateDocNoRequest) (*types.AllocateDocNoResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.AllocateDocNo(l.ctx, &pb.AllocateDocNoRequest{
+		UserId:         userID,
+		WorkspaceId:    req.WorkspaceID,
+		Prefix:         req.Prefix,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AllocateDocNoResponse{DocNo: toDocNumber(resp.DocNo)}, nil
+}
@@ -0,0 +1,28 @@
+package docno
+
+import (
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+)
+
+func toDocNumber(n *pb.DocNumber) types.DocNumber {
+	if n == nil {
+		return types.DocNumber{}
+	}
+	return types.DocNumber{
+		ID:             n.Id,
+		WorkspaceID:    n.WorkspaceId,
+		Prefix:         n.Prefix,
+		Year:           n.Year,
+		Seq:            n.Seq,
+		DocNo:          n.DocNo,
+		Status:         n.Status,
+		ConversationID: n.ConversationId,
+		MessageID:      n.MessageId,
+		UserID:         n.UserId,
+		IssuedAt:       n.IssuedAt,
+		ReleasedAt:     n.ReleasedAt,
+		CreatedAt:      n.CreatedAt,
+		UpdatedAt:      n.UpdatedAt,
+	}
+}
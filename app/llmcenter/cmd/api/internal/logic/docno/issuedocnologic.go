@@ -0,0 +1,44 @@
+package docno
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IssueDocNoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 将预留的发文字号标记为已使用, 已使用的字号不能释放
+func NewIssueDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IssueDocNoLogic {
+	return &IssueDocNoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *IssueDocNoLogic) IssueDocNo(req *types.UpdateDocNoRequest) (*types.UpdateDocNoResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.IssueDocNo(l.ctx, &pb.IssueDocNoRequest{
+		UserId: userID,
+		Id:     req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateDocNoResponse{DocNo: toDocNumber(resp.DocNo)}, nil
+}
@@ -0,0 +1,53 @@
+package docno
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDocNosLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 分页查询团队的发文字号
+func NewListDocNosLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDocNosLogic {
+	return &ListDocNosLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListDocNosLogic) ListDocNos(req *types.ListDocNosRequest) (*types.ListDocNosResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ListDocNos(l.ctx, &pb.ListDocNosRequest{
+		UserId:      userID,
+		WorkspaceId: req.WorkspaceID,
+		Prefix:      req.Prefix,
+		Year:        req.Year,
+		Status:      req.Status,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.DocNumber, 0, len(resp.Items))
+	for _, n := range resp.Items {
+		items = append(items, toDocNumber(n))
+	}
+	return &types.ListDocNosResponse{Total: resp.Total, Items: items}, nil
+}
@@ -0,0 +1,44 @@
+package docno
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseDocNoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 释放未使用的发文字号
+func NewReleaseDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseDocNoLogic {
+	return &ReleaseDocNoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReleaseDocNoLogic) ReleaseDocNo(req *types.UpdateDocNoRequest) (*types.UpdateDocNoResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ReleaseDocNo(l.ctx, &pb.ReleaseDocNoRequest{
+		UserId: userID,
+		Id:     req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateDocNoResponse{DocNo: toDocNumber(resp.DocNo)}, nil
+}
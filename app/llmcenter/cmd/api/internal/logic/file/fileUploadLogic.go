@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"strconv"
 
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
-
+	"document_agent/pkg/ctxdata"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -36,6 +37,17 @@ func (l *FileUploadLogic) FileUpload(form *multipart.Form) (*types.FileUploadRes
 		return nil, fmt.Errorf("未找到文件: %v", xerr.ErrFileNotFound)
 	}
 
+	// 可选的团队空间ID，为空时上传到个人空间
+	var workspaceID int64
+	if v := form.Value["workspace_id"]; len(v) > 0 && v[0] != "" {
+		id, err := strconv.ParseInt(v[0], 10, 64)
+		if err != nil || id < 0 {
+			return nil, fmt.Errorf("invalid workspace_id %q: %w", v[0], xerr.ErrRequestParam)
+		}
+		workspaceID = id
+	}
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
 	fileHeader := files[0]
 	fileName := fileHeader.Filename
 	file, err := fileHeader.Open()
@@ -55,7 +67,9 @@ func (l *FileUploadLogic) FileUpload(form *multipart.Form) (*types.FileUploadRes
 	err = stream.Send(&pb.FileUploadRequest{
 		Data: &pb.FileUploadRequest_Info{
 			Info: &pb.FileInfo{
-				FileName: fileName,
+				FileName:    fileName,
+				UserId:      userId,
+				WorkspaceId: workspaceID,
 			},
 		},
 	})
@@ -13,6 +13,8 @@ import (
 
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
 	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -53,6 +55,17 @@ func (l *GetFileLogic) GetFile(req *types.GetFileReq, w http.ResponseWriter, r *
 
 	l.Infof("GetFile path=%q base=%q full=%q relCheck=%q", req.Path, base, full, relCheck)
 
+	// 登记过的上传文件只有上传者或所属团队空间的成员可以读取
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+	if _, err := l.svcCtx.LLMCenterRpc.CheckFileAccess(l.ctx, &pb.CheckFileAccessRequest{
+		UserId: userId,
+		FileId: filepath.ToSlash(relCheck),
+	}); err != nil {
+		l.Errorf("check file access %s for user %d error: %v", relCheck, userId, err)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return nil
+	}
+
 	f, err := os.Open(full)
 	if err != nil {
 		l.Errorf("open file %s error: %v", full, err)
@@ -0,0 +1,48 @@
+package share
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateShareLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 为文档创建只读分享链接
+func NewCreateShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateShareLogic {
+	return &CreateShareLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateShareLogic) CreateShare(req *types.CreateShareRequest) (*types.CreateShareResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.CreateDocumentShare(l.ctx, &pb.CreateDocumentShareRequest{
+		UserId:          userID,
+		ConversationId:  req.ConversationID,
+		MessageId:       req.MessageID,
+		ExpireSeconds:   req.ExpireSeconds,
+		Password:        req.Password,
+		DisableDownload: req.DisableDownload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CreateShareResponse{Share: toDocumentShare(resp.Share)}, nil
+}
@@ -0,0 +1,49 @@
+package share
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSharesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询文档的分享链接及访问次数
+func NewListSharesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSharesLogic {
+	return &ListSharesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSharesLogic) ListShares(req *types.ListSharesRequest) (*types.ListSharesResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.ListDocumentShares(l.ctx, &pb.ListDocumentSharesRequest{
+		UserId:         userID,
+		ConversationId: req.ConversationID,
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.DocumentShare, 0, len(resp.Items))
+	for _, s := range resp.Items {
+		items = append(items, toDocumentShare(s))
+	}
+	return &types.ListSharesResponse{Items: items}, nil
+}
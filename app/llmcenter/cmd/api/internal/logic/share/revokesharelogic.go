@@ -0,0 +1,44 @@
+package share
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeShareLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 撤销分享链接
+func NewRevokeShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeShareLogic {
+	return &RevokeShareLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeShareLogic) RevokeShare(req *types.RevokeShareRequest) (*types.RevokeShareResponse, error) {
+	userID, err := ctxdata.GetUidFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.LLMCenterRpc.RevokeDocumentShare(l.ctx, &pb.RevokeDocumentShareRequest{
+		UserId: userID,
+		Id:     req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RevokeShareResponse{Success: resp.Success}, nil
+}
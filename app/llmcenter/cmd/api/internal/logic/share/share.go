@@ -0,0 +1,26 @@
+package share
+
+import (
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+)
+
+func toDocumentShare(s *pb.DocumentShare) types.DocumentShare {
+	if s == nil {
+		return types.DocumentShare{}
+	}
+	return types.DocumentShare{
+		ID:             s.Id,
+		MessageID:      s.MessageId,
+		ConversationID: s.ConversationId,
+		Url:            s.Url,
+		HasPassword:    s.HasPassword,
+		AllowDownload:  s.AllowDownload,
+		ExpireAt:       s.ExpireAt,
+		Status:         s.Status,
+		ViewCount:      s.ViewCount,
+		DownloadCount:  s.DownloadCount,
+		CreatedAt:      s.CreatedAt,
+		RevokedAt:      s.RevokedAt,
+	}
+}
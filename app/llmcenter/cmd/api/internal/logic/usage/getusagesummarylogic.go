@@ -0,0 +1,78 @@
+package usage
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/app/llmcenter/cmd/api/internal/types"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUsageSummaryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询当前用户的大模型调用用量及配额
+func NewGetUsageSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUsageSummaryLogic {
+	return &GetUsageSummaryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUsageSummaryLogic) GetUsageSummary(req *types.GetUsageSummaryRequest) (*types.GetUsageSummaryResponse, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+	rpcResp, err := l.svcCtx.LLMCenterRpc.GetUsageSummary(l.ctx, &pb.GetUsageSummaryRequest{
+		UserId:    userId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.UsageItem, 0, len(rpcResp.Items))
+	for _, it := range rpcResp.Items {
+		items = append(items, toUsageItem(it))
+	}
+
+	resp := &types.GetUsageSummaryResponse{
+		Items: items,
+		Total: toUsageItem(rpcResp.Total),
+	}
+	if q := rpcResp.Quota; q != nil {
+		resp.Quota = types.QuotaStatus{
+			DailyRequestsUsed:    q.DailyRequestsUsed,
+			DailyRequestsLimit:   q.DailyRequestsLimit,
+			MonthlyRequestsUsed:  q.MonthlyRequestsUsed,
+			MonthlyRequestsLimit: q.MonthlyRequestsLimit,
+			DailyCharsUsed:       q.DailyCharsUsed,
+			DailyCharsLimit:      q.DailyCharsLimit,
+			MonthlyCharsUsed:     q.MonthlyCharsUsed,
+			MonthlyCharsLimit:    q.MonthlyCharsLimit,
+		}
+	}
+	return resp, nil
+}
+
+func toUsageItem(it *pb.UsageItem) types.UsageItem {
+	if it == nil {
+		return types.UsageItem{}
+	}
+	return types.UsageItem{
+		Date:          it.Date,
+		CallType:      it.CallType,
+		RequestCount:  it.RequestCount,
+		SuccessCount:  it.SuccessCount,
+		FailureCount:  it.FailureCount,
+		PromptChars:   it.PromptChars,
+		ResponseChars: it.ResponseChars,
+		AvgLatencyMs:  it.AvgLatencyMs,
+	}
+}
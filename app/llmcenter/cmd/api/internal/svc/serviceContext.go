@@ -1,23 +1,46 @@
 package svc
 
 import (
+	"context"
 	"os"
 	"path/filepath"
-	"time"
 
 	"document_agent/app/llmcenter/cmd/api/internal/config"
 	"document_agent/app/llmcenter/cmd/rpc/llmcenter"
 	"document_agent/app/llmcenter/model"
-	"document_agent/pkg/tool"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/filecleaner"
+	"document_agent/pkg/health"
+	"document_agent/pkg/interceptor/rpcclient"
+	"document_agent/pkg/ratelimit"
+	"document_agent/pkg/session"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/rest"
+	"google.golang.org/grpc"
 
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
+// maxRpcRecvMsgSize RPC 客户端最大接收消息大小，需容纳导出的文件与审计 CSV
+const maxRpcRecvMsgSize = 32 * 1024 * 1024
+
 type ServiceContext struct {
-	Config       config.Config
-	LLMCenterRpc llmcenter.LlmCenter
-	FilesModel   model.FilesModel
+	Config          config.Config
+	LLMCenterRpc    llmcenter.LlmCenter
+	FilesModel      model.FilesModel
+	Auditor         *audit.Recorder
+	AuditRead       rest.Middleware
+	QuotaManage     rest.Middleware
+	FileManage      rest.Middleware
+	SystemDiagnose  rest.Middleware
+	GenerationLimit rest.Middleware // 生成接口限流，多个 API 副本通过 Redis 共享计数
+	Sessions        *session.Checker
+	FileCleaner     *filecleaner.Cleaner // 未启用清理的副本也可查询运行记录
+	Health          *health.Checker      // /readyz 依赖检查
+	Redis           *redis.Redis         // 协同编辑的一次性连接凭证
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -33,24 +56,67 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	c.Upload.BaseDir = dir
 
 	// 2. 初始化 svc
+	sqlConn := sqlx.NewMysql(c.DB.DataSource)
+	rds := redis.MustNewRedis(c.Redis)
+	// 客户端 IP 与 User-Agent 通过 metadata 透传给 RPC，用于审计
+	rpcClient := zrpc.MustNewClient(c.LlmCenterRpcConf,
+		zrpc.WithUnaryClientInterceptor(rpcclient.ClientInfoInterceptor),
+		zrpc.WithStreamClientInterceptor(rpcclient.StreamClientInfoInterceptor),
+		zrpc.WithDialOption(grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxRpcRecvMsgSize))),
+	)
+	checker := health.NewChecker(c.HealthCheck,
+		health.MySQL(sqlConn),
+		health.Redis(rds),
+		health.Etcd(c.LlmCenterRpcConf.Etcd.Hosts),
+		health.GRPC("llmcenter-rpc", rpcClient.Conn()),
+	)
+	checker.Add(health.Dir("upload-dir", c.Upload.BaseDir, checker.MinFreeBytes()))
 	svc := &ServiceContext{
-		Config:       c,
-		LLMCenterRpc: llmcenter.NewLlmCenter(zrpc.MustNewClient(c.LlmCenterRpcConf)),
-		FilesModel:   model.NewFilesModel(sqlx.NewMysql(c.DB.DataSource)),
+		Config:          c,
+		LLMCenterRpc:    llmcenter.NewLlmCenter(rpcClient),
+		FilesModel:      model.NewFilesModel(sqlConn),
+		Auditor:         audit.NewRecorder(model.NewAuditLogsModel(sqlConn)),
+		AuditRead:       authz.RequirePerms(authz.PermAuditRead),
+		QuotaManage:     authz.RequirePerms(authz.PermQuotaManage),
+		FileManage:      authz.RequirePerms(authz.PermFileManage),
+		SystemDiagnose:  authz.RequirePerms(authz.PermSystemDiagnose),
+		GenerationLimit: ratelimit.NewLimiter(rds, c.GenerationLimit).Middleware,
+		Sessions:        session.NewChecker(rds),
+		FileCleaner: filecleaner.NewCleaner(c.FileCleaner, model.NewFilesModel(sqlConn),
+			model.NewHistorydatasModel(sqlConn), rds, c.Etcd.Hosts,
+			avatarFiles(usercenter.NewUsercenter(zrpc.MustNewClient(c.UsercenterRpcConf)))),
+		Health: checker,
+		Redis:  rds,
 	}
 
-	// 3. 启动文件清理（无 etcd 锁，后期可加）
+	// 3. 启动文件清理，多个副本中只有持有主节点锁的实例执行
 	if c.FileCleaner.Enable {
-		go tool.StartFileCleaner(
-			tool.FileCleanerCfg{
-				Dir:          c.FileCleaner.Dir,
-				Retention:    time.Duration(c.FileCleaner.RetentionDays) * 24 * time.Hour,
-				MaxSizeBytes: c.FileCleaner.MaxSizeMB * 1024 * 1024,
-			},
-			time.Duration(c.FileCleaner.IntervalMinutes)*time.Minute,
-			svc.FilesModel,
-		)
+		go svc.FileCleaner.Start()
 	}
 
 	return svc
 }
+
+// avatarPageSize 分页查询头像的批大小
+const avatarPageSize = 500
+
+// avatarFiles 用户头像引用的文件，文件清理时保留
+func avatarFiles(uc usercenter.Usercenter) filecleaner.Protector {
+	return func(ctx context.Context) (map[string]struct{}, error) {
+		files := make(map[string]struct{})
+		var after int64
+		for {
+			resp, err := uc.ListAvatars(ctx, &usercenter.ListAvatarsReq{AfterUserId: after, Limit: avatarPageSize})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range resp.List {
+				files[item.Avatar] = struct{}{}
+			}
+			if len(resp.List) < avatarPageSize {
+				return files, nil
+			}
+			after = resp.List[len(resp.List)-1].UserId
+		}
+	}
+}
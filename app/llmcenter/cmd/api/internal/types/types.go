@@ -3,14 +3,68 @@
 
 package types
 
+type AllocateDocNoRequest struct {
+	WorkspaceID    int64  `json:"workspace_id,optional"` // 发文字号登记为文档所属会话的团队, 传入时须与之一致
+	Prefix         string `json:"prefix"`                // 发文机关代字, 1~12 个汉字
+	ConversationID string `json:"conversation_id,optional"`
+	MessageID      string `json:"message_id"`
+}
+
+type AllocateDocNoResponse struct {
+	DocNo DocNumber `json:"doc_no"`
+}
+
+type ApprovalAction struct {
+	Action          string `json:"action"` // 如 submit | withdraw | pass | reject | sign | revoke
+	Label           string `json:"label"`
+	ToState         string `json:"to_state"`
+	NeedAssignee    bool   `json:"need_assignee"`    // 须指定下一步的审批人
+	CommentRequired bool   `json:"comment_required"` // 须填写审批意见
+}
+
+type ApprovalRecord struct {
+	ID          int64  `json:"id"`
+	Action      string `json:"action"`
+	ActionLabel string `json:"action_label"`
+	FromState   string `json:"from_state"`
+	ToState     string `json:"to_state"`
+	UserID      int64  `json:"user_id"`
+	UserName    string `json:"user_name"`
+	AssigneeID  int64  `json:"assignee_id"` // 流转后的审批人
+	Comment     string `json:"comment"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+type ApprovalSigner struct {
+	Label    string `json:"label"` // 如 核稿人、签发人
+	UserID   int64  `json:"user_id"`
+	UserName string `json:"user_name"`
+	SignedAt int64  `json:"signed_at"`
+}
+
+type AuditLog struct {
+	ID             int64  `json:"id"`
+	UserID         int64  `json:"user_id"`
+	Action         string `json:"action"`
+	ConversationID string `json:"conversation_id"`
+	TargetID       string `json:"target_id"`
+	ClientIP       string `json:"client_ip"`
+	UserAgent      string `json:"user_agent"`
+	HashBefore     string `json:"hash_before"`
+	HashAfter      string `json:"hash_after"`
+	Detail         string `json:"detail"`
+	CreatedAt      string `json:"created_at"`
+}
+
 type ChatCompletionsRequest struct {
 	ConversationID   string      `json:"conversation_id,optional"`
-	Documenttype     string      `json:"documenttype"`
+	Documenttype     string      `json:"documenttype,optional"`
 	Information      string      `json:"information"`
 	Requests         string      `json:"requests"`
 	UseKnowledgeBase bool        `json:"use_knowledge_base,optional"`
 	KnowledgeBaseID  string      `json:"knowledge_base_id,optional"`
 	References       []Reference `json:"references,optional"` // 来自 llm.api
+	WorkspaceID      int64       `json:"workspace_id,optional"`
 }
 
 type ChatCompletionsResponse struct {
@@ -22,15 +76,57 @@ type ChatResumeRequest struct {
 	TemplateID     string      `json:"template_id,optional"`
 	Documenttype   string      `json:"documenttype,optional"`
 	References     []Reference `json:"references,optional"` // 来自 llm.api
+	FormatCheck    bool        `json:"format_check,optional"`
 }
 
 type ChatResumeResponse struct {
 }
 
+type CheckDocumentRequest struct {
+	ConversationID string `json:"conversation_id,optional"`
+	MessageID      string `json:"message_id"`
+}
+
+type CheckDocumentResponse struct {
+	MessageID    string          `json:"message_id"`
+	Passed       bool            `json:"passed"` // 没有 error 级别的问题
+	ErrorCount   int64           `json:"error_count"`
+	WarningCount int64           `json:"warning_count"`
+	InfoCount    int64           `json:"info_count"`
+	Findings     []FormatFinding `json:"findings"` // 来自 llm.api
+}
+
+type CollabConnectRequest struct {
+	Ticket string `form:"ticket"`
+}
+
+type CollabTicketRequest struct {
+	ConversationID string `json:"conversation_id,optional"`
+	MessageID      string `json:"message_id"`
+}
+
+type CollabTicketResponse struct {
+	Ticket    string `json:"ticket"`
+	ExpiresIn int64  `json:"expires_in"` // 凭证有效期, 单位秒
+}
+
+type CommentAnchor struct {
+	Start  int64  `json:"start"`
+	End    int64  `json:"end"`
+	Text   string `json:"text"`   // 当前定位到的文本
+	Status string `json:"status"` // anchored | fuzzy (原文已被修改) | orphaned (原文已被删除)
+}
+
+type ConfigIssue struct {
+	Field   string `json:"field"`   // 配置项，例如 Download.SignKey
+	Message string `json:"message"` // 问题说明
+}
+
 type Conversation struct {
 	ConversationID string `json:"conversation_id"`
 	Title          string `json:"title"`
 	UpdatedAt      string `json:"updated_at"`
+	UserID         int64  `json:"user_id"`
 }
 
 type ConvertMarkdownLinkRequest struct {
@@ -45,24 +141,144 @@ type ConvertMarkdownLinkResponse struct {
 	Url         string `json:"url"`
 }
 
+type CreateCommentRequest struct {
+	ConversationID string  `json:"conversation_id,optional"`
+	MessageID      string  `json:"message_id"`
+	ParentID       int64   `json:"parent_id,optional"` // 回复的批注ID
+	Content        string  `json:"content"`
+	AnchorStart    int64   `json:"anchor_start,optional"`
+	AnchorEnd      int64   `json:"anchor_end,optional"`
+	Quote          string  `json:"quote,optional"`            // 选中的文本, 文档已被他人修改时据此重新定位
+	MentionUserIDs []int64 `json:"mention_user_ids,optional"` // 可从团队成员接口选择
+}
+
+type CreateCommentResponse struct {
+	Comment DocumentComment `json:"comment"`
+}
+
+type CreateShareRequest struct {
+	ConversationID  string `json:"conversation_id,optional"`
+	MessageID       string `json:"message_id"`
+	ExpireSeconds   int64  `json:"expire_seconds,optional"`   // 有效期秒数, 不填使用默认值(7 天), 最长 30 天
+	Password        string `json:"password,optional"`         // 访问密码, 不填表示无需密码
+	DisableDownload bool   `json:"disable_download,optional"` // 只允许在线查看
+}
+
+type CreateShareResponse struct {
+	Share DocumentShare `json:"share"`
+}
+
+type DeleteDocumentRequest struct {
+	ConversationID string `json:"conversation_id,optional"`
+	MessageID      string `json:"message_id"`
+}
+
+type DeleteDocumentResponse struct {
+	Success bool `json:"success"`
+}
+
+type DeleteUsageQuotaRequest struct {
+	ID int64 `json:"id"`
+}
+
+type DeleteUsageQuotaResponse struct {
+	Success bool `json:"success"`
+}
+
+type DiagnosticsRequest struct {
+}
+
+type DiagnosticsResponse struct {
+	Services []ServiceDiagnostics `json:"services"` // 当前 API 实例及其调用的 llmcenter-rpc
+}
+
+type DocNumber struct {
+	ID             int64  `json:"id"`
+	WorkspaceID    int64  `json:"workspace_id"`
+	Prefix         string `json:"prefix"`
+	Year           int64  `json:"year"`
+	Seq            int64  `json:"seq"`
+	DocNo          string `json:"doc_no"`
+	Status         string `json:"status"` // reserved | issued | released
+	ConversationID string `json:"conversation_id"`
+	MessageID      string `json:"message_id"`
+	UserID         int64  `json:"user_id"`     // 最后一次分配、使用或释放的用户
+	IssuedAt       int64  `json:"issued_at"`   // 未使用为 0
+	ReleasedAt     int64  `json:"released_at"` // 未释放过为 0
+	CreatedAt      int64  `json:"created_at"`
+	UpdatedAt      int64  `json:"updated_at"`
+}
+
 type Document struct {
 	ID        string `json:"id"`
 	Content   string `json:"content"`
 	CreatedAt string `json:"created_at"`
 }
 
+type DocumentApproval struct {
+	MessageID      string           `json:"message_id"`
+	ConversationID string           `json:"conversation_id"`
+	State          string           `json:"state"`
+	StateLabel     string           `json:"state_label"`
+	Locked         bool             `json:"locked"`      // 文档不能修改
+	AssigneeID     int64            `json:"assignee_id"` // 当前审批人, 0 表示无
+	SubmitterID    int64            `json:"submitter_id"`
+	Version        int64            `json:"version"` // 流转时传回, 状态已变化时返回错误
+	UpdatedAt      int64            `json:"updated_at"`
+	Actions        []ApprovalAction `json:"actions"` // 当前用户可执行的操作
+	Signers        []ApprovalSigner `json:"signers"` // 本轮审批的签署人, 导出时标注在版头
+}
+
+type DocumentComment struct {
+	ID             int64             `json:"id"`
+	MessageID      string            `json:"message_id"`
+	ConversationID string            `json:"conversation_id"`
+	ParentID       int64             `json:"parent_id"` // 0 表示首条批注
+	UserID         int64             `json:"user_id"`
+	Content        string            `json:"content"`
+	Quote          string            `json:"quote"`            // 发表时引用的原文
+	Anchor         *CommentAnchor    `json:"anchor,omitempty"` // 未引用文本时为空
+	MentionUserIDs []int64           `json:"mention_user_ids"`
+	Status         string            `json:"status"` // open | resolved
+	ResolvedBy     int64             `json:"resolved_by"`
+	ResolvedAt     int64             `json:"resolved_at"`
+	CreatedAt      int64             `json:"created_at"`
+	Replies        []DocumentComment `json:"replies"`
+}
+
+type DocumentShare struct {
+	ID             int64  `json:"id"`
+	MessageID      string `json:"message_id"`
+	ConversationID string `json:"conversation_id"`
+	Url            string `json:"url"` // 带签名的分享链接
+	HasPassword    bool   `json:"has_password"`
+	AllowDownload  bool   `json:"allow_download"`
+	ExpireAt       int64  `json:"expire_at"`
+	Status         string `json:"status"` // active | expired | revoked
+	ViewCount      int64  `json:"view_count"`
+	DownloadCount  int64  `json:"download_count"`
+	CreatedAt      int64  `json:"created_at"`
+	RevokedAt      int64  `json:"revoked_at"` // 未撤销为 0
+}
+
 type DownloadFileRequest struct {
-	Prompt      string     `json:"prompt"`
-	Type        string     `json:"type"` // "pdf" | "docx"
-	Information []InfoItem `json:"information,optional"`
+	Prompt           string     `json:"prompt"`
+	Type             string     `json:"type"` // "pdf" | "docx"
+	Information      []InfoItem `json:"information,optional"`
+	ConversationID   string     `json:"conversation_id,optional"`     // 导出内容所属会话ID（用于审计）
+	MessageID        string     `json:"message_id,optional"`          // 导出内容对应的文档ID（用于审计）
+	DocNoWorkspaceID int64      `json:"doc_no_workspace_id,optional"` // 传入 doc_no_prefix 时从文档所属团队的发文字号登记中为文档分配字号, 须传 conversation_id 与 message_id; 传入时须与文档所属团队一致
+	DocNoPrefix      string     `json:"doc_no_prefix,optional"`       // 发文机关代字, 如 某政; 分配的字号优先于 information 中的 docNo, 并通过响应头 X-Doc-No（URL 编码）返回
 }
 
 type EditDocumentRequest struct {
 	ConversationID   string `json:"conversation_id"`
 	MessageID        string `json:"message_id"`
-	Prompt           string `json:"prompt"`
+	Prompt           string `json:"prompt,optional"` // address_comments 为 true 时可为空, 作为补充要求
 	UseKnowledgeBase bool   `json:"use_knowledge_base,optional"`
 	KnowledgeBaseID  string `json:"knowledge_base_id,optional"`
+	AddressComments  bool   `json:"address_comments,optional"` // 按全部未解决的批注修改文档
+	FormatCheck      bool   `json:"format_check,optional"`     // 修改保存后进行公文格式检查, 结果通过 "check" 事件推送
 }
 
 type EditDocumentResponse struct {
@@ -71,6 +287,30 @@ type EditDocumentResponse struct {
 type EmptyResp struct {
 }
 
+type ExportAuditLogsRequest struct {
+	UserID         int64  `form:"user_id,optional"`
+	ConversationID string `form:"conversation_id,optional"`
+	Action         string `form:"action,optional"`
+	StartTime      int64  `form:"start_time,optional"`
+	EndTime        int64  `form:"end_time,optional"`
+}
+
+type FileCleanerRun struct {
+	Instance     string `json:"instance"`      // 执行清理的实例
+	DryRun       bool   `json:"dry_run"`       // 是否为演练模式
+	StartedAt    int64  `json:"started_at"`    // 开始时间 (Unix 秒)
+	DurationMs   int64  `json:"duration_ms"`   // 耗时
+	ScannedRows  int64  `json:"scanned_rows"`  // 扫描的文件记录数
+	ScannedFiles int64  `json:"scanned_files"` // 扫描的磁盘文件数
+	Expired      int64  `json:"expired"`       // 过期或超限而删除的文件
+	Referenced   int64  `json:"referenced"`    // 已过期但仍被近期历史数据或头像引用、或属于团队空间而保留的文件
+	OrphanFiles  int64  `json:"orphan_files"`  // 没有文件记录的磁盘文件
+	OrphanRows   int64  `json:"orphan_rows"`   // 磁盘文件已不存在的文件记录
+	Failed       int64  `json:"failed"`        // 删除失败的文件或记录
+	FreedBytes   int64  `json:"freed_bytes"`   // 释放的磁盘空间
+	Error        string `json:"error"`         // 清理中止的原因
+}
+
 type FileReference struct {
 	FileID   string `json:"file_id"`  // stored_name
 	Filename string `json:"filename"` // 用户上传的原始文件名
@@ -87,6 +327,26 @@ type FileUploadResponse struct {
 	Message  string `json:"message"`
 }
 
+type FormatFinding struct {
+	Rule       string `json:"rule"`
+	Severity   string `json:"severity"`
+	Line       int64  `json:"line"`
+	Column     int64  `json:"column"`
+	Excerpt    string `json:"excerpt"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion"`
+}
+
+type GetApprovalRequest struct {
+	ConversationID string `form:"conversation_id,optional"`
+	MessageID      string `form:"message_id"`
+}
+
+type GetApprovalResponse struct {
+	Approval DocumentApproval `json:"approval"`
+	History  []ApprovalRecord `json:"history"`
+}
+
 type GetConversationDetailRequest struct {
 	ConversationID string `path:"conversation_id"`
 }
@@ -98,6 +358,7 @@ type GetConversationDetailResponse struct {
 }
 
 type GetConversationsRequest struct {
+	WorkspaceID int64 `form:"workspace_id,optional"`
 }
 
 type GetConversationsResponse struct {
@@ -126,6 +387,25 @@ type GetHistoryDataResponse struct {
 	Items          []HistoryData `json:"items"`
 }
 
+type GetUsageSummaryRequest struct {
+	StartDate string `form:"start_date,optional"`
+	EndDate   string `form:"end_date,optional"`
+}
+
+type GetUsageSummaryResponse struct {
+	Items []UsageItem `json:"items"` // 按日期与调用类型汇总, 来自 llm.api
+	Total UsageItem   `json:"total"`
+	Quota QuotaStatus `json:"quota"`
+}
+
+type HealthCheck struct {
+	Name       string `json:"name"`        // 检查项，例如 mysql、tesseract-langs、font-dir
+	Status     string `json:"status"`      // ok | fail
+	Detail     string `json:"detail"`      // 版本、可用空间等附加信息
+	Error      string `json:"error"`       // 失败原因
+	DurationMs int64  `json:"duration_ms"` // 耗时
+}
+
 type HistoryData struct {
 	ID           string          `json:"id"`           // 对应 message_id
 	Documenttype string          `json:"documenttype"` // 文章类型
@@ -140,6 +420,89 @@ type InfoItem struct {
 	Contant string `json:"contant"` // 保持和前端一致的拼写
 }
 
+type ListApprovalTasksRequest struct {
+	Limit int64 `form:"limit,optional"`
+}
+
+type ListApprovalTasksResponse struct {
+	Items []DocumentApproval `json:"items"`
+}
+
+type ListAuditLogsRequest struct {
+	UserID         int64  `form:"user_id,optional"`
+	ConversationID string `form:"conversation_id,optional"`
+	Action         string `form:"action,optional"` // generate | resume | edit | update | export | public_download | delete | share | share_access | comment | approval | doc_no | collab
+	StartTime      int64  `form:"start_time,optional"`
+	EndTime        int64  `form:"end_time,optional"`
+	Page           int64  `form:"page,optional"`      // 从 1 开始
+	PageSize       int64  `form:"page_size,optional"` // 默认 20, 最大 100
+}
+
+type ListAuditLogsResponse struct {
+	Total int64      `json:"total"`
+	Items []AuditLog `json:"items"` // 来自 llm.api
+}
+
+type ListCommentsRequest struct {
+	ConversationID string `form:"conversation_id,optional"`
+	MessageID      string `form:"message_id"`
+	Status         string `form:"status,optional"` // open | resolved
+}
+
+type ListCommentsResponse struct {
+	Items     []DocumentComment `json:"items"`
+	OpenCount int64             `json:"open_count"`
+}
+
+type ListDocNosRequest struct {
+	WorkspaceID int64  `form:"workspace_id"`
+	Prefix      string `form:"prefix,optional"`
+	Year        int64  `form:"year,optional"`
+	Status      string `form:"status,optional"` // reserved | issued | released
+	Page        int64  `form:"page,optional"`
+	PageSize    int64  `form:"page_size,optional"` // 最大 100
+}
+
+type ListDocNosResponse struct {
+	Total int64       `json:"total"`
+	Items []DocNumber `json:"items"`
+}
+
+type ListFileCleanerRunsRequest struct {
+	Limit int64 `form:"limit,optional"` // 默认且最多 50 条
+}
+
+type ListFileCleanerRunsResponse struct {
+	Enabled bool             `json:"enabled"`
+	DryRun  bool             `json:"dry_run"`
+	Runs    []FileCleanerRun `json:"runs"` // 按时间倒序
+}
+
+type ListMentionedCommentsRequest struct {
+	OpenOnly bool  `form:"open_only,optional"`
+	Limit    int64 `form:"limit,optional"`
+}
+
+type ListMentionedCommentsResponse struct {
+	Items []DocumentComment `json:"items"`
+}
+
+type ListSharesRequest struct {
+	ConversationID string `form:"conversation_id,optional"`
+	MessageID      string `form:"message_id"`
+}
+
+type ListSharesResponse struct {
+	Items []DocumentShare `json:"items"`
+}
+
+type ListUsageQuotasRequest struct {
+}
+
+type ListUsageQuotasResponse struct {
+	Items []UsageQuota `json:"items"` // 来自 llm.api
+}
+
 type Message struct {
 	ID          string `json:"id"`
 	Role        string `json:"role"`
@@ -154,11 +517,57 @@ type PublicDownloadRequest struct {
 	Sig  string `form:"sig"`  // HMAC-SHA256(base64url)
 }
 
+type PublicShareDownloadRequest struct {
+	Token string `form:"token"`
+	Exp   int64  `form:"exp"`
+	Sig   string `form:"sig"`
+	Type  string `form:"type"` // docx | pdf
+}
+
+type PublicShareRequest struct {
+	Token    string `form:"token"`
+	Exp      int64  `form:"exp"` // 过期时间戳（秒）
+	Sig      string `form:"sig"` // HMAC-SHA256(base64url)
+	Password string `form:"password,optional"`
+}
+
+type QuotaStatus struct {
+	DailyRequestsUsed    int64 `json:"daily_requests_used"`
+	DailyRequestsLimit   int64 `json:"daily_requests_limit"`
+	MonthlyRequestsUsed  int64 `json:"monthly_requests_used"`
+	MonthlyRequestsLimit int64 `json:"monthly_requests_limit"`
+	DailyCharsUsed       int64 `json:"daily_chars_used"`
+	DailyCharsLimit      int64 `json:"daily_chars_limit"`
+	MonthlyCharsUsed     int64 `json:"monthly_chars_used"`
+	MonthlyCharsLimit    int64 `json:"monthly_chars_limit"`
+}
+
 type Reference struct {
 	Type   string `json:"type"`
 	FileID string `json:"file_id"`
 }
 
+type ResolveCommentRequest struct {
+	ID       int64 `json:"id"`
+	Resolved bool  `json:"resolved"` // false 表示重新打开
+}
+
+type ResolveCommentResponse struct {
+	Comment DocumentComment `json:"comment"`
+}
+
+type RevokeShareRequest struct {
+	ID int64 `json:"id"`
+}
+
+type RevokeShareResponse struct {
+	Success bool `json:"success"`
+}
+
+type SSECheckEvent struct {
+	Result CheckDocumentResponse `json:"result"`
+}
+
 type SSEEndEvent struct {
 	ConversationID string `json:"conversation_id"`
 	MessageID      string `json:"message_id"`
@@ -175,12 +584,75 @@ type SSEMessageEvent struct {
 	Chunk string `json:"chunk"`
 }
 
+type ServiceDiagnostics struct {
+	Name         string        `json:"name"`          // 服务名
+	GoVersion    string        `json:"go_version"`    // 构建使用的 Go 版本
+	Revision     string        `json:"revision"`      // 构建时的 git 提交
+	BuildTime    string        `json:"build_time"`    // 提交时间
+	Status       string        `json:"status"`        // 全部依赖检查通过时为 ok，否则为 fail
+	Error        string        `json:"error"`         // 无法获取该服务的诊断信息时的原因
+	Checks       []HealthCheck `json:"checks"`        // 依赖检查结果
+	ConfigIssues []ConfigIssue `json:"config_issues"` // 配置问题
+}
+
+type SetUsageQuotaRequest struct {
+	Quota UsageQuota `json:"quota"`
+}
+
+type SetUsageQuotaResponse struct {
+	Quota UsageQuota `json:"quota"`
+}
+
+type TransitionApprovalRequest struct {
+	ConversationID string `json:"conversation_id,optional"`
+	MessageID      string `json:"message_id"`
+	Action         string `json:"action"`
+	AssigneeID     int64  `json:"assignee_id,optional"` // need_assignee 为 true 时必填, 可从团队成员接口选择
+	Comment        string `json:"comment,optional"`     // 审批意见, 最多 500 字
+	Version        int64  `json:"version,optional"`
+}
+
+type TransitionApprovalResponse struct {
+	Approval DocumentApproval `json:"approval"`
+}
+
+type UpdateDocNoRequest struct {
+	ID int64 `json:"id"`
+}
+
+type UpdateDocNoResponse struct {
+	DocNo DocNumber `json:"doc_no"`
+}
+
 type UpdateDocumentRequest struct {
-	Conversation_id string `json:"conversation_id"`
-	Message_id      string `json:"message_id"`
-	Prompt          string `json:"prompt"`
+	Conversation_id string  `json:"conversation_id"`
+	Message_id      string  `json:"message_id"`
+	Prompt          string  `json:"prompt"`
+	Base            *string `json:"base,optional"` // 开始编辑时加载的文档内容, 保存时以此为基准与期间其他人的修改合并; 未传时整篇覆盖
 }
 
 type UpdateDocumentResponse struct {
 	Success bool `json:"success"`
 }
+
+type UsageItem struct {
+	Date          string `json:"date"`
+	CallType      string `json:"call_type"`
+	RequestCount  int64  `json:"request_count"`
+	SuccessCount  int64  `json:"success_count"`
+	FailureCount  int64  `json:"failure_count"`
+	PromptChars   int64  `json:"prompt_chars"`
+	ResponseChars int64  `json:"response_chars"`
+	AvgLatencyMs  int64  `json:"avg_latency_ms"`
+}
+
+type UsageQuota struct {
+	ID              int64  `json:"id,optional"`
+	SubjectType     string `json:"subject_type"`
+	Subject         string `json:"subject"`
+	DailyRequests   int64  `json:"daily_requests,optional"`
+	MonthlyRequests int64  `json:"monthly_requests,optional"`
+	DailyChars      int64  `json:"daily_chars,optional"`
+	MonthlyChars    int64  `json:"monthly_chars,optional"`
+	UpdatedAt       string `json:"updated_at,optional"`
+}
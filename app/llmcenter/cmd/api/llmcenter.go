@@ -7,6 +7,7 @@ import (
 	"document_agent/app/llmcenter/cmd/api/internal/config"
 	"document_agent/app/llmcenter/cmd/api/internal/handler"
 	"document_agent/app/llmcenter/cmd/api/internal/svc"
+	"document_agent/pkg/clientinfo"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/rest"
@@ -26,8 +27,15 @@ func main() {
 	)
 	defer server.Stop()
 
+	// 采集客户端 IP 与 User-Agent，供审计使用；只信任经过配置的反向代理转发的来源地址
+	server.Use(clientinfo.MustNewResolver(c.ClientInfo).Middleware)
+
 	ctx := svc.NewServiceContext(c)
+	// 拒绝已登出或被禁用账号的 token
+	server.Use(ctx.Sessions.Middleware)
 	handler.RegisterHandlers(server, ctx)
+	// 存活与就绪探针，不需要鉴权
+	server.AddRoutes(ctx.Health.Routes())
 
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
@@ -0,0 +1,127 @@
+package collab
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sync"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/ot"
+	"document_agent/pkg/xerr"
+)
+
+// Client 协同编辑的一个连接。服务端消息从 Events 读取，连接被会话断开时 Done 关闭，Err 为断开原因
+type Client struct {
+	ID        string
+	User      User
+	session   *session
+	selection *pb.CollabSelection // 由会话的锁保护
+	events    chan *pb.CollabResponse
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+func newClient(s *session, u User) *Client {
+	return &Client{
+		ID:      newClientID(),
+		User:    u,
+		session: s,
+		events:  make(chan *pb.CollabResponse, s.hub.c.SendBuffer),
+		done:    make(chan struct{}),
+	}
+}
+
+// Events 待发送给连接的服务端消息
+func (c *Client) Events() <-chan *pb.CollabResponse {
+	return c.events
+}
+
+// Done 连接被会话断开时关闭
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err 连接被断开的原因，Done 关闭后有效
+func (c *Client) Err() error {
+	return c.err
+}
+
+// Submit 提交基于 revision 版本的操作，selection 为提交后的光标，可以为空
+func (c *Client) Submit(revision int64, ops []*pb.TextOp, selection *pb.CollabSelection) error {
+	op, err := FromTextOps(ops)
+	if err != nil {
+		return err
+	}
+	return c.session.submit(c, revision, op, selection)
+}
+
+// Select 更新光标并通知其他连接
+func (c *Client) Select(selection *pb.CollabSelection) {
+	c.session.selectRange(c, selection)
+}
+
+// send 不阻塞地发送消息，待发送的消息过多时断开连接，须持有会话的锁
+func (c *Client) send(ev *pb.CollabResponse) {
+	select {
+	case <-c.done:
+	case c.events <- ev:
+	default:
+		c.close(fmt.Errorf("client %s send buffer full: %w", c.ID, xerr.ErrCollabDisconnected))
+	}
+}
+
+func (c *Client) close(err error) {
+	c.closeOnce.Do(func() {
+		c.err = err
+		close(c.done)
+	})
+}
+
+func (c *Client) info() *pb.CollabClient {
+	return &pb.CollabClient{
+		ClientId:  c.ID,
+		UserId:    c.User.ID,
+		UserName:  c.User.Name,
+		CanWrite:  c.User.CanWrite,
+		Selection: c.selection,
+	}
+}
+
+func newClientID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// FromTextOps 将 RPC 消息中的操作分量转换为操作
+func FromTextOps(ops []*pb.TextOp) (ot.Operation, error) {
+	var o ot.Operation
+	for i, op := range ops {
+		if op.Retain < 0 || op.Delete < 0 || op.Retain > math.MaxInt32 || op.Delete > math.MaxInt32 {
+			return nil, fmt.Errorf("component %d out of range: %w", i, xerr.ErrCollabOperationInvalid)
+		}
+		set := 0
+		for _, ok := range []bool{op.Retain > 0, op.Insert != "", op.Delete > 0} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("component %d: %w", i, xerr.ErrCollabOperationInvalid)
+		}
+		o = o.Retain(int(op.Retain)).Insert(op.Insert).Delete(int(op.Delete))
+	}
+	return o, nil
+}
+
+// ToTextOps 将操作转换为 RPC 消息中的操作分量
+func ToTextOps(o ot.Operation) []*pb.TextOp {
+	ops := make([]*pb.TextOp, 0, len(o))
+	for _, op := range o {
+		ops = append(ops, &pb.TextOp{Retain: int64(op.Retain), Insert: op.Insert, Delete: int64(op.Delete)})
+	}
+	return ops
+}
@@ -0,0 +1,232 @@
+// Package collab 文档的多人实时协同编辑。
+//
+// 同一 RPC 实例中每个正在协同编辑的文档有一个会话，会话按版本号顺序应用各连接提交的操作（pkg/ot），
+// 并推送给其他连接。会话定期通过 DocumentRepository 保存快照，保存前读取数据库中的内容，
+// 其他实例的会话或接口（整篇保存、大模型修改）写入的内容与会话中的内容三方合并后作为服务端操作推送，
+// 因此同一文档的连接落在不同实例上时也能收敛，只是彼此的修改要等到快照间隔后才能看到。
+package collab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/ot"
+)
+
+// 修改的来源
+const (
+	SourceCollab = "collab" // 协同编辑连接提交的操作
+	SourceEdit   = "edit"   // 大模型修改
+	SourceUpdate = "update" // 整篇保存
+	SourceSync   = "sync"   // 保存快照时合并的其他实例或接口写入的内容
+)
+
+// mergeAttempts 合并后条件保存的最大尝试次数，每次失败说明期间数据库被其他写入修改
+const mergeAttempts = 3
+
+// ErrConflict 合并写入多次遇到并发写入
+var ErrConflict = errors.New("document changed concurrently")
+
+// Config 协同编辑配置，未配置的字段使用括号中的默认值
+type Config struct {
+	SnapshotSeconds int `json:",optional"` // 保存快照并合并其他写入的间隔秒数（5）
+	MaxHistory      int `json:",optional"` // 保留的历史操作数，基于更早版本提交的操作须重新连接（1000）
+	MaxContentLen   int `json:",optional"` // 协同编辑的文档最大字符数（200000）
+	SendBuffer      int `json:",optional"` // 每个连接待发送的消息数上限，超过时断开该连接（256）
+}
+
+func (c Config) withDefaults() Config {
+	if c.SnapshotSeconds <= 0 {
+		c.SnapshotSeconds = 5
+	}
+	if c.MaxHistory <= 0 {
+		c.MaxHistory = 1000
+	}
+	if c.MaxContentLen <= 0 {
+		c.MaxContentLen = 200000
+	}
+	if c.SendBuffer <= 0 {
+		c.SendBuffer = 256
+	}
+	return c
+}
+
+// Documents 文档的读取与条件保存，由 repository.DocumentRepository 实现
+type Documents interface {
+	FindDocument(ctx context.Context, messageId string) (*model.Documents, error)
+	SwapDocumentContent(ctx context.Context, messageId, old, content string) (bool, error)
+}
+
+// LockFunc 查询文档是否处于锁定的审批状态，锁定的文档不能修改
+type LockFunc func(ctx context.Context, messageID string) (bool, error)
+
+// User 加入协同编辑的用户
+type User struct {
+	ID       int64
+	Name     string
+	CanWrite bool // 只有查看权限时只能查看与显示光标
+}
+
+// Hub 管理本实例中的协同编辑会话，并发安全
+type Hub struct {
+	c        Config
+	docs     Documents
+	locked   LockFunc
+	auditor  *audit.Recorder
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+func NewHub(c Config, docs Documents, locked LockFunc, auditor *audit.Recorder) *Hub {
+	return &Hub{
+		c:        c.withDefaults(),
+		docs:     docs,
+		locked:   locked,
+		auditor:  auditor,
+		sessions: make(map[string]*session),
+	}
+}
+
+// Join 加入文档的协同编辑，文档没有会话时从数据库加载。
+// 返回的连接首先收到 init 事件，调用方须在连接结束时调用 Leave
+func (h *Hub) Join(ctx context.Context, doc *model.Documents, u User) (*Client, error) {
+	s, err := h.acquire(ctx, doc.MessageId, doc.ConversationId, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.join(u), nil
+}
+
+// Leave 离开协同编辑，最后一个连接离开时保存并关闭会话
+func (h *Hub) Leave(c *Client) {
+	c.session.leave(c)
+	h.release(c.session)
+}
+
+// Merge 将基于 base 修改得到的 content 合并到文档当前的内容并保存，返回合并后的内容。
+// 文档有会话时作为服务端操作推送给各连接，否则直接与数据库中的内容合并，
+// 因此大模型修改与整篇保存期间其他人的修改不会被覆盖
+func (h *Hub) Merge(ctx context.Context, messageID string, userID int64, source, base, content string) (string, error) {
+	s, err := h.acquire(ctx, messageID, "", false)
+	if err != nil {
+		return "", err
+	}
+	if s != nil {
+		defer h.release(s)
+		return s.merge(ctx, userID, source, base, content)
+	}
+
+	for range mergeAttempts {
+		doc, err := h.docs.FindDocument(ctx, messageID)
+		if err != nil {
+			return "", err
+		}
+		op, err := ot.Merge(base, doc.Content, content)
+		if err != nil {
+			return "", err
+		}
+		merged, err := op.Apply(doc.Content)
+		if err != nil {
+			return "", err
+		}
+		ok, err := h.docs.SwapDocumentContent(ctx, messageID, doc.Content, merged)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return merged, nil
+		}
+	}
+	return "", fmt.Errorf("merge document %s: %w", messageID, ErrConflict)
+}
+
+// Content 文档会话中的最新内容，可能包含尚未保存的修改；没有会话时返回 false
+func (h *Hub) Content(ctx context.Context, messageID string) (string, bool) {
+	s, err := h.acquire(ctx, messageID, "", false)
+	if err != nil || s == nil {
+		return "", false
+	}
+	defer h.release(s)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.content, s.closed == nil
+}
+
+// Flush 立即保存文档会话中的修改，没有会话时不做处理
+func (h *Hub) Flush(ctx context.Context, messageID string) error {
+	s, err := h.acquire(ctx, messageID, "", false)
+	if err != nil || s == nil {
+		return err
+	}
+	defer h.release(s)
+	return s.sync(ctx)
+}
+
+// SetLocked 文档审批状态变化后通知会话中的连接，锁定后不再接受修改
+func (h *Hub) SetLocked(ctx context.Context, messageID string, locked bool) {
+	s, err := h.acquire(ctx, messageID, "", false)
+	if err != nil || s == nil {
+		return
+	}
+	defer h.release(s)
+	s.setLocked(locked)
+}
+
+// Close 文档被删除时断开会话中的全部连接，未保存的修改丢弃
+func (h *Hub) Close(ctx context.Context, messageID string, reason error) {
+	s, err := h.acquire(ctx, messageID, "", false)
+	if err != nil || s == nil {
+		return
+	}
+	defer h.release(s)
+	s.close(reason)
+}
+
+// acquire 查询文档的会话并增加引用，create 为 true 时在没有会话时创建并加载，为 false 时没有会话返回 nil
+func (h *Hub) acquire(ctx context.Context, messageID, conversationID string, create bool) (*session, error) {
+	h.mu.Lock()
+	s, ok := h.sessions[messageID]
+	if !ok {
+		if !create {
+			h.mu.Unlock()
+			return nil, nil
+		}
+		s = newSession(h, messageID, conversationID)
+		h.sessions[messageID] = s
+	}
+	s.refs++
+	h.mu.Unlock()
+
+	if !ok {
+		s.err = s.load(ctx)
+		close(s.ready)
+		if s.err == nil {
+			go s.run()
+		}
+	}
+	<-s.ready
+	if s.err != nil {
+		h.release(s)
+		return nil, s.err
+	}
+	return s, nil
+}
+
+// release 减少会话的引用，没有引用时移除会话，加载成功的会话保存后停止
+func (h *Hub) release(s *session) {
+	h.mu.Lock()
+	s.refs--
+	last := s.refs == 0
+	if last && h.sessions[s.messageID] == s {
+		delete(h.sessions, s.messageID)
+	}
+	h.mu.Unlock()
+
+	if last && s.err == nil {
+		s.stop()
+	}
+}
@@ -0,0 +1,348 @@
+package collab
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/xerr"
+)
+
+// documents 内存中的文档，locked 为审批锁定状态
+type documents struct {
+	mu      sync.Mutex
+	content map[string]string
+	locked  bool
+	swaps   int
+}
+
+func (d *documents) FindDocument(_ context.Context, messageId string) (*model.Documents, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	content, ok := d.content[messageId]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &model.Documents{MessageId: messageId, ConversationId: "conv", Content: content}, nil
+}
+
+func (d *documents) SwapDocumentContent(_ context.Context, messageId, old, content string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.content[messageId] != old {
+		return false, nil
+	}
+	d.content[messageId] = content
+	d.swaps++
+	return true, nil
+}
+
+func (d *documents) get(messageId string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.content[messageId]
+}
+
+func (d *documents) set(messageId, content string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.content[messageId] = content
+}
+
+func (d *documents) setLocked(locked bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.locked = locked
+}
+
+func (d *documents) isLocked(context.Context, string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.locked, nil
+}
+
+type auditLogs struct {
+	model.AuditLogsModel
+	mu      sync.Mutex
+	entries []*model.AuditLogs
+}
+
+func (m *auditLogs) Insert(_ context.Context, data *model.AuditLogs) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, data)
+	return nil
+}
+
+func (m *auditLogs) users() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []int64
+	for _, e := range m.entries {
+		ids = append(ids, e.UserId)
+	}
+	return ids
+}
+
+const docID = "doc-1"
+
+func newTestHub(t *testing.T, content string) (*Hub, *documents, *auditLogs) {
+	t.Helper()
+	docs := &documents{content: map[string]string{docID: content}}
+	logs := &auditLogs{}
+	// 定时保存的间隔足够长，测试中只通过 Flush、Merge 与离开时保存
+	h := NewHub(Config{SnapshotSeconds: 3600, MaxHistory: 4}, docs, docs.isLocked, audit.NewRecorder(logs))
+	return h, docs, logs
+}
+
+func join(t *testing.T, h *Hub, u User) *Client {
+	t.Helper()
+	c, err := h.Join(context.Background(), &model.Documents{MessageId: docID, ConversationId: "conv"}, u)
+	if err != nil {
+		t.Fatalf("Join: %v", err)
+	}
+	t.Cleanup(func() { h.Leave(c) })
+	return c
+}
+
+// next 读取连接的下一条消息
+func next(t *testing.T, c *Client) *pb.CollabResponse {
+	t.Helper()
+	select {
+	case ev := <-c.Events():
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no event")
+		return nil
+	}
+}
+
+// nextOf 跳过光标消息，读取下一条文档消息
+func nextOf(t *testing.T, c *Client) *pb.CollabResponse {
+	t.Helper()
+	for {
+		if ev := next(t, c); ev.GetPresence() == nil {
+			return ev
+		}
+	}
+}
+
+// insertAt 在 length 个字符的文档的 pos 处插入 text
+func insertAt(length, pos int, text string) []*pb.TextOp {
+	var ops []*pb.TextOp
+	if pos > 0 {
+		ops = append(ops, &pb.TextOp{Retain: int64(pos)})
+	}
+	ops = append(ops, &pb.TextOp{Insert: text})
+	if length > pos {
+		ops = append(ops, &pb.TextOp{Retain: int64(length - pos)})
+	}
+	return ops
+}
+
+func content(h *Hub) string {
+	s, _ := h.Content(context.Background(), docID)
+	return s
+}
+
+func TestConcurrentSubmits(t *testing.T) {
+	h, docs, logs := newTestHub(t, "请准时参加。")
+	a := join(t, h, User{ID: 1, CanWrite: true})
+	b := join(t, h, User{ID: 2, CanWrite: true})
+	if init := next(t, b).GetInit(); init == nil || init.Revision != 0 || init.Content != "请准时参加。" || len(init.Clients) != 1 {
+		t.Fatalf("init = %+v", init)
+	}
+	next(t, a) // init
+
+	// 两个连接都基于版本 0 提交，后到的操作与先到的转换后应用
+	if err := a.Submit(0, insertAt(6, 0, "各部门："), nil); err != nil {
+		t.Fatalf("submit a: %v", err)
+	}
+	if err := b.Submit(0, insertAt(6, 5, "，不得缺席"), &pb.CollabSelection{Anchor: 10, Head: 10}); err != nil {
+		t.Fatalf("submit b: %v", err)
+	}
+	const want = "各部门：请准时参加，不得缺席。"
+	if got := content(h); got != want {
+		t.Fatalf("content = %q, want %q", got, want)
+	}
+	if ack := nextOf(t, a).GetAck(); ack == nil || ack.Revision != 1 {
+		t.Fatalf("a ack = %+v", ack)
+	}
+	if op := nextOf(t, a).GetOperation(); op == nil || op.Revision != 2 || op.UserId != 2 || op.Source != SourceCollab {
+		t.Fatalf("a remote op = %+v", op)
+	}
+	if op := nextOf(t, b).GetOperation(); op == nil || op.Revision != 1 || op.UserId != 1 {
+		t.Fatalf("b remote op = %+v", op)
+	}
+	if ack := nextOf(t, b).GetAck(); ack == nil || ack.Revision != 2 {
+		t.Fatalf("b ack = %+v", ack)
+	}
+
+	// 保存后按贡献者记录审计
+	if err := h.Flush(context.Background(), docID); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if docs.get(docID) != want || len(logs.users()) != 2 {
+		t.Fatalf("saved %q, audit users %v", docs.get(docID), logs.users())
+	}
+	if st := nextOf(t, a).GetState(); st == nil || st.SavedRevision != 2 {
+		t.Fatalf("state = %+v", st)
+	}
+}
+
+func TestSubmitRejected(t *testing.T) {
+	h, docs, _ := newTestHub(t, "abc")
+	w := join(t, h, User{ID: 1, CanWrite: true})
+	r := join(t, h, User{ID: 2})
+
+	if err := r.Submit(0, insertAt(3, 0, "x"), nil); !errors.Is(err, xerr.ErrCollabReadOnly) {
+		t.Fatalf("read-only submit err = %v", err)
+	}
+	if err := w.Submit(0, insertAt(5, 0, "x"), nil); !errors.Is(err, xerr.ErrCollabOperationInvalid) {
+		t.Fatalf("wrong base length err = %v", err)
+	}
+	if err := w.Submit(0, []*pb.TextOp{{Retain: 1, Insert: "x"}}, nil); !errors.Is(err, xerr.ErrCollabOperationInvalid) {
+		t.Fatalf("invalid component err = %v", err)
+	}
+	if err := w.Submit(1, insertAt(3, 0, "x"), nil); !errors.Is(err, xerr.ErrCollabRevisionInvalid) {
+		t.Fatalf("future revision err = %v", err)
+	}
+
+	// 超过 MaxHistory 后基于过早版本的操作须重新连接
+	for i := range 5 {
+		if err := w.Submit(int64(i), insertAt(3+i, 0, "x"), nil); err != nil {
+			t.Fatalf("submit %d: %v", i, err)
+		}
+	}
+	if err := w.Submit(0, insertAt(3, 0, "y"), nil); !errors.Is(err, xerr.ErrCollabRevisionInvalid) {
+		t.Fatalf("trimmed revision err = %v", err)
+	}
+
+	// 锁定后拒绝修改，已有的未保存修改丢弃
+	docs.setLocked(true)
+	if err := h.Flush(context.Background(), docID); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if got := content(h); got != "abc" || docs.get(docID) != "abc" {
+		t.Fatalf("content = %q, saved %q", got, docs.get(docID))
+	}
+	if err := w.Submit(6, insertAt(3, 0, "x"), nil); !errors.Is(err, xerr.ErrDocumentLocked) {
+		t.Fatalf("locked submit err = %v", err)
+	}
+}
+
+func TestMergeIntoSession(t *testing.T) {
+	const base = "# 通知\n定于下周召开会议。\n"
+	h, docs, _ := newTestHub(t, base)
+	c := join(t, h, User{ID: 1, CanWrite: true})
+	next(t, c) // init
+
+	// 大模型基于 base 修改期间，连接中有尚未保存的修改
+	if err := c.Submit(0, insertAt(15, 15, "请准时参加。\n"), nil); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	nextOf(t, c) // ack
+	merged, err := h.Merge(context.Background(), docID, 9, SourceEdit, base, "# 关于召开会议的通知\n定于下周召开会议。\n")
+	if err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	const want = "# 关于召开会议的通知\n定于下周召开会议。\n请准时参加。\n"
+	if merged != want || docs.get(docID) != want || content(h) != want {
+		t.Fatalf("merged %q, saved %q, session %q", merged, docs.get(docID), content(h))
+	}
+	if op := nextOf(t, c).GetOperation(); op == nil || op.Source != SourceEdit || op.UserId != 9 || op.ClientId != "" {
+		t.Fatalf("merge op = %+v", op)
+	}
+}
+
+func TestMergeLocked(t *testing.T) {
+	const base = "请准时参加。"
+	h, docs, _ := newTestHub(t, base)
+	c := join(t, h, User{ID: 1, CanWrite: true})
+	next(t, c) // init
+
+	// 合并后保存前文档被锁定：修改被丢弃并返回 ErrDocumentLocked
+	docs.setLocked(true)
+	_, err := h.Merge(context.Background(), docID, 9, SourceEdit, base, "请各单位准时参加。")
+	if !errors.Is(err, xerr.ErrDocumentLocked) {
+		t.Fatalf("Merge err = %v, want ErrDocumentLocked", err)
+	}
+	if docs.get(docID) != base || content(h) != base {
+		t.Fatalf("saved %q, session %q", docs.get(docID), content(h))
+	}
+	nextOf(t, c) // merge 推送的操作
+	if st := nextOf(t, c).GetState(); st == nil || !st.Locked {
+		t.Fatalf("state = %+v", st)
+	}
+	if reset := nextOf(t, c).GetReset_(); reset == nil || reset.Content != base {
+		t.Fatalf("reset = %+v", reset)
+	}
+
+	// 会话已锁定时直接拒绝
+	if _, err := h.Merge(context.Background(), docID, 9, SourceEdit, base, "改动"); !errors.Is(err, xerr.ErrDocumentLocked) {
+		t.Fatalf("Merge err = %v, want ErrDocumentLocked", err)
+	}
+}
+
+func TestSyncMergesExternalWrites(t *testing.T) {
+	h, docs, _ := newTestHub(t, "甲\n乙\n")
+	c := join(t, h, User{ID: 1, CanWrite: true})
+	next(t, c) // init
+	if err := c.Submit(0, insertAt(4, 0, "标题\n"), nil); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	nextOf(t, c) // ack
+
+	// 其他实例写入的内容在保存时合并
+	docs.set(docID, "甲\n乙\n丙\n")
+	if err := h.Flush(context.Background(), docID); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	const want = "标题\n甲\n乙\n丙\n"
+	if content(h) != want || docs.get(docID) != want {
+		t.Fatalf("session %q, saved %q", content(h), docs.get(docID))
+	}
+	if op := nextOf(t, c).GetOperation(); op == nil || op.Source != SourceSync {
+		t.Fatalf("sync op = %+v", op)
+	}
+}
+
+func TestMergeWithoutSession(t *testing.T) {
+	h, docs, _ := newTestHub(t, "甲\n乙\n")
+	docs.set(docID, "甲\n乙\n丙\n")
+	merged, err := h.Merge(context.Background(), docID, 1, SourceUpdate, "甲\n乙\n", "标题\n甲\n乙\n")
+	if err != nil || merged != "标题\n甲\n乙\n丙\n" || docs.get(docID) != merged {
+		t.Fatalf("merged %q, %v", merged, err)
+	}
+	if _, ok := h.Content(context.Background(), docID); ok {
+		t.Fatal("Merge without session should not create one")
+	}
+}
+
+func TestDeletedDocumentClosesSession(t *testing.T) {
+	h, docs, _ := newTestHub(t, "abc")
+	c := join(t, h, User{ID: 1, CanWrite: true})
+	docs.mu.Lock()
+	delete(docs.content, docID)
+	docs.mu.Unlock()
+
+	if err := h.Flush(context.Background(), docID); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	select {
+	case <-c.Done():
+	case <-time.After(time.Second):
+		t.Fatal("client not closed")
+	}
+	if !errors.Is(c.Err(), xerr.ErrMessageNotFound) {
+		t.Fatalf("close reason = %v", c.Err())
+	}
+	if err := c.Submit(0, insertAt(3, 0, "x"), nil); !errors.Is(err, xerr.ErrMessageNotFound) {
+		t.Fatalf("submit after delete err = %v", err)
+	}
+}
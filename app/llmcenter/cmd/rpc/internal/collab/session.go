@@ -0,0 +1,457 @@
+package collab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/ot"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// syncTimeout 定时保存与最后一个连接离开时保存的超时时间
+const syncTimeout = 10 * time.Second
+
+// session 一个文档的协同编辑会话
+type session struct {
+	hub            *Hub
+	messageID      string
+	conversationID string
+
+	refs     int           // 连接与进行中的调用数，由 hub.mu 保护
+	ready    chan struct{} // 加载完成后关闭
+	err      error         // 加载失败的原因，ready 关闭后有效
+	done     chan struct{} // 停止定时保存
+	stopOnce sync.Once
+
+	syncMu sync.Mutex // 串行化保存，persisted 只在持有该锁时修改
+
+	mu            sync.Mutex
+	content       string
+	length        int   // content 的字符数
+	revision      int64 // 当前版本，每应用一个操作加一
+	base          int64 // history[0] 基于的版本
+	history       []ot.Operation
+	persisted     string // 最近一次读取或保存的数据库内容
+	savedRevision int64
+	locked        bool
+	lockReset     int64 // 最近一次因锁定丢弃未保存修改后的版本
+	closed        error // 文档已删除时不再接受连接与修改
+	contributors  map[int64]struct{}
+	clients       map[string]*Client
+}
+
+func newSession(h *Hub, messageID, conversationID string) *session {
+	return &session{
+		hub:            h,
+		messageID:      messageID,
+		conversationID: conversationID,
+		ready:          make(chan struct{}),
+		done:           make(chan struct{}),
+		contributors:   make(map[int64]struct{}),
+		clients:        make(map[string]*Client),
+	}
+}
+
+func (s *session) load(ctx context.Context) error {
+	doc, err := s.hub.docs.FindDocument(ctx, s.messageID)
+	if err != nil {
+		return err
+	}
+	locked, err := s.hub.locked(ctx, s.messageID)
+	if err != nil {
+		return err
+	}
+	if s.conversationID == "" {
+		s.conversationID = doc.ConversationId
+	}
+	s.content, s.persisted = doc.Content, doc.Content
+	s.length = utf8.RuneCountInString(doc.Content)
+	s.locked = locked
+	return nil
+}
+
+// run 定时保存快照，直到会话停止
+func (s *session) run() {
+	ticker := time.NewTicker(time.Duration(s.hub.c.SnapshotSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.syncInBackground()
+		}
+	}
+}
+
+// stop 停止定时保存并保存最后的修改
+func (s *session) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		s.syncInBackground()
+	})
+}
+
+func (s *session) syncInBackground() {
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+	if err := s.sync(ctx); err != nil {
+		logx.WithContext(ctx).Errorf("collab sync document %s failed: %v", s.messageID, err)
+	}
+}
+
+// join 加入连接，向其发送 init 并通知其他连接
+func (s *session) join(u User) *Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := newClient(s, u)
+	if s.closed != nil {
+		c.close(s.closed)
+		return c
+	}
+	clients := make([]*pb.CollabClient, 0, len(s.clients))
+	for _, other := range s.clients {
+		clients = append(clients, other.info())
+	}
+	c.send(&pb.CollabResponse{Event: &pb.CollabResponse_Init{Init: &pb.CollabInit{
+		ClientId: c.ID,
+		Revision: s.revision,
+		Content:  s.content,
+		CanWrite: u.CanWrite,
+		Locked:   s.locked,
+		Clients:  clients,
+	}}})
+	s.clients[c.ID] = c
+	s.broadcast(presenceEvent(c, false), c)
+	return c
+}
+
+func (s *session) leave(c *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.close(nil)
+	if _, ok := s.clients[c.ID]; !ok {
+		return
+	}
+	delete(s.clients, c.ID)
+	s.broadcast(presenceEvent(c, true), nil)
+}
+
+// submit 将连接基于 revision 版本提交的操作与其后的操作转换后应用
+func (s *session) submit(c *Client, revision int64, op ot.Operation, selection *pb.CollabSelection) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case s.closed != nil:
+		return s.closed
+	case s.clients[c.ID] != c:
+		return fmt.Errorf("client %s has left: %w", c.ID, xerr.ErrCollabDisconnected)
+	case !c.User.CanWrite:
+		return fmt.Errorf("user %d submit to document %s: %w", c.User.ID, s.messageID, xerr.ErrCollabReadOnly)
+	case s.locked:
+		return fmt.Errorf("submit to document %s: %w", s.messageID, xerr.ErrDocumentLocked)
+	case revision < s.base || revision > s.revision:
+		return fmt.Errorf("revision %d not in [%d, %d]: %w", revision, s.base, s.revision, xerr.ErrCollabRevisionInvalid)
+	}
+
+	var err error
+	for _, concurrent := range s.history[revision-s.base:] {
+		if op, _, err = ot.Transform(op, concurrent); err != nil {
+			return fmt.Errorf("transform operation: %v: %w", err, xerr.ErrCollabOperationInvalid)
+		}
+	}
+	if op.BaseLen() != s.length {
+		return fmt.Errorf("operation base length %d, document length %d: %w", op.BaseLen(), s.length, xerr.ErrCollabOperationInvalid)
+	}
+	if op.TargetLen() > s.hub.c.MaxContentLen {
+		return fmt.Errorf("document length %d exceeds %d: %w", op.TargetLen(), s.hub.c.MaxContentLen, xerr.ErrCollabOperationInvalid)
+	}
+	if err := s.apply(op, c, c.User.ID, SourceCollab); err != nil {
+		return fmt.Errorf("apply operation: %v: %w", err, xerr.ErrCollabOperationInvalid)
+	}
+	s.contributors[c.User.ID] = struct{}{}
+	c.send(&pb.CollabResponse{Event: &pb.CollabResponse_Ack{Ack: &pb.CollabAck{Revision: s.revision}}})
+	if selection != nil {
+		c.selection = s.clamp(selection)
+		s.broadcast(presenceEvent(c, false), c)
+	}
+	return nil
+}
+
+func (s *session) selectRange(c *Client, selection *pb.CollabSelection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed != nil || s.clients[c.ID] != c {
+		return
+	}
+	c.selection = s.clamp(selection)
+	s.broadcast(presenceEvent(c, false), c)
+}
+
+// apply 应用已转换到当前版本的操作，转换各连接的光标，并推送给 author 以外的连接，须持有 s.mu
+func (s *session) apply(op ot.Operation, author *Client, userID int64, source string) error {
+	content, err := op.Apply(s.content)
+	if err != nil {
+		return err
+	}
+	s.content, s.length = content, op.TargetLen()
+	s.revision++
+	s.history = append(s.history, op)
+	if drop := len(s.history) - s.hub.c.MaxHistory; drop > 0 {
+		s.history = append([]ot.Operation(nil), s.history[drop:]...)
+		s.base += int64(drop)
+	}
+
+	authorID := ""
+	if author != nil {
+		authorID = author.ID
+	}
+	ev := &pb.CollabResponse{Event: &pb.CollabResponse_Operation{Operation: &pb.CollabRemoteOperation{
+		Revision: s.revision,
+		Ops:      ToTextOps(op),
+		ClientId: authorID,
+		UserId:   userID,
+		Source:   source,
+	}}}
+	for _, c := range s.clients {
+		if c.selection != nil {
+			// 光标消息可能已在发送中，替换而不修改
+			c.selection = &pb.CollabSelection{
+				Anchor: int64(ot.TransformIndex(op, int(c.selection.Anchor))),
+				Head:   int64(ot.TransformIndex(op, int(c.selection.Head))),
+			}
+		}
+		if c != author {
+			c.send(ev)
+		}
+	}
+	return nil
+}
+
+// merge 将基于 base 修改得到的 content 作为服务端操作合并到会话中并立即保存，返回保存后的内容。
+// 文档已锁定，或保存前被锁定而丢弃了本次修改时返回 ErrDocumentLocked
+func (s *session) merge(ctx context.Context, userID int64, source, base, content string) (string, error) {
+	// 合并到保存完成前不允许其他保存，保存时因锁定丢弃的修改一定包含本次修改
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	if s.closed != nil {
+		s.mu.Unlock()
+		return "", s.closed
+	}
+	if s.locked {
+		s.mu.Unlock()
+		return "", fmt.Errorf("merge into document %s: %w", s.messageID, xerr.ErrDocumentLocked)
+	}
+	op, err := ot.Merge(base, s.content, content)
+	changed := err == nil && !op.IsNoop()
+	if changed {
+		err = s.apply(op, nil, userID, source)
+	}
+	revision := s.revision
+	s.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.syncLocked(ctx); err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.closed != nil:
+		return "", s.closed
+	case changed && s.lockReset > revision:
+		return "", fmt.Errorf("document %s locked before merge was saved: %w", s.messageID, xerr.ErrDocumentLocked)
+	}
+	return s.persisted, nil
+}
+
+// sync 合并数据库中被其他实例或接口修改的内容，再保存会话中的修改。
+// 保存使用内容比较，期间数据库被修改时重新合并，最多尝试 mergeAttempts 次
+func (s *session) sync(ctx context.Context) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	return s.syncLocked(ctx)
+}
+
+// syncLocked 同 sync，须持有 s.syncMu
+func (s *session) syncLocked(ctx context.Context) error {
+	for range mergeAttempts {
+		saved, err := s.syncOnce(ctx)
+		if err != nil || saved {
+			return err
+		}
+	}
+	return fmt.Errorf("sync document %s: %w", s.messageID, ErrConflict)
+}
+
+// syncOnce 返回 false 表示保存时数据库已被修改，须持有 s.syncMu
+func (s *session) syncOnce(ctx context.Context) (bool, error) {
+	doc, err := s.hub.docs.FindDocument(ctx, s.messageID)
+	if errors.Is(err, model.ErrNotFound) {
+		s.close(fmt.Errorf("document %s deleted: %w", s.messageID, xerr.ErrMessageNotFound))
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	locked, err := s.hub.locked(ctx, s.messageID)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	if s.closed != nil {
+		s.mu.Unlock()
+		return true, nil
+	}
+	if doc.Content != s.persisted {
+		op, err := ot.Merge(s.persisted, s.content, doc.Content)
+		if err == nil {
+			err = s.apply(op, nil, 0, SourceSync)
+		}
+		if err != nil {
+			// 无法合并时以数据库为准
+			logx.WithContext(ctx).Errorf("collab merge document %s failed, reset to stored content: %v", s.messageID, err)
+			s.reset(doc.Content)
+		}
+		s.persisted = doc.Content
+	}
+	s.setLockedLocked(locked)
+	if locked && s.content != s.persisted {
+		// 锁定前未保存的修改丢弃
+		s.reset(s.persisted)
+		s.lockReset = s.revision
+	}
+	if s.content == s.persisted {
+		s.savedRevision = s.revision
+		s.mu.Unlock()
+		return true, nil
+	}
+	old, content, revision := s.persisted, s.content, s.revision
+	contributors := s.contributors
+	s.contributors = make(map[int64]struct{})
+	s.mu.Unlock()
+
+	ok, err := s.hub.docs.SwapDocumentContent(ctx, s.messageID, old, content)
+	if err != nil || !ok {
+		s.mu.Lock()
+		for id := range contributors {
+			s.contributors[id] = struct{}{}
+		}
+		s.mu.Unlock()
+		return false, err
+	}
+
+	s.mu.Lock()
+	s.persisted = content
+	s.savedRevision = revision
+	s.broadcast(s.stateEvent(), nil)
+	s.mu.Unlock()
+
+	for userID := range contributors {
+		s.hub.auditor.Record(ctx, audit.Entry{
+			UserId:         userID,
+			Action:         audit.ActionCollab,
+			ConversationId: s.conversationID,
+			TargetId:       s.messageID,
+			HashBefore:     audit.Hash(old),
+			HashAfter:      audit.Hash(content),
+			Detail:         fmt.Sprintf("revision=%d,contributors=%d", revision, len(contributors)),
+		})
+	}
+	return true, nil
+}
+
+func (s *session) setLocked(locked bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setLockedLocked(locked)
+}
+
+// setLockedLocked 锁定状态变化时通知各连接，须持有 s.mu
+func (s *session) setLockedLocked(locked bool) {
+	if s.locked == locked {
+		return
+	}
+	s.locked = locked
+	s.broadcast(s.stateEvent(), nil)
+}
+
+// reset 丢弃会话中的修改与历史，各连接须以 content 重新开始，须持有 s.mu
+func (s *session) reset(content string) {
+	s.content, s.length = content, utf8.RuneCountInString(content)
+	s.revision++
+	s.history, s.base = nil, s.revision
+	s.contributors = make(map[int64]struct{})
+	for _, c := range s.clients {
+		if c.selection != nil {
+			c.selection = s.clamp(c.selection)
+		}
+	}
+	s.broadcast(&pb.CollabResponse{Event: &pb.CollabResponse_Reset_{Reset_: &pb.CollabReset{
+		Revision: s.revision,
+		Content:  content,
+	}}}, nil)
+}
+
+// close 断开全部连接，会话不再接受连接与修改
+func (s *session) close(reason error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed != nil {
+		return
+	}
+	s.closed = reason
+	for _, c := range s.clients {
+		c.close(reason)
+	}
+}
+
+// broadcast 推送给 except 以外的连接，须持有 s.mu
+func (s *session) broadcast(ev *pb.CollabResponse, except *Client) {
+	for _, c := range s.clients {
+		if c != except {
+			c.send(ev)
+		}
+	}
+}
+
+func (s *session) stateEvent() *pb.CollabResponse {
+	return &pb.CollabResponse{Event: &pb.CollabResponse_State{State: &pb.CollabState{
+		Locked:        s.locked,
+		SavedRevision: s.savedRevision,
+	}}}
+}
+
+// clamp 将光标限制在文档范围内，须持有 s.mu
+func (s *session) clamp(sel *pb.CollabSelection) *pb.CollabSelection {
+	limit := func(n int64) int64 {
+		return min(max(n, 0), int64(s.length))
+	}
+	return &pb.CollabSelection{Anchor: limit(sel.Anchor), Head: limit(sel.Head)}
+}
+
+func presenceEvent(c *Client, left bool) *pb.CollabResponse {
+	return &pb.CollabResponse{Event: &pb.CollabResponse_Presence{Presence: &pb.CollabPresence{
+		Client: c.info(),
+		Left:   left,
+	}}}
+}
@@ -1,6 +1,12 @@
 package config
 
 import (
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
+	"document_agent/pkg/approval"
+	"document_agent/pkg/circuit"
+	"document_agent/pkg/health"
+	"document_agent/pkg/screening"
+
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
@@ -26,7 +32,18 @@ type Config struct {
 		IdleConnTimeout     int
 		DisableCompression  bool
 	}
-	Upload struct {
+	// 星辰调用失败重试，未配置的字段使用默认值
+	LlmRetry struct {
+		MaxAttempts    int   `json:",optional"` // 含首次请求在内的最大尝试次数（3）
+		BaseDelayMs    int   `json:",optional"` // 首次重试前的等待毫秒数，之后逐次翻倍并加随机抖动（500）
+		MaxDelayMs     int   `json:",optional"` // 单次等待上限（5000）
+		RetryableCodes []int `json:",optional"` // 可重试的星辰错误码（服务繁忙与限流类错误码）
+	} `json:",optional"`
+	LlmBreaker circuit.Config `json:",optional"` // 星辰调用熔断，每个提供方独立计算
+	// 大模型提供方，按 Priority 从小到大依次故障转移，同一优先级内按 Weight 加权分流；
+	// 未配置时使用 XingChen 作为唯一的提供方
+	LlmProviders []LlmProvider `json:",optional"`
+	Upload       struct {
 		BaseDir string
 	}
 	Font struct {
@@ -41,4 +58,35 @@ type Config struct {
 		SignKey       string // 用于签名的密钥
 		ExpireSeconds int    // 链接有效期，单位秒
 	}
+	// 文档分享链接，签名使用 Download.SignKey，未配置的字段使用默认值
+	Share struct {
+		BaseURL              string `json:",optional"` // 分享页地址（Download.BaseURL 末尾的 /public/file 替换为 /public/share）
+		DefaultExpireSeconds int64  `json:",optional"` // 未指定有效期时的默认值（604800，7 天）
+		MaxExpireSeconds     int64  `json:",optional"` // 有效期上限（2592000，30 天）
+		MaxPasswordFailures  int64  `json:",optional"` // 同一链接 15 分钟内允许输错密码的次数（10）
+		RenderCacheSeconds   int    `json:",optional"` // 分享页与下载文件的缓存秒数（600），按导出内容计算缓存键，文档修改后不会命中旧缓存
+		IpPerMinute          int    `json:",optional"` // 同一客户端 IP 每分钟访问分享链接的次数上限（60）
+		LinkPerMinute        int    `json:",optional"` // 同一分享链接每分钟的访问次数上限（300）
+	} `json:",optional"`
+	Screening screening.Config       `json:",optional"` // 敏感词与涉密信息筛查
+	Redaction screening.RedactConfig `json:",optional"` // 引用文件个人信息可逆脱敏
+	Approval  approval.Config        `json:",optional"` // 公文审批流程，未配置时为 拟稿 → 核稿 → 签发
+	// 用户中心，读取用户资料中的默认文章类型与公文版头
+	UsercenterRpcConf zrpc.RpcClientConf
+	HealthCheck       health.Config `json:",optional"` // gRPC 就绪状态与诊断接口的依赖检查
+	Collab            collab.Config `json:",optional"` // 多人实时协同编辑
+}
+
+// LlmProvider 兼容星辰工作流接口的大模型提供方（端点）
+type LlmProvider struct {
+	Name         string // 提供方名称，记录在生成的消息中，并作为 gRPC 健康检查的服务名
+	Model        string `json:",optional"` // 模型或工作流名称，记录在生成的消息中，默认为 FlowID
+	ApiURL       string
+	ApiResumeURL string `json:",optional"`
+	ApiUploadURL string `json:",optional"` // 图片上传接口地址，未配置时该提供方不接收带图片的请求
+	ApiKey       string
+	ApiSecret    string
+	FlowID       string `json:",optional"`  // 该提供方的工作流ID，默认为 XingChen.FlowID
+	Priority     int    `json:",optional"`  // 越小越优先，当前优先级的提供方都不可用时转移到下一优先级
+	Weight       int    `json:",default=1"` // 同一优先级内的分流权重，0 表示仅作为同层备用
 }
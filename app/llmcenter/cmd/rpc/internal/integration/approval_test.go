@@ -0,0 +1,161 @@
+package integration
+
+import (
+	"testing"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+)
+
+// approvalFixture 团队空间中的一篇文档：用户 1 为编辑，用户 2、3、4 只能查看
+func approvalFixture(t *testing.T) (h *harness, convID, docID string) {
+	h = newHarness(t)
+	const workspaceID = 7
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	for _, uid := range []int64{2, 3, 4} {
+		h.users.setRole(uid, workspaceID, workspace.RoleViewer)
+	}
+	convID = h.generateIn(1, workspaceID)
+	return h, convID, h.seedDocument(convID, "# 关于召开年度工作会议的通知\n\n各部门：定于下周召开年度工作会议。")
+}
+
+func (h *harness) transition(in *pb.TransitionDocumentApprovalRequest) (*pb.DocumentApproval, error) {
+	resp, err := h.client.TransitionDocumentApproval(h.ctx(), in)
+	return resp.GetApproval(), err
+}
+
+func actionNames(a *pb.DocumentApproval) []string {
+	var names []string
+	for _, act := range a.Actions {
+		names = append(names, act.Action)
+	}
+	return names
+}
+
+func TestApprovalTransitions(t *testing.T) {
+	h, convID, docID := approvalFixture(t)
+	req := func(userID int64, action string, assignee int64, comment string) *pb.TransitionDocumentApprovalRequest {
+		return &pb.TransitionDocumentApprovalRequest{UserId: userID, ConversationId: convID, MessageId: docID, Action: action, AssigneeId: assignee, Comment: comment}
+	}
+
+	got, err := h.client.GetDocumentApproval(h.ctx(), &pb.GetDocumentApprovalRequest{UserId: 1, ConversationId: convID, MessageId: docID})
+	if err != nil {
+		t.Fatalf("GetDocumentApproval: %v", err)
+	}
+	if a := got.Approval; a.State != "draft" || a.Locked || a.Version != 0 || len(a.Actions) != 1 || a.Actions[0].Action != "submit" {
+		t.Fatalf("initial approval = %+v", a)
+	}
+
+	// 只有编辑可以提交，审批人须为能查看文档的其他用户
+	_, err = h.transition(req(2, "submit", 3, ""))
+	requireCode(t, err, xerr.ErrApprovalPermissionDenied)
+	for _, assignee := range []int64{0, 1, 99} {
+		_, err = h.transition(req(1, "submit", assignee, ""))
+		requireCode(t, err, xerr.ErrApprovalAssigneeInvalid)
+	}
+	_, err = h.transition(req(1, "pass", 2, ""))
+	requireCode(t, err, xerr.ErrApprovalActionInvalid)
+
+	a, err := h.transition(req(1, "submit", 2, ""))
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if a.State != "reviewing" || !a.Locked || a.AssigneeId != 2 || a.SubmitterId != 1 || a.Version != 1 {
+		t.Fatalf("submitted approval = %+v", a)
+	}
+
+	// 只有当前审批人可以核稿，退回须填写意见，过期的 version 被拒绝
+	_, err = h.transition(req(4, "pass", 3, ""))
+	requireCode(t, err, xerr.ErrApprovalPermissionDenied)
+	_, err = h.transition(req(2, "reject", 0, " "))
+	requireCode(t, err, xerr.ErrApprovalCommentRequired)
+	stale := req(2, "pass", 3, "")
+	stale.Version = 5
+	_, err = h.transition(stale)
+	requireCode(t, err, xerr.ErrApprovalConflict)
+
+	// 退回后回到拟稿，签署人重新计算
+	if a, err = h.transition(req(2, "reject", 0, "请补充会议地点")); err != nil || a.State != "draft" || a.Locked || a.AssigneeId != 0 {
+		t.Fatalf("reject: %+v, %v", a, err)
+	}
+	if _, err = h.transition(req(1, "submit", 2, "")); err != nil {
+		t.Fatalf("resubmit: %v", err)
+	}
+	if a, err = h.transition(req(2, "pass", 3, "同意")); err != nil || a.State != "signing" || a.AssigneeId != 3 {
+		t.Fatalf("pass: %+v, %v", a, err)
+	}
+	if a, err = h.transition(req(3, "sign", 0, "")); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if a.State != "approved" || !a.Locked || a.AssigneeId != 0 || a.Version != 5 || len(actionNames(a)) != 0 {
+		t.Fatalf("signed approval = %+v", a)
+	}
+	if len(a.Signers) != 2 || a.Signers[0].Label != "核稿人" || a.Signers[0].UserId != 2 || a.Signers[1].Label != "签发人" || a.Signers[1].UserId != 3 {
+		t.Fatalf("signers = %+v", a.Signers)
+	}
+
+	// 已签发的文档只有管理员可以撤销签发
+	_, err = h.transition(req(1, "withdraw", 0, ""))
+	requireCode(t, err, xerr.ErrApprovalActionInvalid)
+	_, err = h.transition(req(1, "revoke", 0, "重新修改"))
+	requireCode(t, err, xerr.ErrApprovalPermissionDenied)
+
+	got, err = h.client.GetDocumentApproval(h.ctx(), &pb.GetDocumentApprovalRequest{UserId: 4, ConversationId: convID, MessageId: docID})
+	if err != nil {
+		t.Fatalf("GetDocumentApproval: %v", err)
+	}
+	var actions []string
+	for _, r := range got.History {
+		actions = append(actions, r.Action)
+	}
+	if len(actions) != 5 || actions[1] != "reject" || got.History[1].Comment != "请补充会议地点" || got.History[4].UserId != 3 {
+		t.Fatalf("history = %+v", got.History)
+	}
+	if n := countAudit(h, audit.ActionApproval); n != 5 {
+		t.Fatalf("approval audit entries = %d", n)
+	}
+}
+
+func TestApprovalLocksDocument(t *testing.T) {
+	h, convID, docID := approvalFixture(t)
+	if _, err := h.transition(&pb.TransitionDocumentApprovalRequest{UserId: 1, ConversationId: convID, MessageId: docID, Action: "submit", AssigneeId: 2}); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	original := h.store.document(docID).Content
+
+	_, err := h.client.UpdateDocument(h.ctx(), &pb.UpdateDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改动"})
+	requireCode(t, err, xerr.ErrDocumentLocked)
+	_, err = h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改为正式语气"})
+	requireCode(t, err, xerr.ErrDocumentLocked)
+	_, err = h.client.DeleteDocument(h.ctx(), &pb.DeleteDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID})
+	requireCode(t, err, xerr.ErrDocumentLocked)
+	if doc := h.store.document(docID); doc == nil || doc.Content != original {
+		t.Fatalf("locked document changed: %+v", doc)
+	}
+	if n := len(h.mock.Requests()); n != 1 {
+		t.Fatalf("upstream requests = %d, locked edit should not call the model", n)
+	}
+
+	// 撤回后解锁
+	if _, err := h.transition(&pb.TransitionDocumentApprovalRequest{UserId: 1, ConversationId: convID, MessageId: docID, Action: "withdraw"}); err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if _, err := h.client.UpdateDocument(h.ctx(), &pb.UpdateDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改动"}); err != nil {
+		t.Fatalf("UpdateDocument after withdraw: %v", err)
+	}
+	if doc := h.store.document(docID); doc.Content != "改动" {
+		t.Fatalf("document = %q", doc.Content)
+	}
+}
+
+func countAudit(h *harness, action string) int {
+	n := 0
+	for _, a := range h.store.auditActions() {
+		if a == action {
+			n++
+		}
+	}
+	return n
+}
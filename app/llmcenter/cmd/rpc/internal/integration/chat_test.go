@@ -0,0 +1,352 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/usage"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+	"document_agent/pkg/xingchenmock"
+)
+
+func TestChatCompletions(t *testing.T) {
+	h := newHarness(t)
+
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议", Requests: "语言简洁"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if got := res.text(); got != "第一段。第二段。" || len(res.chunks) != 2 {
+		t.Fatalf("chunks = %q", res.chunks)
+	}
+	if res.end == nil || res.end.ConversationId == "" || res.end.MessageId == "" {
+		t.Fatalf("end event = %+v", res.end)
+	}
+
+	conv := h.store.conversation(res.end.ConversationId)
+	if conv == nil || conv.UserId != 1 || conv.Title != "关于召开年度工作会议" {
+		t.Fatalf("conversation = %+v", conv)
+	}
+	histories := h.store.historiesOf(res.end.ConversationId)
+	if len(histories) != 1 || histories[0].MessageId != res.end.MessageId || histories[0].Requests != "语言简洁" {
+		t.Fatalf("historydatas = %+v", histories)
+	}
+
+	reqs := h.mock.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("upstream requests = %d, want 1", len(reqs))
+	}
+	req := reqs[0]
+	if req.FlowID != flowID || req.UID != "1" || req.ChatID != res.end.ConversationId {
+		t.Fatalf("upstream request = %+v", req)
+	}
+	if !strings.HasPrefix(req.Authorization, "Bearer "+apiKey) {
+		t.Fatalf("authorization = %q", req.Authorization)
+	}
+	if !strings.Contains(req.Input, "请写一篇通知，基本信息：关于召开年度工作会议") {
+		t.Fatalf("prompt = %q", req.Input)
+	}
+
+	if actions := h.store.auditActions(); len(actions) != 1 || actions[0] != audit.ActionGenerate {
+		t.Fatalf("audit actions = %v", actions)
+	}
+	eventually(t, func() bool { return len(h.store.usageRecords()) == 1 }, "usage record")
+	u := h.store.usageRecords()[0]
+	if u.CallType != usage.CallGenerate || u.SuccessCount != 1 || u.ResponseChars != int64(len([]rune(res.text()))) {
+		t.Fatalf("usage = %+v", u)
+	}
+}
+
+func TestChatCompletionsDefaultDocumentType(t *testing.T) {
+	h := newHarness(t)
+
+	// 未指定文章类型时使用用户资料中的默认文章类型
+	h.generate(1)
+	if _, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Information: "关于调整作息时间"}); err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	reqs := h.mock.Requests()
+	if !strings.Contains(reqs[len(reqs)-1].Input, "请写一篇通知") {
+		t.Fatalf("prompt = %q", reqs[len(reqs)-1].Input)
+	}
+}
+
+func TestChatCompletionsContinuesConversation(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文")
+	if _, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改为正式语气"}); err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, ConversationId: convID, Documenttype: "通知", Information: "补充会议地点"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if res.end.ConversationId != convID {
+		t.Fatalf("conversation = %s, want %s", res.end.ConversationId, convID)
+	}
+	// 继续会话时携带此前的消息作为历史
+	reqs := h.mock.Requests()
+	history := reqs[len(reqs)-1].History
+	if len(history) != 2 || history[0].Role != "user" || history[0].Content != "改为正式语气" || history[1].Role != "assistant" {
+		t.Fatalf("history = %+v", history)
+	}
+
+	// 其他用户不能继续个人空间的会话
+	_, err = h.chat(&pb.ChatCompletionsRequest{UserId: 2, ConversationId: convID, Documenttype: "通知", Information: "补充"})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+}
+
+func TestChatCompletionsWorkspace(t *testing.T) {
+	h := newHarness(t)
+	const workspaceID = 7
+
+	in := &pb.ChatCompletionsRequest{UserId: 1, WorkspaceId: workspaceID, Documenttype: "通知", Information: "关于召开年度工作会议"}
+	_, err := h.chat(in)
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+
+	h.users.setRole(1, workspaceID, workspace.RoleViewer)
+	_, err = h.chat(in)
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+	if n := len(h.mock.Requests()); n != 0 {
+		t.Fatalf("upstream requests = %d, want 0", n)
+	}
+
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	res, err := h.chat(in)
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if conv := h.store.conversation(res.end.ConversationId); conv.WorkspaceId != workspaceID {
+		t.Fatalf("conversation workspace = %d", conv.WorkspaceId)
+	}
+}
+
+func TestChatCompletionsToleratesInterruptAndMalformedLines(t *testing.T) {
+	h := newHarness(t)
+	sc := xingchenmock.Reply("第一段。", "第二段。")
+	sc.Malformed = true
+	sc.Interrupt = &xingchenmock.Interrupt{EventID: "event-1", Type: "direct", Content: "请确认大纲"}
+	h.mock.Enqueue(sc)
+
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if got := res.text(); got != "第一段。第二段。" {
+		t.Fatalf("reply = %q", got)
+	}
+}
+
+func TestChatCompletionsWithoutStopMarker(t *testing.T) {
+	h := newHarness(t)
+	sc := xingchenmock.Reply("第一段。", "第二段。")
+	sc.NoStop = true
+	h.mock.Enqueue(sc)
+
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if got := res.text(); got != "第一段。第二段。" || res.end == nil {
+		t.Fatalf("reply = %q, end = %+v", got, res.end)
+	}
+}
+
+func TestChatCompletionsRetriesBeforeFirstChunk(t *testing.T) {
+	h := newHarness(t)
+	h.mock.Enqueue(
+		xingchenmock.Scenario{Status: http.StatusServiceUnavailable},
+		xingchenmock.Scenario{ErrorCode: 10110, ErrorMessage: "服务繁忙"},
+	)
+
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if got := res.text(); got != "第一段。第二段。" {
+		t.Fatalf("reply = %q", got)
+	}
+	if n := len(h.mock.Requests()); n != 3 {
+		t.Fatalf("upstream requests = %d, want 3", n)
+	}
+}
+
+func TestChatCompletionsUpstreamErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario xingchenmock.Scenario
+		requests int    // 期望的上游请求次数
+		chunks   string // 出错前已输出的正文
+	}{
+		{
+			name:     "non retryable code",
+			scenario: xingchenmock.Scenario{ErrorCode: 10013, ErrorMessage: "参数错误"},
+			requests: 1,
+		},
+		{
+			name:     "client error status",
+			scenario: xingchenmock.Scenario{Status: http.StatusUnauthorized, Body: "unauthorized"},
+			requests: 1,
+		},
+		{
+			name:     "retries exhausted",
+			scenario: xingchenmock.Scenario{Status: http.StatusBadGateway},
+			requests: 3,
+		},
+		{
+			name:     "error after first chunk",
+			scenario: xingchenmock.Scenario{Chunks: []string{"第一段。", "第二段。"}, ErrorCode: 10110, ErrorAfter: 1},
+			requests: 1,
+			chunks:   "第一段。",
+		},
+		{
+			name:     "connection dropped mid stream",
+			scenario: xingchenmock.Scenario{Chunks: []string{"第一段。", "第二段。"}, DropAfter: 1},
+			requests: 1,
+			chunks:   "第一段。",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.mock.SetDefault(tt.scenario)
+
+			res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+			requireCode(t, err, xerr.ErrLLMApiError)
+			if got := res.text(); got != tt.chunks {
+				t.Fatalf("chunks before error = %q, want %q", got, tt.chunks)
+			}
+			// 已经输出正文后不再重试
+			if n := len(h.mock.Requests()); n != tt.requests {
+				t.Fatalf("upstream requests = %d, want %d", n, tt.requests)
+			}
+			eventually(t, func() bool { return len(h.store.usageRecords()) == 1 }, "usage record")
+			if u := h.store.usageRecords()[0]; u.FailureCount != 1 {
+				t.Fatalf("usage = %+v", u)
+			}
+		})
+	}
+}
+
+func TestChatCompletionsSlowStreamCanceledByClient(t *testing.T) {
+	h := newHarness(t)
+	sc := xingchenmock.Reply("第一段。", "第二段。", "第三段。")
+	sc.ChunkDelay = 200 * time.Millisecond
+	h.mock.Enqueue(sc)
+
+	ctx, cancel := context.WithCancel(h.ctx())
+	stream, err := h.client.ChatCompletions(ctx, &pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil || resp.GetMessage().GetChunk() != "第一段。" {
+		t.Fatalf("first event = %+v, err = %v", resp, err)
+	}
+	cancel()
+
+	// 客户端断开后服务端停止读取上游并记录一次失败调用，不写审计日志
+	eventually(t, func() bool {
+		records := h.store.usageRecords()
+		return len(records) == 1 && records[0].FailureCount == 1
+	}, "failed usage record")
+	if actions := h.store.auditActions(); len(actions) != 0 {
+		t.Fatalf("audit actions = %v", actions)
+	}
+}
+
+func TestChatCompletionsScreening(t *testing.T) {
+	h := newHarness(t, func(c *config.Config) {
+		c.Screening = screening.Config{
+			Enable:    true,
+			WordLists: []screening.WordListConf{{Name: "涉密词", Action: screening.ActionBlock, Words: []string{"绝密"}}},
+		}
+	})
+
+	// 输入命中时不创建会话，也不请求星辰
+	_, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "绝密会议安排"})
+	requireCode(t, err, xerr.ErrContentBlocked)
+	if n := len(h.mock.Requests()); n != 0 {
+		t.Fatalf("upstream requests = %d, want 0", n)
+	}
+
+	// 输出命中时中止输出
+	h.mock.Enqueue(xingchenmock.Reply("本文件", "属于绝密内容"))
+	_, err = h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	requireCode(t, err, xerr.ErrContentBlocked)
+}
+
+func TestChatCompletionsScreeningMask(t *testing.T) {
+	h := newHarness(t, func(c *config.Config) {
+		c.Screening = screening.Config{
+			Enable:    true,
+			WordLists: []screening.WordListConf{{Name: "内部词", Action: screening.ActionMask, Words: []string{"内部"}}},
+		}
+	})
+
+	// 脱敏后的 information 与 requests 用于提示词、会话标题与历史数据
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "内部会议安排", Requests: "注明内部传阅"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if input := h.mock.Requests()[0].Input; strings.Contains(input, "内部") || !strings.Contains(input, "**会议安排") {
+		t.Fatalf("prompt = %q", input)
+	}
+	histories := h.store.historiesOf(res.end.ConversationId)
+	if len(histories) != 1 || histories[0].Information != "**会议安排" || histories[0].Requests != "注明**传阅" {
+		t.Fatalf("histories = %+v", histories)
+	}
+	if conv := h.store.conversation(res.end.ConversationId); strings.Contains(conv.Title, "内部") {
+		t.Fatalf("title = %q", conv.Title)
+	}
+}
+
+func TestChatCompletionsRedactHistory(t *testing.T) {
+	h := newHarness(t, func(c *config.Config) {
+		c.Redaction = screening.RedactConfig{Enable: true}
+	})
+
+	// 修改文档的回复中带有个人信息，作为助手消息保存时为原文
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+	h.mock.Enqueue(xingchenmock.Reply("联系人：张三，电话13812345678。"))
+	if _, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "补充联系人"}); err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+	if messages := h.store.messagesOf(convID); len(messages) == 0 || !strings.Contains(messages[len(messages)-1].Content, "13812345678") {
+		t.Fatalf("messages = %+v", messages)
+	}
+
+	// 后续对话中历史消息脱敏后发送，占位符在输出时还原
+	h.mock.Enqueue(xingchenmock.Reply("请{{姓名1}}于明日前回电。"))
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, ConversationId: convID, Documenttype: "通知", Information: "补充回电要求"})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	reqs := h.mock.Requests()
+	req := reqs[len(reqs)-1]
+	if len(req.History) == 0 {
+		t.Fatal("upstream request without history")
+	}
+	for _, m := range req.History {
+		if strings.Contains(m.Content, "张三") || strings.Contains(m.Content, "13812345678") {
+			t.Fatalf("history sent to upstream = %q", m.Content)
+		}
+	}
+	if !strings.Contains(req.Input, "占位符") {
+		t.Fatalf("prompt without redaction hint = %q", req.Input)
+	}
+	if got := res.text(); got != "请张三于明日前回电。" {
+		t.Fatalf("reply = %q", got)
+	}
+}
@@ -0,0 +1,207 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+	"document_agent/pkg/xingchenmock"
+)
+
+// collabConn 一个协同编辑连接
+type collabConn struct {
+	t      *testing.T
+	stream pb.LlmCenter_CollabDocumentClient
+	init   *pb.CollabInit
+}
+
+func (h *harness) collab(userID int64, convID, docID string) *collabConn {
+	h.t.Helper()
+	stream, err := h.client.CollabDocument(h.ctx())
+	if err != nil {
+		h.t.Fatalf("CollabDocument: %v", err)
+	}
+	if err := stream.Send(&pb.CollabRequest{Event: &pb.CollabRequest_Join{Join: &pb.CollabJoin{UserId: userID, ConversationId: convID, MessageId: docID}}}); err != nil {
+		h.t.Fatalf("send join: %v", err)
+	}
+	c := &collabConn{t: h.t, stream: stream}
+	c.init = c.until(func(ev *pb.CollabResponse) bool { return ev.GetInit() != nil }).GetInit()
+	return c
+}
+
+// until 读取消息直到 match 返回 true
+func (c *collabConn) until(match func(*pb.CollabResponse) bool) *pb.CollabResponse {
+	c.t.Helper()
+	for {
+		ev, err := c.stream.Recv()
+		if err != nil {
+			c.t.Fatalf("collab recv: %v", err)
+		}
+		if match(ev) {
+			return ev
+		}
+	}
+}
+
+// untilRevision 读取消息直到收到 revision 版本的确认或其他连接的操作，返回期间收到的操作来源
+func (c *collabConn) untilRevision(revision int64) []string {
+	c.t.Helper()
+	var sources []string
+	c.until(func(ev *pb.CollabResponse) bool {
+		if op := ev.GetOperation(); op != nil {
+			sources = append(sources, op.Source)
+			return op.Revision == revision
+		}
+		return ev.GetAck().GetRevision() == revision
+	})
+	return sources
+}
+
+func (c *collabConn) submit(revision int64, ops ...*pb.TextOp) {
+	c.t.Helper()
+	if err := c.stream.Send(&pb.CollabRequest{Event: &pb.CollabRequest_Operation{Operation: &pb.CollabOperation{Revision: revision, Ops: ops}}}); err != nil {
+		c.t.Fatalf("send operation: %v", err)
+	}
+}
+
+// closedWith 读取消息直到连接被服务端断开，返回断开的原因
+func (c *collabConn) closedWith() error {
+	c.t.Helper()
+	for {
+		if _, err := c.stream.Recv(); err != nil {
+			return err
+		}
+	}
+}
+
+// insertOps 在 content 中 before 之前插入 text 的操作
+func insertOps(t *testing.T, content, before, text string) []*pb.TextOp {
+	t.Helper()
+	i := strings.Index(content, before)
+	if i < 0 {
+		t.Fatalf("%q not found", before)
+	}
+	pos, total := utf8.RuneCountInString(content[:i]), utf8.RuneCountInString(content)
+	ops := []*pb.TextOp{{Insert: text}, {Retain: int64(total - pos)}}
+	if pos > 0 {
+		ops = append([]*pb.TextOp{{Retain: int64(pos)}}, ops...)
+	}
+	return ops
+}
+
+func TestCollabSession(t *testing.T) {
+	h := newHarness(t)
+	const workspaceID = 7
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	h.users.setRole(2, workspaceID, workspace.RoleEditor)
+	h.users.setRole(3, workspaceID, workspace.RoleViewer)
+	convID := h.generateIn(1, workspaceID)
+	original := "# 通知\n定于下周召开会议。\n请准时参加。\n"
+	docID := h.seedDocument(convID, original)
+
+	a := h.collab(1, convID, docID)
+	b := h.collab(2, convID, docID)
+	viewer := h.collab(3, convID, docID)
+	if !a.init.CanWrite || viewer.init.CanWrite || viewer.init.Content != original || len(viewer.init.Clients) != 2 {
+		t.Fatalf("init = %+v / %+v", a.init, viewer.init)
+	}
+
+	// 两个连接同时基于版本 0 提交，服务端转换后收敛
+	a.submit(0, insertOps(t, original, "通知", "关于召开会议的")...)
+	b.submit(0, insertOps(t, original, "请准时", "各部门：")...)
+	for _, c := range []*collabConn{a, b, viewer} {
+		c.untilRevision(2)
+	}
+	live := "# 关于召开会议的通知\n定于下周召开会议。\n各部门：请准时参加。\n"
+	if got, _ := h.svcCtx.Collab.Content(h.ctx(), docID); got != live {
+		t.Fatalf("session content = %q, want %q", got, live)
+	}
+
+	// 只有查看权限的连接提交时断开
+	viewer.submit(2, insertOps(t, live, "定于", "拟")...)
+	requireCode(t, viewer.closedWith(), xerr.ErrCollabReadOnly)
+
+	// 大模型修改以会话中未保存的内容为原文，生成期间其他人的修改合并保留
+	h.mock.Reset()
+	rewrite := strings.Replace(live, "定于下周召开会议。", "定于本周五上午召开年度工作会议。", 1)
+	h.mock.Enqueue(xingchenmock.Scenario{Chunks: []string{rewrite}, ChunkDelay: 300 * time.Millisecond})
+	edited := make(chan error, 1)
+	go func() {
+		// h.edit 失败时调用 t.Fatalf，不能在其他协程中使用
+		stream, err := h.client.EditDocument(h.ctx(), &pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "明确会议时间"})
+		if err == nil {
+			_, err = collect(stream.Recv)
+		}
+		edited <- err
+	}()
+	eventually(t, func() bool { return len(h.mock.Requests()) == 1 }, "edit request")
+	if !strings.Contains(h.mock.Requests()[0].Input, "各部门：请准时参加。") {
+		t.Fatalf("edit prompt does not use live content: %q", h.mock.Requests()[0].Input)
+	}
+	b.submit(2, &pb.TextOp{Retain: int64(utf8.RuneCountInString(live))}, &pb.TextOp{Insert: "特此通知。\n"})
+	b.untilRevision(3)
+	if err := <-edited; err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+	want := rewrite + "特此通知。\n"
+	if sources := a.untilRevision(4); len(sources) != 2 || sources[0] != collab.SourceCollab || sources[1] != collab.SourceEdit {
+		t.Fatalf("operation sources = %v", sources)
+	}
+	if doc := h.store.document(docID); doc.Content != want {
+		t.Fatalf("saved content = %q, want %q", doc.Content, want)
+	}
+	if n := countAudit(h, audit.ActionCollab); n != 2 {
+		t.Fatalf("collab audit entries = %d", n)
+	}
+
+	// 提交审批后锁定，各连接收到锁定状态，之后的修改被拒绝
+	if _, err := h.transition(&pb.TransitionDocumentApprovalRequest{UserId: 1, ConversationId: convID, MessageId: docID, Action: "submit", AssigneeId: 3}); err != nil {
+		t.Fatalf("submit approval: %v", err)
+	}
+	for _, c := range []*collabConn{a, b} {
+		c.until(func(ev *pb.CollabResponse) bool { return ev.GetState().GetLocked() })
+	}
+	a.submit(4, insertOps(t, want, "特此", "此致。")...)
+	requireCode(t, a.closedWith(), xerr.ErrDocumentLocked)
+	if doc := h.store.document(docID); doc.Content != want {
+		t.Fatalf("locked document changed: %q", doc.Content)
+	}
+}
+
+func TestUpdateDocumentMergesFromBase(t *testing.T) {
+	h := newHarness(t)
+	const workspaceID = 7
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	h.users.setRole(2, workspaceID, workspace.RoleEditor)
+	convID := h.generateIn(1, workspaceID)
+	base := "# 通知\n定于下周召开会议。\n请准时参加。\n"
+	docID := h.seedDocument(convID, base)
+	update := func(userID int64, base *string, content string) {
+		t.Helper()
+		if _, err := h.client.UpdateDocument(h.ctx(), &pb.UpdateDocumentRequest{
+			UserId: userID, ConversationId: convID, MessageId: docID, Prompt: content, Base: base,
+		}); err != nil {
+			t.Fatalf("UpdateDocument: %v", err)
+		}
+	}
+
+	// 两个客户端基于同一内容先后保存，后保存的以加载时的内容为基准合并，两处修改都保留
+	update(1, &base, strings.Replace(base, "# 通知", "# 关于召开会议的通知", 1))
+	update(2, &base, strings.Replace(base, "请准时参加。", "各部门：请准时参加。", 1))
+	merged := "# 关于召开会议的通知\n定于下周召开会议。\n各部门：请准时参加。\n"
+	if doc := h.store.document(docID); doc.Content != merged {
+		t.Fatalf("merged content = %q, want %q", doc.Content, merged)
+	}
+
+	// 未传基准时整篇覆盖
+	update(1, nil, base)
+	if doc := h.store.document(docID); doc.Content != base {
+		t.Fatalf("content without base = %q, want %q", doc.Content, base)
+	}
+}
@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/textanchor"
+	"document_agent/pkg/xerr"
+	"document_agent/pkg/xingchenmock"
+)
+
+// runeRange 返回 text 在 content 中首次出现的字符位置
+func runeRange(t *testing.T, content, text string) (int64, int64) {
+	t.Helper()
+	i := strings.Index(content, text)
+	if i < 0 {
+		t.Fatalf("%q not found", text)
+	}
+	start := utf8.RuneCountInString(content[:i])
+	return int64(start), int64(start + utf8.RuneCountInString(text))
+}
+
+func (h *harness) comment(in *pb.CreateDocumentCommentRequest) *pb.DocumentComment {
+	h.t.Helper()
+	resp, err := h.client.CreateDocumentComment(h.ctx(), in)
+	if err != nil {
+		h.t.Fatalf("CreateDocumentComment: %v", err)
+	}
+	return resp.Comment
+}
+
+func TestCommentReanchoring(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	original := "# 关于召开年度工作会议的通知\n\n各部门：\n\n定于下周三召开年度工作会议，请各单位负责人准时参加。\n\n会议地点另行通知。\n\n特此通知。"
+	docID := h.seedDocument(convID, original)
+
+	quotes := []string{"请各单位负责人准时参加", "会议地点另行通知", "定于下周三召开年度工作会议"}
+	var ids []int64
+	for _, q := range quotes {
+		start, end := runeRange(t, original, q)
+		c := h.comment(&pb.CreateDocumentCommentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Content: "请核实", AnchorStart: start, AnchorEnd: end})
+		if c.Quote != q || c.Anchor.GetStatus() != textanchor.StatusAnchored {
+			t.Fatalf("comment = %+v", c)
+		}
+		ids = append(ids, c.Id)
+	}
+	h.comment(&pb.CreateDocumentCommentRequest{UserId: 1, MessageId: docID, ParentId: ids[0], Content: "已确认"})
+
+	// 前面插入文字、删除一段、改动引用中的两个字
+	updated := "# 关于召开年度工作会议的通知\n\n各部门：\n\n为总结全年工作，定于本周五召开年度工作会议，请各单位负责人准时参加。\n\n特此通知。"
+	if _, err := h.client.UpdateDocument(h.ctx(), &pb.UpdateDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: updated}); err != nil {
+		t.Fatalf("UpdateDocument: %v", err)
+	}
+
+	resp, err := h.client.ListDocumentComments(h.ctx(), &pb.ListDocumentCommentsRequest{UserId: 1, ConversationId: convID, MessageId: docID})
+	if err != nil {
+		t.Fatalf("ListDocumentComments: %v", err)
+	}
+	if len(resp.Items) != 3 || resp.OpenCount != 3 || len(resp.Items[0].Replies) != 1 {
+		t.Fatalf("comments = %+v", resp)
+	}
+	start, end := runeRange(t, updated, quotes[0])
+	if a := resp.Items[0].Anchor; a.Status != textanchor.StatusAnchored || a.Start != start || a.End != end {
+		t.Fatalf("moved anchor = %+v, want [%d,%d)", a, start, end)
+	}
+	if a := resp.Items[1].Anchor; a.Status != textanchor.StatusOrphaned || resp.Items[1].Quote != quotes[1] {
+		t.Fatalf("deleted anchor = %+v", a)
+	}
+	start, end = runeRange(t, updated, "定于本周五召开年度工作会议")
+	if a := resp.Items[2].Anchor; a.Status != textanchor.StatusFuzzy || a.Text != "定于本周五召开年度工作会议" || a.Start != start || a.End != end {
+		t.Fatalf("edited anchor = %+v", a)
+	}
+
+	// 定位结果按文档内容保存，内容不变时不再重新定位
+	for _, id := range ids {
+		if c := h.store.comment(id); c.AnchorHash != audit.Hash(updated) {
+			t.Fatalf("comment %d anchor hash not updated", id)
+		}
+	}
+
+	// 客户端按旧内容提交的位置，根据引用的文本重新定位；找不到时拒绝
+	start, end = runeRange(t, original, "特此通知")
+	c := h.comment(&pb.CreateDocumentCommentRequest{UserId: 1, MessageId: docID, Content: "落款", AnchorStart: start, AnchorEnd: end, Quote: "特此通知"})
+	if wantStart, _ := runeRange(t, updated, "特此通知"); c.Anchor.Start != wantStart || c.Anchor.Text != "特此通知" {
+		t.Fatalf("relocated anchor = %+v", c.Anchor)
+	}
+	_, err = h.client.CreateDocumentComment(h.ctx(), &pb.CreateDocumentCommentRequest{UserId: 1, MessageId: docID, Content: "地点",
+		AnchorStart: 0, AnchorEnd: 8, Quote: quotes[1]})
+	requireCode(t, err, xerr.ErrCommentAnchorInvalid)
+
+	// 按批注修改时，失效的批注使用发表时引用的原文
+	h.mock.Reset()
+	h.mock.Enqueue(xingchenmock.Reply("修改后的", "内容"))
+	if _, err := h.client.ResolveDocumentComment(h.ctx(), &pb.ResolveDocumentCommentRequest{UserId: 1, Id: c.Id, Resolved: true}); err != nil {
+		t.Fatalf("ResolveDocumentComment: %v", err)
+	}
+	if _, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, AddressComments: true}); err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+	prompt := h.mock.Requests()[0].Input
+	for _, want := range []string{"针对“请各单位负责人准时参加”：请核实", "回复：已确认", "针对“会议地点另行通知”（原文已修改）", "针对“定于本周五召开年度工作会议”"} {
+		if !strings.Contains(prompt, want) {
+			t.Fatalf("prompt missing %q: %q", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "落款") {
+		t.Fatalf("resolved comment in prompt: %q", prompt)
+	}
+}
@@ -0,0 +1,127 @@
+package integration
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+)
+
+func (h *harness) allocateDocNo(userID, workspaceID int64, convID, docID string) (*pb.DocNumber, error) {
+	resp, err := h.client.AllocateDocNo(h.ctx(), &pb.AllocateDocNoRequest{
+		UserId: userID, WorkspaceId: workspaceID, Prefix: "某政", ConversationId: convID, MessageId: docID,
+	})
+	return resp.GetDocNo(), err
+}
+
+func TestDocNoReserveReleaseReuse(t *testing.T) {
+	fakePandoc(t)
+	h := newHarness(t)
+	const workspaceID = 7
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	h.users.setRole(1, 8, workspace.RoleEditor)
+	h.users.setRole(2, workspaceID, workspace.RoleViewer)
+	var convs, docs []string
+	for range 3 {
+		convID := h.generateIn(1, workspaceID)
+		convs, docs = append(convs, convID), append(docs, h.seedDocument(convID, "# 关于召开年度工作会议的通知\n\n各部门：定于下周召开年度工作会议。"))
+	}
+	docNo := func(seq int) string { return fmt.Sprintf("某政〔%d〕%d号", time.Now().Year(), seq) }
+
+	first, err := h.allocateDocNo(1, workspaceID, convs[0], docs[0])
+	if err != nil {
+		t.Fatalf("AllocateDocNo: %v", err)
+	}
+	if first.Seq != 1 || first.DocNo != docNo(1) || first.Status != model.DocNoReserved || first.WorkspaceId != workspaceID {
+		t.Fatalf("first doc no = %+v", first)
+	}
+	// 同一文档重复分配返回已预留的字号；省略团队时使用文档所属的团队
+	if again, err := h.allocateDocNo(1, 0, convs[0], docs[0]); err != nil || again.Id != first.Id {
+		t.Fatalf("allocate again = %+v, %v", again, err)
+	}
+	second, err := h.allocateDocNo(1, 0, convs[1], docs[1])
+	if err != nil || second.Seq != 2 || second.WorkspaceId != workspaceID {
+		t.Fatalf("second doc no = %+v, %v", second, err)
+	}
+
+	// 只有团队编辑可以分配与释放，请求中的团队须与文档所属的团队一致
+	_, err = h.allocateDocNo(2, workspaceID, convs[2], docs[2])
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+	_, err = h.allocateDocNo(1, 8, convs[2], docs[2])
+	requireCode(t, err, xerr.ErrRequestParam)
+	_, err = h.client.ReleaseDocNo(h.ctx(), &pb.ReleaseDocNoRequest{UserId: 2, Id: first.Id})
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+
+	released, err := h.client.ReleaseDocNo(h.ctx(), &pb.ReleaseDocNoRequest{UserId: 1, Id: first.Id})
+	if err != nil || released.DocNo.Status != model.DocNoReleased || released.DocNo.ReleasedAt == 0 {
+		t.Fatalf("release = %+v, %v", released, err)
+	}
+	_, err = h.client.ReleaseDocNo(h.ctx(), &pb.ReleaseDocNoRequest{UserId: 1, Id: first.Id})
+	requireCode(t, err, xerr.ErrDocNoStatusInvalid)
+
+	// 释放的字号优先分配给下一份文档，导出时一并分配
+	exported, err := h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{
+		UserId: 1, ConversationId: convs[2], MessageId: docs[2], Markdown: "正文", Type: "docx", DocNoPrefix: "某政",
+	})
+	if err != nil {
+		t.Fatalf("ConvertMarkdown: %v", err)
+	}
+	if exported.DocNo != docNo(1) {
+		t.Fatalf("exported doc no = %q, want reused %q", exported.DocNo, docNo(1))
+	}
+	if third, err := h.allocateDocNo(1, workspaceID, convs[2], docs[2]); err != nil || third.Id != first.Id || third.MessageId != docs[2] {
+		t.Fatalf("reused doc no = %+v, %v", third, err)
+	}
+
+	// 已使用的字号不能释放
+	if _, err := h.client.IssueDocNo(h.ctx(), &pb.IssueDocNoRequest{UserId: 1, Id: second.Id}); err != nil {
+		t.Fatalf("IssueDocNo: %v", err)
+	}
+	_, err = h.client.ReleaseDocNo(h.ctx(), &pb.ReleaseDocNoRequest{UserId: 1, Id: second.Id})
+	requireCode(t, err, xerr.ErrDocNoStatusInvalid)
+
+	// 个人空间的文档没有发文字号登记
+	personal := h.generate(1)
+	_, err = h.allocateDocNo(1, workspaceID, personal, h.seedDocument(personal, "正文"))
+	requireCode(t, err, xerr.ErrDocNoPersonal)
+
+	if n := countAudit(h, audit.ActionDocNo); n != 7 {
+		t.Fatalf("doc no audit entries = %d", n)
+	}
+}
+
+func TestExportWithoutDocNo(t *testing.T) {
+	fakePandoc(t)
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "正文")
+
+	// 未填写、未分配、用户资料中也没有发文字号时不输出文号
+	exported, err := h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{
+		UserId: 1, ConversationId: convID, MessageId: docID, Markdown: "正文", Type: "docx",
+	})
+	if err != nil {
+		t.Fatalf("ConvertMarkdown: %v", err)
+	}
+	if got := string(exported.Data); exported.DocNo != "" || strings.Contains(got, "GovDocNo") || !strings.Contains(got, "GovTitle") {
+		t.Fatalf("export without doc no = %q, %q", exported.DocNo, got)
+	}
+
+	// 手填的文号照常输出
+	exported, err = h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{
+		UserId: 1, ConversationId: convID, MessageId: docID, Markdown: "正文", Type: "docx",
+		Information: []*pb.InfoItem{{Type: "docNo", Contant: "某政〔2025〕3号"}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertMarkdown: %v", err)
+	}
+	if got := string(exported.Data); !strings.Contains(got, "GovDocNo") || !strings.Contains(got, "某政〔2025〕3号") {
+		t.Fatalf("export with doc no = %q", got)
+	}
+}
@@ -0,0 +1,324 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm"
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/gongwen"
+	"document_agent/pkg/usage"
+	"document_agent/pkg/xerr"
+	"document_agent/pkg/xingchenmock"
+
+	"google.golang.org/grpc"
+)
+
+func TestChatResume(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	h.mock.Reset()
+	h.mock.Enqueue(xingchenmock.Reply("# 关于召开年度工作会议的通知\n\n", "各部门：\n\n定于下周召开年度工作会议。"))
+
+	res, err := h.resume(&pb.ChatResumeRequest{UserId: 1, ConversationId: convID, Content: "一、会议时间\n二、会议地点", FormatCheck: true})
+	if err != nil {
+		t.Fatalf("ChatResume: %v", err)
+	}
+	if res.end == nil || res.end.ConversationId != convID || res.end.MessageId == "" {
+		t.Fatalf("end event = %+v", res.end)
+	}
+	if res.check.GetResult().GetMessageId() != res.end.MessageId {
+		t.Fatalf("check event = %+v", res.check)
+	}
+
+	// 最终文档连同提供方与模型一起保存
+	doc := h.store.document(res.end.MessageId)
+	if doc == nil || doc.Content != res.text() || doc.ConversationId != convID {
+		t.Fatalf("document = %+v", doc)
+	}
+	if doc.Provider != provider.DefaultName || doc.Model != flowID {
+		t.Fatalf("document generated by %s/%s", doc.Provider, doc.Model)
+	}
+
+	req := h.mock.Requests()[0]
+	if !strings.Contains(req.Input, "生成一篇通知") || !strings.Contains(req.Input, "清单内容如下：一、会议时间\n二、会议地点") {
+		t.Fatalf("prompt = %q", req.Input)
+	}
+	if actions := h.store.auditActions(); len(actions) != 2 || actions[1] != audit.ActionResume {
+		t.Fatalf("audit actions = %v", actions)
+	}
+	eventually(t, func() bool { return len(h.store.usageRecords()) == 2 }, "usage records")
+	if u := h.store.usageRecords()[1]; u.CallType != usage.CallResume || u.SuccessCount != 1 {
+		t.Fatalf("usage = %+v", u)
+	}
+}
+
+func TestChatResumeAccess(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+
+	_, err := h.resume(&pb.ChatResumeRequest{UserId: 2, ConversationId: convID, Content: "大纲"})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+
+	_, err = h.resume(&pb.ChatResumeRequest{UserId: 1, ConversationId: "missing", Content: "大纲"})
+	requireCode(t, err, xerr.ErrConversationNotFound)
+}
+
+func TestChatResumeFailover(t *testing.T) {
+	backup := xingchenmock.New(xingchenmock.Reply("备用提供方生成的正文"))
+	backupServer := httptest.NewServer(backup)
+	t.Cleanup(backupServer.Close)
+
+	h := newHarness(t, func(c *config.Config) {
+		c.LlmProviders = []config.LlmProvider{
+			{Name: "primary", ApiURL: c.XingChen.ApiURL, ApiKey: apiKey, Weight: 1},
+			{Name: "backup", Model: "backup-model", ApiURL: backupServer.URL + "/workflow/v1/chat/completions", ApiKey: apiKey, Priority: 1, Weight: 1},
+		}
+	})
+	h.mock.SetDefault(xingchenmock.Scenario{Status: http.StatusServiceUnavailable})
+
+	convID := h.generate(1)
+	res, err := h.resume(&pb.ChatResumeRequest{UserId: 1, ConversationId: convID, Content: "大纲"})
+	if err != nil {
+		t.Fatalf("ChatResume: %v", err)
+	}
+	doc := h.store.document(res.end.MessageId)
+	if doc == nil || doc.Content != "备用提供方生成的正文" || doc.Provider != "backup" || doc.Model != "backup-model" {
+		t.Fatalf("document = %+v", doc)
+	}
+	if len(h.mock.Requests()) == 0 || len(backup.Requests()) != 2 {
+		t.Fatalf("primary requests = %d, backup requests = %d", len(h.mock.Requests()), len(backup.Requests()))
+	}
+}
+
+func TestUploadImageFailover(t *testing.T) {
+	backup := xingchenmock.New(xingchenmock.Reply("备用提供方修改的正文"))
+	backupServer := httptest.NewServer(backup)
+	t.Cleanup(backupServer.Close)
+
+	h := newHarness(t, func(c *config.Config) {
+		c.LlmProviders = []config.LlmProvider{
+			{Name: "primary", ApiURL: c.XingChen.ApiURL, ApiUploadURL: c.XingChen.UploadURL, ApiKey: apiKey, Weight: 1},
+			{Name: "backup", ApiURL: backupServer.URL + "/workflow/v1/chat/completions", ApiUploadURL: backupServer.URL + "/workflow/v1/upload_file", ApiKey: apiKey, Priority: 1, Weight: 1},
+		}
+	})
+	image := filepath.Join(t.TempDir(), "seal.png")
+	if err := os.WriteFile(image, []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// 主提供方上传失败后转移到备用提供方
+	h.mock.Enqueue(xingchenmock.Scenario{Status: http.StatusServiceUnavailable})
+	client := llm.NewXingChenClient(context.Background(), h.svcCtx)
+	url, err := client.UploadImage(image)
+	if err != nil || !strings.HasSuffix(url, "/seal.png") {
+		t.Fatalf("UploadImage = %q, %v", url, err)
+	}
+	if len(h.mock.Requests()) != 1 || len(backup.Requests()) != 1 || backup.Requests()[0].Upload != "seal.png" {
+		t.Fatalf("primary requests = %d, backup requests = %+v", len(h.mock.Requests()), backup.Requests())
+	}
+
+	// 图片只在备用提供方有效，即使主提供方可用，后续生成也固定使用备用提供方
+	stream := &editStream{}
+	reply, err := client.StreamChatForEdit([]byte(`{"flow_id":"mock-flow","parameters":{"AGENT_USER_INPUT":"修改"}}`), stream, "")
+	if err != nil || reply != "备用提供方修改的正文" {
+		t.Fatalf("StreamChatForEdit = %q, %v", reply, err)
+	}
+	if providerName, _ := client.Served(); providerName != "backup" || len(h.mock.Requests()) != 1 {
+		t.Fatalf("served by %s, primary requests = %d", providerName, len(h.mock.Requests()))
+	}
+}
+
+func TestUploadImageSkipsProvidersWithoutUpload(t *testing.T) {
+	backup := xingchenmock.New(xingchenmock.Reply("备用提供方修改的正文"))
+	backupServer := httptest.NewServer(backup)
+	t.Cleanup(backupServer.Close)
+
+	h := newHarness(t, func(c *config.Config) {
+		c.LlmProviders = []config.LlmProvider{
+			{Name: "primary", ApiURL: c.XingChen.ApiURL, ApiKey: apiKey, Weight: 1},
+			{Name: "backup", ApiURL: backupServer.URL + "/workflow/v1/chat/completions", ApiUploadURL: backupServer.URL + "/workflow/v1/upload_file", ApiKey: apiKey, Priority: 1, Weight: 1},
+		}
+	})
+	image := filepath.Join(t.TempDir(), "seal.png")
+	if err := os.WriteFile(image, []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := llm.NewXingChenClient(context.Background(), h.svcCtx).UploadImage(image); err != nil {
+		t.Fatalf("UploadImage: %v", err)
+	}
+	if len(h.mock.Requests()) != 0 || len(backup.Requests()) != 1 {
+		t.Fatalf("primary requests = %d, backup requests = %d", len(h.mock.Requests()), len(backup.Requests()))
+	}
+}
+
+// editStream 直接调用大模型客户端时使用的修改流，只记录发送的正文
+type editStream struct {
+	grpc.ServerStream
+	chunks []string
+}
+
+func (s *editStream) Send(resp *pb.EditDocumentResponse) error {
+	s.chunks = append(s.chunks, resp.GetMessage().GetChunk())
+	return nil
+}
+
+func TestEditDocument(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+
+	// 读取一次文档使其进入缓存
+	if _, err := h.svcCtx.DocRepo.FindDocument(context.Background(), docID); err != nil {
+		t.Fatalf("FindDocument: %v", err)
+	}
+	cacheKey := "document:info:" + docID
+	if !h.redis.Exists(cacheKey) {
+		t.Fatalf("document %s is not cached", docID)
+	}
+
+	h.mock.Reset()
+	h.mock.Enqueue(xingchenmock.Reply("修改后的", "内容"))
+	res, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改为正式语气"})
+	if err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+	if res.text() != "修改后的内容" || res.end == nil || res.end.MessageId != docID {
+		t.Fatalf("reply = %q, end = %+v", res.text(), res.end)
+	}
+
+	req := h.mock.Requests()[0]
+	if !strings.Contains(req.Input, "原文：\n原文内容") || !strings.Contains(req.Input, "修改提示：改为正式语气") {
+		t.Fatalf("prompt = %q", req.Input)
+	}
+
+	// 文档更新后缓存失效，用户与助手消息写入会话
+	if doc := h.store.document(docID); doc.Content != "修改后的内容" {
+		t.Fatalf("document content = %q", doc.Content)
+	}
+	if h.redis.Exists(cacheKey) {
+		t.Fatalf("document cache %s not invalidated", cacheKey)
+	}
+	messages := h.store.messagesOf(convID)
+	if len(messages) != 2 || messages[0].Content != "改为正式语气" || messages[1].Content != "修改后的内容" {
+		t.Fatalf("messages = %+v", messages)
+	}
+	if !strings.Contains(messages[1].Metadata.String, provider.DefaultName) {
+		t.Fatalf("assistant message metadata = %q", messages[1].Metadata.String)
+	}
+	if actions := h.store.auditActions(); actions[len(actions)-1] != audit.ActionEdit {
+		t.Fatalf("audit actions = %v", actions)
+	}
+}
+
+func TestEditDocumentFormatCheck(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+	h.mock.Reset()
+	h.mock.Enqueue(xingchenmock.Reply("关于召开年度工作会议的通知\n\n各部门：\n\n一，会议时间"))
+
+	res, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "补充会议时间", FormatCheck: true})
+	if err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+	if res.end == nil || res.check == nil {
+		t.Fatalf("end = %+v, check = %+v", res.end, res.check)
+	}
+
+	// 检查保存后的文档，而不是大模型的原始输出
+	result := res.check.GetResult()
+	if result.MessageId != docID || result.WarningCount == 0 {
+		t.Fatalf("check result = %+v", result)
+	}
+	found := false
+	for _, f := range result.Findings {
+		found = found || f.Rule == gongwen.RuleHeading && f.Suggestion == "一、会议时间"
+	}
+	if !found {
+		t.Fatalf("findings = %+v", result.Findings)
+	}
+}
+
+func TestEditDocumentUpstreamError(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+	h.mock.Enqueue(xingchenmock.Scenario{ErrorCode: 10013, ErrorMessage: "参数错误"})
+
+	_, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改为正式语气"})
+	requireCode(t, err, xerr.ErrLLMApiError)
+
+	// 调用失败时文档保持不变，也不写入消息
+	if doc := h.store.document(docID); doc.Content != "原文内容" {
+		t.Fatalf("document content = %q", doc.Content)
+	}
+	if messages := h.store.messagesOf(convID); len(messages) != 0 {
+		t.Fatalf("messages = %+v", messages)
+	}
+}
+
+func TestEditDocumentAccess(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	otherConvID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+
+	_, err := h.edit(&pb.EditDocumentRequest{UserId: 2, ConversationId: convID, MessageId: docID, Prompt: "修改"})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+
+	// 文档不属于请求中的会话
+	_, err = h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: otherConvID, MessageId: docID, Prompt: "修改"})
+	requireCode(t, err, xerr.ErrMessageNotFound)
+
+	_, err = h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: "missing", Prompt: "修改"})
+	requireCode(t, err, xerr.ErrMessageNotFound)
+}
+
+func TestConvertMarkdown(t *testing.T) {
+	h := newHarness(t, func(c *config.Config) {
+		c.LuaFilters.Align = staticFile(t, "lua/align.lua")
+		c.LuaFilters.Gov = staticFile(t, "lua/gov.lua")
+	})
+	convID := h.generate(1)
+
+	if _, err := h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{UserId: 1, Markdown: "正文", Type: "html"}); err == nil {
+		t.Fatal("want error for unsupported type")
+	}
+	_, err := h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{UserId: 2, ConversationId: convID, Markdown: "正文", Type: "docx"})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+
+	if _, err := exec.LookPath("pandoc"); err != nil {
+		t.Skip("pandoc not installed")
+	}
+	resp, err := h.client.ConvertMarkdown(h.ctx(), &pb.ConvertMarkdownRequest{UserId: 1, ConversationId: convID, Markdown: "# 通知\n\n正文", Type: "docx"})
+	if err != nil {
+		t.Fatalf("ConvertMarkdown: %v", err)
+	}
+	if resp.Filename != "export.docx" || len(resp.Data) == 0 {
+		t.Fatalf("response = %s, %d bytes", resp.Filename, len(resp.Data))
+	}
+	if actions := h.store.auditActions(); actions[len(actions)-1] != audit.ActionExport {
+		t.Fatalf("audit actions = %v", actions)
+	}
+}
+
+// staticFile 返回 deploy/static 下文件的绝对路径
+func staticFile(t *testing.T, name string) string {
+	t.Helper()
+	path, err := filepath.Abs(filepath.Join("..", "..", "..", "..", "..", "..", "deploy", "static", name))
+	if err != nil {
+		t.Fatalf("static file %s: %v", name, err)
+	}
+	return path
+}
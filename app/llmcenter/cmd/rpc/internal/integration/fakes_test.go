@@ -0,0 +1,735 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/authz"
+
+	"google.golang.org/grpc"
+)
+
+// store 代替 MySQL 的内存数据，各模型的内存实现共用一把锁。
+// 内存模型只实现 RPC 逻辑用到的方法，调用其他方法会因嵌入的接口为 nil 而 panic，便于发现遗漏
+type store struct {
+	mu              sync.Mutex
+	conversations   map[string]*model.Conversations
+	messages        []*model.Messages
+	documents       map[string]*model.Documents
+	histories       []*model.Historydatas
+	files           map[string]*model.Files
+	audits          []*model.AuditLogs
+	usages          []*model.Usage
+	quotas          []*model.UsageQuota
+	shares          []*model.DocumentShares
+	comments        []*model.DocumentComments
+	approvals       []*model.DocumentApprovals
+	approvalHistory []*model.DocumentApprovalHistory
+	mentions        []*model.DocumentCommentMention
+	docNumbers      []*model.DocNumbers
+	docNoSeqs       map[string]int64 // workspaceId/prefix/year -> last_seq
+}
+
+func newStore() *store {
+	return &store{
+		conversations: make(map[string]*model.Conversations),
+		documents:     make(map[string]*model.Documents),
+		files:         make(map[string]*model.Files),
+		docNoSeqs:     make(map[string]int64),
+	}
+}
+
+// conversation 返回会话副本，不存在时为 nil
+func (s *store) conversation(id string) *model.Conversations {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.conversations[id]; ok {
+		cp := *c
+		return &cp
+	}
+	return nil
+}
+
+func (s *store) document(id string) *model.Documents {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.documents[id]; ok {
+		cp := *d
+		return &cp
+	}
+	return nil
+}
+
+func (s *store) messagesOf(conversationID string) []model.Messages {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []model.Messages
+	for _, m := range s.messages {
+		if m.ConversationId == conversationID {
+			result = append(result, *m)
+		}
+	}
+	return result
+}
+
+func (s *store) historiesOf(conversationID string) []model.Historydatas {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []model.Historydatas
+	for _, h := range s.histories {
+		if h.ConversationId == conversationID {
+			result = append(result, *h)
+		}
+	}
+	return result
+}
+
+func (s *store) auditActions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var actions []string
+	for _, a := range s.audits {
+		actions = append(actions, a.Action)
+	}
+	return actions
+}
+
+func (s *store) usageRecords() []model.Usage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []model.Usage
+	for _, u := range s.usages {
+		result = append(result, *u)
+	}
+	return result
+}
+
+// share 返回分享链接副本，不存在时为 nil
+func (s *store) share(id int64) *model.DocumentShares {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sh := range s.shares {
+		if sh.Id == id {
+			cp := *sh
+			return &cp
+		}
+	}
+	return nil
+}
+
+// updateShare 直接修改分享链接，用于构造过期等状态
+func (s *store) updateShare(id int64, update func(*model.DocumentShares)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sh := range s.shares {
+		if sh.Id == id {
+			update(sh)
+		}
+	}
+}
+
+// comment 返回批注副本，不存在时为 nil
+func (s *store) comment(id int64) *model.DocumentComments {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.comments {
+		if c.Id == id {
+			cp := *c
+			return &cp
+		}
+	}
+	return nil
+}
+
+// insertResult 内存模型插入后返回的自增主键
+type insertResult int64
+
+func (r insertResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r insertResult) RowsAffected() (int64, error) { return 1, nil }
+
+type conversationsModel struct {
+	model.ConversationsModel
+	s *store
+}
+
+func (m conversationsModel) Insert(_ context.Context, data *model.Conversations) (sql.Result, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.CreatedAt, cp.UpdatedAt = time.Now(), time.Now()
+	m.s.conversations[data.ConversationId] = &cp
+	return nil, nil
+}
+
+func (m conversationsModel) FindOne(_ context.Context, conversationId string) (*model.Conversations, error) {
+	if c := m.s.conversation(conversationId); c != nil {
+		return c, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m conversationsModel) FindAllByUser(_ context.Context, userId string) ([]*model.Conversations, error) {
+	return m.find(func(c *model.Conversations) bool {
+		return strconv.FormatInt(c.UserId, 10) == userId && c.WorkspaceId == 0
+	}), nil
+}
+
+func (m conversationsModel) FindAllByWorkspace(_ context.Context, workspaceId int64) ([]*model.Conversations, error) {
+	return m.find(func(c *model.Conversations) bool { return c.WorkspaceId == workspaceId }), nil
+}
+
+func (m conversationsModel) find(match func(*model.Conversations) bool) []*model.Conversations {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.Conversations
+	for _, c := range m.s.conversations {
+		if match(c) {
+			cp := *c
+			result = append(result, &cp)
+		}
+	}
+	return result
+}
+
+type messagesModel struct {
+	model.MessagesModel
+	s *store
+}
+
+func (m messagesModel) Insert(_ context.Context, data *model.Messages) (sql.Result, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.CreatedAt = time.Now()
+	m.s.messages = append(m.s.messages, &cp)
+	return nil, nil
+}
+
+func (m messagesModel) FindAllByConversation(_ context.Context, conversationId string) ([]*model.Messages, error) {
+	var result []*model.Messages
+	for _, msg := range m.s.messagesOf(conversationId) {
+		result = append(result, &msg)
+	}
+	return result, nil
+}
+
+type documentsModel struct {
+	model.DocumentsModel
+	s *store
+}
+
+func (m documentsModel) InsertDocument(_ context.Context, messageID, conversationID, content, provider, modelName string) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	m.s.documents[messageID] = &model.Documents{
+		MessageId:      messageID,
+		ConversationId: conversationID,
+		Content:        content,
+		Provider:       provider,
+		Model:          modelName,
+		CreatedAt:      time.Now(),
+	}
+	return nil
+}
+
+func (m documentsModel) FindOne(_ context.Context, messageId string) (*model.Documents, error) {
+	if d := m.s.document(messageId); d != nil {
+		return d, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m documentsModel) FindByConversationId(_ context.Context, conversationId string) ([]*model.Documents, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.Documents
+	for _, d := range m.s.documents {
+		if d.ConversationId == conversationId {
+			cp := *d
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+func (m documentsModel) UpdateContent(_ context.Context, messageID, content string) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	if d, ok := m.s.documents[messageID]; ok {
+		d.Content = content
+	}
+	return nil
+}
+
+func (m documentsModel) SwapContent(_ context.Context, messageID, oldHash, content string) (bool, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	d, ok := m.s.documents[messageID]
+	if !ok || audit.Hash(d.Content) != oldHash {
+		return false, nil
+	}
+	d.Content = content
+	return true, nil
+}
+
+type historydatasModel struct {
+	model.HistorydatasModel
+	s *store
+}
+
+func (m historydatasModel) Insert(_ context.Context, data *model.Historydatas) (sql.Result, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.CreatedAt = time.Now()
+	m.s.histories = append(m.s.histories, &cp)
+	return nil, nil
+}
+
+func (m historydatasModel) FindByConversationId(_ context.Context, conversationId string) ([]*model.Historydatas, error) {
+	var result []*model.Historydatas
+	for _, h := range m.s.historiesOf(conversationId) {
+		result = append(result, &h)
+	}
+	return result, nil
+}
+
+type filesModel struct {
+	model.FilesModel
+	s *store
+}
+
+func (m filesModel) InsertFile(_ context.Context, filename, storedName string, userId, workspaceId int64) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	m.s.files[storedName] = &model.Files{
+		Filename:    filename,
+		StoredName:  storedName,
+		UserId:      userId,
+		WorkspaceId: workspaceId,
+		CreatedAt:   time.Now(),
+	}
+	return nil
+}
+
+func (m filesModel) FindByStoredName(_ context.Context, storedName string) (*model.File, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	f, ok := m.s.files[storedName]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &model.File{
+		Filename:    f.Filename,
+		StoredName:  f.StoredName,
+		UserId:      f.UserId,
+		WorkspaceId: f.WorkspaceId,
+		CreatedAt:   f.CreatedAt,
+	}, nil
+}
+
+type auditLogsModel struct {
+	model.AuditLogsModel
+	s *store
+}
+
+func (m auditLogsModel) Insert(_ context.Context, data *model.AuditLogs) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.CreatedAt = time.Now()
+	m.s.audits = append(m.s.audits, &cp)
+	return nil
+}
+
+type usageModel struct {
+	model.UsageModel
+	s *store
+}
+
+func (m usageModel) Add(_ context.Context, data *model.Usage) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	m.s.usages = append(m.s.usages, &cp)
+	return nil
+}
+
+func (m usageModel) SumByUser(_ context.Context, userId int64, start, end time.Time) (*model.UsageTotal, error) {
+	var total model.UsageTotal
+	for _, u := range m.s.usageRecords() {
+		if u.UserId == userId && !u.UsageDate.Before(start) && u.UsageDate.Before(end) {
+			total.RequestCount += u.RequestCount
+			total.Chars += u.PromptChars + u.ResponseChars
+		}
+	}
+	return &total, nil
+}
+
+type documentSharesModel struct {
+	model.DocumentSharesModel
+	s *store
+}
+
+func (m documentSharesModel) Insert(_ context.Context, data *model.DocumentShares) (sql.Result, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.Id = int64(len(m.s.shares) + 1)
+	m.s.shares = append(m.s.shares, &cp)
+	return insertResult(cp.Id), nil
+}
+
+func (m documentSharesModel) FindOne(_ context.Context, id int64) (*model.DocumentShares, error) {
+	if sh := m.s.share(id); sh != nil {
+		return sh, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m documentSharesModel) FindOneByToken(_ context.Context, token string) (*model.DocumentShares, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, sh := range m.s.shares {
+		if sh.Token == token {
+			cp := *sh
+			return &cp, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m documentSharesModel) Revoke(_ context.Context, id int64) error {
+	m.s.updateShare(id, func(sh *model.DocumentShares) {
+		if !sh.RevokedAt.Valid {
+			sh.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+	})
+	return nil
+}
+
+func (m documentSharesModel) IncrViewCount(_ context.Context, id int64) error {
+	m.s.updateShare(id, func(sh *model.DocumentShares) { sh.ViewCount++ })
+	return nil
+}
+
+func (m documentSharesModel) IncrDownloadCount(_ context.Context, id int64) error {
+	m.s.updateShare(id, func(sh *model.DocumentShares) { sh.DownloadCount++ })
+	return nil
+}
+
+type documentCommentsModel struct {
+	model.DocumentCommentsModel
+	s *store
+}
+
+func (m documentCommentsModel) InsertWithMentions(_ context.Context, data *model.DocumentComments, mentionUserIds []int64) (int64, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	cp := *data
+	cp.Id = int64(len(m.s.comments) + 1)
+	m.s.comments = append(m.s.comments, &cp)
+	for _, userId := range mentionUserIds {
+		m.s.mentions = append(m.s.mentions, &model.DocumentCommentMention{CommentId: cp.Id, UserId: userId})
+	}
+	return cp.Id, nil
+}
+
+func (m documentCommentsModel) FindOne(_ context.Context, id int64) (*model.DocumentComments, error) {
+	if c := m.s.comment(id); c != nil {
+		return c, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m documentCommentsModel) FindByMessageId(_ context.Context, messageId string) ([]*model.DocumentComments, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.DocumentComments
+	for _, c := range m.s.comments {
+		if c.MessageId == messageId {
+			cp := *c
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+func (m documentCommentsModel) FindMentions(_ context.Context, commentIds []int64) ([]*model.DocumentCommentMention, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.DocumentCommentMention
+	for _, mention := range m.s.mentions {
+		if slices.Contains(commentIds, mention.CommentId) {
+			cp := *mention
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+func (m documentCommentsModel) UpdateAnchor(_ context.Context, data *model.DocumentComments) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, c := range m.s.comments {
+		if c.Id == data.Id {
+			c.AnchorText, c.AnchorPrefix, c.AnchorSuffix = data.AnchorText, data.AnchorPrefix, data.AnchorSuffix
+			c.AnchorStart, c.AnchorEnd = data.AnchorStart, data.AnchorEnd
+			c.AnchorStatus, c.AnchorHash = data.AnchorStatus, data.AnchorHash
+		}
+	}
+	return nil
+}
+
+func (m documentCommentsModel) UpdateStatus(_ context.Context, id int64, status string, resolvedBy int64) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, c := range m.s.comments {
+		if c.Id == id {
+			c.Status, c.ResolvedBy = status, resolvedBy
+			c.ResolvedAt = sql.NullTime{Time: time.Now(), Valid: resolvedBy > 0}
+		}
+	}
+	return nil
+}
+
+type usageQuotaModel struct {
+	model.UsageQuotaModel
+	s *store
+}
+
+func (m usageQuotaModel) FindOneBySubjectTypeSubject(_ context.Context, subjectType, subject string) (*model.UsageQuota, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, q := range m.s.quotas {
+		if q.SubjectType == subjectType && q.Subject == subject {
+			cp := *q
+			return &cp, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m usageQuotaModel) FindBySubjects(_ context.Context, subjectType string, subjects []string) ([]*model.UsageQuota, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.UsageQuota
+	for _, q := range m.s.quotas {
+		if q.SubjectType == subjectType && slices.Contains(subjects, q.Subject) {
+			cp := *q
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+type documentApprovalsModel struct {
+	model.DocumentApprovalsModel
+	s *store
+}
+
+func (m documentApprovalsModel) FindOneByMessageId(_ context.Context, messageId string) (*model.DocumentApprovals, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, a := range m.s.approvals {
+		if a.MessageId == messageId {
+			cp := *a
+			return &cp, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+// Transition 与 MySQL 实现一致：新建时同一文档只能有一条记录，更新时按 version 乐观锁
+func (m documentApprovalsModel) Transition(_ context.Context, data *model.DocumentApprovals, history *model.DocumentApprovalHistory) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	if data.Id == 0 {
+		for _, a := range m.s.approvals {
+			if a.MessageId == data.MessageId {
+				return model.ErrVersionConflict
+			}
+		}
+		data.Id, data.Version = int64(len(m.s.approvals)+1), 1
+		cp := *data
+		cp.CreatedAt, cp.UpdatedAt = time.Now(), time.Now()
+		m.s.approvals = append(m.s.approvals, &cp)
+	} else {
+		i := slices.IndexFunc(m.s.approvals, func(a *model.DocumentApprovals) bool { return a.Id == data.Id && a.Version == data.Version })
+		if i < 0 {
+			return model.ErrVersionConflict
+		}
+		data.Version++
+		cp := *data
+		cp.UpdatedAt = time.Now()
+		m.s.approvals[i] = &cp
+	}
+	history.ApprovalId = data.Id
+	cp := *history
+	cp.Id, cp.CreatedAt = int64(len(m.s.approvalHistory)+1), time.Now()
+	m.s.approvalHistory = append(m.s.approvalHistory, &cp)
+	return nil
+}
+
+func (m documentApprovalsModel) FindHistory(_ context.Context, approvalId int64) ([]*model.DocumentApprovalHistory, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.DocumentApprovalHistory
+	for _, h := range m.s.approvalHistory {
+		if h.ApprovalId == approvalId {
+			cp := *h
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+func (m documentApprovalsModel) FindByAssignee(_ context.Context, assigneeId int64, limit int64) ([]*model.DocumentApprovals, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	var result []*model.DocumentApprovals
+	for _, a := range slices.Backward(m.s.approvals) {
+		if a.AssigneeId == assigneeId && int64(len(result)) < limit {
+			cp := *a
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+type docNumbersModel struct {
+	model.DocNumbersModel
+	s *store
+}
+
+// Reserve 与 MySQL 实现一致：已有预留或使用的字号时直接返回，否则优先复用序号最小的已释放字号，再递增序号
+func (m docNumbersModel) Reserve(_ context.Context, data *model.DocNumbers, docNo func(seq int64) string) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	same := func(n *model.DocNumbers) bool {
+		return n.WorkspaceId == data.WorkspaceId && n.Prefix == data.Prefix && n.Year == data.Year
+	}
+	for _, n := range slices.Backward(m.s.docNumbers) {
+		if same(n) && n.MessageId == data.MessageId && (n.Status == model.DocNoReserved || n.Status == model.DocNoIssued) {
+			*data = *n
+			return nil
+		}
+	}
+
+	data.Status = model.DocNoReserved
+	var released *model.DocNumbers
+	for _, n := range m.s.docNumbers {
+		if same(n) && n.Status == model.DocNoReleased && (released == nil || n.Seq < released.Seq) {
+			released = n
+		}
+	}
+	if released != nil {
+		data.Id, data.Seq, data.DocNo, data.ReleasedAt, data.CreatedAt = released.Id, released.Seq, released.DocNo, released.ReleasedAt, released.CreatedAt
+		data.UpdatedAt = time.Now()
+		*released = *data
+		return nil
+	}
+
+	key := fmt.Sprintf("%d/%s/%d", data.WorkspaceId, data.Prefix, data.Year)
+	m.s.docNoSeqs[key]++
+	data.Seq, data.DocNo = m.s.docNoSeqs[key], docNo(m.s.docNoSeqs[key])
+	data.Id, data.CreatedAt, data.UpdatedAt = int64(len(m.s.docNumbers)+1), time.Now(), time.Now()
+	cp := *data
+	m.s.docNumbers = append(m.s.docNumbers, &cp)
+	return nil
+}
+
+func (m docNumbersModel) FindOne(_ context.Context, id int64) (*model.DocNumbers, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, n := range m.s.docNumbers {
+		if n.Id == id {
+			cp := *n
+			return &cp, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m docNumbersModel) FindActiveByMessageId(_ context.Context, messageId string) (*model.DocNumbers, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, n := range slices.Backward(m.s.docNumbers) {
+		if n.MessageId == messageId && (n.Status == model.DocNoReserved || n.Status == model.DocNoIssued) {
+			cp := *n
+			return &cp, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m docNumbersModel) UpdateStatus(_ context.Context, id int64, from, to string, userId int64) (bool, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, n := range m.s.docNumbers {
+		if n.Id == id && n.Status == from {
+			n.Status, n.UserId, n.UpdatedAt = to, userId, time.Now()
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m docNumbersModel) ReleaseByMessageId(_ context.Context, messageId string, userId int64) error {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	for _, n := range m.s.docNumbers {
+		if n.MessageId == messageId && n.Status == model.DocNoReserved {
+			n.Status, n.UserId, n.UpdatedAt = model.DocNoReleased, userId, time.Now()
+		}
+	}
+	return nil
+}
+
+// usercenterRpc 代替用户中心：用户角色由 userRoles 指定，未指定时为普通用户；默认文章类型为"通知"，团队空间角色由 roles 指定
+type usercenterRpc struct {
+	usercenter.Usercenter
+	mu        sync.Mutex
+	roles     map[[2]int64]string // {userId, workspaceId} -> role
+	userRoles map[int64][]string
+}
+
+func (u *usercenterRpc) setRole(userID, workspaceID int64, role string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.roles[[2]int64{userID, workspaceID}] = role
+}
+
+func (u *usercenterRpc) setUserRoles(userID int64, roles ...string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.userRoles[userID] = roles
+}
+
+func (u *usercenterRpc) GetUserInfo(_ context.Context, in *usercenter.GetUserInfoReq, _ ...grpc.CallOption) (*usercenter.GetUserInfoResp, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	roles, ok := u.userRoles[in.Id]
+	if !ok {
+		roles = []string{authz.RoleUser}
+	}
+	return &usercenter.GetUserInfoResp{User: &usercenter.User{Id: in.Id, Roles: roles}}, nil
+}
+
+func (u *usercenterRpc) GetUserProfile(_ context.Context, in *usercenter.GetUserProfileReq, _ ...grpc.CallOption) (*usercenter.GetUserProfileResp, error) {
+	return &usercenter.GetUserProfileResp{Profile: &usercenter.UserProfile{UserId: in.UserId, DefaultDocType: "通知"}}, nil
+}
+
+func (u *usercenterRpc) GetWorkspaceRole(_ context.Context, in *usercenter.GetWorkspaceRoleReq, _ ...grpc.CallOption) (*usercenter.GetWorkspaceRoleResp, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return &usercenter.GetWorkspaceRoleResp{Role: u.roles[[2]int64{in.UserId, in.WorkspaceId}]}, nil
+}
@@ -0,0 +1,158 @@
+package integration
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+)
+
+func TestFileUpload(t *testing.T) {
+	h := newHarness(t)
+	content := []byte("参考材料：年度工作会议定于周五上午九点在三楼会议室召开。")
+
+	resp, err := h.upload(&pb.FileInfo{FileName: "会议安排.txt", UserId: 1}, content, 7)
+	if err != nil {
+		t.Fatalf("FileUpload: %v", err)
+	}
+	if resp.FileName != "会议安排.txt" || resp.Url != resp.FileId+".txt" {
+		t.Fatalf("response = %+v", resp)
+	}
+	saved, err := os.ReadFile(filepath.Join(h.svcCtx.Config.Upload.BaseDir, resp.Url))
+	if err != nil || !bytes.Equal(saved, content) {
+		t.Fatalf("saved file = %q, err = %v", saved, err)
+	}
+
+	// 引用上传的文件生成时，文件内容并入提示词，历史数据中记录原始文件名
+	res, err := h.chat(&pb.ChatCompletionsRequest{
+		UserId:       1,
+		Documenttype: "通知",
+		Information:  "关于召开年度工作会议",
+		References:   []*pb.Reference{{Type: "file", FileId: resp.Url}},
+	})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if input := h.mock.Requests()[0].Input; !strings.Contains(input, string(content)) {
+		t.Fatalf("prompt = %q", input)
+	}
+	history, err := h.client.GetHistoryData(h.ctx(), &pb.GetHistoryDataRequest{UserId: 1, ConversationId: res.end.ConversationId})
+	if err != nil {
+		t.Fatalf("GetHistoryData: %v", err)
+	}
+	if len(history.Items) != 1 || len(history.Items[0].References) != 1 || history.Items[0].References[0].Filename != "会议安排.txt" {
+		t.Fatalf("history = %+v", history.Items)
+	}
+}
+
+func TestFileUploadReferenceOfOtherUser(t *testing.T) {
+	h := newHarness(t)
+	content := []byte("仅上传者可见的内容")
+	resp, err := h.upload(&pb.FileInfo{FileName: "私有.txt", UserId: 1}, content, 1024)
+	if err != nil {
+		t.Fatalf("FileUpload: %v", err)
+	}
+
+	// 无权读取的引用文件直接跳过
+	_, err = h.chat(&pb.ChatCompletionsRequest{
+		UserId:       2,
+		Documenttype: "通知",
+		Information:  "关于召开年度工作会议",
+		References:   []*pb.Reference{{Type: "file", FileId: resp.Url}},
+	})
+	if err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if input := h.mock.Requests()[0].Input; strings.Contains(input, string(content)) {
+		t.Fatalf("prompt contains another user's file: %q", input)
+	}
+}
+
+func TestFileUploadWorkspace(t *testing.T) {
+	h := newHarness(t)
+	const workspaceID = 7
+	info := &pb.FileInfo{FileName: "团队材料.txt", UserId: 1, WorkspaceId: workspaceID}
+
+	h.users.setRole(1, workspaceID, workspace.RoleViewer)
+	_, err := h.upload(info, []byte("团队材料"), 1024)
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+	if entries, _ := os.ReadDir(h.svcCtx.Config.Upload.BaseDir); len(entries) != 0 {
+		t.Fatalf("upload dir has %d files, want 0", len(entries))
+	}
+
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	if _, err := h.upload(info, []byte("团队材料"), 1024); err != nil {
+		t.Fatalf("FileUpload: %v", err)
+	}
+}
+
+func TestFileUploadRequiresFileInfo(t *testing.T) {
+	h := newHarness(t)
+	stream, err := h.client.FileUpload(h.ctx())
+	if err != nil {
+		t.Fatalf("FileUpload: %v", err)
+	}
+	if err := stream.Send(&pb.FileUploadRequest{Data: &pb.FileUploadRequest_Chunk{Chunk: []byte("data")}}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if _, err := stream.CloseAndRecv(); err == nil {
+		t.Fatal("want error when the first message is not FileInfo")
+	}
+}
+
+func TestCheckFileAccessOwnerOnly(t *testing.T) {
+	h := newHarness(t)
+	resp, err := h.upload(&pb.FileInfo{FileName: "头像.png", UserId: 1}, []byte("png"), 1024)
+	if err != nil {
+		t.Fatalf("FileUpload: %v", err)
+	}
+
+	// 设置头像时只接受本人上传的已登记文件
+	if _, err := h.client.CheckFileAccess(h.ctx(), &pb.CheckFileAccessRequest{UserId: 1, FileId: resp.Url, OwnerOnly: true}); err != nil {
+		t.Fatalf("CheckFileAccess: %v", err)
+	}
+	_, err = h.client.CheckFileAccess(h.ctx(), &pb.CheckFileAccessRequest{UserId: 2, FileId: resp.Url, OwnerOnly: true})
+	requireCode(t, err, xerr.ErrPermissionDenied)
+	_, err = h.client.CheckFileAccess(h.ctx(), &pb.CheckFileAccessRequest{UserId: 1, FileId: "missing.png", OwnerOnly: true})
+	requireCode(t, err, xerr.ErrFileNotFound)
+
+	// 不限本人时未登记的文件由调用方决定是否放行
+	res, err := h.client.CheckFileAccess(h.ctx(), &pb.CheckFileAccessRequest{UserId: 1, FileId: "missing.png"})
+	if err != nil || res.Registered {
+		t.Fatalf("CheckFileAccess = %+v, %v", res, err)
+	}
+}
+
+func TestCheckFileAccessOwnerless(t *testing.T) {
+	h := newHarness(t)
+	// 早期上传、未记录上传者的个人文件
+	h.store.mu.Lock()
+	h.store.files["legacy.txt"] = &model.Files{Filename: "旧材料.txt", StoredName: "legacy.txt"}
+	h.store.mu.Unlock()
+	content := "未记录上传者的参考材料"
+	if err := os.WriteFile(filepath.Join(h.svcCtx.Config.Upload.BaseDir, "legacy.txt"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := h.client.CheckFileAccess(h.ctx(), &pb.CheckFileAccessRequest{UserId: 1, FileId: "legacy.txt"})
+	requireCode(t, err, xerr.ErrPermissionDenied)
+
+	// 引用时同样跳过
+	if _, err := h.chat(&pb.ChatCompletionsRequest{
+		UserId:       1,
+		Documenttype: "通知",
+		Information:  "关于召开年度工作会议",
+		References:   []*pb.Reference{{Type: "file", FileId: "legacy.txt"}},
+	}); err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+	if input := h.mock.Requests()[0].Input; strings.Contains(input, content) {
+		t.Fatalf("prompt references an ownerless file: %q", input)
+	}
+}
@@ -0,0 +1,311 @@
+// Package integration llmcenter RPC 的集成测试。
+//
+// 测试在进程内启动带有与线上相同拦截器的 llmcenter gRPC 服务，并通过 zrpc 客户端以 API 的方式调用：
+// 星辰接口由 xingchenmock 模拟，Redis 使用 miniredis，MySQL 与用户中心使用内存实现，不依赖任何外部服务。
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
+	"document_agent/app/llmcenter/cmd/rpc/internal/repository"
+	"document_agent/app/llmcenter/cmd/rpc/internal/server"
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/llmcenter"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/approval"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/interceptor/rpcserver"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/usage"
+	"document_agent/pkg/xingchenmock"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/zrpc"
+	xerror "github.com/zeromicro/x/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flowID  = "mock-flow"
+	apiKey  = "mock-key"
+	timeout = 10 * time.Second
+)
+
+func TestMain(m *testing.M) {
+	logx.Disable()
+	os.Exit(m.Run())
+}
+
+// harness 一个独立的 llmcenter RPC 实例及其依赖
+type harness struct {
+	t      *testing.T
+	mock   *xingchenmock.Server
+	store  *store
+	users  *usercenterRpc
+	redis  *miniredis.Miniredis
+	svcCtx *svc.ServiceContext
+	client llmcenter.LlmCenter
+}
+
+// option 修改测试实例的配置
+type option func(c *config.Config)
+
+// newHarness 启动 llmcenter RPC，星辰接口默认逐段输出"第一段。第二段。"
+func newHarness(t *testing.T, opts ...option) *harness {
+	t.Helper()
+
+	mock := xingchenmock.New(xingchenmock.Reply("第一段。", "第二段。"))
+	upstream := httptest.NewServer(mock)
+	t.Cleanup(upstream.Close)
+
+	var c config.Config
+	c.Name = "llmcenter.rpc"
+	c.XingChen.FlowID = flowID
+	c.XingChen.ApiURL = upstream.URL + "/workflow/v1/chat/completions"
+	c.XingChen.ApiResumeURL = upstream.URL + "/workflow/v1/resume"
+	c.XingChen.UploadURL = upstream.URL + "/workflow/v1/upload_file"
+	c.XingChen.ApiKey = apiKey
+	c.XingChen.ApiSecret = "mock-secret"
+	c.LlmApiClient.Timeout = 10
+	c.LlmRetry.BaseDelayMs = 1
+	c.LlmRetry.MaxDelayMs = 5
+	c.Upload.BaseDir = t.TempDir()
+	c.Download.BaseURL = "http://127.0.0.1:8010/llmcenter/v1/public/file"
+	c.Download.SignKey = "mock-sign-key"
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+
+	st := newStore()
+	users := &usercenterRpc{roles: make(map[[2]int64]string), userRoles: make(map[int64][]string)}
+	documents := documentsModel{s: st}
+	screener, err := screening.NewScreener(c.Screening)
+	if err != nil {
+		t.Fatalf("new screener: %v", err)
+	}
+	svcCtx := &svc.ServiceContext{
+		Config:                 c,
+		ConversationModel:      conversationsModel{s: st},
+		MessageModel:           messagesModel{s: st},
+		FilesModel:             filesModel{s: st},
+		DocumentsModel:         documents,
+		HistoryDatasModel:      historydatasModel{s: st},
+		AuditLogsModel:         auditLogsModel{s: st},
+		UsageModel:             usageModel{s: st},
+		UsageQuotaModel:        usageQuotaModel{s: st},
+		DocumentApprovalsModel: documentApprovalsModel{s: st},
+		DocNumbersModel:        docNumbersModel{s: st},
+		DocumentSharesModel:    documentSharesModel{s: st},
+		DocumentCommentsModel:  documentCommentsModel{s: st},
+		LlmApiClient:           &http.Client{Timeout: time.Duration(c.LlmApiClient.Timeout) * time.Second},
+		LlmRouter:              provider.NewRouter(c),
+		RedisClient:            rds,
+		DocRepo:                repository.NewDocumentRepository(documents, rds),
+		Screener:               screener,
+		Auditor:                audit.NewRecorder(auditLogsModel{s: st}),
+		UsageRecorder:          usage.NewRecorder(usageModel{s: st}),
+		UsercenterRpc:          users,
+		Approval:               approval.MustNew(c.Approval),
+	}
+	svcCtx.Collab = collab.NewHub(c.Collab, svcCtx.DocRepo,
+		svc.ApprovalLockFunc(svcCtx.DocumentApprovalsModel, svcCtx.Approval), svcCtx.Auditor)
+
+	// 与 main 中注册的拦截器一致，业务错误以错误码作为 gRPC 状态码返回
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(rpcserver.MetricsInterceptor, rpcserver.LoggerInterceptor),
+		grpc.ChainStreamInterceptor(rpcserver.StreamMetricsInterceptor, rpcserver.StreamLoggerInterceptor),
+	)
+	pb.RegisterLlmCenterServer(grpcServer, server.NewLlmCenterServer(svcCtx))
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	cli, err := zrpc.NewClient(zrpc.RpcClientConf{Endpoints: []string{lis.Addr().String()}, NonBlock: true, Timeout: timeout.Milliseconds()})
+	if err != nil {
+		t.Fatalf("new rpc client: %v", err)
+	}
+	t.Cleanup(func() { cli.Conn().Close() })
+
+	return &harness{
+		t:      t,
+		mock:   mock,
+		store:  st,
+		users:  users,
+		redis:  mr,
+		svcCtx: svcCtx,
+		client: llmcenter.NewLlmCenter(cli),
+	}
+}
+
+// ctx 返回带超时的请求上下文
+func (h *harness) ctx() context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	h.t.Cleanup(cancel)
+	return ctx
+}
+
+// sseResult 一次流式调用收到的事件
+type sseResult struct {
+	chunks []string
+	end    *pb.SSEEndEvent
+	check  *pb.SSECheckEvent
+}
+
+func (r sseResult) text() string {
+	var s string
+	for _, c := range r.chunks {
+		s += c
+	}
+	return s
+}
+
+type sseResponse interface {
+	GetMessage() *pb.SSEMessageEvent
+	GetEnd() *pb.SSEEndEvent
+}
+
+// collect 读取流中的全部事件，直到流结束或出错
+func collect[T sseResponse](recv func() (T, error)) (sseResult, error) {
+	var result sseResult
+	for {
+		resp, err := recv()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		if msg := resp.GetMessage(); msg != nil {
+			result.chunks = append(result.chunks, msg.Chunk)
+		}
+		if end := resp.GetEnd(); end != nil {
+			result.end = end
+		}
+		if withCheck, ok := any(resp).(interface{ GetCheck() *pb.SSECheckEvent }); ok && withCheck.GetCheck() != nil {
+			result.check = withCheck.GetCheck()
+		}
+	}
+}
+
+func (h *harness) chat(in *pb.ChatCompletionsRequest) (sseResult, error) {
+	h.t.Helper()
+	stream, err := h.client.ChatCompletions(h.ctx(), in)
+	if err != nil {
+		h.t.Fatalf("ChatCompletions: %v", err)
+	}
+	return collect(stream.Recv)
+}
+
+func (h *harness) resume(in *pb.ChatResumeRequest) (sseResult, error) {
+	h.t.Helper()
+	stream, err := h.client.ChatResume(h.ctx(), in)
+	if err != nil {
+		h.t.Fatalf("ChatResume: %v", err)
+	}
+	return collect(stream.Recv)
+}
+
+func (h *harness) edit(in *pb.EditDocumentRequest) (sseResult, error) {
+	h.t.Helper()
+	stream, err := h.client.EditDocument(h.ctx(), in)
+	if err != nil {
+		h.t.Fatalf("EditDocument: %v", err)
+	}
+	return collect(stream.Recv)
+}
+
+// upload 以 chunkSize 分块上传文件
+func (h *harness) upload(info *pb.FileInfo, content []byte, chunkSize int) (*pb.FileUploadResponse, error) {
+	h.t.Helper()
+	stream, err := h.client.FileUpload(h.ctx())
+	if err != nil {
+		h.t.Fatalf("FileUpload: %v", err)
+	}
+	if err := stream.Send(&pb.FileUploadRequest{Data: &pb.FileUploadRequest_Info{Info: info}}); err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(content); start += chunkSize {
+		end := min(start+chunkSize, len(content))
+		if err := stream.Send(&pb.FileUploadRequest{Data: &pb.FileUploadRequest_Chunk{Chunk: content[start:end]}}); err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// generate 在个人空间新建会话并生成一次，返回会话ID
+func (h *harness) generate(userID int64) string {
+	h.t.Helper()
+	return h.generateIn(userID, 0)
+}
+
+// generateIn 在团队空间 workspaceID 中新建会话并生成一次，返回会话ID
+func (h *harness) generateIn(userID, workspaceID int64) string {
+	h.t.Helper()
+	res, err := h.chat(&pb.ChatCompletionsRequest{UserId: userID, WorkspaceId: workspaceID, Documenttype: "通知", Information: "关于召开年度工作会议"})
+	if err != nil {
+		h.t.Fatalf("generate: %v", err)
+	}
+	if res.end == nil || res.end.ConversationId == "" {
+		h.t.Fatalf("generate: missing end event: %+v", res)
+	}
+	return res.end.ConversationId
+}
+
+// seedDocument 直接写入一篇已生成的文档，返回文档ID
+func (h *harness) seedDocument(conversationID, content string) string {
+	h.t.Helper()
+	id := "doc-" + conversationID
+	if err := h.svcCtx.DocumentsModel.InsertDocument(context.Background(), id, conversationID, content, provider.DefaultName, flowID); err != nil {
+		h.t.Fatalf("seed document: %v", err)
+	}
+	return id
+}
+
+// requireCode 断言错误的 gRPC 状态码为 want 的业务错误码
+func requireCode(t *testing.T, err error, want error) {
+	t.Helper()
+	var codeMsg *xerror.CodeMsg
+	if !errors.As(want, &codeMsg) {
+		t.Fatalf("%v is not a business error", want)
+	}
+	if err == nil {
+		t.Fatalf("want error %d(%s), got nil", codeMsg.Code, codeMsg.Msg)
+	}
+	if got := status.Code(err); got != codes.Code(codeMsg.Code) {
+		t.Fatalf("want error %d(%s), got %v", codeMsg.Code, codeMsg.Msg, err)
+	}
+}
+
+// eventually 在超时前轮询直到 cond 成立
+func eventually(t *testing.T, cond func() bool, msg string) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", msg)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
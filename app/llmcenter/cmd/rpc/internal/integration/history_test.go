@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"testing"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+)
+
+func TestGetConversations(t *testing.T) {
+	h := newHarness(t)
+	h.generate(1)
+	h.generate(1)
+	h.generate(2)
+
+	resp, err := h.client.GetConversations(h.ctx(), &pb.GetConversationsRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetConversations: %v", err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("conversations = %d, want 2", len(resp.Data))
+	}
+	for _, c := range resp.Data {
+		if c.UserId != 1 || c.Title != "关于召开年度工作会议" {
+			t.Fatalf("conversation = %+v", c)
+		}
+	}
+
+	_, err = h.client.GetConversations(h.ctx(), &pb.GetConversationsRequest{UserId: 3})
+	requireCode(t, err, xerr.ErrConversationNotFound)
+}
+
+func TestGetConversationsWorkspace(t *testing.T) {
+	h := newHarness(t)
+	const workspaceID = 7
+	h.users.setRole(1, workspaceID, workspace.RoleEditor)
+	h.users.setRole(2, workspaceID, workspace.RoleViewer)
+	if _, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, WorkspaceId: workspaceID, Documenttype: "通知", Information: "团队会议"}); err != nil {
+		t.Fatalf("ChatCompletions: %v", err)
+	}
+
+	// 团队空间的会话对所有成员可见，且不出现在个人空间中
+	resp, err := h.client.GetConversations(h.ctx(), &pb.GetConversationsRequest{UserId: 2, WorkspaceId: workspaceID})
+	if err != nil {
+		t.Fatalf("GetConversations: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].UserId != 1 {
+		t.Fatalf("conversations = %+v", resp.Data)
+	}
+	_, err = h.client.GetConversations(h.ctx(), &pb.GetConversationsRequest{UserId: 1})
+	requireCode(t, err, xerr.ErrConversationNotFound)
+
+	_, err = h.client.GetConversations(h.ctx(), &pb.GetConversationsRequest{UserId: 3, WorkspaceId: workspaceID})
+	requireCode(t, err, xerr.ErrWorkspaceAccessDenied)
+}
+
+func TestConversationHistory(t *testing.T) {
+	h := newHarness(t)
+	convID := h.generate(1)
+	docID := h.seedDocument(convID, "原文内容")
+	if _, err := h.edit(&pb.EditDocumentRequest{UserId: 1, ConversationId: convID, MessageId: docID, Prompt: "改为正式语气"}); err != nil {
+		t.Fatalf("EditDocument: %v", err)
+	}
+
+	detail, err := h.client.GetConversationDetail(h.ctx(), &pb.GetConversationDetailRequest{UserId: 1, ConversationId: convID})
+	if err != nil {
+		t.Fatalf("GetConversationDetail: %v", err)
+	}
+	if len(detail.History) != 2 || detail.History[0].Role != "user" || detail.History[1].Content != "第一段。第二段。" {
+		t.Fatalf("history = %+v", detail.History)
+	}
+
+	docs, err := h.client.GetDocumentDetail(h.ctx(), &pb.GetDocumentDetailRequest{UserId: 1, ConversationId: convID})
+	if err != nil {
+		t.Fatalf("GetDocumentDetail: %v", err)
+	}
+	if len(docs.Documents) != 1 || docs.Documents[0].MessageId != docID || docs.Documents[0].Content != "第一段。第二段。" {
+		t.Fatalf("documents = %+v", docs.Documents)
+	}
+
+	history, err := h.client.GetHistoryData(h.ctx(), &pb.GetHistoryDataRequest{UserId: 1, ConversationId: convID})
+	if err != nil {
+		t.Fatalf("GetHistoryData: %v", err)
+	}
+	if len(history.Items) != 1 || history.Items[0].Documenttype != "通知" || history.Items[0].Information != "关于召开年度工作会议" {
+		t.Fatalf("history data = %+v", history.Items)
+	}
+
+	_, err = h.client.GetConversationDetail(h.ctx(), &pb.GetConversationDetailRequest{UserId: 2, ConversationId: convID})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+	_, err = h.client.GetHistoryData(h.ctx(), &pb.GetHistoryDataRequest{UserId: 2, ConversationId: convID})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+}
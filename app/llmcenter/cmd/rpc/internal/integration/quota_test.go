@@ -0,0 +1,70 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+)
+
+func TestQuotaCheck(t *testing.T) {
+	quota := func(subjectType, subject string, daily, monthly, dailyChars int64) *model.UsageQuota {
+		return &model.UsageQuota{SubjectType: subjectType, Subject: subject, DailyRequests: daily, MonthlyRequests: monthly, DailyChars: dailyChars}
+	}
+	tests := []struct {
+		name   string
+		roles  []string // 为空时为普通用户
+		quotas []*model.UsageQuota
+		want   error
+	}{
+		{"未配置配额", nil, nil, nil},
+		{"角色配额已用完", nil, []*model.UsageQuota{quota(model.QuotaSubjectRole, authz.RoleUser, 5, 0, 0)}, xerr.ErrDailyQuotaExceeded},
+		{"字符数已用完", nil, []*model.UsageQuota{quota(model.QuotaSubjectRole, authz.RoleUser, 0, 0, 500)}, xerr.ErrDailyQuotaExceeded},
+		{"每月配额已用完", nil, []*model.UsageQuota{quota(model.QuotaSubjectRole, authz.RoleUser, 0, 5, 0)}, xerr.ErrMonthlyQuotaExceeded},
+		{"用户级配额放宽角色配额", nil, []*model.UsageQuota{
+			quota(model.QuotaSubjectRole, authz.RoleUser, 5, 0, 0),
+			quota(model.QuotaSubjectUser, "1", 10, 0, 0),
+		}, nil},
+		{"用户级配额收紧", nil, []*model.UsageQuota{quota(model.QuotaSubjectUser, "1", 5, 0, 0)}, xerr.ErrDailyQuotaExceeded},
+		{"多个角色取最宽松", []string{authz.RoleUser, authz.RoleReviewer}, []*model.UsageQuota{
+			quota(model.QuotaSubjectRole, authz.RoleUser, 5, 0, 0),
+			quota(model.QuotaSubjectRole, authz.RoleReviewer, 10, 0, 0),
+		}, nil},
+		{"多个角色均已用完", []string{authz.RoleUser, authz.RoleReviewer}, []*model.UsageQuota{
+			quota(model.QuotaSubjectRole, authz.RoleUser, 5, 0, 0),
+			quota(model.QuotaSubjectRole, authz.RoleReviewer, 3, 0, 0),
+		}, xerr.ErrDailyQuotaExceeded},
+		{"有角色未配置配额时不限", []string{authz.RoleUser, authz.RoleAdmin}, []*model.UsageQuota{
+			quota(model.QuotaSubjectRole, authz.RoleUser, 5, 0, 0),
+		}, nil},
+		{"配额全为 0 表示不限", nil, []*model.UsageQuota{quota(model.QuotaSubjectRole, authz.RoleUser, 0, 0, 0)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHarness(t)
+			if tt.roles != nil {
+				h.users.setUserRoles(1, tt.roles...)
+			}
+			h.store.mu.Lock()
+			h.store.quotas = tt.quotas
+			// 今天已调用 5 次，共 500 字符
+			h.store.usages = append(h.store.usages, &model.Usage{UserId: 1, UsageDate: time.Now(), CallType: "generate", RequestCount: 5, PromptChars: 300, ResponseChars: 200})
+			h.store.mu.Unlock()
+
+			_, err := h.chat(&pb.ChatCompletionsRequest{UserId: 1, Documenttype: "通知", Information: "关于召开年度工作会议"})
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("ChatCompletions: %v", err)
+				}
+				return
+			}
+			requireCode(t, err, tt.want)
+			if n := len(h.mock.Requests()); n != 0 {
+				t.Fatalf("%d model requests sent after quota exceeded", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/xerr"
+)
+
+const shareContent = "# 关于召开年度工作会议的通知\n\n各部门：\n\n定于下周召开年度工作会议。"
+
+// fakePandoc 在 PATH 最前面放置一个原样输出输入内容的 pandoc：指定了 -o 时将最后一个参数（输入文件）复制到输出文件，
+// 否则从标准输入读取。返回其被调用的次数
+func fakePandoc(t *testing.T) func() int {
+	t.Helper()
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	script := "#!/bin/sh\necho >> " + calls + "\n" +
+		"out=; prev=; for a; do [ \"$prev\" = -o ] && out=$a; prev=$a; done\n" +
+		"if [ -n \"$out\" ]; then exec /bin/cp \"$prev\" \"$out\"; fi\nexec /bin/cat\n"
+	if err := os.WriteFile(filepath.Join(dir, "pandoc"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return func() int {
+		data, _ := os.ReadFile(calls)
+		return strings.Count(string(data), "\n")
+	}
+}
+
+// createShare 为 userID 新建会话与文档并创建分享链接，返回分享记录与令牌
+func (h *harness) createShare(userID int64, password string) (*pb.DocumentShare, string) {
+	h.t.Helper()
+	convID := h.generate(userID)
+	docID := h.seedDocument(convID, shareContent)
+	resp, err := h.client.CreateDocumentShare(h.ctx(), &pb.CreateDocumentShareRequest{
+		UserId: userID, ConversationId: convID, MessageId: docID, Password: password,
+	})
+	if err != nil {
+		h.t.Fatalf("CreateDocumentShare: %v", err)
+	}
+	u, err := url.Parse(resp.Share.Url)
+	if err != nil {
+		h.t.Fatalf("parse share url %q: %v", resp.Share.Url, err)
+	}
+	return resp.Share, u.Query().Get("token")
+}
+
+func (h *harness) openShare(token, password, unlock string) (*pb.OpenDocumentShareResponse, error) {
+	return h.client.OpenDocumentShare(h.ctx(), &pb.OpenDocumentShareRequest{Token: token, Format: "html", Password: password, Unlock: unlock})
+}
+
+func TestDocumentSharePassword(t *testing.T) {
+	fakePandoc(t)
+	h := newHarness(t)
+	share, token := h.createShare(1, "s3cret")
+	if !share.HasPassword || share.Status != "active" {
+		t.Fatalf("share = %+v", share)
+	}
+
+	_, err := h.openShare(token, "", "")
+	requireCode(t, err, xerr.ErrSharePasswordRequired)
+	_, err = h.openShare(token, "wrong", "")
+	requireCode(t, err, xerr.ErrSharePasswordIncorrect)
+
+	resp, err := h.openShare(token, "s3cret", "")
+	if err != nil {
+		t.Fatalf("open with password: %v", err)
+	}
+	if resp.Unlock == "" || resp.Title != "关于召开年度工作会议的通知" || !strings.Contains(resp.Html, "定于下周召开年度工作会议") {
+		t.Fatalf("resp = %+v", resp)
+	}
+
+	// 凭证可代替密码；伪造的凭证不能通过
+	if _, err := h.openShare(token, "", resp.Unlock); err != nil {
+		t.Fatalf("open with unlock: %v", err)
+	}
+	_, err = h.openShare(token, "", resp.Unlock+"x")
+	requireCode(t, err, xerr.ErrSharePasswordRequired)
+	if got := h.store.share(share.Id).ViewCount; got != 2 {
+		t.Fatalf("view count = %d", got)
+	}
+}
+
+func TestDocumentSharePasswordLocked(t *testing.T) {
+	h := newHarness(t, func(c *config.Config) { c.Share.MaxPasswordFailures = 2 })
+	_, token := h.createShare(1, "s3cret")
+
+	for range 2 {
+		_, err := h.openShare(token, "wrong", "")
+		requireCode(t, err, xerr.ErrSharePasswordIncorrect)
+	}
+	// 锁定后正确的密码也被拒绝
+	_, err := h.openShare(token, "s3cret", "")
+	requireCode(t, err, xerr.ErrSharePasswordLocked)
+}
+
+func TestDocumentShareExpiredAndRevoked(t *testing.T) {
+	fakePandoc(t)
+	h := newHarness(t)
+	expired, expiredToken := h.createShare(1, "")
+	revoked, revokedToken := h.createShare(1, "")
+	if _, err := h.openShare(expiredToken, "", ""); err != nil {
+		t.Fatalf("open active share: %v", err)
+	}
+
+	h.store.updateShare(expired.Id, func(sh *model.DocumentShares) { sh.ExpireAt = time.Now().Add(-time.Second) })
+	_, err := h.openShare(expiredToken, "", "")
+	requireCode(t, err, xerr.ErrShareExpired)
+
+	// 只有具备修改权限的用户可以撤销
+	_, err = h.client.RevokeDocumentShare(h.ctx(), &pb.RevokeDocumentShareRequest{UserId: 2, Id: revoked.Id})
+	requireCode(t, err, xerr.ErrConversationAccessDenied)
+	if _, err := h.client.RevokeDocumentShare(h.ctx(), &pb.RevokeDocumentShareRequest{UserId: 1, Id: revoked.Id}); err != nil {
+		t.Fatalf("RevokeDocumentShare: %v", err)
+	}
+	_, err = h.openShare(revokedToken, "", "")
+	requireCode(t, err, xerr.ErrShareRevoked)
+
+	_, err = h.openShare("no-such-token", "", "")
+	requireCode(t, err, xerr.ErrShareNotFound)
+}
+
+func TestDocumentShareRenderCache(t *testing.T) {
+	pandocCalls := fakePandoc(t)
+	h := newHarness(t)
+	share, token := h.createShare(1, "")
+
+	for range 3 {
+		if _, err := h.openShare(token, "", ""); err != nil {
+			t.Fatalf("open share: %v", err)
+		}
+	}
+	if got := pandocCalls(); got != 1 {
+		t.Fatalf("pandoc called %d times for the same document", got)
+	}
+
+	// 文档修改后重新渲染
+	if err := h.svcCtx.DocRepo.UpdateDocumentContent(context.Background(), share.MessageId, shareContent+"\n\n请准时参加。"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := h.openShare(token, "", "")
+	if err != nil {
+		t.Fatalf("open share: %v", err)
+	}
+	if got := pandocCalls(); got != 2 || !strings.Contains(resp.Html, "请准时参加") {
+		t.Fatalf("pandoc calls = %d, html = %q", got, resp.Html)
+	}
+	if got := h.store.share(share.Id).ViewCount; got != 4 {
+		t.Fatalf("view count = %d", got)
+	}
+}
+
+func TestDocumentShareRateLimit(t *testing.T) {
+	fakePandoc(t)
+	h := newHarness(t, func(c *config.Config) {
+		c.Share.IpPerMinute = 2
+		c.Share.LinkPerMinute = 2
+	})
+	_, first := h.createShare(1, "")
+	_, second := h.createShare(1, "")
+	open := func(ip, token string) error {
+		ctx := clientinfo.AppendToOutgoing(clientinfo.NewContext(h.ctx(), clientinfo.Info{IP: ip}))
+		_, err := h.client.OpenDocumentShare(ctx, &pb.OpenDocumentShareRequest{Token: token, Format: "html"})
+		return err
+	}
+
+	// 同一链接每分钟 2 次
+	for _, ip := range []string{"198.51.100.1", "198.51.100.2"} {
+		if err := open(ip, first); err != nil {
+			t.Fatalf("open from %s: %v", ip, err)
+		}
+	}
+	err := open("198.51.100.3", first)
+	requireCode(t, err, xerr.ErrShareTooFrequent)
+	if wait, ok := xerr.RetryAfter(err); !ok || wait <= 0 || wait > time.Minute {
+		t.Fatalf("retry after = %v, %v", wait, ok)
+	}
+
+	// 同一 IP 每分钟 2 次，不同的链接合并计数
+	if err := open("198.51.100.1", second); err != nil {
+		t.Fatalf("open second share: %v", err)
+	}
+	requireCode(t, open("198.51.100.1", second), xerr.ErrShareTooFrequent)
+	if err := open("198.51.100.4", second); err != nil {
+		t.Fatalf("open from another ip: %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Package provider 大模型提供方路由：维护一组兼容星辰工作流接口的提供方（端点），
+// 按优先级故障转移、同一优先级内按权重分流，每个提供方有独立的熔断器用于健康跟踪。
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/pkg/circuit"
+)
+
+// DefaultName 未配置 LlmProviders 时，由 XingChen 配置生成的唯一提供方的名称
+const DefaultName = "xingchen"
+
+// Provider 一个大模型提供方
+type Provider struct {
+	Name         string
+	Model        string
+	ApiURL       string
+	ApiResumeURL string
+	ApiUploadURL string
+	ApiKey       string
+	ApiSecret    string
+	FlowID       string
+	Priority     int
+	Weight       int
+	Breaker      *circuit.Breaker
+}
+
+// AuthToken 请求头 Authorization 的值
+func (p *Provider) AuthToken() string {
+	return fmt.Sprintf("Bearer %s:%s", p.ApiKey, p.ApiSecret)
+}
+
+// RequestBody 将请求体中的 flow_id 替换为该提供方的工作流ID；请求体不含 flow_id（如 Resume API）时原样返回
+func (p *Provider) RequestBody(reqBody []byte) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(reqBody, &fields); err != nil {
+		return reqBody
+	}
+	if _, ok := fields["flow_id"]; !ok || p.FlowID == "" {
+		return reqBody
+	}
+	flowID, _ := json.Marshal(p.FlowID)
+	if string(fields["flow_id"]) == string(flowID) {
+		return reqBody
+	}
+	fields["flow_id"] = flowID
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return reqBody
+	}
+	return body
+}
+
+// ChatURL 对话接口地址
+func ChatURL(p *Provider) string { return p.ApiURL }
+
+// ResumeURL Resume 接口地址
+func ResumeURL(p *Provider) string { return p.ApiResumeURL }
+
+// UploadURL 图片上传接口地址
+func UploadURL(p *Provider) string { return p.ApiUploadURL }
+
+// Router 提供方路由
+type Router struct {
+	providers []*Provider
+}
+
+// NewRouter 根据配置创建路由。未配置 LlmProviders 时使用 XingChen 作为唯一的提供方
+func NewRouter(c config.Config) *Router {
+	cfgs := c.LlmProviders
+	if len(cfgs) == 0 {
+		cfgs = []config.LlmProvider{{
+			Name:         DefaultName,
+			ApiURL:       c.XingChen.ApiURL,
+			ApiResumeURL: c.XingChen.ApiResumeURL,
+			ApiUploadURL: c.XingChen.UploadURL,
+			ApiKey:       c.XingChen.ApiKey,
+			ApiSecret:    c.XingChen.ApiSecret,
+			Weight:       1,
+		}}
+	}
+
+	r := &Router{}
+	for _, pc := range cfgs {
+		p := &Provider{
+			Name:         pc.Name,
+			Model:        pc.Model,
+			ApiURL:       pc.ApiURL,
+			ApiResumeURL: pc.ApiResumeURL,
+			ApiUploadURL: pc.ApiUploadURL,
+			ApiKey:       pc.ApiKey,
+			ApiSecret:    pc.ApiSecret,
+			FlowID:       pc.FlowID,
+			Priority:     pc.Priority,
+			Weight:       pc.Weight,
+			Breaker:      circuit.NewBreaker(pc.Name, c.LlmBreaker),
+		}
+		if p.FlowID == "" {
+			p.FlowID = c.XingChen.FlowID
+		}
+		if p.Model == "" {
+			p.Model = p.FlowID
+		}
+		r.providers = append(r.providers, p)
+	}
+	// 稳定排序，同一优先级保持配置顺序
+	slices.SortStableFunc(r.providers, func(a, b *Provider) int { return a.Priority - b.Priority })
+	return r
+}
+
+// Providers 全部提供方，按优先级排序
+func (r *Router) Providers() []*Provider {
+	return r.providers
+}
+
+// Candidates 返回本次调用依次尝试的提供方，跳过 endpoint 为空的提供方。
+// 优先级高的在前；同一优先级内按权重随机排序，权重为 0 的提供方排在同层最后，仅在同层其他提供方失败时使用
+func (r *Router) Candidates(endpoint func(*Provider) string) []*Provider {
+	out := make([]*Provider, 0, len(r.providers))
+	for start := 0; start < len(r.providers); {
+		end := start
+		for end < len(r.providers) && r.providers[end].Priority == r.providers[start].Priority {
+			end++
+		}
+		out = append(out, weightedOrder(r.providers[start:end], endpoint)...)
+		start = end
+	}
+	return out
+}
+
+// weightedOrder 加权随机排列（Efraimidis-Spirakis）：每个提供方取 -ln(U)/weight 作为排序键，键越小越靠前
+func weightedOrder(tier []*Provider, endpoint func(*Provider) string) []*Provider {
+	type keyed struct {
+		p   *Provider
+		key float64
+	}
+	items := make([]keyed, 0, len(tier))
+	for _, p := range tier {
+		if endpoint(p) == "" {
+			continue
+		}
+		key := math.Inf(1)
+		if p.Weight > 0 {
+			key = -math.Log(1-rand.Float64()) / float64(p.Weight)
+		}
+		items = append(items, keyed{p: p, key: key})
+	}
+	slices.SortStableFunc(items, func(a, b keyed) int {
+		switch {
+		case a.key < b.key:
+			return -1
+		case a.key > b.key:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	out := make([]*Provider, 0, len(items))
+	for _, it := range items {
+		out = append(out, it.p)
+	}
+	return out
+}
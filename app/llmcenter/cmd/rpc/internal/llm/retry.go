@@ -0,0 +1,186 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
+	"document_agent/app/llmcenter/cmd/rpc/types"
+	"document_agent/pkg/circuit"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/xerr"
+)
+
+// 重试默认值
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 500 * time.Millisecond
+	defaultMaxDelay    = 5 * time.Second
+)
+
+// defaultRetryableCodes 默认可重试的星辰错误码：服务繁忙、内部网络错误、QPS 超限、并发超限。
+// 鉴权失败、参数错误、内容审核不通过、token 超限等错误重试也不会成功
+var defaultRetryableCodes = []int{10110, 10222, 11202, 11203}
+
+// upstreamError 星辰返回的错误或与星辰通信失败。retryable 表示在尚未向客户端输出内容时可以重试；
+// code 用于监控：network、http_<状态码> 或星辰错误码
+type upstreamError struct {
+	err       error
+	retryable bool
+	code      string
+}
+
+func (e *upstreamError) Error() string { return e.err.Error() }
+
+func (e *upstreamError) Unwrap() error { return e.err }
+
+// transient 可重试的网络错误
+func transient(err error) error {
+	return &upstreamError{err: err, retryable: true, code: "network"}
+}
+
+func upstreamFailure(code string, retryable bool, err error) error {
+	return &upstreamError{err: err, retryable: retryable, code: code}
+}
+
+// retryableStatus 5xx、429 与 408 视为暂时性错误
+func retryableStatus(code int) bool {
+	return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+}
+
+func (c *XingChenClient) retryableCode(code int) bool {
+	codes := c.svcCtx.Config.LlmRetry.RetryableCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableCodes
+	}
+	return slices.Contains(codes, code)
+}
+
+// streamWithRetry 按路由顺序调用各提供方的流式接口并处理响应。
+// 在向客户端输出第一段内容之前失败且错误可重试时，转移到下一个提供方；所有提供方都尝试过后，
+// 按指数退避加随机抖动从头重试，总尝试次数不少于提供方数量。已输出内容后不再重试，避免客户端收到重复内容。
+// 熔断中的提供方直接跳过，全部熔断时快速失败。首包耗时与总耗时包含重试，按实际提供的提供方与 callType 上报
+func (c *XingChenClient) streamWithRetry(callType string, endpoint func(*provider.Provider) string, reqBody []byte, conversationID string, handler sseEventHandler) (reply string, err error) {
+	candidates := c.candidates(endpoint)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no llm provider configured for this endpoint: %w", xerr.ErrLLMUnavailable)
+	}
+	maxAttempts := c.svcCtx.Config.LlmRetry.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	maxAttempts = max(maxAttempts, len(candidates))
+
+	start := time.Now()
+	providerName := candidates[0].Name
+	ctx, span := tracing.Start(c.ctx, "llm.stream", tracing.AttrCallType.String(callType))
+	tracing.SetConversation(ctx, conversationID, "")
+	defer func() {
+		metrics.ObserveLLMGeneration(providerName, callType, start, err)
+		span.SetAttributes(tracing.AttrProvider.String(providerName))
+		tracing.End(span, err)
+	}()
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		p := candidates[(attempt-1)%len(candidates)]
+		if err := p.Breaker.Allow(); err != nil {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("llm provider %s %v, conversationId:%s: %w", p.Name, err, conversationID, xerr.ErrLLMUnavailable)
+			}
+			continue
+		}
+
+		// 所有提供方都已尝试过，再次重试前等待
+		if round := (attempt - 1) / len(candidates); round > 0 {
+			delay := c.backoff(round)
+			select {
+			case <-c.ctx.Done():
+				p.Breaker.Ignore()
+				return "", fmt.Errorf("llm retry canceled: %v:%w", c.ctx.Err(), xerr.ErrLLMApiCancel)
+			case <-time.After(delay):
+			}
+		}
+
+		providerName = p.Name
+		attemptCtx, attemptSpan := tracing.Start(ctx, "llm.attempt", tracing.AttrProvider.String(p.Name),
+			tracing.AttrModel.String(p.Model), tracing.AttrAttempt.Int(attempt))
+		sent := false
+		reply, err := c.streamOnce(attemptCtx, p, endpoint(p), reqBody, conversationID, func(apiResp *types.LLMApiResponse) (bool, error) {
+			if !sent && len(apiResp.Choices) > 0 && apiResp.Choices[0].Delta.Content != "" {
+				sent = true
+				metrics.ObserveLLMFirstChunk(p.Name, callType, time.Since(start))
+				attemptSpan.AddEvent("first_chunk")
+			}
+			return handler(apiResp)
+		})
+		reportBreaker(p.Breaker, err)
+
+		var upErr *upstreamError
+		if errors.As(err, &upErr) {
+			metrics.IncLLMUpstreamError(p.Name, upErr.code)
+			attemptSpan.SetAttributes(tracing.AttrErrorCode.String(upErr.code))
+		}
+		tracing.End(attemptSpan, err)
+		if err == nil || sent || !errors.As(err, &upErr) || !upErr.retryable {
+			if err == nil || sent {
+				c.served = p
+			}
+			return reply, err
+		}
+		lastErr = err
+		c.Infof("llm provider %s failed before streaming, attempt %d/%d, conversationId:%s, err:%v",
+			p.Name, attempt, maxAttempts, conversationID, err)
+	}
+	return "", lastErr
+}
+
+func (c *XingChenClient) streamOnce(ctx context.Context, p *provider.Provider, url string, reqBody []byte, conversationID string, handler sseEventHandler) (string, error) {
+	resp, err := c.doStreamRequest(ctx, p, url, reqBody)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return c.processStreamResponse(resp.Body, conversationID, handler)
+}
+
+// reportBreaker 上报本次尝试的结果：暂时性错误计为失败；成功或上游明确拒绝（说明上游可用）计为成功；
+// 客户端取消、内容拦截等与上游可用性无关的错误不计入
+func reportBreaker(b *circuit.Breaker, err error) {
+	var upErr *upstreamError
+	switch {
+	case err == nil:
+		b.Success()
+	case errors.As(err, &upErr) && upErr.retryable:
+		b.Failure()
+	case errors.As(err, &upErr):
+		b.Success()
+	default:
+		b.Ignore()
+	}
+}
+
+// backoff 第 round 轮全部失败后的等待时间：BaseDelay*2^(round-1)，不超过 MaxDelay，实际取其 [1/2, 1] 之间的随机值
+func (c *XingChenClient) backoff(round int) time.Duration {
+	cfg := c.svcCtx.Config.LlmRetry
+	base, maxDelay := defaultBaseDelay, defaultMaxDelay
+	if cfg.BaseDelayMs > 0 {
+		base = time.Duration(cfg.BaseDelayMs) * time.Millisecond
+	}
+	if cfg.MaxDelayMs > 0 {
+		maxDelay = time.Duration(cfg.MaxDelayMs) * time.Millisecond
+	}
+
+	delay := maxDelay
+	if shift := round - 1; shift < 30 {
+		delay = min(base<<shift, maxDelay)
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,225 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/types"
+	"document_agent/pkg/circuit"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/xerr"
+	"document_agent/pkg/xingchenmock"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func TestMain(m *testing.M) {
+	logx.Disable()
+	m.Run()
+}
+
+// newTestClient 以模拟的星辰接口作为唯一提供方创建客户端
+func newTestClient(t *testing.T, ctx context.Context, mock *xingchenmock.Server, modify func(c *config.Config)) *XingChenClient {
+	t.Helper()
+	upstream := httptest.NewServer(mock)
+	t.Cleanup(upstream.Close)
+
+	var c config.Config
+	c.XingChen.FlowID = "mock-flow"
+	c.XingChen.ApiURL = upstream.URL + "/workflow/v1/chat/completions"
+	c.XingChen.ApiKey = "mock-key"
+	c.XingChen.ApiSecret = "mock-secret"
+	c.LlmRetry.MaxAttempts = 3
+	c.LlmRetry.BaseDelayMs = 1
+	c.LlmRetry.MaxDelayMs = 5
+	if modify != nil {
+		modify(&c)
+	}
+	screener, err := screening.NewScreener(c.Screening)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewXingChenClient(ctx, &svc.ServiceContext{
+		Config:       c,
+		LlmApiClient: &http.Client{Timeout: 10 * time.Second},
+		LlmRouter:    provider.NewRouter(c),
+		Screener:     screener,
+	})
+}
+
+// stream 调用 streamWithRetry，返回已输出的正文
+func stream(c *XingChenClient) (string, error) {
+	var sent string
+	_, err := c.streamWithRetry("generate", provider.ChatURL, []byte(`{}`), "conv", func(apiResp *types.LLMApiResponse) (bool, error) {
+		if len(apiResp.Choices) > 0 {
+			sent += apiResp.Choices[0].Delta.Content
+		}
+		return false, nil
+	})
+	return sent, err
+}
+
+func TestStreamWithRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		scenarios []xingchenmock.Scenario // 依次返回，之后正常输出
+		requests  int
+		sent      string
+		wantErr   error
+	}{
+		{
+			name:      "首包前失败后重试成功",
+			scenarios: []xingchenmock.Scenario{{Status: http.StatusServiceUnavailable}, {ErrorCode: 10110, ErrorMessage: "服务繁忙"}},
+			requests:  3,
+			sent:      "第一段。第二段。",
+		},
+		{
+			name:      "输出首包后出错不再重试",
+			scenarios: []xingchenmock.Scenario{{Chunks: []string{"第一段。", "第二段。"}, ErrorCode: 10110, ErrorAfter: 1}},
+			requests:  1,
+			sent:      "第一段。",
+			wantErr:   xerr.ErrLLMApiError,
+		},
+		{
+			name:      "输出首包后连接中断不再重试",
+			scenarios: []xingchenmock.Scenario{{Chunks: []string{"第一段。", "第二段。"}, DropAfter: 1}},
+			requests:  1,
+			sent:      "第一段。",
+			wantErr:   xerr.ErrLLMApiError,
+		},
+		{
+			name:      "不可重试的错误码",
+			scenarios: []xingchenmock.Scenario{{ErrorCode: 10013, ErrorMessage: "参数错误"}},
+			requests:  1,
+			wantErr:   xerr.ErrLLMApiError,
+		},
+		{
+			name: "重试次数用尽",
+			scenarios: []xingchenmock.Scenario{
+				{Status: http.StatusBadGateway}, {Status: http.StatusBadGateway}, {Status: http.StatusBadGateway},
+			},
+			requests: 3,
+			wantErr:  xerr.ErrLLMApiError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := xingchenmock.New(xingchenmock.Reply("第一段。", "第二段。"))
+			mock.Enqueue(tt.scenarios...)
+			c := newTestClient(t, context.Background(), mock, nil)
+
+			sent, err := stream(c)
+			if sent != tt.sent {
+				t.Fatalf("sent = %q, want %q", sent, tt.sent)
+			}
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if n := len(mock.Requests()); n != tt.requests {
+				t.Fatalf("upstream requests = %d, want %d", n, tt.requests)
+			}
+		})
+	}
+}
+
+func TestStreamWithRetryBackoffCanceled(t *testing.T) {
+	mock := xingchenmock.New(xingchenmock.Scenario{Status: http.StatusServiceUnavailable})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newTestClient(t, ctx, mock, func(c *config.Config) {
+		c.LlmRetry.BaseDelayMs = 10_000
+		c.LlmRetry.MaxDelayMs = 10_000
+	})
+
+	// 第一次失败后进入退避等待，等待期间客户端断开
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := stream(c)
+	if !errors.Is(err, xerr.ErrLLMApiCancel) {
+		t.Fatalf("err = %v, want ErrLLMApiCancel", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("canceled retry returned after %v", elapsed)
+	}
+	if n := len(mock.Requests()); n != 1 {
+		t.Fatalf("upstream requests = %d, want 1", n)
+	}
+	// 取消不计入熔断，也不占用探测名额
+	if status := c.svcCtx.LlmRouter.Providers()[0].Breaker.Status(); status.State != circuit.StateClosed || status.Failures != 1 {
+		t.Fatalf("breaker = %+v", status)
+	}
+}
+
+func TestStreamWithRetryBreakerOpen(t *testing.T) {
+	mock := xingchenmock.New(xingchenmock.Scenario{Status: http.StatusBadGateway})
+	c := newTestClient(t, context.Background(), mock, func(c *config.Config) {
+		c.LlmBreaker = circuit.Config{FailureThreshold: 2, OpenSeconds: 60}
+	})
+
+	// 连续失败达到阈值后熔断，之后的尝试直接跳过
+	if _, err := stream(c); !errors.Is(err, xerr.ErrLLMApiError) {
+		t.Fatalf("err = %v", err)
+	}
+	if n := len(mock.Requests()); n != 2 {
+		t.Fatalf("upstream requests = %d, want 2", n)
+	}
+	if _, err := stream(c); !errors.Is(err, xerr.ErrLLMUnavailable) {
+		t.Fatalf("err with breaker open = %v", err)
+	}
+	if n := len(mock.Requests()); n != 2 {
+		t.Fatalf("upstream requests with breaker open = %d, want 2", n)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := newTestClient(t, context.Background(), xingchenmock.New(xingchenmock.Reply("ok")), func(c *config.Config) {
+		c.LlmRetry.BaseDelayMs = 100
+		c.LlmRetry.MaxDelayMs = 1000
+	})
+	tests := []struct {
+		round int
+		max   time.Duration // 实际等待在 [max/2, max] 之间
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{40, time.Second},
+	}
+	for _, tt := range tests {
+		for range 50 {
+			if got := c.backoff(tt.round); got < tt.max/2 || got > tt.max {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", tt.round, got, tt.max/2, tt.max)
+			}
+		}
+	}
+
+	// 未配置时使用默认值
+	c.svcCtx.Config.LlmRetry.BaseDelayMs, c.svcCtx.Config.LlmRetry.MaxDelayMs = 0, 0
+	if got := c.backoff(1); got < defaultBaseDelay/2 || got > defaultBaseDelay {
+		t.Fatalf("default backoff(1) = %v", got)
+	}
+}
+
+func TestRetryableStatus(t *testing.T) {
+	for code, want := range map[int]bool{
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+		http.StatusTooManyRequests:     true,
+		http.StatusRequestTimeout:      true,
+		http.StatusBadRequest:          false,
+		http.StatusUnauthorized:        false,
+		http.StatusForbidden:           false,
+	} {
+		if got := retryableStatus(code); got != want {
+			t.Errorf("retryableStatus(%d) = %v, want %v", code, got, want)
+		}
+	}
+}
@@ -5,17 +5,26 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/app/llmcenter/cmd/rpc/types"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/usage"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -23,8 +32,11 @@ import (
 
 // XingChenClient 封装了与星火大模型 API 的交互
 type XingChenClient struct {
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx      context.Context
+	svcCtx   *svc.ServiceContext
+	redactor *screening.Redactor // 可选：用于将输出中的脱敏占位符还原为原文
+	served   *provider.Provider  // 最近一次调用实际使用的提供方
+	pinned   *provider.Provider  // 上传过图片的提供方，图片 URL 只在该提供方有效
 	logx.Logger
 }
 
@@ -41,37 +53,93 @@ func NewXingChenClient(ctx context.Context, svcCtx *svc.ServiceContext) *XingChe
 	}
 }
 
-// UploadImage 上传图片到星火并返回 URL
+// WithRedactor 设置请求级脱敏器，流式输出中出现的占位符会被还原为原文
+func (c *XingChenClient) WithRedactor(r *screening.Redactor) *XingChenClient {
+	c.redactor = r
+	return c
+}
+
+// Served 返回最近一次调用实际使用的提供方名称与模型，未调用或全部提供方均失败时为空
+func (c *XingChenClient) Served() (providerName, model string) {
+	if c.served == nil {
+		return "", ""
+	}
+	return c.served.Name, c.served.Model
+}
+
+// UploadImage 上传图片并返回 URL。只使用配置了上传接口的提供方，按路由顺序在可重试的失败后转移到下一个；
+// 图片 URL 只在上传的提供方有效，上传成功后该客户端的后续调用固定使用这个提供方
 func (c *XingChenClient) UploadImage(filePath string) (string, error) {
-	file, err := os.Open(filePath)
+	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return "", fmt.Errorf("无法打开文件 %s: %w", filePath, err)
 	}
-	defer file.Close()
+	candidates := c.svcCtx.LlmRouter.Candidates(provider.UploadURL)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no llm provider supports image upload: %w", xerr.ErrLLMUnavailable)
+	}
+
+	var lastErr error
+	for _, p := range candidates {
+		if err := p.Breaker.Allow(); err != nil {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("llm provider %s %v: %w", p.Name, err, xerr.ErrLLMUnavailable)
+			}
+			continue
+		}
+		url, err := c.uploadOnce(p, filepath.Base(filePath), data)
+		reportBreaker(p.Breaker, err)
+		if err == nil {
+			c.pinned = p
+			return url, nil
+		}
+		var upErr *upstreamError
+		if errors.As(err, &upErr) {
+			metrics.IncLLMUpstreamError(p.Name, upErr.code)
+		}
+		if !errors.As(err, &upErr) || !upErr.retryable {
+			return "", err
+		}
+		lastErr = err
+		c.Infof("llm provider %s failed to upload image %s, err:%v", p.Name, filePath, err)
+	}
+	return "", lastErr
+}
 
+// uploadOnce 向指定提供方上传一次图片
+func (c *XingChenClient) uploadOnce(p *provider.Provider, filename string, data []byte) (string, error) {
 	var buf bytes.Buffer
 	writer := multipart.NewWriter(&buf)
-	part, err := writer.CreateFormFile("file", filepath.Base(filePath))
+	part, err := writer.CreateFormFile("file", filename)
 	if err != nil {
 		return "", err
 	}
-	if _, err = io.Copy(part, file); err != nil {
+	if _, err = part.Write(data); err != nil {
 		return "", err
 	}
 	writer.Close()
 
-	req, err := http.NewRequest("POST", c.svcCtx.Config.XingChen.UploadURL, &buf)
+	req, err := http.NewRequestWithContext(c.ctx, "POST", p.ApiUploadURL, &buf)
 	if err != nil {
 		return "", err
 	}
-	req.Header.Set("Authorization", c.getAuthToken())
+	req.Header.Set("Authorization", p.AuthToken())
 	req.Header.Set("Content-Type", writer.FormDataContentType())
+	tracing.InjectHTTP(c.ctx, req.Header)
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", err
+		if c.ctx.Err() != nil {
+			return "", fmt.Errorf("failed to upload image: %+v:%w", err, xerr.ErrLLMApiCancel)
+		}
+		return "", transient(fmt.Errorf("failed to upload image: %+v:%w", err, xerr.ErrLLMApiError))
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", upstreamFailure("http_"+strconv.Itoa(resp.StatusCode), retryableStatus(resp.StatusCode),
+			fmt.Errorf("upload image returned non-200 status: %d, body: %s :%w", resp.StatusCode, string(body), xerr.ErrLLMApiError))
+	}
 
 	var result struct {
 		Code    int    `json:"code"`
@@ -81,21 +149,29 @@ func (c *XingChenClient) UploadImage(filePath string) (string, error) {
 		} `json:"data"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", err
+		return "", transient(fmt.Errorf("failed to decode upload response: %v:%w", err, xerr.ErrLLMApiError))
 	}
 	if result.Code != 0 {
-		return "", fmt.Errorf("上传失败 code=%d: %s", result.Code, result.Message)
+		return "", upstreamFailure(strconv.Itoa(result.Code), c.retryableCode(result.Code),
+			fmt.Errorf("上传失败 code=%d: %s :%w", result.Code, result.Message, xerr.ErrLLMApiError))
 	}
 	return result.Data.URL, nil
 }
 
-// StreamChat 调用大模型 API 并处理流式响应
-func (c *XingChenClient) StreamChat(reqBody []byte, stream pb.LlmCenter_ChatCompletionsServer, conversationID string) (string, error) {
-	resp, err := c.doStreamRequest(c.svcCtx.Config.XingChen.ApiURL, reqBody)
-	if err != nil {
-		return "", err
+// candidates 本次调用依次尝试的提供方。上传过图片时只使用上传的提供方
+func (c *XingChenClient) candidates(endpoint func(*provider.Provider) string) []*provider.Provider {
+	if c.pinned != nil {
+		if endpoint(c.pinned) == "" {
+			return nil
+		}
+		return []*provider.Provider{c.pinned}
 	}
-	defer resp.Body.Close()
+	return c.svcCtx.LlmRouter.Candidates(endpoint)
+}
+
+// StreamChat 调用大模型 API 并处理流式响应
+func (c *XingChenClient) StreamChat(reqBody []byte, stream pb.LlmCenter_ChatCompletionsServer, conversationID string) (reply string, err error) {
+	defer c.meter(usage.CallGenerate, reqBody, time.Now(), &reply, &err)
 
 	// 定义 Chat 流程的事件处理器
 	handler := func(apiResp *types.LLMApiResponse) (bool, error) {
@@ -117,16 +193,12 @@ func (c *XingChenClient) StreamChat(reqBody []byte, stream pb.LlmCenter_ChatComp
 		return false, nil
 	}
 
-	return c.processStreamResponse(resp.Body, handler)
+	return c.streamWithRetry(usage.CallGenerate, provider.ChatURL, reqBody, conversationID, handler)
 }
 
 // StreamResume 调用大模型 Resume API 并处理流式响应
-func (c *XingChenClient) StreamResume(reqBody []byte, stream pb.LlmCenter_ChatResumeServer) (string, error) {
-	resp, err := c.doStreamRequest(c.svcCtx.Config.XingChen.ApiResumeURL, reqBody)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
+func (c *XingChenClient) StreamResume(reqBody []byte, stream pb.LlmCenter_ChatResumeServer) (reply string, err error) {
+	defer c.meter(usage.CallResume, reqBody, time.Now(), &reply, &err)
 
 	// 定义 Resume 流程的事件处理器
 	handler := func(apiResp *types.LLMApiResponse) (bool, error) {
@@ -140,39 +212,65 @@ func (c *XingChenClient) StreamResume(reqBody []byte, stream pb.LlmCenter_ChatRe
 		return false, nil
 	}
 
-	return c.processStreamResponse(resp.Body, handler)
+	return c.streamWithRetry(usage.CallResume, provider.ResumeURL, reqBody, "", handler)
 }
 
-// doStreamRequest 创建并执行一个流式API请求
-func (c *XingChenClient) doStreamRequest(url string, reqBody []byte) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(c.ctx, "POST", url, bytes.NewReader(reqBody))
+// doStreamRequest 向指定提供方创建并执行一个流式API请求，请求头携带 trace 上下文
+func (c *XingChenClient) doStreamRequest(ctx context.Context, p *provider.Provider, url string, reqBody []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(p.RequestBody(reqBody)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create http request: %+v:%w", err, xerr.ErrLLMApiCancel)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.getAuthToken())
+	req.Header.Set("Authorization", p.AuthToken())
 	req.Header.Set("Accept", "text/event-stream")
+	tracing.InjectHTTP(ctx, req.Header)
 
 	resp, err := c.svcCtx.LlmApiClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to call llm api: %+v:%w", err, xerr.ErrLLMApiCancel)
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("failed to call llm api: %+v:%w", err, xerr.ErrLLMApiCancel)
+		}
+		// 连接失败、连接被重置、超时等网络错误
+		return nil, transient(fmt.Errorf("failed to call llm api: %+v:%w", err, xerr.ErrLLMApiError))
 	}
 
+	tracing.SetAttributes(ctx, tracing.AttrHTTPStatus.Int(resp.StatusCode))
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		resp.Body.Close() // 确保在出错时也关闭 body
-		return nil, fmt.Errorf("llm api returned non-200 status: %d, body: %s :%w",
-			resp.StatusCode, string(bodyBytes), xerr.ErrLLMApiError)
+		return nil, upstreamFailure("http_"+strconv.Itoa(resp.StatusCode), retryableStatus(resp.StatusCode), fmt.Errorf("llm api returned non-200 status: %d, body: %s :%w",
+			resp.StatusCode, string(bodyBytes), xerr.ErrLLMApiError))
 	}
 
 	return resp, nil
 }
 
 // processStreamResponse 是处理 SSE 流的核心逻辑
-func (c *XingChenClient) processStreamResponse(body io.Reader, handler sseEventHandler) (string, error) {
+// 开启内容筛查时，正文增量会先经过流式筛查器：命中脱敏策略的内容以掩码输出，命中拦截策略则中止流。
+// 设置了脱敏器时，筛查后的正文再将占位符还原为原文。
+func (c *XingChenClient) processStreamResponse(body io.Reader, conversationID string, handler sseEventHandler) (string, error) {
 	scanner := bufio.NewScanner(body)
 	var assistantReply strings.Builder
+	filter := c.svcCtx.Screener.NewStreamFilter()
+	restorer := c.redactor.NewRestoreStream()
+
+	// screen 对一段输出执行筛查并记录审计日志
+	screen := func(text string, flush bool) (string, error) {
+		var res *screening.Result
+		if flush {
+			text, res = filter.Flush()
+		} else {
+			text, res = filter.Write(text)
+		}
+		screening.Audit(c.ctx, screening.StageOutput, res, logx.Field("conversationId", conversationID))
+		if res.Blocked() {
+			return "", fmt.Errorf("llm output blocked by screening, conversationId:%s: %w", conversationID, xerr.ErrContentBlocked)
+		}
+		return text, nil
+	}
 
+	finished := false
 	for scanner.Scan() {
 		line := scanner.Text()
 		if line == "" || !strings.HasPrefix(line, "data: ") {
@@ -187,8 +285,28 @@ func (c *XingChenClient) processStreamResponse(body io.Reader, handler sseEventH
 		}
 
 		if apiResp.Code != 0 {
-			return "", fmt.Errorf("LLM API error response: code=%d, message=%s :%w",
-				apiResp.Code, apiResp.Message, xerr.ErrLLMApiError)
+			return "", upstreamFailure(strconv.Itoa(apiResp.Code), c.retryableCode(apiResp.Code), fmt.Errorf("LLM API error response: code=%d, message=%s :%w",
+				apiResp.Code, apiResp.Message, xerr.ErrLLMApiError))
+		}
+
+		if len(apiResp.Choices) > 0 {
+			content, err := screen(apiResp.Choices[0].Delta.Content, false)
+			if err != nil {
+				return "", err
+			}
+			if apiResp.Choices[0].FinishReason == "stop" {
+				rest, err := screen("", true)
+				if err != nil {
+					return "", err
+				}
+				content += rest
+				finished = true
+			}
+			content = restorer.Write(content)
+			if finished {
+				content += restorer.Flush()
+			}
+			apiResp.Choices[0].Delta.Content = content
 		}
 
 		// 使用回调处理特定事件
@@ -202,21 +320,64 @@ func (c *XingChenClient) processStreamResponse(body io.Reader, handler sseEventH
 
 		if len(apiResp.Choices) > 0 {
 			assistantReply.WriteString(apiResp.Choices[0].Delta.Content)
-			if apiResp.Choices[0].FinishReason == "stop" {
+			if finished {
 				break
 			}
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return "", fmt.Errorf("error reading llm stream: %v:%w", err, xerr.ErrLLMApiError)
+		if c.ctx.Err() != nil {
+			return "", fmt.Errorf("error reading llm stream: %v:%w", err, xerr.ErrLLMApiCancel)
+		}
+		return "", transient(fmt.Errorf("error reading llm stream: %v:%w", err, xerr.ErrLLMApiError))
+	}
+
+	// 流在没有 stop 标记的情况下结束时，输出筛查器中暂存的剩余内容
+	if !finished {
+		rest, err := screen("", true)
+		if err != nil {
+			return "", err
+		}
+		rest = restorer.Write(rest) + restorer.Flush()
+		if rest != "" {
+			tail := &types.LLMApiResponse{Choices: []types.LLMChoice{{Delta: types.LLMDelta{Content: rest}}}}
+			if _, err := handler(tail); err != nil {
+				return "", err
+			}
+			assistantReply.WriteString(rest)
+		}
 	}
 
 	return assistantReply.String(), nil
 }
 
-func (c *XingChenClient) getAuthToken() string {
-	return fmt.Sprintf("Bearer %s:%s", c.svcCtx.Config.XingChen.ApiKey, c.svcCtx.Config.XingChen.ApiSecret)
+// meter 在流式调用结束后记录用量。用户ID与提示词取自请求体，与上游看到的调用方一致；
+// 未携带用户ID的请求（如 Resume API）不计量
+func (c *XingChenClient) meter(callType string, reqBody []byte, start time.Time, reply *string, err *error) {
+	var req struct {
+		UID        string              `json:"uid"`
+		Parameters types.LLMParameters `json:"parameters"`
+		History    []types.LLMMessage  `json:"history"`
+	}
+	if jsonErr := json.Unmarshal(reqBody, &req); jsonErr != nil {
+		c.Errorf("meter: failed to unmarshal llm request: %v", jsonErr)
+		return
+	}
+	userID, _ := strconv.ParseInt(req.UID, 10, 64)
+
+	promptChars := utf8.RuneCountInString(req.Parameters.AgentUserInput)
+	for _, msg := range req.History {
+		promptChars += utf8.RuneCountInString(msg.Content)
+	}
+	c.svcCtx.UsageRecorder.Record(c.ctx, usage.Call{
+		UserId:        userID,
+		CallType:      callType,
+		PromptChars:   int64(promptChars),
+		ResponseChars: int64(utf8.RuneCountInString(*reply)),
+		Latency:       time.Since(start),
+		Success:       *err == nil,
+	})
 }
 
 func (c *XingChenClient) handleInterruptEvent(apiResp *types.LLMApiResponse, stream pb.LlmCenter_ChatCompletionsServer, conversationID string) error {
@@ -239,12 +400,8 @@ func (c *XingChenClient) handleInterruptEvent(apiResp *types.LLMApiResponse, str
 	return nil
 }
 
-func (c *XingChenClient) StreamChatForEdit(reqBody []byte, stream pb.LlmCenter_EditDocumentServer, conversationID string) (string, error) {
-	resp, err := c.doStreamRequest(c.svcCtx.Config.XingChen.ApiURL, reqBody)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
+func (c *XingChenClient) StreamChatForEdit(reqBody []byte, stream pb.LlmCenter_EditDocumentServer, conversationID string) (reply string, err error) {
+	defer c.meter(usage.CallEdit, reqBody, time.Now(), &reply, &err)
 
 	handler := func(apiResp *types.LLMApiResponse) (bool, error) {
 		if len(apiResp.Choices) > 0 && apiResp.Choices[0].Delta.Content != "" {
@@ -262,17 +419,13 @@ func (c *XingChenClient) StreamChatForEdit(reqBody []byte, stream pb.LlmCenter_E
 		return false, nil
 	}
 
-	return c.processStreamResponse(resp.Body, handler)
+	return c.streamWithRetry(usage.CallEdit, provider.ChatURL, reqBody, conversationID, handler)
 }
 
 // StreamChatForResume 调用大模型通用 Chat API，但把增量结果按 ChatResumeResponse 推给客户端。
 // 注意：这里不处理 interrupt 事件（Resume 场景无需事件ID恢复）。
-func (c *XingChenClient) StreamChatForResume(reqBody []byte, stream pb.LlmCenter_ChatResumeServer, conversationID string) (string, error) {
-	resp, err := c.doStreamRequest(c.svcCtx.Config.XingChen.ApiURL, reqBody)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
+func (c *XingChenClient) StreamChatForResume(reqBody []byte, stream pb.LlmCenter_ChatResumeServer, conversationID string) (reply string, err error) {
+	defer c.meter(usage.CallResume, reqBody, time.Now(), &reply, &err)
 
 	handler := func(apiResp *types.LLMApiResponse) (bool, error) {
 		// Resume 场景：仅发送正文增量
@@ -287,5 +440,5 @@ func (c *XingChenClient) StreamChatForResume(reqBody []byte, stream pb.LlmCenter
 		return false, nil
 	}
 
-	return c.processStreamResponse(resp.Body, handler)
+	return c.streamWithRetry(usage.CallResume, provider.ChatURL, reqBody, conversationID, handler)
 }
@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AllocateDocNoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAllocateDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AllocateDocNoLogic {
+	return &AllocateDocNoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: AllocateDocNo
+// 文档须可编辑；同一文档重复分配同一代字时返回已预留的字号
+func (l *AllocateDocNoLogic) AllocateDocNo(in *pb.AllocateDocNoRequest) (*pb.AllocateDocNoResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "AllocateDocNo", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	n, err := allocateDocNo(l.ctx, l.svcCtx, "AllocateDocNo", in.UserId, in.WorkspaceId, in.Prefix, doc)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AllocateDocNoResponse{DocNo: toPbDocNumber(n)}, nil
+}
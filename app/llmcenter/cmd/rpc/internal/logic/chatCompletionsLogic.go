@@ -5,19 +5,26 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/llm"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/app/llmcenter/cmd/rpc/types"
 	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
 	"document_agent/pkg/fileprocessor"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/screening"
 	"document_agent/pkg/tool"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -39,17 +46,49 @@ func NewChatCompletionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *C
 
 // ChatCompletions 是处理聊天请求的核心 RPC 方法
 func (l *ChatCompletionsLogic) ChatCompletions(in *pb.ChatCompletionsRequest, stream pb.LlmCenter_ChatCompletionsServer) error {
+	// 用量配额校验
+	if err := checkQuota(l.ctx, l.svcCtx, in.UserId); err != nil {
+		return err
+	}
+
+	// 未指定文章类型时使用用户资料中的默认文章类型
+	if in.Documenttype == "" {
+		in.Documenttype = loadUserProfile(l.ctx, l.svcCtx, in.UserId).DefaultDocType
+		if in.Documenttype == "" {
+			return fmt.Errorf("documenttype is empty and user %d has no default: %w", in.UserId, xerr.ErrRequestParam)
+		}
+	}
+
+	// 0. 敏感词与涉密信息筛查（拦截的请求不创建会话）
+	information, err := screenText(l.ctx, l.svcCtx, screening.StageInput, "information", in.UserId, in.ConversationId, in.Information)
+	if err != nil {
+		return err
+	}
+	requests, err := screenText(l.ctx, l.svcCtx, screening.StageInput, "requests", in.UserId, in.ConversationId, in.Requests)
+	if err != nil {
+		return err
+	}
+	// 之后的会话标题与历史数据同样使用脱敏后的内容
+	in.Information, in.Requests = information, requests
+
 	// 1. 获取或创建会话（使用 information 来生成标题）
-	conversationID, historyMessages, err := l.getOrCreateConversation(in.UserId, in.ConversationId, in.Information)
+	conversationID, historyMessages, err := l.getOrCreateConversation(in.UserId, in.WorkspaceId, in.ConversationId, in.Information)
 	if err != nil {
 		return err
 	}
+	tracing.SetConversation(l.ctx, conversationID, in.Documenttype)
 
 	// 2. 构造最终的 prompt
-	basePrompt := fmt.Sprintf("%s请写一篇%s，基本信息：%s", l.svcCtx.Config.XingChen.FlagCode1, in.Documenttype, in.Information)
-
-	// 3. 处理文件引用，并增强 prompt（传入 basePrompt）
-	finalPrompt, imgURL, err := l.processReferences(basePrompt, in.References)
+	basePrompt := fmt.Sprintf("%s请写一篇%s，基本信息：%s", l.svcCtx.Config.XingChen.FlagCode1, in.Documenttype, information)
+
+	// 3. 处理文件引用，并增强 prompt（传入 basePrompt）；引用文本中的个人信息先替换为占位符
+	// 无权读取的引用文件直接跳过
+	redactor := screening.NewRedactor(l.svcCtx.Config.Redaction)
+	references := accessibleReferences(l.ctx, l.svcCtx, in.UserId, in.References)
+	finalPrompt, imgURL, err := l.processReferences(in.UserId, conversationID, basePrompt, references, redactor)
+	if errors.Is(err, xerr.ErrContentBlocked) {
+		return err
+	}
 	if err != nil {
 		l.Errorf("processReferences failed: %v. proceeding with original prompt.", err)
 		finalPrompt = basePrompt
@@ -62,7 +101,7 @@ func (l *ChatCompletionsLogic) ChatCompletions(in *pb.ChatCompletionsRequest, st
 	}
 
 	// 5. 构建大模型请求
-	llmReq := l.buildLLMRequest(in.UserId, conversationID, finalPrompt, historyMessages, imgURL)
+	llmReq := l.buildLLMRequest(in.UserId, conversationID, finalPrompt, historyMessages, imgURL, redactor)
 	reqBody, err := json.Marshal(llmReq)
 	if err != nil {
 		l.Errorf("failed to marshal llm request: %v :%w", err, xerr.ErrRequestParam)
@@ -70,7 +109,7 @@ func (l *ChatCompletionsLogic) ChatCompletions(in *pb.ChatCompletionsRequest, st
 	}
 
 	// 6. 发起大模型推理
-	xingchenClient := llm.NewXingChenClient(l.ctx, l.svcCtx)
+	xingchenClient := llm.NewXingChenClient(l.ctx, l.svcCtx).WithRedactor(redactor)
 	assistantReply, err := xingchenClient.StreamChat(reqBody, stream, conversationID)
 	if err != nil {
 		return err
@@ -81,12 +120,25 @@ func (l *ChatCompletionsLogic) ChatCompletions(in *pb.ChatCompletionsRequest, st
 		return l.sendEndEvent(stream, conversationID, "")
 	}
 
+	// 8. 记录审计日志（生成内容的摘要）
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionGenerate,
+		ConversationId: conversationID,
+		TargetId:       userMessageID,
+		HashAfter:      audit.Hash(assistantReply),
+		Detail:         in.Documenttype,
+	})
+
 	// 9. 发送结束事件
 	return l.sendEndEvent(stream, conversationID, userMessageID)
 }
 
 // processReferences 处理文件引用，增强 prompt
-func (l *ChatCompletionsLogic) processReferences(prompt string, references []*pb.Reference) (string, string, error) {
+func (l *ChatCompletionsLogic) processReferences(userID int64, conversationID, prompt string, references []*pb.Reference, redactor *screening.Redactor) (string, string, error) {
+	ctx, span := tracing.Start(l.ctx, "references", tracing.AttrReferenceCount.Int(len(references)))
+	defer span.End()
+
 	var imgURL string
 	var fileContents []string
 	reImg := regexp.MustCompile(`(?i)\.(jpg|jpeg|png)$`)
@@ -99,7 +151,7 @@ func (l *ChatCompletionsLogic) processReferences(prompt string, references []*pb
 
 			if reImg.MatchString(ref.FileId) {
 				// —— 新逻辑：本地 OCR —— //
-				text, err := l.ocrImage(localPath, "chi_sim+eng")
+				text, err := l.ocrImage(ctx, localPath, "chi_sim+eng")
 				if err != nil {
 					l.Errorf("图片OCR失败：file_id=%s err=%v", ref.FileId, err)
 					continue
@@ -113,6 +165,11 @@ func (l *ChatCompletionsLogic) processReferences(prompt string, references []*pb
 					runes := []rune(text)
 					text = string(runes[:3000]) + "...(OCR内容已截断)"
 				}
+				text = redactor.Redact(text)
+				text, err = screenText(l.ctx, l.svcCtx, screening.StageReference, ref.FileId, userID, conversationID, text)
+				if err != nil {
+					return "", "", err
+				}
 				fileContents = append(fileContents, fmt.Sprintf("一张图片（%s）识别到的文字：\n%s", ext, text))
 			} else if reDoc.MatchString(ref.FileId) {
 				var content string
@@ -138,6 +195,11 @@ func (l *ChatCompletionsLogic) processReferences(prompt string, references []*pb
 				if len(content) > 5000 {
 					content = content[:5000] + "...(已截断)"
 				}
+				content = redactor.Redact(content)
+				content, err = screenText(l.ctx, l.svcCtx, screening.StageReference, ref.FileId, userID, conversationID, content)
+				if err != nil {
+					return "", "", err
+				}
 				fileContents = append(fileContents, fmt.Sprintf("一份%s文件内容如下：\n%s", ext, content))
 			}
 		} /*else if ref.Type == "formworkfile" {
@@ -175,6 +237,9 @@ func (l *ChatCompletionsLogic) processReferences(prompt string, references []*pb
 	if len(fileContents) > 0 {
 		prompt += "\n\n用户提供了以下文件内容作为参考：\n" + strings.Join(fileContents, "\n\n")
 	}
+	if redactor.Len() > 0 {
+		l.Infof("processReferences redacted %d personal info items, conversationId:%s", redactor.Len(), conversationID)
+	}
 	//if len(formworkfilefileContents) > 0 {
 	//	prompt += "\n\n模板文件：\n" + strings.Join(formworkfilefileContents, "\n\n")
 	//}
@@ -207,14 +272,21 @@ func (l *ChatCompletionsLogic) saveToHistoryDatas(conversationID string, in *pb.
 	return messageID, nil
 }
 
-// getOrCreateConversation 	获取或创建会话，并获取历史消息
-func (l *ChatCompletionsLogic) getOrCreateConversation(userID int64, convID, prompt string) (string, []*pb.Message, error) { // 返回的 Message 应该是数据库模型
+// getOrCreateConversation 	获取或创建会话，并获取历史消息。在团队空间中新建或继续会话需要编辑权限
+func (l *ChatCompletionsLogic) getOrCreateConversation(userID, workspaceID int64, convID, prompt string) (string, []*pb.Message, error) { // 返回的 Message 应该是数据库模型
 	if convID == "" {
+		if workspaceID != workspace.Personal {
+			if err := checkWorkspaceAccess(l.ctx, l.svcCtx, userID, workspaceID, workspace.Write); err != nil {
+				return "", nil, err
+			}
+		}
+
 		// 创建新会话
 		newConvID := tool.GenerateULID()
 		newConversation := &model.Conversations{
 			ConversationId: newConvID,
 			UserId:         userID,
+			WorkspaceId:    workspaceID,
 			Title:          l.generateTitle(prompt), // 使用 prompt 生成一个初始标题
 		}
 
@@ -227,18 +299,15 @@ func (l *ChatCompletionsLogic) getOrCreateConversation(userID int64, convID, pro
 	}
 
 	// 如果提供了 convID，则尝试从数据库获取会话
-	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, convID)
-	if err != nil {
-		return "", nil, fmt.Errorf("getOrCreateConversation db conversation FindOne err:%+v, conversationId:%s: %w", err, convID, xerr.ErrConversationNotFound)
-	}
-	if conversation.UserId != userID {
-		return "", nil, fmt.Errorf("getOrCreateConversation 该用户id无法访问此会话 userId:%d, conversationId:%s: %w", userID, convID, xerr.ErrConversationAccessDenied)
+	if _, err := findAccessibleConversation(l.ctx, l.svcCtx, "getOrCreateConversation", userID, convID, workspace.Write); err != nil {
+		return "", nil, err
 	}
 
 	// 从数据库获取该会话的历史消息
 	getConversationDetailLogic := NewGetConversationDetailLogic(l.ctx, l.svcCtx)
 	GetConversationDetailResponse, err := getConversationDetailLogic.GetConversationDetail(&pb.GetConversationDetailRequest{
 		ConversationId: convID,
+		UserId:         userID,
 	})
 	if err != nil {
 		return "", nil, fmt.Errorf("getOrCreateConversation db message FindAllByConversationID err:%+v, conversationId:%s: %w", err, convID, xerr.ErrMessageNotFound)
@@ -246,22 +315,17 @@ func (l *ChatCompletionsLogic) getOrCreateConversation(userID int64, convID, pro
 	return convID, GetConversationDetailResponse.GetHistory(), nil
 }
 
-// buildLLMRequest 构建发送给星火大模型 API 的请求体
-func (l *ChatCompletionsLogic) buildLLMRequest(userID int64, convID, prompt string, history []*pb.Message, imgUrl string) types.LLMApiRequest {
+// buildLLMRequest 构建发送给星火大模型 API 的请求体，历史消息与引用文本共用同一脱敏映射
+func (l *ChatCompletionsLogic) buildLLMRequest(userID int64, convID, prompt string, history []*pb.Message, imgUrl string, redactor *screening.Redactor) types.LLMApiRequest {
 
 	// 只取最近的10条历史消息，history是按照时间顺序排列的，所以最新的消息在最后
 	start := 0
 	if len(history) > 10 {
 		start = len(history) - 10
 	}
-	apiHistory := make([]types.LLMMessage, 0, len(history)-start)
-	for _, msg := range history[start:] {
-
-		apiHistory = append(apiHistory, types.LLMMessage{
-			Role:        msg.Role,
-			ContentType: "text",
-			Content:     msg.Content,
-		})
+	apiHistory := redactHistory(redactor, history[start:])
+	if redactor.Len() > 0 {
+		prompt += redactionHint
 	}
 
 	flowID := l.svcCtx.Config.XingChen.FlowID
@@ -304,23 +368,30 @@ func (l *ChatCompletionsLogic) generateTitle(prompt string) string {
 
 // ocrImage 使用 Tesseract 对图片进行 OCR，返回识别到的文本。
 // lang 语言建议 "chi_sim+eng"（有英文数字时更稳），也可仅 "chi_sim"。
-func (l *ChatCompletionsLogic) ocrImage(imagePath string, lang string) (string, error) {
+func (l *ChatCompletionsLogic) ocrImage(ctx context.Context, imagePath string, lang string) (text string, err error) {
 	if lang == "" {
 		lang = "chi_sim+eng"
 	}
+	ctx, span := tracing.Start(ctx, "ocr")
+	defer func() { tracing.End(span, err) }()
+
 	// 将识别结果输出到 stdout，避免中间文件：tesseract <image> stdout -l <lang> --psm 3
 	// 你也可以把 --psm 换为 6/7/11 等场景更合适的模式。
-	cmd := exec.CommandContext(l.ctx, "tesseract", imagePath, "stdout", "-l", lang, "--psm", "3")
+	cmd := exec.CommandContext(ctx, "tesseract", imagePath, "stdout", "-l", lang, "--psm", "3")
 	var out bytes.Buffer
 	var stderr bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = &stderr
+	cmd.Env = tracing.CommandEnv(ctx)
 
-	if err := cmd.Run(); err != nil {
+	start := time.Now()
+	err = cmd.Run()
+	metrics.ObserveOCR(start, err)
+	if err != nil {
 		l.Errorf("tesseract ocr failed, stderr: %s", stderr.String())
 		return "", err
 	}
-	text := strings.TrimSpace(out.String())
+	text = strings.TrimSpace(out.String())
 	// 简单清洗
 	text = regexp.MustCompile(`[ \t\r\f]+`).ReplaceAllString(text, " ")
 	return text, nil
@@ -4,21 +4,27 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/llm"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/app/llmcenter/cmd/rpc/types"
-	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
 	"document_agent/pkg/tool"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"document_agent/pkg/fileprocessor"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/screening"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -42,15 +48,29 @@ func (l *ChatResumeLogic) ChatResume(in *pb.ChatResumeRequest, stream pb.LlmCent
 	if err := l.validateConversation(in.UserId, in.ConversationId); err != nil {
 		return err
 	}
+	if err := checkQuota(l.ctx, l.svcCtx, in.UserId); err != nil {
+		return err
+	}
+
+	// 未指定文章类型时使用用户资料中的默认文章类型
+	if in.Documenttype == "" {
+		in.Documenttype = loadUserProfile(l.ctx, l.svcCtx, in.UserId).DefaultDocType
+	}
+	tracing.SetConversation(l.ctx, in.ConversationId, in.Documenttype)
 
 	// 2) 取该会话最近的历史消息（与 ChatCompletions 一致）
-	history, err := l.getRecentHistory(in.ConversationId)
+	history, err := l.getRecentHistory(in.UserId, in.ConversationId)
 	if err != nil {
 		return err
 	}
 
-	// 3) 构建大模型请求体（沿用与 ChatCompletions 一致的 StreamChat 请求结构）
-	llmReq := l.buildLLMRequest(in.UserId, in.ConversationId, in.Documenttype, in.Content, history, in.References)
+	// 3) 构建大模型请求体（沿用与 ChatCompletions 一致的 StreamChat 请求结构），无权读取的引用文件直接跳过
+	redactor := screening.NewRedactor(l.svcCtx.Config.Redaction)
+	references := accessibleReferences(l.ctx, l.svcCtx, in.UserId, in.References)
+	llmReq, err := l.buildLLMRequest(in.UserId, in.ConversationId, in.Documenttype, in.Content, history, references, redactor)
+	if err != nil {
+		return err
+	}
 
 	reqBody, err := json.Marshal(llmReq)
 	if err != nil {
@@ -59,7 +79,8 @@ func (l *ChatResumeLogic) ChatResume(in *pb.ChatResumeRequest, stream pb.LlmCent
 	}
 
 	// 4) 直接调用大模型 StreamChat（不再使用 Resume API）
-	xingchenClient := llm.NewXingChenClient(l.ctx, l.svcCtx)
+	// 输出中出现的脱敏占位符会在流式输出与最终文档中还原为原文
+	xingchenClient := llm.NewXingChenClient(l.ctx, l.svcCtx).WithRedactor(redactor)
 	assistantReply, err := xingchenClient.StreamChatForResume(reqBody, stream, in.ConversationId)
 
 	if err != nil {
@@ -72,36 +93,47 @@ func (l *ChatResumeLogic) ChatResume(in *pb.ChatResumeRequest, stream pb.LlmCent
 	}
 
 	// 5) 将最终生成的完整内容保存到 documents（复用你原有的保存逻辑）
-	assistantMessageID, err := l.saveFinalDocument(in.ConversationId, assistantReply)
+	providerName, model := xingchenClient.Served()
+	assistantMessageID, err := l.saveFinalDocument(in.ConversationId, assistantReply, providerName, model)
 	if err != nil {
 		// 记录错误，但不中断结束事件
 		l.Errorf("saveFinalDocument failed: %v", err)
 	}
 
-	// 6) 发送结束事件（带 message_id）
+	// 记录审计日志：操作前为用户确认的大纲，操作后为最终文档
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionResume,
+		ConversationId: in.ConversationId,
+		TargetId:       assistantMessageID,
+		HashBefore:     audit.Hash(in.Content),
+		HashAfter:      audit.Hash(assistantReply),
+		Detail:         in.Documenttype,
+	})
+
+	// 6) 可选：对生成结果进行公文格式检查，结果以 check 事件推送
+	if in.FormatCheck {
+		if err := l.sendCheckEvent(stream, assistantMessageID, assistantReply); err != nil {
+			return err
+		}
+	}
+
+	// 7) 发送结束事件（带 message_id）
 	return l.sendEndEvent(stream, in.ConversationId, assistantMessageID)
 }
 
-// validateConversation 验证会话是否存在且属于该用户
+// validateConversation 验证会话是否存在，且用户为会话创建者或团队空间中具备编辑权限的成员
 func (l *ChatResumeLogic) validateConversation(userID int64, convID string) error {
-	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, convID)
-	if err != nil {
-		if err == model.ErrNotFound {
-			return fmt.Errorf("validateConversation db conversation FindOne not found err:%+v, conversationId:%s: %w", err, convID, xerr.ErrConversationNotFound)
-		}
-		return fmt.Errorf("validateConversation db conversation FindOne err:%+v, conversationId:%s: %w", err, convID, xerr.ErrDbError)
-	}
-	if conversation.UserId != userID {
-		return fmt.Errorf("validateConversation user %d is not allowed to access conversation %s: %w", userID, convID, xerr.ErrConversationAccessDenied)
-	}
-	return nil
+	_, err := findAccessibleConversation(l.ctx, l.svcCtx, "validateConversation", userID, convID, workspace.Write)
+	return err
 }
 
 // getRecentHistory 拉取会话历史并仅保留最近 10 条（与 ChatCompletions 的做法一致）
-func (l *ChatResumeLogic) getRecentHistory(convID string) ([]*pb.Message, error) {
+func (l *ChatResumeLogic) getRecentHistory(userID int64, convID string) ([]*pb.Message, error) {
 	getConversationDetailLogic := NewGetConversationDetailLogic(l.ctx, l.svcCtx)
 	resp, err := getConversationDetailLogic.GetConversationDetail(&pb.GetConversationDetailRequest{
 		ConversationId: convID,
+		UserId:         userID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("getRecentHistory db message FindAllByConversationID err:%+v, conversationId:%s: %w", err, convID, xerr.ErrMessageNotFound)
@@ -116,12 +148,21 @@ func (l *ChatResumeLogic) getRecentHistory(convID string) ([]*pb.Message, error)
 }
 
 // buildLLMRequest 与 ChatCompletions 的组装逻辑保持一致（不含图片）
-func (l *ChatResumeLogic) buildLLMRequest(userID int64, convID string, documentType string, prompt string, history []*pb.Message, references []*pb.Reference) types.LLMApiRequest {
+func (l *ChatResumeLogic) buildLLMRequest(userID int64, convID string, documentType string, prompt string, history []*pb.Message, references []*pb.Reference, redactor *screening.Redactor) (types.LLMApiRequest, error) {
+	// 敏感词与涉密信息筛查
+	prompt, err := screenText(l.ctx, l.svcCtx, screening.StageInput, "content", userID, convID, prompt)
+	if err != nil {
+		return types.LLMApiRequest{}, err
+	}
+
 	// 先拼接 documenttype
 	basePrompt := fmt.Sprintf("请根据用户给的内容清单中的内容生成一篇%s", documentType)
 
 	// 处理文件引用（图片OCR + 文本）
-	enrichedPrompt, err := l.enrichPromptWithReferences(basePrompt, references)
+	enrichedPrompt, err := l.enrichPromptWithReferences(userID, convID, basePrompt, references, redactor)
+	if errors.Is(err, xerr.ErrContentBlocked) {
+		return types.LLMApiRequest{}, err
+	}
 	if err != nil {
 		l.Errorf("enrichPromptWithReferences failed: %v", err)
 		enrichedPrompt = basePrompt
@@ -140,13 +181,10 @@ func (l *ChatResumeLogic) buildLLMRequest(userID int64, convID string, documentT
 		history = history[len(history)-10:]
 	}
 
-	apiHistory := make([]types.LLMMessage, 0, len(history))
-	for _, msg := range history {
-		apiHistory = append(apiHistory, types.LLMMessage{
-			Role:        msg.Role,
-			ContentType: "text",
-			Content:     msg.Content,
-		})
+	// 历史消息与引用文本共用同一脱敏映射
+	apiHistory := redactHistory(redactor, history)
+	if redactor.Len() > 0 {
+		enrichedPrompt += redactionHint
 	}
 
 	return types.LLMApiRequest{
@@ -159,16 +197,16 @@ func (l *ChatResumeLogic) buildLLMRequest(userID int64, convID string, documentT
 		Stream:  true,
 		ChatID:  convID,
 		History: apiHistory,
-	}
+	}, nil
 }
 
-// saveFinalDocument 保存最终生成的完整文章
-func (l *ChatResumeLogic) saveFinalDocument(conversationID, content string) (string, error) {
+// saveFinalDocument 保存最终生成的完整文章，并记录生成它的提供方与模型
+func (l *ChatResumeLogic) saveFinalDocument(conversationID, content, providerName, model string) (string, error) {
 	if content == "" {
 		return "", nil
 	}
 	documentID := tool.GenerateULID()
-	if err := l.svcCtx.DocumentsModel.InsertDocument(l.ctx, documentID, conversationID, content); err != nil {
+	if err := l.svcCtx.DocumentsModel.InsertDocument(l.ctx, documentID, conversationID, content, providerName, model); err != nil {
 		return "", fmt.Errorf("saveFinalDocument db Insert to documents err:%+v: %w", err, xerr.ErrDbError)
 	}
 	return documentID, nil
@@ -186,11 +224,22 @@ func (l *ChatResumeLogic) sendEndEvent(stream pb.LlmCenter_ChatResumeServer, con
 	return nil
 }
 
+// sendCheckEvent 向客户端发送公文格式检查结果
+func (l *ChatResumeLogic) sendCheckEvent(stream pb.LlmCenter_ChatResumeServer, messageID, content string) error {
+	checkEvent := &pb.SSECheckEvent{Result: buildCheckResponse(messageID, content)}
+	if err := stream.Send(&pb.ChatResumeResponse{Event: &pb.ChatResumeResponse_Check{Check: checkEvent}}); err != nil {
+		return fmt.Errorf("failed to send check event to client: %v:%w", err, xerr.ErrLLMApiCancel)
+	}
+	return nil
+}
+
 // enrichPromptWithReferences 将文件引用（图片OCR + 文本文件读取）拼进提示词
-func (l *ChatResumeLogic) enrichPromptWithReferences(basePrompt string, references []*pb.Reference) (string, error) {
+func (l *ChatResumeLogic) enrichPromptWithReferences(userID int64, convID, basePrompt string, references []*pb.Reference, redactor *screening.Redactor) (string, error) {
 	if len(references) == 0 {
 		return basePrompt, nil
 	}
+	ctx, span := tracing.Start(l.ctx, "references", tracing.AttrReferenceCount.Int(len(references)))
+	defer span.End()
 
 	var fileContents []string
 	reImg := regexp.MustCompile(`(?i)\.(jpg|jpeg|png)$`)
@@ -205,7 +254,7 @@ func (l *ChatResumeLogic) enrichPromptWithReferences(basePrompt string, referenc
 
 		switch {
 		case reImg.MatchString(ref.FileId):
-			text, err := l.ocrImage(localPath, "chi_sim+eng")
+			text, err := l.ocrImage(ctx, localPath, "chi_sim+eng")
 			if err != nil || strings.TrimSpace(text) == "" {
 				l.Errorf("ChatResume OCR失败 file_id=%s err=%v", ref.FileId, err)
 				continue
@@ -215,6 +264,11 @@ func (l *ChatResumeLogic) enrichPromptWithReferences(basePrompt string, referenc
 			if len(runes) > 3000 {
 				text = string(runes[:3000]) + "...(OCR内容已截断)"
 			}
+			text = redactor.Redact(text)
+			text, err = screenText(l.ctx, l.svcCtx, screening.StageReference, ref.FileId, userID, convID, text)
+			if err != nil {
+				return "", err
+			}
 			fileContents = append(fileContents, fmt.Sprintf("一张图片（%s）识别到的文字：\n%s", ext, text))
 
 		case reDoc.MatchString(ref.FileId):
@@ -241,6 +295,11 @@ func (l *ChatResumeLogic) enrichPromptWithReferences(basePrompt string, referenc
 			if len(content) > 5000 {
 				content = content[:5000] + "...(已截断)"
 			}
+			content = redactor.Redact(content)
+			content, err = screenText(l.ctx, l.svcCtx, screening.StageReference, ref.FileId, userID, convID, content)
+			if err != nil {
+				return "", err
+			}
 			fileContents = append(fileContents, fmt.Sprintf("一份%s文件内容如下：\n%s", ext, content))
 		}
 	}
@@ -248,24 +307,34 @@ func (l *ChatResumeLogic) enrichPromptWithReferences(basePrompt string, referenc
 	if len(fileContents) > 0 {
 		basePrompt += "\n\n参考用户给的实例公文的风格和格式，实例公文的内容如下：\n" + strings.Join(fileContents, "\n\n")
 	}
+	if redactor.Len() > 0 {
+		l.Infof("enrichPromptWithReferences redacted %d personal info items, conversationId:%s", redactor.Len(), convID)
+	}
 	return basePrompt, nil
 }
 
 // ocrImage 使用 Tesseract 对图片进行 OCR，返回识别到的文本
-func (l *ChatResumeLogic) ocrImage(imagePath, lang string) (string, error) {
+func (l *ChatResumeLogic) ocrImage(ctx context.Context, imagePath, lang string) (text string, err error) {
 	if lang == "" {
 		lang = "chi_sim+eng"
 	}
-	cmd := exec.CommandContext(l.ctx, "tesseract", imagePath, "stdout", "-l", lang, "--psm", "3")
+	ctx, span := tracing.Start(ctx, "ocr")
+	defer func() { tracing.End(span, err) }()
+
+	cmd := exec.CommandContext(ctx, "tesseract", imagePath, "stdout", "-l", lang, "--psm", "3")
 	var out bytes.Buffer
 	var stderr bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
+	cmd.Env = tracing.CommandEnv(ctx)
+	start := time.Now()
+	err = cmd.Run()
+	metrics.ObserveOCR(start, err)
+	if err != nil {
 		l.Errorf("tesseract ocr failed, stderr: %s", stderr.String())
 		return "", err
 	}
-	text := strings.TrimSpace(out.String())
+	text = strings.TrimSpace(out.String())
 	// 简单清洗：压缩多空白
 	text = regexp.MustCompile(`[ \t\r\f]+`).ReplaceAllString(text, " ")
 	return text, nil
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/gongwen"
+	"document_agent/pkg/workspace"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckDocumentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckDocumentLogic {
+	return &CheckDocumentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: CheckDocument
+func (l *CheckDocumentLogic) CheckDocument(in *pb.CheckDocumentRequest) (*pb.CheckDocumentResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "CheckDocument", in.UserId, in.ConversationId, in.MessageId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCheckResponse(doc.MessageId, doc.Content), nil
+}
+
+// buildCheckResponse 对文档内容执行公文格式检查并转换为 pb 结构
+func buildCheckResponse(messageID, content string) *pb.CheckDocumentResponse {
+	findings := gongwen.Check(content)
+	counts := gongwen.CountBySeverity(findings)
+
+	resp := &pb.CheckDocumentResponse{
+		MessageId:    messageID,
+		Passed:       counts[gongwen.SeverityError] == 0,
+		ErrorCount:   int64(counts[gongwen.SeverityError]),
+		WarningCount: int64(counts[gongwen.SeverityWarning]),
+		InfoCount:    int64(counts[gongwen.SeverityInfo]),
+		Findings:     make([]*pb.FormatFinding, 0, len(findings)),
+	}
+	for _, f := range findings {
+		resp.Findings = append(resp.Findings, &pb.FormatFinding{
+			Rule:       f.Rule,
+			Severity:   f.Severity,
+			Line:       int64(f.Line),
+			Column:     int64(f.Column),
+			Excerpt:    f.Excerpt,
+			Message:    f.Message,
+			Suggestion: f.Suggestion,
+		})
+	}
+	return resp
+}
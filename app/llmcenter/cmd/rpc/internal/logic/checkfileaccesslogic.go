@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckFileAccessLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckFileAccessLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckFileAccessLogic {
+	return &CheckFileAccessLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckFileAccess 校验用户是否可以读取上传的文件。未登记的文件（如导出文件）返回 registered=false，由调用方决定是否放行；
+// owner_only 时文件须已登记且由该用户上传
+func (l *CheckFileAccessLogic) CheckFileAccess(in *pb.CheckFileAccessRequest) (*pb.CheckFileAccessResponse, error) {
+	file, err := l.svcCtx.FilesModel.FindByStoredName(l.ctx, in.FileId)
+	if err == model.ErrNotFound {
+		if in.OwnerOnly {
+			return nil, fmt.Errorf("CheckFileAccess fileId:%s is not registered: %w", in.FileId, xerr.ErrFileNotFound)
+		}
+		return &pb.CheckFileAccessResponse{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CheckFileAccess FindByStoredName err:%+v, fileId:%s: %w", err, in.FileId, xerr.ErrDbError)
+	}
+
+	if in.OwnerOnly {
+		if file.UserId != in.UserId {
+			return nil, fmt.Errorf("CheckFileAccess fileId:%s uploaded by %d, not %d: %w", in.FileId, file.UserId, in.UserId, xerr.ErrPermissionDenied)
+		}
+		return &pb.CheckFileAccessResponse{Registered: true}, nil
+	}
+
+	if err := checkFileAccess(l.ctx, l.svcCtx, in.UserId, file); err != nil {
+		return nil, err
+	}
+	return &pb.CheckFileAccessResponse{
+		Registered: true,
+	}, nil
+}
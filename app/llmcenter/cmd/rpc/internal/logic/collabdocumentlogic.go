@@ -0,0 +1,130 @@
+package logic
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CollabDocumentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCollabDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CollabDocumentLogic {
+	return &CollabDocumentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: CollabDocument
+// 首条消息须为 join，之后接收编辑操作与光标；会话推送的消息只在本协程发送，接收在单独的协程中进行
+func (l *CollabDocumentLogic) CollabDocument(stream pb.LlmCenter_CollabDocumentServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := req.GetJoin()
+	if join == nil || join.MessageId == "" {
+		return fmt.Errorf("CollabDocument first message must be join: %w", xerr.ErrRequestParam)
+	}
+
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "CollabDocument", join.UserId, join.ConversationId, join.MessageId, workspace.Read)
+	if err != nil {
+		return err
+	}
+	user, err := l.collabUser(join.UserId, doc)
+	if err != nil {
+		return err
+	}
+	client, err := l.svcCtx.Collab.Join(l.ctx, doc, user)
+	if err != nil {
+		return fmt.Errorf("CollabDocument join err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+	defer l.svcCtx.Collab.Leave(client)
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == nil {
+				err = l.handle(client, req)
+			}
+			if err != nil {
+				recvErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case ev := <-client.Events():
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-client.Done():
+			return client.Err()
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// collabUser 读取昵称作为光标旁显示的名称，并按编辑权限决定能否修改；用户中心不可用时使用默认名称
+func (l *CollabDocumentLogic) collabUser(userID int64, doc *model.Documents) (collab.User, error) {
+	u := collab.User{ID: userID, CanWrite: true}
+	resp, err := l.svcCtx.UsercenterRpc.GetUserInfo(l.ctx, &usercenter.GetUserInfoReq{Id: userID})
+	if err != nil || resp.User == nil {
+		l.Errorf("CollabDocument GetUserInfo err:%v, userId:%d", err, userID)
+	} else {
+		u.Name = resp.User.Nickname
+	}
+	u.Name = cmp.Or(u.Name, fmt.Sprintf("用户%d", userID))
+
+	_, err = findAccessibleConversation(l.ctx, l.svcCtx, "CollabDocument", userID, doc.ConversationId, workspace.Write)
+	switch {
+	case err == nil:
+	case errors.Is(err, xerr.ErrWorkspaceAccessDenied) || errors.Is(err, xerr.ErrConversationAccessDenied):
+		u.CanWrite = false
+	default:
+		return u, err
+	}
+	return u, nil
+}
+
+func (l *CollabDocumentLogic) handle(client *collab.Client, req *pb.CollabRequest) error {
+	switch ev := req.Event.(type) {
+	case *pb.CollabRequest_Operation:
+		op := ev.Operation
+		if op == nil {
+			return fmt.Errorf("CollabDocument empty operation: %w", xerr.ErrRequestParam)
+		}
+		return client.Submit(op.Revision, op.Ops, op.Selection)
+	case *pb.CollabRequest_Selection:
+		if ev.Selection != nil {
+			client.Select(ev.Selection)
+		}
+		return nil
+	default:
+		return fmt.Errorf("CollabDocument unexpected message %T: %w", req.Event, xerr.ErrRequestParam)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
 	"document_agent/pkg/tool"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -45,13 +46,14 @@ func (l *ConvertMarkdownLinkLogic) ConvertMarkdownLink(in *pb.ConvertMarkdownLin
 
 	md = applyLineAlignments(md)
 
-	title := "某某县人民政府文件"
-	docNo := "某政【2025】1号"
+	// 工作流调用没有用户身份，使用系统默认的发文机关标志；没有分配发文字号，不输出文号
+	title := defaultHeaderTitle
+	docNo := ""
 
-	md = decorateGovHeaderAndBody(md, t, title, docNo)
+	md = decorateGovHeaderAndBody(md, t, title, docNo, "")
 
 	// 2) 通过 Pandoc 生成目标格式
-	data, err := runPandoc(l.ctx, md, t, l.svcCtx.Config.Font.Path, l.svcCtx.Config.LuaFilters.Align, l.svcCtx.Config.LuaFilters.Gov, title, docNo)
+	data, err := runPandoc(l.ctx, md, t, l.svcCtx.Config.Font.Path, l.svcCtx.Config.LuaFilters.Align, l.svcCtx.Config.LuaFilters.Gov, title, docNo, "")
 	if err != nil {
 		return nil, fmt.Errorf("渲染失败: %w", err)
 	}
@@ -68,6 +70,15 @@ func (l *ConvertMarkdownLinkLogic) ConvertMarkdownLink(in *pb.ConvertMarkdownLin
 		return nil, fmt.Errorf("写文件失败: %w", err)
 	}
 
+	// 工作流调用没有用户身份，目标记为落盘文件名，便于与后续的公开下载记录关联
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		Action:     audit.ActionExport,
+		TargetId:   name,
+		HashBefore: audit.Hash(in.Markdown),
+		HashAfter:  audit.HashBytes(data),
+		Detail:     "type=" + t + ",source=workflow",
+	})
+
 	// 4) 生成签名直链
 	expire := l.svcCtx.Config.Download.ExpireSeconds
 	if expire <= 0 {
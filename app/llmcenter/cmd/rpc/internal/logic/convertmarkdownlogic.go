@@ -2,17 +2,24 @@ package logic
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"html"
 	"os"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -32,54 +39,131 @@ func (l *ConvertMarkdownLogic) ConvertMarkdown(in *pb.ConvertMarkdownRequest) (*
 		return nil, fmt.Errorf("type 仅支持 pdf 或 docx")
 	}
 
-	// 1) 预处理 Markdown// 1) 预处理
-	md := preprocessMarkdown(in.Markdown)
-
-	// 2) 应用“首行居中、末两行右对齐”
-	md = applyLineAlignments(md)
-
-	title, docNo := pickTitleDocNo(in.GetInformation())
-
-	if title == "" {
-		title = "某某县人民政府文件" // 默认值
-	}
-	if docNo == "" {
-		docNo = "某政【2025】1号" // 默认值
+	// 指定了所属会话时校验查看权限，避免审计记录关联到无权访问的会话
+	if in.ConversationId != "" {
+		if _, err := findAccessibleConversation(l.ctx, l.svcCtx, "ConvertMarkdown", in.UserId, in.ConversationId, workspace.Read); err != nil {
+			return nil, err
+		}
 	}
 
-	md = decorateGovHeaderAndBody(md, t, title, docNo)
+	// 指定了发文机关代字时从文档所属团队的发文字号登记中为文档分配字号，优先于请求中的 DocNo
+	info := in.GetInformation()
+	var docNo string
+	if in.DocNoPrefix != "" {
+		if in.ConversationId == "" || in.MessageId == "" {
+			return nil, fmt.Errorf("ConvertMarkdown doc no requires conversation_id and message_id: %w", xerr.ErrRequestParam)
+		}
+		doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "ConvertMarkdown", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+		if err != nil {
+			return nil, err
+		}
+		n, err := allocateDocNo(l.ctx, l.svcCtx, "ConvertMarkdown", in.UserId, in.DocNoWorkspaceId, in.DocNoPrefix, doc)
+		if err != nil {
+			return nil, err
+		}
+		docNo = n.DocNo
+		info = append(slices.Clone(info), &pb.InfoItem{Type: "DocNo", Contant: docNo})
+	}
 
-	// 3) 运行 pandoc
+	// 按公文格式导出，版头依次使用请求中的 Title/DocNo、文档登记的发文字号、用户资料中的版头与系统默认值；
+	// 导出已审批的文档时在版头标注审批状态与签署人
 	outName := "export." + t
-	contentType := map[string]string{
-		"pdf":  "application/pdf",
-		"docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
-	}[t]
-
-	data, err := runPandoc(
-		l.ctx,
-		md,
-		t,
-		l.svcCtx.Config.Font.Path,
-		l.svcCtx.Config.LuaFilters.Align,
-		l.svcCtx.Config.LuaFilters.Gov,
-		title, // ✅ 传入
-		docNo, // ✅ 传入
-	)
-
+	data, err := exportDocument(l.ctx, l.svcCtx, in.UserId, in.ConversationId, in.MessageId, in.Markdown, t, info)
 	if err != nil {
 		return nil, err
 	}
 
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionExport,
+		ConversationId: in.ConversationId,
+		TargetId:       in.MessageId,
+		HashBefore:     audit.Hash(in.Markdown),
+		HashAfter:      audit.HashBytes(data),
+		Detail:         "type=" + t,
+	})
+
 	return &pb.ConvertMarkdownResponse{
 		Filename:    outName,
-		ContentType: contentType,
+		ContentType: exportContentTypes[t],
 		Data:        data,
+		DocNo:       docNo,
 	}, nil
 }
 
-// 根据输出类型，拼接“红字抬头 + 文号 + 红线”，并把正文首/末行对齐
-func decorateGovHeaderAndBody(src, typ, title, docNo string) string {
+// exportContentTypes 导出格式对应的 Content-Type
+var exportContentTypes = map[string]string{
+	"pdf":  "application/pdf",
+	"docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+}
+
+// exportDocument 按公文格式将 Markdown 导出为 pdf 或 docx，版头与审批标注见 prepareExport
+func exportDocument(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, conversationID, messageID, markdown, typ string, info []*pb.InfoItem) ([]byte, error) {
+	return prepareExport(ctx, svcCtx, userID, conversationID, messageID, markdown, typ, info).run(ctx, svcCtx)
+}
+
+// exportInput 交给 pandoc 的全部内容，相同的输入导出相同的文件
+type exportInput struct {
+	markdown string
+	typ      string
+	title    string
+	docNo    string
+	stamp    string
+}
+
+// prepareExport 预处理 Markdown 并确定版头。
+// 版头优先使用 info 中的 Title/DocNo，未指定文号时使用文档在登记中预留或使用的发文字号，仍未确定时使用 userID 的用户资料中的版头；
+// 发文机关标志最终使用系统默认值，文号仍为空时不输出文号；
+// markdown 与会话 conversationID 中文档 messageID 的审批内容一致时，在文号下方标注审批状态与签署人
+func prepareExport(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, conversationID, messageID, markdown, typ string, info []*pb.InfoItem) exportInput {
+	// 1) 预处理 Markdown
+	md := preprocessMarkdown(markdown)
+
+	// 2) 应用“首行居中、末两行右对齐”
+	md = applyLineAlignments(md)
+
+	title, docNo := pickTitleDocNo(info)
+	if docNo == "" {
+		docNo = documentDocNo(ctx, svcCtx, conversationID, messageID)
+	}
+	if title == "" || docNo == "" {
+		profileTitle, profileDocNo := profileHeader(loadUserProfile(ctx, svcCtx, userID))
+		title = cmp.Or(title, profileTitle, defaultHeaderTitle)
+		docNo = cmp.Or(docNo, profileDocNo)
+	}
+
+	stamp := approvalStamp(ctx, svcCtx, conversationID, messageID, markdown)
+	return exportInput{
+		markdown: decorateGovHeaderAndBody(md, typ, title, docNo, stamp),
+		typ:      typ,
+		title:    title,
+		docNo:    docNo,
+		stamp:    stamp,
+	}
+}
+
+// hash 导出内容的摘要，用于缓存导出结果
+func (in exportInput) hash() string {
+	return audit.Hash(strings.Join([]string{in.typ, in.title, in.docNo, in.stamp, in.markdown}, "\x00"))
+}
+
+// run 运行 pandoc
+func (in exportInput) run(ctx context.Context, svcCtx *svc.ServiceContext) ([]byte, error) {
+	return runPandoc(
+		ctx,
+		in.markdown,
+		in.typ,
+		svcCtx.Config.Font.Path,
+		svcCtx.Config.LuaFilters.Align,
+		svcCtx.Config.LuaFilters.Gov,
+		in.title, // ✅ 传入
+		in.docNo, // ✅ 传入
+		in.stamp,
+	)
+}
+
+// 根据输出类型，拼接“红字抬头 + 文号 + 审批标注 + 红线”，并把正文首/末行对齐；docNo、stamp 为空时不输出
+func decorateGovHeaderAndBody(src, typ, title, docNo, stamp string) string {
 	header := ""
 	switch typ {
 	case "pdf":
@@ -90,15 +174,26 @@ func decorateGovHeaderAndBody(src, typ, title, docNo string) string {
 ::: {.GovTitle}
 %s
 :::
-
+`, html.EscapeString(title))
+		if docNo != "" {
+			header += fmt.Sprintf(`
 ::: {.GovDocNo}
 %s
 :::
-
+`, html.EscapeString(docNo))
+		}
+		if stamp != "" {
+			header += fmt.Sprintf(`
+::: {.GovStamp}
+%s
+:::
+`, html.EscapeString(stamp))
+		}
+		header += `
 ::: {.GovRedLine}
  
 :::
-`, html.EscapeString(title), html.EscapeString(docNo))
+`
 	}
 	return header + "\n" + src
 }
@@ -213,7 +308,7 @@ func escapeNumberDots(line string) string {
 /*************** 调用 Pandoc ***************/
 
 // 通过 pandoc 把 markdown 渲染为指定类型 (pdf/docx)
-func runPandoc(ctx context.Context, markdown, typ, fontDir, alignLua, govLua string, pdfTitle, pdfDocNo string) ([]byte, error) {
+func runPandoc(ctx context.Context, markdown, typ, fontDir, alignLua, govLua string, pdfTitle, pdfDocNo, pdfStamp string) ([]byte, error) {
 	mdFile, err := os.CreateTemp("", "md2-"+time.Now().Format("20060102150405")+"-*.md")
 	if err != nil {
 		return nil, fmt.Errorf("创建临时文件失败: %w", err)
@@ -256,7 +351,7 @@ func runPandoc(ctx context.Context, markdown, typ, fontDir, alignLua, govLua str
 		args = append(args, "--pdf-engine-opt=-halt-on-error", "--pdf-engine-opt=-interaction=nonstopmode")
 
 		// 这里写 include-before-body 内容（注意花括号作用域，\centering 不外溢）
-		tex := buildPdfHeaderTex(pdfTitle, pdfDocNo)
+		tex := buildPdfHeaderTex(pdfTitle, pdfDocNo, pdfStamp)
 
 		f, e := os.CreateTemp("", "inc-*.tex")
 		if e != nil {
@@ -281,10 +376,16 @@ func runPandoc(ctx context.Context, markdown, typ, fontDir, alignLua, govLua str
 
 	c, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
+	c, span := tracing.Start(c, "pandoc", tracing.AttrFormat.String(typ))
 	cmd := exec.CommandContext(c, "pandoc", args...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
+	cmd.Env = tracing.CommandEnv(c)
+	start := time.Now()
+	err = cmd.Run()
+	metrics.ObservePandoc(typ, start, err)
+	tracing.End(span, err)
+	if err != nil {
 		return nil, fmt.Errorf("pandoc 执行失败: %v\nstderr: %s", err, stderr.String())
 	}
 	data, err := os.ReadFile(outFile)
@@ -403,23 +504,30 @@ func pickTitleDocNo(items []*pb.InfoItem) (title, docNo string) {
 	return
 }
 
-// 新增：根据 title/docNo 生成 tex 头
-func buildPdfHeaderTex(title, docNo string) string {
+// 新增：根据 title/docNo 生成 tex 头，docNo 为空时不输出文号，stamp 不为空时在文号下方标注审批信息
+func buildPdfHeaderTex(title, docNo, stamp string) string {
 	esc := func(s string) string {
-		// 极简 LaTeX 转义（足够覆盖常见中文标题中的特殊字符）
+		// 极简 LaTeX 转义（足够覆盖常见中文标题中的特殊字符）；签署人昵称由其他用户设置，反斜杠同样转义，避免注入命令
 		replacer := strings.NewReplacer(
-			`%`, `\%`, `$`, `\$`, `#`, `\#`, `&`, `\&`,
+			`\`, `\textbackslash{}`, `%`, `\%`, `$`, `\$`, `#`, `\#`, `&`, `\&`,
 			`_`, `\_`, `{`, `\{`, `}`, `\}`, `^`, `\^{}`, `~`, `\~{}`,
 		)
 		return replacer.Replace(s)
 	}
+	docNoTex := ""
+	if docNo != "" {
+		docNoTex = fmt.Sprintf(`{\centering {\large %s}\par}`+"\n", esc(docNo))
+	}
+	stampTex := ""
+	if stamp != "" {
+		stampTex = fmt.Sprintf(`{\centering {\small %s}\par}`+"\n", esc(stamp))
+	}
 	return fmt.Sprintf(
 		`{\centering {\fontsize{36pt}{42pt}\selectfont\textcolor{red}{%s}}\par}
 \vspace{4pt}
-{\centering {\large %s}\par}
-{\color{red}\rule{\linewidth}{1.2pt}}
+%s%s{\color{red}\rule{\linewidth}{1.2pt}}
 \vspace{8pt}
-`, esc(title), esc(docNo))
+`, esc(title), docNoTex, stampTex)
 }
 
 /***************（保留以兼容 docx core 里可能用到的）***************/
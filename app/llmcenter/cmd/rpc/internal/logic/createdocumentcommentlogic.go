@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/textanchor"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateDocumentCommentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateDocumentCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateDocumentCommentLogic {
+	return &CreateDocumentCommentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: CreateDocumentComment
+// 能查看文档的用户即可发表批注（审阅人通常只有查看权限）
+func (l *CreateDocumentCommentLogic) CreateDocumentComment(in *pb.CreateDocumentCommentRequest) (*pb.CreateDocumentCommentResponse, error) {
+	content := strings.TrimSpace(in.Content)
+	if content == "" || len([]rune(content)) > maxCommentLength {
+		return nil, fmt.Errorf("CreateDocumentComment content empty or longer than %d: %w", maxCommentLength, xerr.ErrRequestParam)
+	}
+
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "CreateDocumentComment", in.UserId, in.ConversationId, in.MessageId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, doc.ConversationId)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDocumentComment db conversation FindOne err:%+v, conversationId:%s: %w", err, doc.ConversationId, xerr.ErrDbError)
+	}
+	mentions, err := checkMentions(l.ctx, l.svcCtx, conversation, in.MentionUserIds)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDocumentComment: %w", err)
+	}
+
+	comment := &model.DocumentComments{
+		MessageId:      doc.MessageId,
+		ConversationId: doc.ConversationId,
+		UserId:         in.UserId,
+		Content:        content,
+		Status:         commentStatusOpen,
+		CreatedAt:      time.Now(),
+	}
+	threadStatus := commentStatusOpen
+	if in.ParentId > 0 {
+		// 回复：挂在首条批注下，不引用文本
+		root, err := l.findRoot(doc, in.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		comment.ParentId, comment.Status, threadStatus = root.Id, "", root.Status
+	} else if in.AnchorEnd > in.AnchorStart {
+		a, err := l.anchor(doc, in)
+		if err != nil {
+			return nil, err
+		}
+		comment.Quote = a.Text
+		setCommentAnchor(comment, a, textanchor.StatusAnchored, audit.Hash(doc.Content))
+	}
+
+	if comment.Id, err = l.svcCtx.DocumentCommentsModel.InsertWithMentions(l.ctx, comment, mentions); err != nil {
+		return nil, fmt.Errorf("CreateDocumentComment InsertWithMentions err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+
+	op := "create"
+	if comment.ParentId != 0 {
+		op = "reply"
+	}
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionComment,
+		ConversationId: doc.ConversationId,
+		TargetId:       doc.MessageId,
+		Detail:         fmt.Sprintf("op=%s,comment=%d,parent=%d,mentions=%d", op, comment.Id, comment.ParentId, len(mentions)),
+	})
+
+	return &pb.CreateDocumentCommentResponse{Comment: toPbComment(comment, mentions, threadStatus)}, nil
+}
+
+// findRoot 查询被回复的批注所属的首条批注，回复的回复同样归入首条批注
+func (l *CreateDocumentCommentLogic) findRoot(doc *model.Documents, parentID int64) (*model.DocumentComments, error) {
+	parent, err := l.svcCtx.DocumentCommentsModel.FindOne(l.ctx, parentID)
+	if err == nil && parent.ParentId != 0 {
+		parent, err = l.svcCtx.DocumentCommentsModel.FindOne(l.ctx, parent.ParentId)
+	}
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("CreateDocumentComment parent %d not found: %w", parentID, xerr.ErrCommentNotFound)
+		}
+		return nil, fmt.Errorf("CreateDocumentComment FindOne err:%+v, id:%d: %w", err, parentID, xerr.ErrDbError)
+	}
+	if parent.MessageId != doc.MessageId {
+		return nil, fmt.Errorf("CreateDocumentComment parent %d does not belong to document %s: %w", parentID, doc.MessageId, xerr.ErrCommentNotFound)
+	}
+	return parent, nil
+}
+
+// anchor 按请求中的位置创建锚点。提供了 quote 且与该位置的文本不一致时（文档已被他人修改），按 quote 重新查找
+func (l *CreateDocumentCommentLogic) anchor(doc *model.Documents, in *pb.CreateDocumentCommentRequest) (textanchor.Anchor, error) {
+	a, err := textanchor.New(doc.Content, int(in.AnchorStart), int(in.AnchorEnd))
+	if errors.Is(err, textanchor.ErrTooLong) || len([]rune(in.Quote)) > textanchor.MaxLen {
+		return a, fmt.Errorf("CreateDocumentComment quote longer than %d: %w", textanchor.MaxLen, xerr.ErrRequestParam)
+	}
+	if in.Quote == "" || (err == nil && a.Text == in.Quote) {
+		if err != nil {
+			return a, fmt.Errorf("CreateDocumentComment anchor [%d,%d) err:%v: %w", in.AnchorStart, in.AnchorEnd, err, xerr.ErrCommentAnchorInvalid)
+		}
+		return a, nil
+	}
+
+	located, status := textanchor.Locate(doc.Content, textanchor.Anchor{Start: int(in.AnchorStart), End: int(in.AnchorEnd), Text: in.Quote})
+	if status != textanchor.StatusAnchored {
+		return a, fmt.Errorf("CreateDocumentComment quote not found in document %s: %w", doc.MessageId, xerr.ErrCommentAnchorInvalid)
+	}
+	return located, nil
+}
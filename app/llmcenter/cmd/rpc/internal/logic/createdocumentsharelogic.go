@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/password"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateDocumentShareLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateDocumentShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateDocumentShareLogic {
+	return &CreateDocumentShareLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: CreateDocumentShare
+// 分享相当于对外公开文档，需要具备修改权限（个人文档的创建者或团队空间的编辑）
+func (l *CreateDocumentShareLogic) CreateDocumentShare(in *pb.CreateDocumentShareRequest) (*pb.CreateDocumentShareResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "CreateDocumentShare", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	if len([]rune(in.Password)) > maxSharePasswordLength {
+		return nil, fmt.Errorf("CreateDocumentShare password longer than %d: %w", maxSharePasswordLength, xerr.ErrRequestParam)
+	}
+
+	var passwordHash string
+	if in.Password != "" {
+		if passwordHash, err = password.Hash(in.Password); err != nil {
+			return nil, fmt.Errorf("CreateDocumentShare hash password err:%v: %w", err, xerr.ErrServerCommon)
+		}
+	}
+	token, err := newShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("CreateDocumentShare generate token err:%v: %w", err, xerr.ErrServerCommon)
+	}
+
+	now := time.Now()
+	share := &model.DocumentShares{
+		Token:          token,
+		MessageId:      doc.MessageId,
+		ConversationId: doc.ConversationId,
+		UserId:         in.UserId,
+		PasswordHash:   passwordHash,
+		AllowDownload:  1,
+		ExpireAt:       now.Add(time.Duration(shareExpireSeconds(l.svcCtx.Config, in.ExpireSeconds)) * time.Second).Truncate(time.Second),
+		CreatedAt:      now,
+	}
+	if in.DisableDownload {
+		share.AllowDownload = 0
+	}
+	ret, err := l.svcCtx.DocumentSharesModel.Insert(l.ctx, share)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDocumentShare Insert err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+	if share.Id, err = ret.LastInsertId(); err != nil {
+		return nil, fmt.Errorf("CreateDocumentShare LastInsertId err:%+v: %w", err, xerr.ErrDbError)
+	}
+
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionShare,
+		ConversationId: doc.ConversationId,
+		TargetId:       doc.MessageId,
+		HashAfter:      audit.Hash(doc.Content),
+		Detail: fmt.Sprintf("op=create,share=%d,expire_at=%d,password=%t,download=%t",
+			share.Id, share.ExpireAt.Unix(), passwordHash != "", share.AllowDownload == 1),
+	})
+
+	return &pb.CreateDocumentShareResponse{Share: toPbDocumentShare(l.svcCtx.Config, share)}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteDocumentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteDocumentLogic {
+	return &DeleteDocumentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: DeleteDocument
+func (l *DeleteDocumentLogic) DeleteDocument(in *pb.DeleteDocumentRequest) (*pb.DeleteDocumentResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "DeleteDocument", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	// 审批中或已签发的文档不能删除，需先撤回或撤销签发
+	if err := checkDocumentUnlocked(l.ctx, l.svcCtx, "DeleteDocument", doc); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.DocRepo.DeleteDocument(l.ctx, in.MessageId); err != nil {
+		return nil, fmt.Errorf("DeleteDocument err:%+v, messageId:%s: %w", err, in.MessageId, xerr.ErrDbError)
+	}
+	// 释放文档预留但未使用的发文字号，释放失败不影响删除，字号可在登记中手动释放
+	if err := l.svcCtx.DocNumbersModel.ReleaseByMessageId(l.ctx, in.MessageId, in.UserId); err != nil {
+		l.Errorf("DeleteDocument ReleaseByMessageId err:%+v, messageId:%s", err, in.MessageId)
+	}
+	// 断开正在协同编辑该文档的连接
+	l.svcCtx.Collab.Close(l.ctx, in.MessageId, fmt.Errorf("document %s deleted: %w", in.MessageId, xerr.ErrMessageNotFound))
+
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionDelete,
+		ConversationId: doc.ConversationId,
+		TargetId:       in.MessageId,
+		HashBefore:     audit.Hash(doc.Content),
+	})
+
+	return &pb.DeleteDocumentResponse{Success: true}, nil
+}
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteUsageQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteUsageQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteUsageQuotaLogic {
+	return &DeleteUsageQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: DeleteUsageQuota
+func (l *DeleteUsageQuotaLogic) DeleteUsageQuota(in *pb.DeleteUsageQuotaRequest) (*pb.DeleteUsageQuotaResponse, error) {
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("invalid quota id %d: %w", in.Id, xerr.ErrRequestParam)
+	}
+	if err := l.svcCtx.UsageQuotaModel.Delete(l.ctx, in.Id); err != nil {
+		return nil, fmt.Errorf("DeleteUsageQuota err:%+v, id:%d: %w", err, in.Id, xerr.ErrDbError)
+	}
+	return &pb.DeleteUsageQuotaResponse{Success: true}, nil
+}
@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/gongwen"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultDocNoPageSize = 20
+	maxDocNoPageSize     = 100
+)
+
+// allocateDocNo 从文档所属团队的发文字号登记中为文档分配当年的字号，文档已预留或使用了该代字当年的字号时直接返回。
+// 登记按文档所属会话的团队确定并校验用户对该团队具备编辑权限；workspaceID 为请求中指定的团队，非 0 时须与之一致。
+// 个人空间的文档没有发文字号登记，不能分配
+func allocateDocNo(ctx context.Context, svcCtx *svc.ServiceContext, caller string, userID, workspaceID int64, prefix string, doc *model.Documents) (*model.DocNumbers, error) {
+	prefix = strings.TrimSpace(prefix)
+	if !gongwen.ValidDocNoPrefix(prefix) {
+		return nil, fmt.Errorf("%s doc no prefix %q: %w", caller, prefix, xerr.ErrDocNoPrefixInvalid)
+	}
+	conversation, err := findAccessibleConversation(ctx, svcCtx, caller, userID, doc.ConversationId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	if conversation.WorkspaceId == workspace.Personal {
+		return nil, fmt.Errorf("%s document %s is in a personal conversation: %w", caller, doc.MessageId, xerr.ErrDocNoPersonal)
+	}
+	if workspaceID != 0 && workspaceID != conversation.WorkspaceId {
+		return nil, fmt.Errorf("%s workspace %d does not match document workspace %d: %w", caller, workspaceID, conversation.WorkspaceId, xerr.ErrRequestParam)
+	}
+	workspaceID = conversation.WorkspaceId
+
+	year := time.Now().Year()
+	n := &model.DocNumbers{
+		WorkspaceId:    workspaceID,
+		Prefix:         prefix,
+		Year:           int64(year),
+		MessageId:      doc.MessageId,
+		ConversationId: doc.ConversationId,
+		UserId:         userID,
+	}
+	err = svcCtx.DocNumbersModel.Reserve(ctx, n, func(seq int64) string { return gongwen.FormatDocNo(prefix, year, seq) })
+	if err != nil {
+		return nil, fmt.Errorf("%s Reserve doc no err:%+v, workspaceId:%d, prefix:%s: %w", caller, err, workspaceID, prefix, xerr.ErrDbError)
+	}
+
+	svcCtx.Auditor.Record(ctx, audit.Entry{
+		UserId:         userID,
+		Action:         audit.ActionDocNo,
+		ConversationId: doc.ConversationId,
+		TargetId:       doc.MessageId,
+		Detail:         fmt.Sprintf("op=allocate,workspace=%d,doc_no=%s", workspaceID, n.DocNo),
+	})
+	return n, nil
+}
+
+// findDocNumber 查询发文字号并校验用户对其所属团队具备指定访问级别
+func findDocNumber(ctx context.Context, svcCtx *svc.ServiceContext, caller string, userID, id int64, access workspace.Access) (*model.DocNumbers, error) {
+	n, err := svcCtx.DocNumbersModel.FindOne(ctx, id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("%s doc no not found, id:%d: %w", caller, id, xerr.ErrDocNoNotFound)
+		}
+		return nil, fmt.Errorf("%s FindOne doc no err:%+v, id:%d: %w", caller, err, id, xerr.ErrDbError)
+	}
+	if err := checkWorkspaceAccess(ctx, svcCtx, userID, n.WorkspaceId, access); err != nil {
+		return nil, fmt.Errorf("%s: %w", caller, err)
+	}
+	return n, nil
+}
+
+// updateDocNoStatus 将发文字号从 from 状态改为 to 状态，状态已被其他请求修改时返回错误
+func updateDocNoStatus(ctx context.Context, svcCtx *svc.ServiceContext, caller string, userID int64, n *model.DocNumbers, from, to string) error {
+	if n.Status != from {
+		return fmt.Errorf("%s doc no %d is %s, want %s: %w", caller, n.Id, n.Status, from, xerr.ErrDocNoStatusInvalid)
+	}
+	ok, err := svcCtx.DocNumbersModel.UpdateStatus(ctx, n.Id, from, to, userID)
+	if err != nil {
+		return fmt.Errorf("%s UpdateStatus doc no err:%+v, id:%d: %w", caller, err, n.Id, xerr.ErrDbError)
+	}
+	if !ok {
+		return fmt.Errorf("%s doc no %d is no longer %s: %w", caller, n.Id, from, xerr.ErrDocNoStatusInvalid)
+	}
+
+	now := time.Now()
+	n.Status, n.UserId, n.UpdatedAt = to, userID, now
+	switch to {
+	case model.DocNoIssued:
+		n.IssuedAt.Time, n.IssuedAt.Valid = now, true
+	case model.DocNoReleased:
+		n.ReleasedAt.Time, n.ReleasedAt.Valid = now, true
+	}
+
+	svcCtx.Auditor.Record(ctx, audit.Entry{
+		UserId:         userID,
+		Action:         audit.ActionDocNo,
+		ConversationId: n.ConversationId,
+		TargetId:       n.MessageId,
+		Detail:         fmt.Sprintf("op=%s,workspace=%d,doc_no=%s", to, n.WorkspaceId, n.DocNo),
+	})
+	return nil
+}
+
+// documentDocNo 导出时文档在登记中预留或使用的发文字号，须属于会话 conversationID；没有或查询失败时为空
+func documentDocNo(ctx context.Context, svcCtx *svc.ServiceContext, conversationID, messageID string) string {
+	if messageID == "" {
+		return ""
+	}
+	n, err := svcCtx.DocNumbersModel.FindActiveByMessageId(ctx, messageID)
+	if err != nil {
+		if err != model.ErrNotFound {
+			logx.WithContext(ctx).Errorf("load doc no for export failed, messageId:%s, err:%v", messageID, err)
+		}
+		return ""
+	}
+	if n.ConversationId != conversationID {
+		return ""
+	}
+	return n.DocNo
+}
+
+func toPbDocNumber(n *model.DocNumbers) *pb.DocNumber {
+	item := &pb.DocNumber{
+		Id:             n.Id,
+		WorkspaceId:    n.WorkspaceId,
+		Prefix:         n.Prefix,
+		Year:           n.Year,
+		Seq:            n.Seq,
+		DocNo:          n.DocNo,
+		Status:         n.Status,
+		ConversationId: n.ConversationId,
+		MessageId:      n.MessageId,
+		UserId:         n.UserId,
+		CreatedAt:      n.CreatedAt.Unix(),
+		UpdatedAt:      n.UpdatedAt.Unix(),
+	}
+	if n.IssuedAt.Valid {
+		item.IssuedAt = n.IssuedAt.Time.Unix()
+	}
+	if n.ReleasedAt.Valid {
+		item.ReleasedAt = n.ReleasedAt.Time.Unix()
+	}
+	return item
+}
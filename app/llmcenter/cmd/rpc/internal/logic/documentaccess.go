@@ -0,0 +1,132 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// workspaceRole 查询用户在团队空间中的角色，非成员返回空角色。
+// 用户中心不可用时返回错误，调用方应拒绝访问
+func workspaceRole(ctx context.Context, svcCtx *svc.ServiceContext, userID, workspaceID int64) (string, error) {
+	resp, err := svcCtx.UsercenterRpc.GetWorkspaceRole(ctx, &usercenter.GetWorkspaceRoleReq{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("GetWorkspaceRole err:%v, userId:%d, workspaceId:%d: %w", err, userID, workspaceID, xerr.ErrServerCommon)
+	}
+	return resp.Role, nil
+}
+
+// checkWorkspaceAccess 校验用户在团队空间中具备指定访问级别
+func checkWorkspaceAccess(ctx context.Context, svcCtx *svc.ServiceContext, userID, workspaceID int64, access workspace.Access) error {
+	role, err := workspaceRole(ctx, svcCtx, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if !workspace.Allows(role, access) {
+		return fmt.Errorf("user %d with role %q is not allowed to access workspace %d: %w", userID, role, workspaceID, xerr.ErrWorkspaceAccessDenied)
+	}
+	return nil
+}
+
+// checkConversationAccess 个人空间的会话只有创建者可以访问，团队空间的会话按成员角色判断
+func checkConversationAccess(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, conversation *model.Conversations, access workspace.Access) error {
+	if conversation.WorkspaceId == workspace.Personal {
+		if conversation.UserId != userID {
+			return fmt.Errorf("user %d is not allowed to access conversation %s: %w", userID, conversation.ConversationId, xerr.ErrConversationAccessDenied)
+		}
+		return nil
+	}
+	if err := checkWorkspaceAccess(ctx, svcCtx, userID, conversation.WorkspaceId, access); err != nil {
+		return fmt.Errorf("conversation %s: %w", conversation.ConversationId, err)
+	}
+	return nil
+}
+
+// canReadConversation 判断其他用户（如被提及的用户、指定的审批人）能否查看会话，用户中心不可用时返回错误
+func canReadConversation(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, conversation *model.Conversations) (bool, error) {
+	if conversation.WorkspaceId == workspace.Personal {
+		return userID == conversation.UserId, nil
+	}
+	role, err := workspaceRole(ctx, svcCtx, userID, conversation.WorkspaceId)
+	if err != nil {
+		return false, err
+	}
+	return workspace.Allows(role, workspace.Read), nil
+}
+
+// findAccessibleConversation 查询会话并校验用户具备指定访问级别。
+// caller 为调用方名称，用于错误信息。
+func findAccessibleConversation(ctx context.Context, svcCtx *svc.ServiceContext, caller string, userID int64, conversationID string, access workspace.Access) (*model.Conversations, error) {
+	conversation, err := svcCtx.ConversationModel.FindOne(ctx, conversationID)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("%s conversation not found, conversationId:%s: %w", caller, conversationID, xerr.ErrConversationNotFound)
+		}
+		return nil, fmt.Errorf("%s db conversation FindOne err:%+v, conversationId:%s: %w", caller, err, conversationID, xerr.ErrDbError)
+	}
+	if err := checkConversationAccess(ctx, svcCtx, userID, conversation, access); err != nil {
+		return nil, fmt.Errorf("%s: %w", caller, err)
+	}
+	return conversation, nil
+}
+
+// findAccessibleDocument 查询文档，并校验其属于指定会话（conversationID 为空时不校验）且用户对该会话具备指定访问级别。
+// caller 为调用方名称，用于错误信息。
+func findAccessibleDocument(ctx context.Context, svcCtx *svc.ServiceContext, caller string, userID int64, conversationID, messageID string, access workspace.Access) (*model.Documents, error) {
+	doc, err := svcCtx.DocRepo.FindDocument(ctx, messageID)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("%s document not found, messageId:%s: %w", caller, messageID, xerr.ErrMessageNotFound)
+		}
+		return nil, fmt.Errorf("%s FindDocument err:%+v, messageId:%s: %w", caller, err, messageID, xerr.ErrDbError)
+	}
+	if conversationID != "" && doc.ConversationId != conversationID {
+		return nil, fmt.Errorf("%s document %s does not belong to conversation %s: %w", caller, messageID, conversationID, xerr.ErrMessageNotFound)
+	}
+
+	if _, err := findAccessibleConversation(ctx, svcCtx, caller, userID, doc.ConversationId, access); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// checkFileAccess 校验用户可以读取上传的文件：团队空间文件需为空间成员，个人文件仅上传者可读。
+// 早期上传的个人文件未记录上传者（user_id 为 0），无法判断归属，任何人都不可读
+func checkFileAccess(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, file *model.File) error {
+	if file.WorkspaceId != workspace.Personal {
+		return checkWorkspaceAccess(ctx, svcCtx, userID, file.WorkspaceId, workspace.Read)
+	}
+	if file.UserId == 0 || file.UserId != userID {
+		return fmt.Errorf("user %d is not allowed to read file %s: %w", userID, file.StoredName, xerr.ErrPermissionDenied)
+	}
+	return nil
+}
+
+// accessibleReferences 过滤掉用户无权读取的引用文件，避免通过引用读取他人的上传内容。
+// 未登记的文件无法判断归属，一并过滤
+func accessibleReferences(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, references []*pb.Reference) []*pb.Reference {
+	var result []*pb.Reference
+	for _, ref := range references {
+		file, err := svcCtx.FilesModel.FindByStoredName(ctx, ref.FileId)
+		if err == nil {
+			err = checkFileAccess(ctx, svcCtx, userID, file)
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("skip inaccessible reference, userId:%d, fileId:%s, err:%v", userID, ref.FileId, err)
+			continue
+		}
+		result = append(result, ref)
+	}
+	return result
+}
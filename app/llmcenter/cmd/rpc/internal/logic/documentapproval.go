@@ -0,0 +1,206 @@
+package logic
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/approval"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxApprovalCommentLength  = 500 // 审批意见的最大字符数
+	defaultApprovalTasksLimit = 50
+	maxApprovalTasksLimit     = 200
+)
+
+// loadApproval 查询文档的审批记录，未进入审批流程时返回处于初始状态、Id 为 0 的记录
+func loadApproval(ctx context.Context, svcCtx *svc.ServiceContext, caller string, doc *model.Documents) (*model.DocumentApprovals, error) {
+	a, err := svcCtx.DocumentApprovalsModel.FindOneByMessageId(ctx, doc.MessageId)
+	switch err {
+	case nil:
+		return a, nil
+	case model.ErrNotFound:
+		return &model.DocumentApprovals{
+			MessageId:      doc.MessageId,
+			ConversationId: doc.ConversationId,
+			State:          svcCtx.Approval.Initial(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%s FindOneByMessageId approval err:%+v, messageId:%s: %w", caller, err, doc.MessageId, xerr.ErrDbError)
+	}
+}
+
+// checkDocumentUnlocked 文档处于锁定的审批状态（核稿中、已签发等）时不能修改或删除
+func checkDocumentUnlocked(ctx context.Context, svcCtx *svc.ServiceContext, caller string, doc *model.Documents) error {
+	a, err := loadApproval(ctx, svcCtx, caller, doc)
+	if err != nil {
+		return err
+	}
+	if svcCtx.Approval.Locked(a.State) {
+		return fmt.Errorf("%s document %s is in approval state %s: %w", caller, doc.MessageId, a.State, xerr.ErrDocumentLocked)
+	}
+	return nil
+}
+
+// approvalUser 执行审批操作的用户
+type approvalUser struct {
+	name  string   // 昵称，作为签署人记录
+	roles []string // RBAC 角色与流程角色
+}
+
+// loadApprovalUser 读取用户的 RBAC 角色与昵称，并按文档补充流程角色：有编辑权限时为 editor，为当前审批人时为 assignee。
+// 角色决定能否签发，用户中心不可用时返回错误，不按普通用户处理
+func loadApprovalUser(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, conversation *model.Conversations, a *model.DocumentApprovals) (*approvalUser, error) {
+	resp, err := svcCtx.UsercenterRpc.GetUserInfo(ctx, &usercenter.GetUserInfoReq{Id: userID})
+	if err != nil || resp.User == nil {
+		return nil, fmt.Errorf("GetUserInfo err:%v, userId:%d: %w", err, userID, xerr.ErrServerCommon)
+	}
+	u := &approvalUser{
+		name:  cmp.Or(resp.User.Nickname, fmt.Sprintf("用户%d", userID)),
+		roles: slices.Clone(resp.User.Roles),
+	}
+
+	err = checkConversationAccess(ctx, svcCtx, userID, conversation, workspace.Write)
+	switch {
+	case err == nil:
+		u.roles = append(u.roles, approval.RoleEditor)
+	case !errors.Is(err, xerr.ErrWorkspaceAccessDenied) && !errors.Is(err, xerr.ErrConversationAccessDenied):
+		return nil, err
+	}
+	if a.AssigneeId != 0 && a.AssigneeId == userID {
+		u.roles = append(u.roles, approval.RoleAssignee)
+	}
+	return u, nil
+}
+
+// approvalHistory 查询审批的流转记录，未进入审批流程时为空
+func approvalHistory(ctx context.Context, svcCtx *svc.ServiceContext, caller string, a *model.DocumentApprovals) ([]*model.DocumentApprovalHistory, error) {
+	if a.Id == 0 {
+		return nil, nil
+	}
+	history, err := svcCtx.DocumentApprovalsModel.FindHistory(ctx, a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%s FindHistory err:%+v, messageId:%s: %w", caller, err, a.MessageId, xerr.ErrDbError)
+	}
+	return history, nil
+}
+
+// approvalSigners 从流转记录中计算本轮审批的签署人：回到初始状态（退回、撤回）后重新计算，同一名目以最后一次签署为准
+func approvalSigners(w *approval.Workflow, history []*model.DocumentApprovalHistory) []*pb.ApprovalSigner {
+	var signers []*pb.ApprovalSigner
+	for _, h := range history {
+		if h.ToState == w.Initial() {
+			signers = nil
+			continue
+		}
+		t, _ := w.Action(h.Action)
+		if t.Signer == "" {
+			continue
+		}
+		signers = slices.DeleteFunc(signers, func(s *pb.ApprovalSigner) bool { return s.Label == t.Signer })
+		signers = append(signers, &pb.ApprovalSigner{
+			Label:    t.Signer,
+			UserId:   h.UserId,
+			UserName: h.UserName,
+			SignedAt: h.CreatedAt.Unix(),
+		})
+	}
+	return signers
+}
+
+// toPbApproval user 不为空时返回其可执行的操作
+func toPbApproval(w *approval.Workflow, a *model.DocumentApprovals, history []*model.DocumentApprovalHistory, user *approvalUser) *pb.DocumentApproval {
+	state, _ := w.State(a.State)
+	item := &pb.DocumentApproval{
+		MessageId:      a.MessageId,
+		ConversationId: a.ConversationId,
+		State:          a.State,
+		StateLabel:     cmp.Or(state.Label, a.State),
+		Locked:         w.Locked(a.State),
+		AssigneeId:     a.AssigneeId,
+		SubmitterId:    a.SubmitterId,
+		Version:        a.Version,
+		Signers:        approvalSigners(w, history),
+	}
+	if a.Id != 0 {
+		item.UpdatedAt = a.UpdatedAt.Unix()
+	}
+	if user != nil {
+		for _, t := range w.Transitions(a.State) {
+			if !t.Allowed(user.roles) {
+				continue
+			}
+			to, _ := w.State(t.To)
+			item.Actions = append(item.Actions, &pb.ApprovalAction{
+				Action:          t.Action,
+				Label:           t.Label,
+				ToState:         t.To,
+				NeedAssignee:    to.Assignee,
+				CommentRequired: t.CommentRequired,
+			})
+		}
+	}
+	return item
+}
+
+func toPbApprovalRecord(w *approval.Workflow, h *model.DocumentApprovalHistory) *pb.ApprovalRecord {
+	t, _ := w.Action(h.Action)
+	return &pb.ApprovalRecord{
+		Id:          h.Id,
+		Action:      h.Action,
+		ActionLabel: cmp.Or(t.Label, h.Action),
+		FromState:   h.FromState,
+		ToState:     h.ToState,
+		UserId:      h.UserId,
+		UserName:    h.UserName,
+		AssigneeId:  h.AssigneeId,
+		Comment:     h.Comment,
+		CreatedAt:   h.CreatedAt.Unix(),
+	}
+}
+
+// approvalStamp 导出时标注在版头的审批状态与签署人，如“已签发　核稿人：张三　签发人：李四”。
+// 仅当导出的内容与最近一次流转时的文档内容一致时标注，避免将审批信息标注到未经审批的内容上；
+// 未进入审批流程、处于初始状态或查询失败时为空
+func approvalStamp(ctx context.Context, svcCtx *svc.ServiceContext, conversationID, messageID, markdown string) string {
+	if messageID == "" {
+		return ""
+	}
+	a, err := svcCtx.DocumentApprovalsModel.FindOneByMessageId(ctx, messageID)
+	if err != nil {
+		if err != model.ErrNotFound {
+			logx.WithContext(ctx).Errorf("load approval for export failed, messageId:%s, err:%v", messageID, err)
+		}
+		return ""
+	}
+	w := svcCtx.Approval
+	if a.ConversationId != conversationID || a.State == w.Initial() || a.ContentHash != audit.Hash(markdown) {
+		return ""
+	}
+	history, err := svcCtx.DocumentApprovalsModel.FindHistory(ctx, a.Id)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("load approval history for export failed, messageId:%s, err:%v", messageID, err)
+		return ""
+	}
+
+	state, _ := w.State(a.State)
+	parts := []string{cmp.Or(state.Label, a.State)}
+	for _, s := range approvalSigners(w, history) {
+		// 版头为单行，昵称中的换行替换为空格
+		parts = append(parts, s.Label+"："+strings.NewReplacer("\r", " ", "\n", " ").Replace(s.UserName))
+	}
+	return strings.Join(parts, "　")
+}
@@ -0,0 +1,197 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/textanchor"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 批注状态
+const (
+	commentStatusOpen     = "open"
+	commentStatusResolved = "resolved"
+)
+
+const (
+	maxCommentLength     = 2000 // 批注内容的最大字符数
+	maxCommentMentions   = 20   // 单条批注最多提及的用户数
+	defaultMentionsLimit = 50
+	maxMentionsLimit     = 200
+)
+
+// commentAnchor 批注中保存的锚点
+func commentAnchor(c *model.DocumentComments) textanchor.Anchor {
+	return textanchor.Anchor{
+		Start:  int(c.AnchorStart),
+		End:    int(c.AnchorEnd),
+		Text:   c.AnchorText,
+		Prefix: c.AnchorPrefix,
+		Suffix: c.AnchorSuffix,
+	}
+}
+
+func setCommentAnchor(c *model.DocumentComments, a textanchor.Anchor, status, contentHash string) {
+	c.AnchorText, c.AnchorPrefix, c.AnchorSuffix = a.Text, a.Prefix, a.Suffix
+	c.AnchorStart, c.AnchorEnd = int64(a.Start), int64(a.End)
+	c.AnchorStatus, c.AnchorHash = status, contentHash
+}
+
+// reanchorComments 文档内容变化后重新定位批注引用的文本，并保存定位结果；保存失败只记录日志，下次查询时重试
+func reanchorComments(ctx context.Context, svcCtx *svc.ServiceContext, doc *model.Documents, comments []*model.DocumentComments) {
+	contentHash := audit.Hash(doc.Content)
+	for _, c := range comments {
+		if c.ParentId != 0 || c.AnchorText == "" || c.AnchorHash == contentHash {
+			continue
+		}
+		a, status := textanchor.Locate(doc.Content, commentAnchor(c))
+		setCommentAnchor(c, a, status, contentHash)
+		if err := svcCtx.DocumentCommentsModel.UpdateAnchor(ctx, c); err != nil {
+			logx.WithContext(ctx).Errorf("reanchor comment %d err:%v", c.Id, err)
+		}
+	}
+}
+
+// loadComments 查询文档的全部批注并重新定位，同时返回各批注提及的用户
+func loadComments(ctx context.Context, svcCtx *svc.ServiceContext, caller string, doc *model.Documents) ([]*model.DocumentComments, map[int64][]int64, error) {
+	comments, err := svcCtx.DocumentCommentsModel.FindByMessageId(ctx, doc.MessageId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s FindByMessageId err:%+v, messageId:%s: %w", caller, err, doc.MessageId, xerr.ErrDbError)
+	}
+	reanchorComments(ctx, svcCtx, doc, comments)
+
+	ids := make([]int64, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.Id)
+	}
+	mentions, err := commentMentions(ctx, svcCtx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s FindMentions err:%+v, messageId:%s: %w", caller, err, doc.MessageId, xerr.ErrDbError)
+	}
+	return comments, mentions, nil
+}
+
+func commentMentions(ctx context.Context, svcCtx *svc.ServiceContext, commentIDs []int64) (map[int64][]int64, error) {
+	rows, err := svcCtx.DocumentCommentsModel.FindMentions(ctx, commentIDs)
+	if err != nil {
+		return nil, err
+	}
+	mentions := make(map[int64][]int64, len(rows))
+	for _, m := range rows {
+		mentions[m.CommentId] = append(mentions[m.CommentId], m.UserId)
+	}
+	return mentions, nil
+}
+
+// commentThreads 将批注按首条批注分组，回复按发表顺序放在 replies 中；status 不为空时只返回该状态的批注
+func commentThreads(comments []*model.DocumentComments, mentions map[int64][]int64, status string) []*pb.DocumentComment {
+	roots := make(map[int64]*pb.DocumentComment)
+	var threads []*pb.DocumentComment
+	for _, c := range comments {
+		if c.ParentId == 0 {
+			item := toPbComment(c, mentions[c.Id], c.Status)
+			roots[c.Id] = item
+			if status == "" || c.Status == status {
+				threads = append(threads, item)
+			}
+		}
+	}
+	for _, c := range comments {
+		if root, ok := roots[c.ParentId]; ok {
+			root.Replies = append(root.Replies, toPbComment(c, mentions[c.Id], root.Status))
+		}
+	}
+	return threads
+}
+
+// toPbComment threadStatus 为所属批注的状态，回复本身不记录状态
+func toPbComment(c *model.DocumentComments, mentionUserIDs []int64, threadStatus string) *pb.DocumentComment {
+	item := &pb.DocumentComment{
+		Id:             c.Id,
+		MessageId:      c.MessageId,
+		ConversationId: c.ConversationId,
+		ParentId:       c.ParentId,
+		UserId:         c.UserId,
+		Content:        c.Content,
+		Quote:          c.Quote,
+		MentionUserIds: mentionUserIDs,
+		Status:         threadStatus,
+		ResolvedBy:     c.ResolvedBy,
+		CreatedAt:      c.CreatedAt.Unix(),
+	}
+	if c.AnchorText != "" {
+		item.Anchor = &pb.CommentAnchor{
+			Start:  c.AnchorStart,
+			End:    c.AnchorEnd,
+			Text:   c.AnchorText,
+			Status: c.AnchorStatus,
+		}
+	}
+	if c.ResolvedAt.Valid {
+		item.ResolvedAt = c.ResolvedAt.Time.Unix()
+	}
+	return item
+}
+
+// checkMentions 去重并校验被提及的用户可以查看该文档：个人空间只有创建者本人，团队空间为空间成员
+func checkMentions(ctx context.Context, svcCtx *svc.ServiceContext, conversation *model.Conversations, userIDs []int64) ([]int64, error) {
+	var result []int64
+	for _, id := range userIDs {
+		if id <= 0 || slices.Contains(result, id) {
+			continue
+		}
+		result = append(result, id)
+	}
+	if len(result) > maxCommentMentions {
+		return nil, fmt.Errorf("mention %d users, at most %d: %w", len(result), maxCommentMentions, xerr.ErrRequestParam)
+	}
+
+	for _, id := range result {
+		ok, err := canReadConversation(ctx, svcCtx, id, conversation)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("user %d cannot read conversation %s: %w", id, conversation.ConversationId, xerr.ErrCommentMentionInvalid)
+		}
+	}
+	return result, nil
+}
+
+// openCommentsPrompt 将文档中未解决的批注及其回复整理为修改提示，返回提示与批注数
+func openCommentsPrompt(ctx context.Context, svcCtx *svc.ServiceContext, doc *model.Documents) (string, int, error) {
+	comments, _, err := loadComments(ctx, svcCtx, "EditDocument", doc)
+	if err != nil {
+		return "", 0, err
+	}
+	threads := commentThreads(comments, nil, commentStatusOpen)
+	if len(threads) == 0 {
+		return "", 0, fmt.Errorf("EditDocument no open comments, messageId:%s: %w", doc.MessageId, xerr.ErrNoOpenComments)
+	}
+
+	var b strings.Builder
+	b.WriteString("请逐条处理以下审阅批注，修改后的文档中不要保留批注本身：")
+	for i, t := range threads {
+		switch {
+		case t.Anchor == nil:
+			fmt.Fprintf(&b, "\n%d. 针对全文：%s", i+1, t.Content)
+		case t.Anchor.Status == textanchor.StatusOrphaned:
+			fmt.Fprintf(&b, "\n%d. 针对“%s”（原文已修改）：%s", i+1, t.Quote, t.Content)
+		default:
+			fmt.Fprintf(&b, "\n%d. 针对“%s”：%s", i+1, t.Anchor.Text, t.Content)
+		}
+		for _, r := range t.Replies {
+			fmt.Fprintf(&b, "\n   回复：%s", r.Content)
+		}
+	}
+	return b.String(), len(threads), nil
+}
@@ -0,0 +1,236 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/metrics"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/limit"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+// 分享链接状态
+const (
+	shareStatusActive  = "active"
+	shareStatusExpired = "expired"
+	shareStatusRevoked = "revoked"
+)
+
+// 分享链接默认值，见 config.Share
+const (
+	defaultShareExpireSeconds    = 7 * 24 * 3600
+	defaultShareMaxExpireSeconds = 30 * 24 * 3600
+	defaultShareMaxPwdFailures   = 10
+	sharePwdFailureWindow        = 15 * 60
+	sharePwdFailureKeyPrefix     = "share:pwdfail:"
+	maxSharePasswordLength       = 64
+
+	defaultShareRenderCacheSeconds = 600
+	maxShareRenderCacheBytes       = 8 << 20 // 超过该大小的导出文件不缓存
+	shareRenderKeyPrefix           = "share:render:"
+	defaultShareIpPerMinute        = 60
+	defaultShareLinkPerMinute      = 300
+	shareIpLimitKeyPrefix          = "share:limit:ip:"
+	shareLinkLimitKeyPrefix        = "share:limit:link:"
+)
+
+// shareRenders 合并同一内容的并发渲染，缓存未命中时只运行一次 pandoc
+var shareRenders = syncx.NewSingleFlight()
+
+// shareExpireSeconds 按配置的默认值与上限确定有效期
+func shareExpireSeconds(c config.Config, requested int64) int64 {
+	def := c.Share.DefaultExpireSeconds
+	if def <= 0 {
+		def = defaultShareExpireSeconds
+	}
+	limit := c.Share.MaxExpireSeconds
+	if limit <= 0 {
+		limit = defaultShareMaxExpireSeconds
+	}
+	if requested <= 0 {
+		requested = def
+	}
+	return min(requested, limit)
+}
+
+func shareMaxPasswordFailures(c config.Config) int64 {
+	if c.Share.MaxPasswordFailures <= 0 {
+		return defaultShareMaxPwdFailures
+	}
+	return c.Share.MaxPasswordFailures
+}
+
+// positiveOr 配置值未设置（不大于 0）时使用默认值
+func positiveOr(n, def int) int {
+	if n <= 0 {
+		return def
+	}
+	return n
+}
+
+// takeShareQuota 按客户端 IP 与分享链接分别计数，任一超过每分钟上限时拒绝访问；Redis 不可用时放行
+func takeShareQuota(ctx context.Context, svcCtx *svc.ServiceContext, token string) error {
+	c := svcCtx.Config.Share
+	limits := []struct {
+		prefix string
+		key    string
+		quota  int
+	}{
+		{shareIpLimitKeyPrefix, clientinfo.FromContext(ctx).IP, positiveOr(c.IpPerMinute, defaultShareIpPerMinute)},
+		{shareLinkLimitKeyPrefix, token, positiveOr(c.LinkPerMinute, defaultShareLinkPerMinute)},
+	}
+	for _, l := range limits {
+		if l.key == "" {
+			continue
+		}
+		code, err := limit.NewPeriodLimit(60, l.quota, svcCtx.RedisClient, l.prefix, limit.Align()).TakeCtx(ctx, l.key)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("share rate limit err:%v, key:%s%s", err, l.prefix, l.key)
+			continue
+		}
+		if code == limit.OverQuota {
+			wait := time.Minute - time.Duration(time.Now().Unix()%60)*time.Second
+			return fmt.Errorf("share rate limited, key:%s%s: %w", l.prefix, l.key, xerr.WithRetryAfter(xerr.ErrShareTooFrequent, wait))
+		}
+	}
+	return nil
+}
+
+// renderShareCached 按内容摘要缓存渲染结果，同一版本的文档只渲染一次；缓存键包含导出内容的全部输入，文档修改、
+// 审批状态或版头变化后自然不再命中。Redis 读写失败时直接渲染
+func renderShareCached(ctx context.Context, svcCtx *svc.ServiceContext, format, hash string, render func() ([]byte, error)) ([]byte, error) {
+	key := shareRenderKeyPrefix + format + ":" + hash
+	if cached, err := svcCtx.RedisClient.GetCtx(ctx, key); err != nil {
+		logx.WithContext(ctx).Errorf("get share render cache err:%v, key:%s", err, key)
+	} else if cached != "" {
+		return []byte(cached), nil
+	}
+
+	v, err := shareRenders.Do(key, func() (any, error) {
+		data, err := render()
+		if err != nil || len(data) > maxShareRenderCacheBytes {
+			return data, err
+		}
+		seconds := positiveOr(svcCtx.Config.Share.RenderCacheSeconds, defaultShareRenderCacheSeconds)
+		if err := svcCtx.RedisClient.SetexCtx(ctx, key, string(data), seconds); err != nil {
+			logx.WithContext(ctx).Errorf("set share render cache err:%v, key:%s", err, key)
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// newShareToken 生成 128 位随机分享令牌（base64url，22 个字符）
+func newShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// shareSignData 分享链接的签名内容，API 层的 /public/share 按相同格式校验
+func shareSignData(token string, exp int64) string {
+	return fmt.Sprintf("share:%s|%d", token, exp)
+}
+
+// shareURL 生成带签名的分享链接，链接过期时间与分享记录一致
+func shareURL(c config.Config, token string, exp int64) string {
+	base := strings.TrimRight(c.Share.BaseURL, "/")
+	if base == "" {
+		base = strings.TrimSuffix(strings.TrimRight(c.Download.BaseURL, "/"), "/file") + "/share"
+	}
+	sig := signHMAC(shareSignData(token, exp), c.Download.SignKey)
+	return fmt.Sprintf("%s?token=%s&exp=%d&sig=%s", base, url.QueryEscape(token), exp, sig)
+}
+
+// shareUnlock 访问密码校验通过后下发的凭证，与密码哈希绑定，不同链接、不同密码之间不能通用
+func shareUnlock(c config.Config, share *model.DocumentShares) string {
+	return signHMAC("share-unlock:"+share.Token+"|"+share.PasswordHash, c.Download.SignKey)
+}
+
+func shareStatus(share *model.DocumentShares, now time.Time) string {
+	switch {
+	case share.RevokedAt.Valid:
+		return shareStatusRevoked
+	case !now.Before(share.ExpireAt):
+		return shareStatusExpired
+	default:
+		return shareStatusActive
+	}
+}
+
+func toPbDocumentShare(c config.Config, share *model.DocumentShares) *pb.DocumentShare {
+	item := &pb.DocumentShare{
+		Id:             share.Id,
+		MessageId:      share.MessageId,
+		ConversationId: share.ConversationId,
+		UserId:         share.UserId,
+		Url:            shareURL(c, share.Token, share.ExpireAt.Unix()),
+		HasPassword:    share.PasswordHash != "",
+		AllowDownload:  share.AllowDownload == 1,
+		ExpireAt:       share.ExpireAt.Unix(),
+		Status:         shareStatus(share, time.Now()),
+		ViewCount:      share.ViewCount,
+		DownloadCount:  share.DownloadCount,
+		CreatedAt:      share.CreatedAt.Unix(),
+	}
+	if share.RevokedAt.Valid {
+		item.RevokedAt = share.RevokedAt.Time.Unix()
+	}
+	return item
+}
+
+// documentTitle 取文档首个非空行作为标题，去掉 Markdown 标题标记
+func documentTitle(markdown string) string {
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, `\n`, "\n"), "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+		if line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// renderShareHTML 通过 pandoc 将 Markdown 渲染为 HTML 片段，禁用原始 HTML，避免文档内容在分享页中执行脚本。
+// 首行居中、末两行右对齐以 data-align 属性输出，由分享页样式处理
+func renderShareHTML(ctx context.Context, markdown string) (string, error) {
+	md := applyLineAlignments(preprocessMarkdown(markdown))
+
+	c, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	c, span := tracing.Start(c, "pandoc", tracing.AttrFormat.String("html"))
+	cmd := exec.CommandContext(c, "pandoc", "-f", "markdown+fenced_divs-raw_html-raw_attribute", "-t", "html5", "--wrap=preserve")
+	cmd.Stdin = strings.NewReader(md)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = tracing.CommandEnv(c)
+	start := time.Now()
+	err := cmd.Run()
+	metrics.ObservePandoc("html", start, err)
+	tracing.End(span, err)
+	if err != nil {
+		return "", fmt.Errorf("pandoc 执行失败: %v\nstderr: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
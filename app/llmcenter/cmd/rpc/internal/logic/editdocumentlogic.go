@@ -5,17 +5,22 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
 	"document_agent/app/llmcenter/cmd/rpc/internal/llm"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
 	"document_agent/app/llmcenter/cmd/rpc/types"
 	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/screening"
 	"document_agent/pkg/tool"
+	"document_agent/pkg/tracing"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stores/sqlc"
 )
 
 type EditDocumentLogic struct {
@@ -35,14 +40,18 @@ func NewEditDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Edit
 func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.LlmCenter_EditDocumentServer) error {
 	assistantMessageID := tool.GenerateULID()
 
-	// 这个在带缓存的版本
-	doc, err := l.svcCtx.DocRepo.FindDocument(l.ctx, in.MessageId)
-	// _, err := l.svcCtx.DocRepo.FindDocument(l.ctx, in.MessageId)
+	// 这个在带缓存的版本，同时校验文档属于该会话且用户具备编辑权限
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "EditDocument", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
 	if err != nil {
-		if err == sqlc.ErrNotFound {
-			return fmt.Errorf("document not found with id %s: %w", in.MessageId, err)
-		}
-		return fmt.Errorf("failed to find document: %w", err)
+		return err
+	}
+	// 审批中或已签发的文档被锁定，不能修改
+	if err := checkDocumentUnlocked(l.ctx, l.svcCtx, "EditDocument", doc); err != nil {
+		return err
+	}
+	tracing.SetConversation(l.ctx, in.ConversationId, "")
+	if err := checkQuota(l.ctx, l.svcCtx, in.UserId); err != nil {
+		return err
 	}
 
 	// // 这个是不带缓存的版本
@@ -52,8 +61,39 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 	// 	return fmt.Errorf("document not found: %w", err)
 	// }
 
+	// 处理批注时，未解决的批注作为修改提示，用户填写的提示作为补充要求
+	userPrompt, commentsCount := in.Prompt, 0
+	if in.AddressComments {
+		commentsPrompt, n, err := openCommentsPrompt(l.ctx, l.svcCtx, doc)
+		if err != nil {
+			return err
+		}
+		commentsCount = n
+		if strings.TrimSpace(in.Prompt) != "" {
+			commentsPrompt += "\n补充要求：" + in.Prompt
+		}
+		userPrompt = commentsPrompt
+	}
+
+	// 文档正在协同编辑时以会话中的最新内容为原文，尚未保存的修改也交给大模型
+	base := doc.Content
+	if live, ok := l.svcCtx.Collab.Content(l.ctx, in.MessageId); ok {
+		base = live
+	}
+
+	// 敏感词与涉密信息筛查
+	editPrompt, err := screenText(l.ctx, l.svcCtx, screening.StageInput, "prompt", in.UserId, in.ConversationId, userPrompt)
+	if err != nil {
+		return err
+	}
+
 	// 2. Construct prompt and call LLM (same as before)
-	prompt := fmt.Sprintf("修改：请根据以下提示修改文档内容：\n\n原文：\n%s\n\n修改提示：%s", doc.Content, in.Prompt)
+	// 文档中的个人信息（如生成时还原的引用内容）同样脱敏后再发送，输出时还原
+	redactor := screening.NewRedactor(l.svcCtx.Config.Redaction)
+	prompt := fmt.Sprintf("修改：请根据以下提示修改文档内容：\n\n原文：\n%s\n\n修改提示：%s", redactor.Redact(base), editPrompt)
+	if redactor.Len() > 0 {
+		prompt += redactionHint
+	}
 
 	llmReq := types.LLMApiRequest{
 		FlowID: l.svcCtx.Config.XingChen.FlowID,
@@ -69,7 +109,7 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 		return xerr.ErrRequestParam
 	}
 
-	client := llm.NewXingChenClient(l.ctx, l.svcCtx)
+	client := llm.NewXingChenClient(l.ctx, l.svcCtx).WithRedactor(redactor)
 	result, err := client.StreamChatForEdit(reqBody, stream, in.ConversationId)
 	if err != nil {
 		return err
@@ -77,13 +117,18 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 
 	// result := "This is a mocked LLM result."
 
+	// 生成期间文档可能已被提交审批，此时不保存修改结果
+	if err := checkDocumentUnlocked(l.ctx, l.svcCtx, "EditDocument", doc); err != nil {
+		return err
+	}
+
 	// 3. Save user and assistant messages (same as before)
 	userMessageID := tool.GenerateULID()
 	_, err = l.svcCtx.MessageModel.Insert(l.ctx, &model.Messages{
 		MessageId:      userMessageID,
 		ConversationId: in.ConversationId,
 		Role:           "user",
-		Content:        in.Prompt,
+		Content:        userMessage(in.Prompt, commentsCount),
 		ContentType:    "text",
 		Metadata:       sql.NullString{Valid: false},
 	})
@@ -98,7 +143,7 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 			Role:           "assistant",
 			Content:        result,
 			ContentType:    "text",
-			Metadata:       sql.NullString{Valid: false},
+			Metadata:       generatedBy(client),
 		}
 		_, err := l.svcCtx.MessageModel.Insert(l.ctx, msg)
 		if err != nil {
@@ -106,13 +151,31 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 		}
 	}
 
-	// 4. Update the document using the repository (handles DB update and cache invalidation)
-	err = l.svcCtx.DocRepo.UpdateDocumentContent(l.ctx, in.MessageId, result)
+	// 4. 修改结果与生成期间其他人的修改三方合并后保存，正在协同编辑时作为操作推送给各连接
+	merged, err := l.svcCtx.Collab.Merge(l.ctx, in.MessageId, in.UserId, collab.SourceEdit, base, result)
 	if err != nil {
 		return fmt.Errorf("更新 documents 表失败: %w", err)
 	}
 
-	// 5. Send end event (same as before)
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionEdit,
+		ConversationId: in.ConversationId,
+		TargetId:       in.MessageId,
+		HashBefore:     audit.Hash(base),
+		HashAfter:      audit.Hash(merged),
+		Detail:         editDetail(commentsCount),
+	})
+
+	// 5. 可选：对保存后的文档进行公文格式检查，结果以 check 事件推送
+	if in.FormatCheck {
+		checkEvent := &pb.SSECheckEvent{Result: buildCheckResponse(in.MessageId, merged)}
+		if err := stream.Send(&pb.EditDocumentResponse{Event: &pb.EditDocumentResponse_Check{Check: checkEvent}}); err != nil {
+			return fmt.Errorf("failed to send check event to client: %v:%w", err, xerr.ErrLLMApiCancel)
+		}
+	}
+
+	// 6. Send end event (same as before)
 	return stream.Send(&pb.EditDocumentResponse{
 		Event: &pb.EditDocumentResponse_End{
 			End: &pb.SSEEndEvent{
@@ -122,3 +185,22 @@ func (l *EditDocumentLogic) EditDocument(in *pb.EditDocumentRequest, stream pb.L
 		},
 	})
 }
+
+// userMessage 会话中记录的用户消息，处理批注时记录批注数与补充要求，而不是完整的修改提示
+func userMessage(prompt string, commentsCount int) string {
+	if commentsCount == 0 {
+		return prompt
+	}
+	msg := fmt.Sprintf("处理 %d 条未解决的批注", commentsCount)
+	if strings.TrimSpace(prompt) != "" {
+		msg += "，补充要求：" + prompt
+	}
+	return msg
+}
+
+func editDetail(commentsCount int) string {
+	if commentsCount == 0 {
+		return ""
+	}
+	return fmt.Sprintf("comments=%d", commentsCount)
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxAuditExportRows 单次导出的最大条数，超出时要求缩小查询范围，避免静默截断。
+// 单行 CSV 不超过约 1KB，API 侧 RPC 客户端的最大接收消息需与之匹配
+const maxAuditExportRows = 20000
+
+type ExportAuditLogsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportAuditLogsLogic {
+	return &ExportAuditLogsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ExportAuditLogs
+func (l *ExportAuditLogsLogic) ExportAuditLogs(in *pb.ExportAuditLogsRequest) (*pb.ExportAuditLogsResponse, error) {
+	filter, err := toAuditLogFilter(in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := l.svcCtx.AuditLogsModel.Count(l.ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ExportAuditLogs Count err:%+v: %w", err, xerr.ErrDbError)
+	}
+	if total > maxAuditExportRows {
+		return nil, fmt.Errorf("ExportAuditLogs too many rows (%d > %d), narrow the query: %w", total, maxAuditExportRows, xerr.ErrRequestParam)
+	}
+
+	logs, err := l.svcCtx.AuditLogsModel.FindList(l.ctx, filter, 0, maxAuditExportRows)
+	if err != nil {
+		return nil, fmt.Errorf("ExportAuditLogs FindList err:%+v: %w", err, xerr.ErrDbError)
+	}
+
+	var buf bytes.Buffer
+	if err := audit.WriteCSV(&buf, logs); err != nil {
+		return nil, fmt.Errorf("ExportAuditLogs write csv err:%+v: %w", err, xerr.ErrServerCommon)
+	}
+
+	return &pb.ExportAuditLogsResponse{
+		Filename:    fmt.Sprintf("audit_logs_%s.csv", time.Now().Format("20060102150405")),
+		ContentType: "text/csv; charset=utf-8",
+		Data:        buf.Bytes(),
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -44,12 +45,19 @@ func (l *FileUploadLogic) FileUpload(stream pb.LlmCenter_FileUploadServer) error
 		return fmt.Errorf("首个数据包必须包含 FileInfo")
 	}
 
+	// 上传到团队空间需要编辑权限
+	if info.WorkspaceId != workspace.Personal {
+		if err := checkWorkspaceAccess(l.ctx, l.svcCtx, info.UserId, info.WorkspaceId, workspace.Write); err != nil {
+			return err
+		}
+	}
+
 	fileName = info.FileName
 	fileID = uuid.New().String()
 	ext := filepath.Ext(fileName)
 	saveName := fileID + ext
 
-	err = l.svcCtx.FilesModel.InsertFile(l.ctx, fileName, saveName)
+	err = l.svcCtx.FilesModel.InsertFile(l.ctx, fileName, saveName, info.UserId, info.WorkspaceId)
 	if err != nil {
 		logx.Errorf("保存文件信息失败: %v", err)
 		return err
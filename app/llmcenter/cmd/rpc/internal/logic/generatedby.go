@@ -0,0 +1,21 @@
+package logic
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm"
+)
+
+// generatedBy 生成消息的 metadata，记录实际生成该内容的大模型提供方与模型，便于后续分析
+func generatedBy(client *llm.XingChenClient) sql.NullString {
+	providerName, model := client.Served()
+	if providerName == "" {
+		return sql.NullString{}
+	}
+	data, err := json.Marshal(map[string]string{"provider": providerName, "model": model})
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(data), Valid: true}
+}
@@ -7,7 +7,7 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
-	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -29,12 +29,9 @@ func NewGetConversationDetailLogic(ctx context.Context, svcCtx *svc.ServiceConte
 
 // RPC 方法: GetConversationDetail
 func (l *GetConversationDetailLogic) GetConversationDetail(in *pb.GetConversationDetailRequest) (*pb.GetConversationDetailResponse, error) {
-	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, in.ConversationId)
+	conversation, err := findAccessibleConversation(l.ctx, l.svcCtx, "GetConversationDetail", in.UserId, in.ConversationId, workspace.Read)
 	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, fmt.Errorf("会话不存在或无权限: %w, ConversationId: %s", xerr.ErrConversationNotFound, in.ConversationId)
-		}
-		return nil, fmt.Errorf("查询会话失败: %v ,ConversationId: %s: %w", err, in.ConversationId, xerr.ErrDbError)
+		return nil, err
 	}
 
 	// 2. 查询该会话下所有消息
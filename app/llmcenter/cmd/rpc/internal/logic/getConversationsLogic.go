@@ -8,7 +8,8 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
-
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -30,12 +31,21 @@ func NewGetConversationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 
 // RPC 方法: GetConversations
 func (l *GetConversationsLogic) GetConversations(in *pb.GetConversationsRequest) (*pb.GetConversationsResponse, error) {
-	// 1. 查询所有会话
+	// 1. 查询所有会话：个人空间只返回本人的会话，团队空间返回全部成员的会话
 	userId := strconv.FormatInt(in.UserId, 10)
 
-	convs, err := l.svcCtx.ConversationModel.FindAllByUser(l.ctx, userId)
+	var convs []*model.Conversations
+	var err error
+	if in.WorkspaceId == workspace.Personal {
+		convs, err = l.svcCtx.ConversationModel.FindAllByUser(l.ctx, userId)
+	} else {
+		if err := checkWorkspaceAccess(l.ctx, l.svcCtx, in.UserId, in.WorkspaceId, workspace.Read); err != nil {
+			return nil, err
+		}
+		convs, err = l.svcCtx.ConversationModel.FindAllByWorkspace(l.ctx, in.WorkspaceId)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("查询会话列表失败: %v, UserId: %s: %w", err, userId, xerr.ErrDbError)
+		return nil, fmt.Errorf("查询会话列表失败: %v, UserId: %s, WorkspaceId: %d: %w", err, userId, in.WorkspaceId, xerr.ErrDbError)
 	}
 
 	// 2. 组装返回
@@ -45,6 +55,7 @@ func (l *GetConversationsLogic) GetConversations(in *pb.GetConversationsRequest)
 			ConversationId: c.ConversationId,
 			Title:          c.Title,
 			UpdatedAt:      c.UpdatedAt.Format(time.RFC3339),
+			UserId:         c.UserId,
 		})
 	}
 
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/health"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDiagnosticsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDiagnosticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDiagnosticsLogic {
+	return &GetDiagnosticsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: GetDiagnostics
+func (l *GetDiagnosticsLogic) GetDiagnostics(in *pb.GetDiagnosticsRequest) (*pb.GetDiagnosticsResponse, error) {
+	build := health.ReadBuildInfo()
+	report := l.svcCtx.Health.Run(l.ctx)
+
+	resp := &pb.GetDiagnosticsResponse{
+		Name:      l.svcCtx.Config.Name,
+		GoVersion: build.GoVersion,
+		Revision:  build.Revision,
+		BuildTime: build.Time,
+		Status:    report.Status,
+	}
+	for _, r := range report.Checks {
+		resp.Checks = append(resp.Checks, &pb.HealthCheck{
+			Name:       r.Name,
+			Status:     r.Status,
+			Detail:     r.Detail,
+			Error:      r.Error,
+			DurationMs: r.DurationMs,
+		})
+	}
+	for _, issue := range configIssues(l.svcCtx.Config) {
+		resp.ConfigIssues = append(resp.ConfigIssues, &pb.ConfigIssue{Field: issue.Field, Message: issue.Message})
+	}
+	return resp, nil
+}
+
+// configIssues 检查启动时不会报错、但会在调用时才失败的配置；外部依赖与文件路径由依赖检查覆盖
+func configIssues(c config.Config) []health.Issue {
+	var issues []health.Issue
+	add := func(field, msg string) {
+		issues = append(issues, health.Issue{Field: field, Message: msg})
+	}
+
+	// 未配置 LlmProviders 时 XingChen 是唯一的提供方
+	if len(c.LlmProviders) == 0 {
+		if c.XingChen.FlowID == "" {
+			add("XingChen.FlowID", "empty")
+		}
+		if c.XingChen.ApiURL == "" {
+			add("XingChen.ApiURL", "empty")
+		}
+		if c.XingChen.ApiKey == "" || c.XingChen.ApiSecret == "" {
+			add("XingChen.ApiKey", "api key or secret is empty")
+		}
+	}
+	names := make(map[string]bool)
+	for i, p := range c.LlmProviders {
+		field := "LlmProviders[" + p.Name + "]"
+		if p.Name == "" {
+			field = "LlmProviders[" + strconv.Itoa(i) + "]"
+			add(field+".Name", "empty")
+		} else if names[p.Name] {
+			add(field+".Name", "duplicate provider name")
+		}
+		names[p.Name] = true
+		if p.ApiURL == "" {
+			add(field+".ApiURL", "empty")
+		}
+		if p.ApiKey == "" || p.ApiSecret == "" {
+			add(field+".ApiKey", "api key or secret is empty")
+		}
+		if p.FlowID == "" && c.XingChen.FlowID == "" {
+			add(field+".FlowID", "empty and XingChen.FlowID is not set")
+		}
+	}
+	if c.LlmApiClient.Timeout <= 0 {
+		add("LlmApiClient.Timeout", "not set, streaming requests never time out")
+	}
+
+	if c.Download.BaseURL == "" {
+		add("Download.BaseURL", "empty, download links are not usable")
+	}
+	if c.Download.SignKey == "" {
+		add("Download.SignKey", "empty, download links can be forged")
+	}
+	if c.Download.ExpireSeconds <= 0 {
+		add("Download.ExpireSeconds", "not positive, download links expire immediately")
+	}
+	if !filepath.IsAbs(c.Upload.BaseDir) {
+		add("Upload.BaseDir", "relative path, resolved against the working directory")
+	}
+
+	for _, wl := range c.Screening.WordLists {
+		if wl.File == "" {
+			continue
+		}
+		if _, err := os.Stat(wl.File); err != nil {
+			add("Screening.WordLists["+wl.Name+"].File", err.Error())
+		}
+	}
+	return issues
+}
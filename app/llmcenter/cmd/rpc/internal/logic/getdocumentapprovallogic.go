@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDocumentApprovalLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDocumentApprovalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDocumentApprovalLogic {
+	return &GetDocumentApprovalLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: GetDocumentApproval
+func (l *GetDocumentApprovalLogic) GetDocumentApproval(in *pb.GetDocumentApprovalRequest) (*pb.GetDocumentApprovalResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "GetDocumentApproval", in.UserId, in.ConversationId, in.MessageId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, doc.ConversationId)
+	if err != nil {
+		return nil, fmt.Errorf("GetDocumentApproval db conversation FindOne err:%+v, conversationId:%s: %w", err, doc.ConversationId, xerr.ErrDbError)
+	}
+
+	a, err := loadApproval(l.ctx, l.svcCtx, "GetDocumentApproval", doc)
+	if err != nil {
+		return nil, err
+	}
+	history, err := approvalHistory(l.ctx, l.svcCtx, "GetDocumentApproval", a)
+	if err != nil {
+		return nil, err
+	}
+	user, err := loadApprovalUser(l.ctx, l.svcCtx, in.UserId, conversation, a)
+	if err != nil {
+		return nil, fmt.Errorf("GetDocumentApproval: %w", err)
+	}
+
+	resp := &pb.GetDocumentApprovalResponse{Approval: toPbApproval(l.svcCtx.Approval, a, history, user)}
+	for _, h := range history {
+		resp.History = append(resp.History, toPbApprovalRecord(l.svcCtx.Approval, h))
+	}
+	return resp, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -28,6 +29,10 @@ func NewGetDocumentDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 
 // RPC 方法: GetDocumentDetail
 func (l *GetDocumentDetailLogic) GetDocumentDetail(in *pb.GetDocumentDetailRequest) (*pb.GetDocumentDetailResponse, error) {
+	if _, err := findAccessibleConversation(l.ctx, l.svcCtx, "GetDocumentDetail", in.UserId, in.ConversationId, workspace.Read); err != nil {
+		return nil, err
+	}
+
 	docs, err := l.svcCtx.DocumentsModel.FindByConversationId(l.ctx, in.ConversationId)
 	if err != nil {
 		return nil, fmt.Errorf("查询 documents 失败: %v: %w", err, xerr.ErrDbError)
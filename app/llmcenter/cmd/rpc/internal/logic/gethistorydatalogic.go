@@ -8,6 +8,7 @@ import (
 
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -34,6 +35,10 @@ func NewGetHistoryDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 
 // RPC 方法: GetHistoryData
 func (l *GetHistoryDataLogic) GetHistoryData(in *pb.GetHistoryDataRequest) (*pb.GetHistoryDataResponse, error) {
+	if _, err := findAccessibleConversation(l.ctx, l.svcCtx, "GetHistoryData", in.UserId, in.ConversationId, workspace.Read); err != nil {
+		return nil, err
+	}
+
 	items, err := l.svcCtx.HistoryDatasModel.FindByConversationId(l.ctx, in.ConversationId)
 	if err != nil {
 		return nil, fmt.Errorf("查询 historydatas 失败: %v: %w", err, xerr.ErrDbError)
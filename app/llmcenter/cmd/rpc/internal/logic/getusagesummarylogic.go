@@ -0,0 +1,128 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/usage"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxUsageSummaryDays 单次查询的最大天数
+const maxUsageSummaryDays = 366
+
+type GetUsageSummaryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUsageSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUsageSummaryLogic {
+	return &GetUsageSummaryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: GetUsageSummary
+func (l *GetUsageSummaryLogic) GetUsageSummary(in *pb.GetUsageSummaryRequest) (*pb.GetUsageSummaryResponse, error) {
+	now := time.Now()
+	start, err := parseUsageDate(in.StartDate, usage.MonthStart(now))
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseUsageDate(in.EndDate, usage.DayStart(now))
+	if err != nil {
+		return nil, err
+	}
+	// 结束日期包含在内
+	end = end.AddDate(0, 0, 1)
+	if !start.Before(end) || end.Sub(start) > maxUsageSummaryDays*24*time.Hour {
+		return nil, fmt.Errorf("invalid usage date range [%s, %s]: %w", in.StartDate, in.EndDate, xerr.ErrRequestParam)
+	}
+
+	rows, err := l.svcCtx.UsageModel.FindByUser(l.ctx, in.UserId, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("GetUsageSummary FindByUser err:%+v, userId:%d: %w", err, in.UserId, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.UsageItem, 0, len(rows))
+	total := &pb.UsageItem{}
+	var totalLatency int64
+	for _, row := range rows {
+		item := &pb.UsageItem{
+			Date:          row.UsageDate.Format(time.DateOnly),
+			CallType:      row.CallType,
+			RequestCount:  row.RequestCount,
+			SuccessCount:  row.SuccessCount,
+			FailureCount:  row.FailureCount,
+			PromptChars:   row.PromptChars,
+			ResponseChars: row.ResponseChars,
+		}
+		if row.RequestCount > 0 {
+			item.AvgLatencyMs = row.LatencyMs / row.RequestCount
+		}
+		items = append(items, item)
+
+		total.RequestCount += row.RequestCount
+		total.SuccessCount += row.SuccessCount
+		total.FailureCount += row.FailureCount
+		total.PromptChars += row.PromptChars
+		total.ResponseChars += row.ResponseChars
+		totalLatency += row.LatencyMs
+	}
+	if total.RequestCount > 0 {
+		total.AvgLatencyMs = totalLatency / total.RequestCount
+	}
+
+	quota, err := l.quotaStatus(in.UserId, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUsageSummaryResponse{
+		Items: items,
+		Total: total,
+		Quota: quota,
+	}, nil
+}
+
+// quotaStatus 当前生效配额及当天、当月的已用量
+func (l *GetUsageSummaryLogic) quotaStatus(userID int64, now time.Time) (*pb.QuotaStatus, error) {
+	limits, err := effectiveLimits(l.ctx, l.svcCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	today, month, err := currentUsage(l.ctx, l.svcCtx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QuotaStatus{
+		DailyRequestsUsed:    today.RequestCount,
+		DailyRequestsLimit:   limits.DailyRequests,
+		MonthlyRequestsUsed:  month.RequestCount,
+		MonthlyRequestsLimit: limits.MonthlyRequests,
+		DailyCharsUsed:       today.Chars,
+		DailyCharsLimit:      limits.DailyChars,
+		MonthlyCharsUsed:     month.Chars,
+		MonthlyCharsLimit:    limits.MonthlyChars,
+	}, nil
+}
+
+// parseUsageDate 解析 2006-01-02 格式的日期（服务器本地时区），为空时返回默认值
+func parseUsageDate(value string, def time.Time) (time.Time, error) {
+	if value == "" {
+		return def, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid usage date %q: %w", value, xerr.ErrRequestParam)
+	}
+	return t, nil
+}
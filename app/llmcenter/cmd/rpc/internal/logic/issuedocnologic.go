@@ -0,0 +1,38 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IssueDocNoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewIssueDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IssueDocNoLogic {
+	return &IssueDocNoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: IssueDocNo
+func (l *IssueDocNoLogic) IssueDocNo(in *pb.IssueDocNoRequest) (*pb.IssueDocNoResponse, error) {
+	n, err := findDocNumber(l.ctx, l.svcCtx, "IssueDocNo", in.UserId, in.Id, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	if err := updateDocNoStatus(l.ctx, l.svcCtx, "IssueDocNo", in.UserId, n, model.DocNoReserved, model.DocNoIssued); err != nil {
+		return nil, err
+	}
+	return &pb.IssueDocNoResponse{DocNo: toPbDocNumber(n)}, nil
+}
@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListApprovalTasksLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListApprovalTasksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListApprovalTasksLogic {
+	return &ListApprovalTasksLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListApprovalTasks
+// 指定审批人之后用户可能已被移出团队或文档已删除，这类审批不返回
+func (l *ListApprovalTasksLogic) ListApprovalTasks(in *pb.ListApprovalTasksRequest) (*pb.ListApprovalTasksResponse, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultApprovalTasksLimit
+	}
+	limit = min(limit, maxApprovalTasksLimit)
+
+	rows, err := l.svcCtx.DocumentApprovalsModel.FindByAssignee(l.ctx, in.UserId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ListApprovalTasks FindByAssignee err:%+v, userId:%d: %w", err, in.UserId, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.DocumentApproval, 0, len(rows))
+	for _, a := range rows {
+		conversation, err := findAccessibleConversation(l.ctx, l.svcCtx, "ListApprovalTasks", in.UserId, a.ConversationId, workspace.Read)
+		if err != nil {
+			continue
+		}
+		if _, err := l.svcCtx.DocRepo.FindDocument(l.ctx, a.MessageId); err != nil {
+			continue
+		}
+		item, err := l.approval(conversation, a)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &pb.ListApprovalTasksResponse{Items: items}, nil
+}
+
+func (l *ListApprovalTasksLogic) approval(conversation *model.Conversations, a *model.DocumentApprovals) (*pb.DocumentApproval, error) {
+	history, err := approvalHistory(l.ctx, l.svcCtx, "ListApprovalTasks", a)
+	if err != nil {
+		return nil, err
+	}
+	user, err := loadApprovalUser(l.ctx, l.svcCtx, a.AssigneeId, conversation, a)
+	if err != nil {
+		return nil, fmt.Errorf("ListApprovalTasks: %w", err)
+	}
+	return toPbApproval(l.svcCtx.Approval, a, history, user), nil
+}
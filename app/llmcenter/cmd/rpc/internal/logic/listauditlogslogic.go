@@ -0,0 +1,107 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultAuditPageSize = 20
+	maxAuditPageSize     = 100
+)
+
+type ListAuditLogsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuditLogsLogic {
+	return &ListAuditLogsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListAuditLogs
+func (l *ListAuditLogsLogic) ListAuditLogs(in *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	filter, err := toAuditLogFilter(in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+
+	total, err := l.svcCtx.AuditLogsModel.Count(l.ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ListAuditLogs Count err:%+v: %w", err, xerr.ErrDbError)
+	}
+	logs, err := l.svcCtx.AuditLogsModel.FindList(l.ctx, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("ListAuditLogs FindList err:%+v: %w", err, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.AuditLog, 0, len(logs))
+	for _, log := range logs {
+		items = append(items, &pb.AuditLog{
+			Id:             log.Id,
+			UserId:         log.UserId,
+			Action:         log.Action,
+			ConversationId: log.ConversationId,
+			TargetId:       log.TargetId,
+			ClientIp:       log.ClientIp,
+			UserAgent:      log.UserAgent,
+			HashBefore:     log.HashBefore,
+			HashAfter:      log.HashAfter,
+			Detail:         log.Detail,
+			CreatedAt:      log.CreatedAt.Format(audit.TimeLayout),
+		})
+	}
+
+	return &pb.ListAuditLogsResponse{Total: total, Items: items}, nil
+}
+
+// toAuditLogFilter 校验查询条件并转换为 model 层过滤器
+func toAuditLogFilter(q *pb.AuditLogQuery) (model.AuditLogFilter, error) {
+	var filter model.AuditLogFilter
+	if q == nil {
+		return filter, nil
+	}
+	if q.Action != "" && !slices.Contains(audit.Actions, q.Action) {
+		return filter, fmt.Errorf("unknown audit action %q: %w", q.Action, xerr.ErrRequestParam)
+	}
+	if q.StartTime > 0 && q.EndTime > 0 && q.StartTime >= q.EndTime {
+		return filter, fmt.Errorf("invalid audit time range [%d, %d): %w", q.StartTime, q.EndTime, xerr.ErrRequestParam)
+	}
+
+	filter.UserId = q.UserId
+	filter.ConversationId = q.ConversationId
+	filter.Action = q.Action
+	if q.StartTime > 0 {
+		filter.StartTime = time.Unix(q.StartTime, 0)
+	}
+	if q.EndTime > 0 {
+		filter.EndTime = time.Unix(q.EndTime, 0)
+	}
+	return filter, nil
+}
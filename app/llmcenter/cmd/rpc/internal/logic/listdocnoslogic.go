@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDocNosLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListDocNosLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDocNosLogic {
+	return &ListDocNosLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListDocNos
+func (l *ListDocNosLogic) ListDocNos(in *pb.ListDocNosRequest) (*pb.ListDocNosResponse, error) {
+	if in.WorkspaceId == workspace.Personal {
+		return nil, fmt.Errorf("ListDocNos doc no requires a workspace: %w", xerr.ErrRequestParam)
+	}
+	switch in.Status {
+	case "", model.DocNoReserved, model.DocNoIssued, model.DocNoReleased:
+	default:
+		return nil, fmt.Errorf("ListDocNos unknown status %q: %w", in.Status, xerr.ErrRequestParam)
+	}
+	if err := checkWorkspaceAccess(l.ctx, l.svcCtx, in.UserId, in.WorkspaceId, workspace.Read); err != nil {
+		return nil, fmt.Errorf("ListDocNos: %w", err)
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultDocNoPageSize
+	}
+	if pageSize > maxDocNoPageSize {
+		pageSize = maxDocNoPageSize
+	}
+
+	filter := model.DocNumberFilter{
+		WorkspaceId: in.WorkspaceId,
+		Prefix:      strings.TrimSpace(in.Prefix),
+		Year:        in.Year,
+		Status:      in.Status,
+	}
+	total, err := l.svcCtx.DocNumbersModel.Count(l.ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ListDocNos Count err:%+v: %w", err, xerr.ErrDbError)
+	}
+	rows, err := l.svcCtx.DocNumbersModel.FindList(l.ctx, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("ListDocNos FindList err:%+v: %w", err, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.DocNumber, 0, len(rows))
+	for _, n := range rows {
+		items = append(items, toPbDocNumber(n))
+	}
+	return &pb.ListDocNosResponse{Total: total, Items: items}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDocumentCommentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListDocumentCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDocumentCommentsLogic {
+	return &ListDocumentCommentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListDocumentComments
+func (l *ListDocumentCommentsLogic) ListDocumentComments(in *pb.ListDocumentCommentsRequest) (*pb.ListDocumentCommentsResponse, error) {
+	if in.Status != "" && in.Status != commentStatusOpen && in.Status != commentStatusResolved {
+		return nil, fmt.Errorf("ListDocumentComments invalid status %q: %w", in.Status, xerr.ErrRequestParam)
+	}
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "ListDocumentComments", in.UserId, in.ConversationId, in.MessageId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, mentions, err := loadComments(l.ctx, l.svcCtx, "ListDocumentComments", doc)
+	if err != nil {
+		return nil, err
+	}
+	var openCount int64
+	for _, c := range comments {
+		if c.ParentId == 0 && c.Status == commentStatusOpen {
+			openCount++
+		}
+	}
+
+	return &pb.ListDocumentCommentsResponse{
+		Items:     commentThreads(comments, mentions, in.Status),
+		OpenCount: openCount,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDocumentSharesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListDocumentSharesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDocumentSharesLogic {
+	return &ListDocumentSharesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListDocumentShares
+// 分享链接本身即访问凭证，与创建一样需要修改权限
+func (l *ListDocumentSharesLogic) ListDocumentShares(in *pb.ListDocumentSharesRequest) (*pb.ListDocumentSharesResponse, error) {
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "ListDocumentShares", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := l.svcCtx.DocumentSharesModel.FindByMessageId(l.ctx, doc.MessageId)
+	if err != nil {
+		return nil, fmt.Errorf("ListDocumentShares FindByMessageId err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.DocumentShare, 0, len(shares))
+	for _, s := range shares {
+		items = append(items, toPbDocumentShare(l.svcCtx.Config, s))
+	}
+	return &pb.ListDocumentSharesResponse{Items: items}, nil
+}
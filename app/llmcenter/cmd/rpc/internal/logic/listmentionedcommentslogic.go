@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMentionedCommentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListMentionedCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMentionedCommentsLogic {
+	return &ListMentionedCommentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListMentionedComments
+// 提及之后用户可能已被移出团队或文档已删除，这类批注不返回
+func (l *ListMentionedCommentsLogic) ListMentionedComments(in *pb.ListMentionedCommentsRequest) (*pb.ListMentionedCommentsResponse, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultMentionsLimit
+	}
+	limit = min(limit, maxMentionsLimit)
+
+	rows, err := l.svcCtx.DocumentCommentsModel.FindMentioned(l.ctx, in.UserId, in.OpenOnly, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ListMentionedComments FindMentioned err:%+v, userId:%d: %w", err, in.UserId, xerr.ErrDbError)
+	}
+
+	// 按会话与文档缓存校验结果，同一文档的多条批注只校验一次
+	conversations := make(map[string]bool)
+	documents := make(map[string]bool)
+	items := make([]*pb.DocumentComment, 0, len(rows))
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ok, checked := conversations[row.ConversationId]
+		if !checked {
+			_, err := findAccessibleConversation(l.ctx, l.svcCtx, "ListMentionedComments", in.UserId, row.ConversationId, workspace.Read)
+			ok = err == nil
+			conversations[row.ConversationId] = ok
+		}
+		if !ok {
+			continue
+		}
+		if ok, checked = documents[row.MessageId]; !checked {
+			_, err := l.svcCtx.DocRepo.FindDocument(l.ctx, row.MessageId)
+			ok = err == nil
+			documents[row.MessageId] = ok
+		}
+		if !ok {
+			continue
+		}
+		items = append(items, toPbComment(&row.DocumentComments, nil, row.ThreadStatus))
+		ids = append(ids, row.Id)
+	}
+
+	mentions, err := commentMentions(l.ctx, l.svcCtx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("ListMentionedComments FindMentions err:%+v: %w", err, xerr.ErrDbError)
+	}
+	for _, item := range items {
+		item.MentionUserIds = mentions[item.Id]
+	}
+	return &pb.ListMentionedCommentsResponse{Items: items}, nil
+}
@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUsageQuotasLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUsageQuotasLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUsageQuotasLogic {
+	return &ListUsageQuotasLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ListUsageQuotas
+func (l *ListUsageQuotasLogic) ListUsageQuotas(in *pb.ListUsageQuotasRequest) (*pb.ListUsageQuotasResponse, error) {
+	quotas, err := l.svcCtx.UsageQuotaModel.FindAll(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ListUsageQuotas FindAll err:%+v: %w", err, xerr.ErrDbError)
+	}
+
+	items := make([]*pb.UsageQuota, 0, len(quotas))
+	for _, q := range quotas {
+		items = append(items, toPbUsageQuota(q))
+	}
+	return &pb.ListUsageQuotasResponse{Items: items}, nil
+}
@@ -0,0 +1,170 @@
+package logic
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/password"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OpenDocumentShareLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOpenDocumentShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OpenDocumentShareLogic {
+	return &OpenDocumentShareLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: OpenDocumentShare
+// 匿名访问，不校验用户身份；导出时使用分享创建者的用户资料作为版头
+func (l *OpenDocumentShareLogic) OpenDocumentShare(in *pb.OpenDocumentShareRequest) (*pb.OpenDocumentShareResponse, error) {
+	format := strings.ToLower(strings.TrimSpace(in.Format))
+	if format != "html" && format != "docx" && format != "pdf" {
+		return nil, fmt.Errorf("OpenDocumentShare unsupported format %q: %w", in.Format, xerr.ErrRequestParam)
+	}
+
+	// 1. 按客户端 IP 与链接限制访问频率，再校验链接状态
+	if err := takeShareQuota(l.ctx, l.svcCtx, in.Token); err != nil {
+		return nil, fmt.Errorf("OpenDocumentShare: %w", err)
+	}
+	share, err := l.svcCtx.DocumentSharesModel.FindOneByToken(l.ctx, in.Token)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("OpenDocumentShare share not found: %w", xerr.ErrShareNotFound)
+		}
+		return nil, fmt.Errorf("OpenDocumentShare FindOneByToken err:%+v: %w", err, xerr.ErrDbError)
+	}
+	switch shareStatus(share, time.Now()) {
+	case shareStatusRevoked:
+		return nil, fmt.Errorf("OpenDocumentShare share %d revoked: %w", share.Id, xerr.ErrShareRevoked)
+	case shareStatusExpired:
+		return nil, fmt.Errorf("OpenDocumentShare share %d expired: %w", share.Id, xerr.ErrShareExpired)
+	}
+	if format != "html" && share.AllowDownload != 1 {
+		return nil, fmt.Errorf("OpenDocumentShare share %d: %w", share.Id, xerr.ErrShareDownloadDisabled)
+	}
+
+	// 2. 校验访问密码
+	resp := &pb.OpenDocumentShareResponse{AllowDownload: share.AllowDownload == 1, ExpireAt: share.ExpireAt.Unix()}
+	if share.PasswordHash != "" {
+		if err := l.checkPassword(share, in.Password, in.Unlock); err != nil {
+			return nil, err
+		}
+		resp.Unlock = shareUnlock(l.svcCtx.Config, share)
+	}
+
+	// 3. 读取文档，文档删除后链接随之失效
+	doc, err := l.svcCtx.DocRepo.FindDocument(l.ctx, share.MessageId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("OpenDocumentShare document %s of share %d deleted: %w", share.MessageId, share.Id, xerr.ErrShareNotFound)
+		}
+		return nil, fmt.Errorf("OpenDocumentShare FindDocument err:%+v, messageId:%s: %w", err, share.MessageId, xerr.ErrDbError)
+	}
+	resp.Title = documentTitle(doc.Content)
+
+	// 4. 渲染或导出（同一版本的文档使用缓存），并累计次数
+	entry := audit.Entry{
+		Action:         audit.ActionShareAccess,
+		ConversationId: share.ConversationId,
+		TargetId:       share.MessageId,
+		HashBefore:     audit.Hash(doc.Content),
+		Detail:         fmt.Sprintf("share=%d,format=%s", share.Id, format),
+	}
+	if format == "html" {
+		html, err := renderShareCached(l.ctx, l.svcCtx, format, audit.Hash(doc.Content), func() ([]byte, error) {
+			html, err := renderShareHTML(l.ctx, doc.Content)
+			return []byte(html), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Html = string(html)
+		if err := l.svcCtx.DocumentSharesModel.IncrViewCount(l.ctx, share.Id); err != nil {
+			l.Errorf("OpenDocumentShare IncrViewCount err:%v, share:%d", err, share.Id)
+		}
+	} else {
+		input := prepareExport(l.ctx, l.svcCtx, share.UserId, doc.ConversationId, doc.MessageId, doc.Content, format, nil)
+		if resp.Data, err = renderShareCached(l.ctx, l.svcCtx, format, input.hash(), func() ([]byte, error) {
+			return input.run(l.ctx, l.svcCtx)
+		}); err != nil {
+			return nil, err
+		}
+		resp.Filename = shareFilename(resp.Title) + "." + format
+		resp.ContentType = exportContentTypes[format]
+		entry.HashAfter = audit.HashBytes(resp.Data)
+		if err := l.svcCtx.DocumentSharesModel.IncrDownloadCount(l.ctx, share.Id); err != nil {
+			l.Errorf("OpenDocumentShare IncrDownloadCount err:%v, share:%d", err, share.Id)
+		}
+	}
+	l.svcCtx.Auditor.Record(l.ctx, entry)
+
+	return resp, nil
+}
+
+// checkPassword 校验访问密码或之前下发的凭证；同一链接在时间窗口内输错次数过多时暂时锁定
+func (l *OpenDocumentShareLogic) checkPassword(share *model.DocumentShares, pwd, unlock string) error {
+	if unlock != "" && hmac.Equal([]byte(unlock), []byte(shareUnlock(l.svcCtx.Config, share))) {
+		return nil
+	}
+	if pwd == "" {
+		return fmt.Errorf("OpenDocumentShare share %d: %w", share.Id, xerr.ErrSharePasswordRequired)
+	}
+
+	key := sharePwdFailureKeyPrefix + share.Token
+	failures, err := l.svcCtx.RedisClient.GetCtx(l.ctx, key)
+	if err != nil {
+		l.Errorf("OpenDocumentShare get password failures err:%v, share:%d", err, share.Id)
+	}
+	if n, _ := strconv.ParseInt(failures, 10, 64); n >= shareMaxPasswordFailures(l.svcCtx.Config) {
+		return fmt.Errorf("OpenDocumentShare share %d locked after %d password failures: %w", share.Id, n, xerr.ErrSharePasswordLocked)
+	}
+
+	ok, _, err := password.Verify(pwd, share.PasswordHash)
+	if err != nil {
+		return fmt.Errorf("OpenDocumentShare verify password err:%v, share:%d: %w", err, share.Id, xerr.ErrServerCommon)
+	}
+	if !ok {
+		if n, err := l.svcCtx.RedisClient.IncrCtx(l.ctx, key); err != nil {
+			l.Errorf("OpenDocumentShare incr password failures err:%v, share:%d", err, share.Id)
+		} else if n == 1 {
+			_ = l.svcCtx.RedisClient.ExpireCtx(l.ctx, key, sharePwdFailureWindow)
+		}
+		return fmt.Errorf("OpenDocumentShare share %d: %w", share.Id, xerr.ErrSharePasswordIncorrect)
+	}
+	return nil
+}
+
+// shareFilename 以文档标题作为下载文件名，去掉文件名中不允许的字符
+func shareFilename(title string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < ' ' {
+			return -1
+		}
+		return r
+	}, title)
+	if r := []rune(strings.TrimSpace(name)); len(r) > 50 {
+		name = string(r[:50])
+	}
+	if name = strings.TrimSpace(name); name == "" {
+		return "document"
+	}
+	return name
+}
@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/usage"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// checkQuota 在发起大模型调用前校验用户的用量配额，达到每日或每月上限时返回对应错误
+func checkQuota(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) error {
+	limits, err := effectiveLimits(ctx, svcCtx, userID)
+	if err != nil {
+		return err
+	}
+	if limits.Unlimited() {
+		return nil
+	}
+
+	today, month, err := currentUsage(ctx, svcCtx, userID, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := limits.Check(today, month); err != nil {
+		return fmt.Errorf("checkQuota userId:%d, limits:%+v, today:%+v, month:%+v: %w", userID, limits, today, month, err)
+	}
+	return nil
+}
+
+// effectiveLimits 读取用户当前生效的配额
+func effectiveLimits(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (usage.Limits, error) {
+	userQuota, err := svcCtx.UsageQuotaModel.FindOneBySubjectTypeSubject(ctx, model.QuotaSubjectUser, strconv.FormatInt(userID, 10))
+	if err == nil {
+		return usage.Resolve(userQuota, nil, nil), nil
+	}
+	if err != model.ErrNotFound {
+		return usage.Limits{}, fmt.Errorf("find user quota err:%+v, userId:%d: %w", err, userID, xerr.ErrDbError)
+	}
+
+	roles := loadUserRoles(ctx, svcCtx, userID)
+	roleQuotas, err := svcCtx.UsageQuotaModel.FindBySubjects(ctx, model.QuotaSubjectRole, roles)
+	if err != nil {
+		return usage.Limits{}, fmt.Errorf("find role quotas err:%+v, userId:%d: %w", err, userID, xerr.ErrDbError)
+	}
+	return usage.Resolve(nil, roles, roleQuotas), nil
+}
+
+// loadUserRoles 读取用户角色。读取失败时按普通用户处理，避免用户中心故障导致无法生成
+func loadUserRoles(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) []string {
+	resp, err := svcCtx.UsercenterRpc.GetUserInfo(ctx, &usercenter.GetUserInfoReq{Id: userID})
+	if err != nil || resp.User == nil {
+		logx.WithContext(ctx).Errorf("load user roles failed, userId:%d, err:%v", userID, err)
+		return []string{authz.RoleUser}
+	}
+	return resp.User.Roles
+}
+
+// currentUsage 统计用户当天与当月的用量
+func currentUsage(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, now time.Time) (today, month *model.UsageTotal, err error) {
+	tomorrow := usage.DayStart(now).AddDate(0, 0, 1)
+	today, err = svcCtx.UsageModel.SumByUser(ctx, userID, usage.DayStart(now), tomorrow)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sum daily usage err:%+v, userId:%d: %w", err, userID, xerr.ErrDbError)
+	}
+	month, err = svcCtx.UsageModel.SumByUser(ctx, userID, usage.MonthStart(now), tomorrow)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sum monthly usage err:%+v, userId:%d: %w", err, userID, xerr.ErrDbError)
+	}
+	return today, month, nil
+}
+
+func toPbUsageQuota(q *model.UsageQuota) *pb.UsageQuota {
+	return &pb.UsageQuota{
+		Id:              q.Id,
+		SubjectType:     q.SubjectType,
+		Subject:         q.Subject,
+		DailyRequests:   q.DailyRequests,
+		MonthlyRequests: q.MonthlyRequests,
+		DailyChars:      q.DailyChars,
+		MonthlyChars:    q.MonthlyChars,
+		UpdatedAt:       q.UpdatedAt.Format(audit.TimeLayout),
+	}
+}
@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/workspace"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseDocNoLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseDocNoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseDocNoLogic {
+	return &ReleaseDocNoLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ReleaseDocNo
+// 只能释放预留中的字号，已使用的字号不能释放，避免同一字号出现在两份公文上
+func (l *ReleaseDocNoLogic) ReleaseDocNo(in *pb.ReleaseDocNoRequest) (*pb.ReleaseDocNoResponse, error) {
+	n, err := findDocNumber(l.ctx, l.svcCtx, "ReleaseDocNo", in.UserId, in.Id, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	if err := updateDocNoStatus(l.ctx, l.svcCtx, "ReleaseDocNo", in.UserId, n, model.DocNoReserved, model.DocNoReleased); err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseDocNoResponse{DocNo: toPbDocNumber(n)}, nil
+}
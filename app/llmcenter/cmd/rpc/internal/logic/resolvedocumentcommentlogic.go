@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveDocumentCommentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResolveDocumentCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveDocumentCommentLogic {
+	return &ResolveDocumentCommentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: ResolveDocumentComment
+// 批注的发表者或具备文档修改权限的用户可以标记解决、重新打开
+func (l *ResolveDocumentCommentLogic) ResolveDocumentComment(in *pb.ResolveDocumentCommentRequest) (*pb.ResolveDocumentCommentResponse, error) {
+	comment, err := l.svcCtx.DocumentCommentsModel.FindOne(l.ctx, in.Id)
+	if err == nil && comment.ParentId != 0 {
+		comment, err = l.svcCtx.DocumentCommentsModel.FindOne(l.ctx, comment.ParentId)
+	}
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("ResolveDocumentComment comment %d not found: %w", in.Id, xerr.ErrCommentNotFound)
+		}
+		return nil, fmt.Errorf("ResolveDocumentComment FindOne err:%+v, id:%d: %w", err, in.Id, xerr.ErrDbError)
+	}
+
+	conversation, err := findAccessibleConversation(l.ctx, l.svcCtx, "ResolveDocumentComment", in.UserId, comment.ConversationId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserId != in.UserId {
+		if err := checkConversationAccess(l.ctx, l.svcCtx, in.UserId, conversation, workspace.Write); err != nil {
+			return nil, fmt.Errorf("ResolveDocumentComment: %w", err)
+		}
+	}
+
+	status, resolvedBy, op := commentStatusOpen, int64(0), "reopen"
+	if in.Resolved {
+		status, resolvedBy, op = commentStatusResolved, in.UserId, "resolve"
+	}
+	if err := l.svcCtx.DocumentCommentsModel.UpdateStatus(l.ctx, comment.Id, status, resolvedBy); err != nil {
+		return nil, fmt.Errorf("ResolveDocumentComment UpdateStatus err:%+v, id:%d: %w", err, comment.Id, xerr.ErrDbError)
+	}
+	comment.Status, comment.ResolvedBy, comment.ResolvedAt = status, resolvedBy, sql.NullTime{Time: time.Now(), Valid: in.Resolved}
+
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionComment,
+		ConversationId: comment.ConversationId,
+		TargetId:       comment.MessageId,
+		Detail:         fmt.Sprintf("op=%s,comment=%d", op, comment.Id),
+	})
+
+	mentions, err := commentMentions(l.ctx, l.svcCtx, []int64{comment.Id})
+	if err != nil {
+		l.Errorf("ResolveDocumentComment FindMentions err:%v, id:%d", err, comment.Id)
+	}
+	return &pb.ResolveDocumentCommentResponse{Comment: toPbComment(comment, mentions[comment.Id], status)}, nil
+}
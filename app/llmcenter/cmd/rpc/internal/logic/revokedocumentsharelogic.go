@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeDocumentShareLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeDocumentShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeDocumentShareLogic {
+	return &RevokeDocumentShareLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: RevokeDocumentShare
+// 按会话校验权限，文档删除后仍可撤销其分享链接
+func (l *RevokeDocumentShareLogic) RevokeDocumentShare(in *pb.RevokeDocumentShareRequest) (*pb.RevokeDocumentShareResponse, error) {
+	share, err := l.svcCtx.DocumentSharesModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, fmt.Errorf("RevokeDocumentShare share not found, id:%d: %w", in.Id, xerr.ErrShareNotFound)
+		}
+		return nil, fmt.Errorf("RevokeDocumentShare FindOne err:%+v, id:%d: %w", err, in.Id, xerr.ErrDbError)
+	}
+	if _, err := findAccessibleConversation(l.ctx, l.svcCtx, "RevokeDocumentShare", in.UserId, share.ConversationId, workspace.Write); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.DocumentSharesModel.Revoke(l.ctx, share.Id); err != nil {
+		return nil, fmt.Errorf("RevokeDocumentShare Revoke err:%+v, id:%d: %w", err, share.Id, xerr.ErrDbError)
+	}
+
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionShare,
+		ConversationId: share.ConversationId,
+		TargetId:       share.MessageId,
+		Detail:         fmt.Sprintf("op=revoke,share=%d", share.Id),
+	})
+
+	return &pb.RevokeDocumentShareResponse{Success: true}, nil
+}
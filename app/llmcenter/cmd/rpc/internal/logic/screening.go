@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/cmd/rpc/types"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// redactionHint 引用文本或历史消息经过脱敏时追加到提示词末尾，要求模型原样保留占位符，便于还原
+const redactionHint = "\n\n注意：参考内容中形如 {{姓名1}}、{{手机号1}} 的占位符代表真实个人信息，如需引用请原样保留占位符，不要改写或编造。"
+
+// screenText 在文本发往大模型前进行敏感词与涉密信息筛查。
+// 命中拦截策略时返回 ErrContentBlocked；命中脱敏策略时返回脱敏后的文本。
+func screenText(ctx context.Context, svcCtx *svc.ServiceContext, stage, field string, userID int64, conversationID, text string) (string, error) {
+	res := svcCtx.Screener.Screen(text)
+	screening.Audit(ctx, stage, res,
+		logx.Field("userId", userID),
+		logx.Field("conversationId", conversationID),
+		logx.Field("field", field),
+	)
+	if res.Blocked() {
+		return "", fmt.Errorf("screenText %s blocked, userId:%d, conversationId:%s: %w", field, userID, conversationID, xerr.ErrContentBlocked)
+	}
+	return res.Text, nil
+}
+
+// redactHistory 将最近的历史消息转换为大模型请求中的历史，其中的个人信息替换为占位符。
+// 助手回复保存时已还原占位符，不脱敏会在后续每轮对话中将原文发给大模型
+func redactHistory(redactor *screening.Redactor, history []*pb.Message) []types.LLMMessage {
+	apiHistory := make([]types.LLMMessage, 0, len(history))
+	for _, msg := range history {
+		apiHistory = append(apiHistory, types.LLMMessage{
+			Role:        msg.Role,
+			ContentType: "text",
+			Content:     redactor.Redact(msg.Content),
+		})
+	}
+	return apiHistory
+}
@@ -0,0 +1,91 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxQuotaRoleLen 角色编码最大长度，与 usercenter role.code 一致
+const maxQuotaRoleLen = 32
+
+type SetUsageQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUsageQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUsageQuotaLogic {
+	return &SetUsageQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: SetUsageQuota
+func (l *SetUsageQuotaLogic) SetUsageQuota(in *pb.SetUsageQuotaRequest) (*pb.SetUsageQuotaResponse, error) {
+	data, err := toUsageQuota(in.Quota)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := l.svcCtx.UsageQuotaModel.FindOneBySubjectTypeSubject(l.ctx, data.SubjectType, data.Subject)
+	switch err {
+	case nil:
+		data.Id = existing.Id
+		err = l.svcCtx.UsageQuotaModel.Update(l.ctx, data)
+	case model.ErrNotFound:
+		_, err = l.svcCtx.UsageQuotaModel.Insert(l.ctx, data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("SetUsageQuota save err:%+v, quota:%+v: %w", err, data, xerr.ErrDbError)
+	}
+
+	saved, err := l.svcCtx.UsageQuotaModel.FindOneBySubjectTypeSubject(l.ctx, data.SubjectType, data.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("SetUsageQuota reload err:%+v, quota:%+v: %w", err, data, xerr.ErrDbError)
+	}
+	return &pb.SetUsageQuotaResponse{Quota: toPbUsageQuota(saved)}, nil
+}
+
+// toUsageQuota 校验配额参数：用户级配额的对象为用户ID，角色级配额的对象为角色编码，各上限不能为负数
+func toUsageQuota(q *pb.UsageQuota) (*model.UsageQuota, error) {
+	if q == nil {
+		return nil, fmt.Errorf("quota is empty: %w", xerr.ErrRequestParam)
+	}
+	subject := strings.TrimSpace(q.Subject)
+	switch q.SubjectType {
+	case model.QuotaSubjectUser:
+		if id, err := strconv.ParseInt(subject, 10, 64); err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid user id %q: %w", q.Subject, xerr.ErrRequestParam)
+		}
+	case model.QuotaSubjectRole:
+		if subject == "" || utf8.RuneCountInString(subject) > maxQuotaRoleLen {
+			return nil, fmt.Errorf("invalid role code %q: %w", q.Subject, xerr.ErrRequestParam)
+		}
+	default:
+		return nil, fmt.Errorf("unknown quota subject type %q: %w", q.SubjectType, xerr.ErrRequestParam)
+	}
+	if q.DailyRequests < 0 || q.MonthlyRequests < 0 || q.DailyChars < 0 || q.MonthlyChars < 0 {
+		return nil, fmt.Errorf("quota limits must not be negative, quota:%+v: %w", q, xerr.ErrRequestParam)
+	}
+
+	return &model.UsageQuota{
+		SubjectType:     q.SubjectType,
+		Subject:         subject,
+		DailyRequests:   q.DailyRequests,
+		MonthlyRequests: q.MonthlyRequests,
+		DailyChars:      q.DailyChars,
+		MonthlyChars:    q.MonthlyChars,
+	}, nil
+}
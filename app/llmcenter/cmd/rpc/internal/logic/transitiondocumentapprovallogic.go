@@ -0,0 +1,151 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/approval"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TransitionDocumentApprovalLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTransitionDocumentApprovalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransitionDocumentApprovalLogic {
+	return &TransitionDocumentApprovalLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RPC 方法: TransitionDocumentApproval
+// 能查看文档的用户即可调用（审批人通常只有查看权限），能否执行该操作由流程配置的角色决定
+func (l *TransitionDocumentApprovalLogic) TransitionDocumentApproval(in *pb.TransitionDocumentApprovalRequest) (*pb.TransitionDocumentApprovalResponse, error) {
+	comment := strings.TrimSpace(in.Comment)
+	if len([]rune(comment)) > maxApprovalCommentLength {
+		return nil, fmt.Errorf("TransitionDocumentApproval comment longer than %d: %w", maxApprovalCommentLength, xerr.ErrRequestParam)
+	}
+
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "TransitionDocumentApproval", in.UserId, in.ConversationId, in.MessageId, workspace.Read)
+	if err != nil {
+		return nil, err
+	}
+	conversation, err := l.svcCtx.ConversationModel.FindOne(l.ctx, doc.ConversationId)
+	if err != nil {
+		return nil, fmt.Errorf("TransitionDocumentApproval db conversation FindOne err:%+v, conversationId:%s: %w", err, doc.ConversationId, xerr.ErrDbError)
+	}
+	a, err := loadApproval(l.ctx, l.svcCtx, "TransitionDocumentApproval", doc)
+	if err != nil {
+		return nil, err
+	}
+	if in.Version != 0 && in.Version != a.Version {
+		return nil, fmt.Errorf("TransitionDocumentApproval version %d, current %d: %w", in.Version, a.Version, xerr.ErrApprovalConflict)
+	}
+
+	// 1) 校验当前状态可以执行该操作，且用户具备所需角色
+	w := l.svcCtx.Approval
+	t, ok := w.Transition(a.State, in.Action)
+	if !ok {
+		return nil, fmt.Errorf("TransitionDocumentApproval action %q not allowed in state %s: %w", in.Action, a.State, xerr.ErrApprovalActionInvalid)
+	}
+	user, err := loadApprovalUser(l.ctx, l.svcCtx, in.UserId, conversation, a)
+	if err != nil {
+		return nil, fmt.Errorf("TransitionDocumentApproval: %w", err)
+	}
+	if !t.Allowed(user.roles) {
+		return nil, fmt.Errorf("TransitionDocumentApproval user %d with roles %v cannot %s: %w", in.UserId, user.roles, t.Action, xerr.ErrApprovalPermissionDenied)
+	}
+	if t.CommentRequired && comment == "" {
+		return nil, fmt.Errorf("TransitionDocumentApproval %s without comment: %w", t.Action, xerr.ErrApprovalCommentRequired)
+	}
+
+	// 2) 进入须指定审批人的状态时校验审批人
+	var assigneeID int64
+	if to, _ := w.State(t.To); to.Assignee {
+		if err := l.checkAssignee(conversation, in.UserId, in.AssigneeId); err != nil {
+			return nil, err
+		}
+		assigneeID = in.AssigneeId
+	}
+
+	// 3) 保存流转，记录流转时的文档内容，导出时据此判断是否标注审批信息；
+	// 协同编辑中尚未保存的修改先保存，记录的内容与流转时的最终内容一致
+	if err := l.svcCtx.Collab.Flush(l.ctx, doc.MessageId); err != nil {
+		return nil, fmt.Errorf("TransitionDocumentApproval Flush err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+	if doc, err = l.svcCtx.DocRepo.FindDocument(l.ctx, doc.MessageId); err != nil {
+		return nil, fmt.Errorf("TransitionDocumentApproval FindDocument err:%+v, messageId:%s: %w", err, in.MessageId, xerr.ErrDbError)
+	}
+	from := a.State
+	a.State, a.AssigneeId, a.ContentHash = t.To, assigneeID, audit.Hash(doc.Content)
+	if from == w.Initial() {
+		a.SubmitterId = in.UserId
+	}
+	history := &model.DocumentApprovalHistory{
+		MessageId:   doc.MessageId,
+		Action:      t.Action,
+		FromState:   from,
+		ToState:     t.To,
+		UserId:      in.UserId,
+		UserName:    user.name,
+		AssigneeId:  assigneeID,
+		Comment:     comment,
+		ContentHash: a.ContentHash,
+	}
+	if err := l.svcCtx.DocumentApprovalsModel.Transition(l.ctx, a, history); err != nil {
+		if errors.Is(err, model.ErrVersionConflict) {
+			return nil, fmt.Errorf("TransitionDocumentApproval messageId:%s: %w", doc.MessageId, xerr.ErrApprovalConflict)
+		}
+		return nil, fmt.Errorf("TransitionDocumentApproval Transition err:%+v, messageId:%s: %w", err, doc.MessageId, xerr.ErrDbError)
+	}
+	a.UpdatedAt = time.Now()
+	// 正在协同编辑的连接随即按新状态锁定或解锁
+	l.svcCtx.Collab.SetLocked(l.ctx, doc.MessageId, w.Locked(t.To))
+
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionApproval,
+		ConversationId: doc.ConversationId,
+		TargetId:       doc.MessageId,
+		HashBefore:     a.ContentHash,
+		Detail:         fmt.Sprintf("action=%s,from=%s,to=%s,assignee=%d", t.Action, from, t.To, assigneeID),
+	})
+
+	records, err := approvalHistory(l.ctx, l.svcCtx, "TransitionDocumentApproval", a)
+	if err != nil {
+		return nil, err
+	}
+	// 流转后审批人已变化，按新状态返回可执行的操作
+	user.roles = slices.DeleteFunc(user.roles, func(r string) bool { return r == approval.RoleAssignee })
+	return &pb.TransitionDocumentApprovalResponse{Approval: toPbApproval(w, a, records, user)}, nil
+}
+
+// checkAssignee 审批人须为可以查看该文档的其他用户
+func (l *TransitionDocumentApprovalLogic) checkAssignee(conversation *model.Conversations, userID, assigneeID int64) error {
+	if assigneeID <= 0 || assigneeID == userID {
+		return fmt.Errorf("TransitionDocumentApproval invalid assignee %d: %w", assigneeID, xerr.ErrApprovalAssigneeInvalid)
+	}
+	ok, err := canReadConversation(l.ctx, l.svcCtx, assigneeID, conversation)
+	if err != nil {
+		return fmt.Errorf("TransitionDocumentApproval: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("TransitionDocumentApproval assignee %d cannot read conversation %s: %w", assigneeID, conversation.ConversationId, xerr.ErrApprovalAssigneeInvalid)
+	}
+	return nil
+}
@@ -2,9 +2,14 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/workspace"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -36,9 +41,27 @@ func NewUpdateDocumentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Up
 
 // UpdateDocument handles manual updates from the user.
 func (l *UpdateDocumentLogic) UpdateDocument(in *pb.UpdateDocumentRequest) (*pb.UpdateDocumentResponse, error) {
-	// Use the repository to update the document.
-	// This single call handles both the database update and cache invalidation.
-	err := l.svcCtx.DocRepo.UpdateDocumentContent(l.ctx, in.MessageId, in.Prompt)
+	// Make sure the document belongs to the caller, and keep the old content for auditing.
+	doc, err := findAccessibleDocument(l.ctx, l.svcCtx, "UpdateDocument", in.UserId, in.ConversationId, in.MessageId, workspace.Write)
+	if err != nil {
+		return nil, err
+	}
+	// Documents under review or approved are locked by the approval workflow.
+	if err := checkDocumentUnlocked(l.ctx, l.svcCtx, "UpdateDocument", doc); err != nil {
+		return nil, err
+	}
+
+	// Merge the saved content with changes made since the client loaded it (collaborative editing, AI edits,
+	// other saves); without a base the client's content replaces the current one.
+	// The repository handles both the conditional database update and cache invalidation.
+	base := doc.Content
+	if in.Base != nil {
+		base = in.GetBase()
+	}
+	merged, err := l.svcCtx.Collab.Merge(l.ctx, in.MessageId, in.UserId, collab.SourceUpdate, base, in.Prompt)
+	if errors.Is(err, xerr.ErrDocumentLocked) {
+		return nil, fmt.Errorf("UpdateDocument: %w", err)
+	}
 	if err != nil {
 		// Log the detailed error for debugging
 		l.Errorf("UpdateDocument failed: %v, MessageId: %s", err, in.MessageId)
@@ -46,5 +69,14 @@ func (l *UpdateDocumentLogic) UpdateDocument(in *pb.UpdateDocumentRequest) (*pb.
 		return nil, xerr.ErrDbError
 	}
 
+	l.svcCtx.Auditor.Record(l.ctx, audit.Entry{
+		UserId:         in.UserId,
+		Action:         audit.ActionUpdate,
+		ConversationId: doc.ConversationId,
+		TargetId:       in.MessageId,
+		HashBefore:     audit.Hash(doc.Content),
+		HashAfter:      audit.Hash(merged),
+	})
+
 	return &pb.UpdateDocumentResponse{Success: true}, nil
 }
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 用户与系统均未设置公文版头时使用的默认发文机关标志。
+// 发文字号没有默认值：未分配也未填写时导出不含文号，避免印出不存在的字号
+const defaultHeaderTitle = "某某县人民政府文件"
+
+// loadUserProfile 读取用户资料，用作请求未指定时的默认值。
+// 读取失败时只记录日志并返回空资料，不影响生成与导出
+func loadUserProfile(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) *usercenter.UserProfile {
+	if userID <= 0 {
+		return &usercenter.UserProfile{}
+	}
+	resp, err := svcCtx.UsercenterRpc.GetUserProfile(ctx, &usercenter.GetUserProfileReq{UserId: userID})
+	if err != nil || resp.Profile == nil {
+		logx.WithContext(ctx).Errorf("load user profile failed, userId:%d, err:%v", userID, err)
+		return &usercenter.UserProfile{}
+	}
+	return resp.Profile
+}
+
+// profileHeader 用户资料中的公文版头与发文字号；未设置版头时使用 "单位名称 + 文件"
+func profileHeader(profile *usercenter.UserProfile) (title, docNo string) {
+	title = profile.GetHeaderTitle()
+	if title == "" && profile.GetOrganization() != "" {
+		title = profile.GetOrganization() + "文件"
+	}
+	return title, profile.GetHeaderDocNo()
+}
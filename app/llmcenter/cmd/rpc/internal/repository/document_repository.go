@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/metrics"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
@@ -50,6 +52,7 @@ func (r *DocumentRepository) FindDocument(ctx context.Context, messageId string)
 		var doc model.Documents
 		if json.Unmarshal([]byte(docJson), &doc) == nil {
 			logger.Infof("cache hit for document: %s", messageId)
+			metrics.IncDocumentCache(metrics.ResultHit)
 			return &doc, nil
 		}
 		// JSON 反序列化失败，视为缓存未命中
@@ -57,6 +60,7 @@ func (r *DocumentRepository) FindDocument(ctx context.Context, messageId string)
 	}
 
 	logger.Infof("cache miss for document: %s", messageId)
+	metrics.IncDocumentCache(metrics.ResultMiss)
 	// 2. 缓存未命中，从数据库查询
 	dbDoc, err := r.documentsModel.FindOne(ctx, messageId)
 	if err != nil {
@@ -98,3 +102,34 @@ func (r *DocumentRepository) UpdateDocumentContent(ctx context.Context, messageI
 
 	return nil
 }
+
+// SwapDocumentContent 仅当文档内容仍为 old 时更新为 content 并使缓存失效，返回是否已更新。
+// 内容未变化时不写入。协同编辑保存快照时使用，避免覆盖期间其他请求写入的内容。
+func (r *DocumentRepository) SwapDocumentContent(ctx context.Context, messageId, old, content string) (bool, error) {
+	if old == content {
+		return true, nil
+	}
+	ok, err := r.documentsModel.SwapContent(ctx, messageId, audit.Hash(old), content)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	docCacheKey := getDocCacheKey(messageId)
+	if _, err := r.redisClient.Del(docCacheKey); err != nil {
+		logx.WithContext(ctx).Errorf("failed to delete cache key %s: %v", docCacheKey, err)
+	}
+	return true, nil
+}
+
+// DeleteDocument 在数据库删除文档并使缓存失效。
+func (r *DocumentRepository) DeleteDocument(ctx context.Context, messageId string) error {
+	if err := r.documentsModel.Delete(ctx, messageId); err != nil {
+		return err
+	}
+
+	docCacheKey := getDocCacheKey(messageId)
+	if _, err := r.redisClient.Del(docCacheKey); err != nil {
+		logx.WithContext(ctx).Errorf("failed to delete cache key %s: %v", docCacheKey, err)
+	}
+	return nil
+}
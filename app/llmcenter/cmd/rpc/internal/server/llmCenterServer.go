@@ -88,3 +88,159 @@ func (s *LlmCenterServer) ConvertMarkdownLink(ctx context.Context, in *pb.Conver
 	l := logic.NewConvertMarkdownLinkLogic(ctx, s.svcCtx)
 	return l.ConvertMarkdownLink(in)
 }
+
+// RPC 方法: CheckDocument
+func (s *LlmCenterServer) CheckDocument(ctx context.Context, in *pb.CheckDocumentRequest) (*pb.CheckDocumentResponse, error) {
+	l := logic.NewCheckDocumentLogic(ctx, s.svcCtx)
+	return l.CheckDocument(in)
+}
+
+// RPC 方法: DeleteDocument
+func (s *LlmCenterServer) DeleteDocument(ctx context.Context, in *pb.DeleteDocumentRequest) (*pb.DeleteDocumentResponse, error) {
+	l := logic.NewDeleteDocumentLogic(ctx, s.svcCtx)
+	return l.DeleteDocument(in)
+}
+
+// RPC 方法: ListAuditLogs
+func (s *LlmCenterServer) ListAuditLogs(ctx context.Context, in *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	l := logic.NewListAuditLogsLogic(ctx, s.svcCtx)
+	return l.ListAuditLogs(in)
+}
+
+// RPC 方法: ExportAuditLogs
+func (s *LlmCenterServer) ExportAuditLogs(ctx context.Context, in *pb.ExportAuditLogsRequest) (*pb.ExportAuditLogsResponse, error) {
+	l := logic.NewExportAuditLogsLogic(ctx, s.svcCtx)
+	return l.ExportAuditLogs(in)
+}
+
+// RPC 方法: CheckFileAccess
+func (s *LlmCenterServer) CheckFileAccess(ctx context.Context, in *pb.CheckFileAccessRequest) (*pb.CheckFileAccessResponse, error) {
+	l := logic.NewCheckFileAccessLogic(ctx, s.svcCtx)
+	return l.CheckFileAccess(in)
+}
+
+// RPC 方法: GetUsageSummary
+func (s *LlmCenterServer) GetUsageSummary(ctx context.Context, in *pb.GetUsageSummaryRequest) (*pb.GetUsageSummaryResponse, error) {
+	l := logic.NewGetUsageSummaryLogic(ctx, s.svcCtx)
+	return l.GetUsageSummary(in)
+}
+
+// RPC 方法: ListUsageQuotas
+func (s *LlmCenterServer) ListUsageQuotas(ctx context.Context, in *pb.ListUsageQuotasRequest) (*pb.ListUsageQuotasResponse, error) {
+	l := logic.NewListUsageQuotasLogic(ctx, s.svcCtx)
+	return l.ListUsageQuotas(in)
+}
+
+// RPC 方法: SetUsageQuota
+func (s *LlmCenterServer) SetUsageQuota(ctx context.Context, in *pb.SetUsageQuotaRequest) (*pb.SetUsageQuotaResponse, error) {
+	l := logic.NewSetUsageQuotaLogic(ctx, s.svcCtx)
+	return l.SetUsageQuota(in)
+}
+
+// RPC 方法: DeleteUsageQuota
+func (s *LlmCenterServer) DeleteUsageQuota(ctx context.Context, in *pb.DeleteUsageQuotaRequest) (*pb.DeleteUsageQuotaResponse, error) {
+	l := logic.NewDeleteUsageQuotaLogic(ctx, s.svcCtx)
+	return l.DeleteUsageQuota(in)
+}
+
+// RPC 方法: CreateDocumentShare
+func (s *LlmCenterServer) CreateDocumentShare(ctx context.Context, in *pb.CreateDocumentShareRequest) (*pb.CreateDocumentShareResponse, error) {
+	l := logic.NewCreateDocumentShareLogic(ctx, s.svcCtx)
+	return l.CreateDocumentShare(in)
+}
+
+// RPC 方法: ListDocumentShares
+func (s *LlmCenterServer) ListDocumentShares(ctx context.Context, in *pb.ListDocumentSharesRequest) (*pb.ListDocumentSharesResponse, error) {
+	l := logic.NewListDocumentSharesLogic(ctx, s.svcCtx)
+	return l.ListDocumentShares(in)
+}
+
+// RPC 方法: RevokeDocumentShare
+func (s *LlmCenterServer) RevokeDocumentShare(ctx context.Context, in *pb.RevokeDocumentShareRequest) (*pb.RevokeDocumentShareResponse, error) {
+	l := logic.NewRevokeDocumentShareLogic(ctx, s.svcCtx)
+	return l.RevokeDocumentShare(in)
+}
+
+// RPC 方法: OpenDocumentShare
+func (s *LlmCenterServer) OpenDocumentShare(ctx context.Context, in *pb.OpenDocumentShareRequest) (*pb.OpenDocumentShareResponse, error) {
+	l := logic.NewOpenDocumentShareLogic(ctx, s.svcCtx)
+	return l.OpenDocumentShare(in)
+}
+
+// RPC 方法: CreateDocumentComment
+func (s *LlmCenterServer) CreateDocumentComment(ctx context.Context, in *pb.CreateDocumentCommentRequest) (*pb.CreateDocumentCommentResponse, error) {
+	l := logic.NewCreateDocumentCommentLogic(ctx, s.svcCtx)
+	return l.CreateDocumentComment(in)
+}
+
+// RPC 方法: ListDocumentComments
+func (s *LlmCenterServer) ListDocumentComments(ctx context.Context, in *pb.ListDocumentCommentsRequest) (*pb.ListDocumentCommentsResponse, error) {
+	l := logic.NewListDocumentCommentsLogic(ctx, s.svcCtx)
+	return l.ListDocumentComments(in)
+}
+
+// RPC 方法: ResolveDocumentComment
+func (s *LlmCenterServer) ResolveDocumentComment(ctx context.Context, in *pb.ResolveDocumentCommentRequest) (*pb.ResolveDocumentCommentResponse, error) {
+	l := logic.NewResolveDocumentCommentLogic(ctx, s.svcCtx)
+	return l.ResolveDocumentComment(in)
+}
+
+// RPC 方法: ListMentionedComments
+func (s *LlmCenterServer) ListMentionedComments(ctx context.Context, in *pb.ListMentionedCommentsRequest) (*pb.ListMentionedCommentsResponse, error) {
+	l := logic.NewListMentionedCommentsLogic(ctx, s.svcCtx)
+	return l.ListMentionedComments(in)
+}
+
+// RPC 方法: GetDocumentApproval
+func (s *LlmCenterServer) GetDocumentApproval(ctx context.Context, in *pb.GetDocumentApprovalRequest) (*pb.GetDocumentApprovalResponse, error) {
+	l := logic.NewGetDocumentApprovalLogic(ctx, s.svcCtx)
+	return l.GetDocumentApproval(in)
+}
+
+// RPC 方法: TransitionDocumentApproval
+func (s *LlmCenterServer) TransitionDocumentApproval(ctx context.Context, in *pb.TransitionDocumentApprovalRequest) (*pb.TransitionDocumentApprovalResponse, error) {
+	l := logic.NewTransitionDocumentApprovalLogic(ctx, s.svcCtx)
+	return l.TransitionDocumentApproval(in)
+}
+
+// RPC 方法: ListApprovalTasks
+func (s *LlmCenterServer) ListApprovalTasks(ctx context.Context, in *pb.ListApprovalTasksRequest) (*pb.ListApprovalTasksResponse, error) {
+	l := logic.NewListApprovalTasksLogic(ctx, s.svcCtx)
+	return l.ListApprovalTasks(in)
+}
+
+// RPC 方法: AllocateDocNo
+func (s *LlmCenterServer) AllocateDocNo(ctx context.Context, in *pb.AllocateDocNoRequest) (*pb.AllocateDocNoResponse, error) {
+	l := logic.NewAllocateDocNoLogic(ctx, s.svcCtx)
+	return l.AllocateDocNo(in)
+}
+
+// RPC 方法: ReleaseDocNo
+func (s *LlmCenterServer) ReleaseDocNo(ctx context.Context, in *pb.ReleaseDocNoRequest) (*pb.ReleaseDocNoResponse, error) {
+	l := logic.NewReleaseDocNoLogic(ctx, s.svcCtx)
+	return l.ReleaseDocNo(in)
+}
+
+// RPC 方法: IssueDocNo
+func (s *LlmCenterServer) IssueDocNo(ctx context.Context, in *pb.IssueDocNoRequest) (*pb.IssueDocNoResponse, error) {
+	l := logic.NewIssueDocNoLogic(ctx, s.svcCtx)
+	return l.IssueDocNo(in)
+}
+
+// RPC 方法: ListDocNos
+func (s *LlmCenterServer) ListDocNos(ctx context.Context, in *pb.ListDocNosRequest) (*pb.ListDocNosResponse, error) {
+	l := logic.NewListDocNosLogic(ctx, s.svcCtx)
+	return l.ListDocNos(in)
+}
+
+// RPC 方法: CollabDocument
+func (s *LlmCenterServer) CollabDocument(stream pb.LlmCenter_CollabDocumentServer) error {
+	l := logic.NewCollabDocumentLogic(stream.Context(), s.svcCtx)
+	return l.CollabDocument(stream)
+}
+
+// RPC 方法: GetDiagnostics
+func (s *LlmCenterServer) GetDiagnostics(ctx context.Context, in *pb.GetDiagnosticsRequest) (*pb.GetDiagnosticsResponse, error) {
+	l := logic.NewGetDiagnosticsLogic(ctx, s.svcCtx)
+	return l.GetDiagnostics(in)
+}
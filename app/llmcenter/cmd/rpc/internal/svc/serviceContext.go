@@ -1,42 +1,99 @@
 package svc
 
 import (
+	"context"
+	"document_agent/app/llmcenter/cmd/rpc/internal/collab"
 	"document_agent/app/llmcenter/cmd/rpc/internal/config"
+	"document_agent/app/llmcenter/cmd/rpc/internal/llm/provider"
 	"document_agent/app/llmcenter/cmd/rpc/internal/repository"
 	"document_agent/app/llmcenter/model"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/approval"
+	"document_agent/pkg/audit"
+	"document_agent/pkg/health"
+	"document_agent/pkg/screening"
+	"document_agent/pkg/usage"
 	"net/http"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config            config.Config
-	ConversationModel model.ConversationsModel
-	MessageModel      model.MessagesModel
-	FilesModel        model.FilesModel
-	DocumentsModel    model.DocumentsModel
-	HistoryDatasModel model.HistorydatasModel
-	LlmApiClient      *http.Client                   // <--- 新增：用于调用 LLM API 的 HTTP 客户端
-	RedisClient       *redis.Redis                   // 2. 添加 RedisClient 字段
-	DocRepo           *repository.DocumentRepository // 文档仓库,用于带缓存的处理最终文档
+	Config                 config.Config
+	ConversationModel      model.ConversationsModel
+	MessageModel           model.MessagesModel
+	FilesModel             model.FilesModel
+	DocumentsModel         model.DocumentsModel
+	HistoryDatasModel      model.HistorydatasModel
+	AuditLogsModel         model.AuditLogsModel
+	UsageModel             model.UsageModel
+	UsageQuotaModel        model.UsageQuotaModel
+	DocumentSharesModel    model.DocumentSharesModel
+	DocumentCommentsModel  model.DocumentCommentsModel
+	DocumentApprovalsModel model.DocumentApprovalsModel
+	DocNumbersModel        model.DocNumbersModel
+	LlmApiClient           *http.Client                   // <--- 新增：用于调用 LLM API 的 HTTP 客户端
+	LlmRouter              *provider.Router               // 大模型提供方路由，各提供方的熔断状态通过 gRPC 健康检查上报
+	RedisClient            *redis.Redis                   // 2. 添加 RedisClient 字段
+	DocRepo                *repository.DocumentRepository // 文档仓库,用于带缓存的处理最终文档
+	Screener               *screening.Screener            // 敏感词与涉密信息筛查
+	Auditor                *audit.Recorder                // 文档操作审计记录
+	UsageRecorder          *usage.Recorder                // 大模型调用用量计量
+	Approval               *approval.Workflow             // 公文审批流程
+	UsercenterRpc          usercenter.Usercenter          // 用户中心，读取用户资料与角色
+	Health                 *health.Checker                // 依赖检查，结果写入 gRPC 就绪状态并用于诊断接口
+	Collab                 *collab.Hub                    // 本实例中的协同编辑会话
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 
 	sqlConn := sqlx.NewMysql(c.DB.DataSource)
 	documentsModel := model.NewDocumentsModel(sqlConn)
+	auditLogsModel := model.NewAuditLogsModel(sqlConn)
+	usageModel := model.NewUsageModel(sqlConn)
 	redisClient := redis.MustNewRedis(c.Redis.RedisConf) // 初始化 Redis 客户端
+	screener := screening.MustNewScreener(c.Screening)
+	screener.StartAutoReload()
+	documentApprovalsModel := model.NewDocumentApprovalsModel(sqlConn)
+	workflow := approval.MustNew(c.Approval)
+	docRepo := repository.NewDocumentRepository(documentsModel, redisClient)
+	auditor := audit.NewRecorder(auditLogsModel)
+
+	checker := health.NewChecker(c.HealthCheck,
+		health.MySQL(sqlConn),
+		health.Redis(redisClient),
+		health.Etcd(c.Etcd.Hosts),
+	)
+	checker.Add(
+		health.Dir("upload-dir", c.Upload.BaseDir, checker.MinFreeBytes()),
+		health.Command("pandoc", "--version"),
+		health.Command("xelatex", "--version"),
+		health.Command("tesseract", "--version"),
+		// 图片 OCR 使用 chi_sim+eng，缺少任一语言包时识别失败
+		health.TesseractLangs("chi_sim", "eng"),
+		health.File("lua-align", c.LuaFilters.Align),
+		health.File("lua-gov", c.LuaFilters.Gov),
+		health.FontDir("font-dir", c.Font.Path),
+	)
 
 	return &ServiceContext{
-		Config:            c,
-		ConversationModel: model.NewConversationsModel(sqlConn),
-		MessageModel:      model.NewMessagesModel(sqlConn),
-		FilesModel:        model.NewFilesModel(sqlConn),
-		DocumentsModel:    documentsModel,
-		HistoryDatasModel: model.NewHistorydatasModel(sqlConn),
-		RedisClient:       redisClient,
+		Config:                 c,
+		ConversationModel:      model.NewConversationsModel(sqlConn),
+		MessageModel:           model.NewMessagesModel(sqlConn),
+		FilesModel:             model.NewFilesModel(sqlConn),
+		DocumentsModel:         documentsModel,
+		HistoryDatasModel:      model.NewHistorydatasModel(sqlConn),
+		AuditLogsModel:         auditLogsModel,
+		UsageModel:             usageModel,
+		UsageQuotaModel:        model.NewUsageQuotaModel(sqlConn),
+		DocumentSharesModel:    model.NewDocumentSharesModel(sqlConn),
+		DocumentCommentsModel:  model.NewDocumentCommentsModel(sqlConn),
+		DocumentApprovalsModel: documentApprovalsModel,
+		DocNumbersModel:        model.NewDocNumbersModel(sqlConn),
+		RedisClient:            redisClient,
 		LlmApiClient: &http.Client{
 			// 设置一个总的请求超时，防止请求永远挂起。
 			// 注意：对于流式请求，这个超时需要足够长。
@@ -48,6 +105,29 @@ func NewServiceContext(c config.Config) *ServiceContext {
 				DisableCompression:  c.LlmApiClient.DisableCompression,
 			},
 		},
-		DocRepo: repository.NewDocumentRepository(documentsModel, redisClient),
+		LlmRouter:     provider.NewRouter(c),
+		DocRepo:       docRepo,
+		Screener:      screener,
+		Auditor:       auditor,
+		UsercenterRpc: usercenter.NewUsercenter(zrpc.MustNewClient(c.UsercenterRpcConf)),
+		UsageRecorder: usage.NewRecorder(usageModel),
+		Approval:      workflow,
+		Health:        checker,
+		Collab:        collab.NewHub(c.Collab, docRepo, ApprovalLockFunc(documentApprovalsModel, workflow), auditor),
+	}
+}
+
+// ApprovalLockFunc 按审批记录判断文档是否锁定，未进入审批流程的文档不锁定
+func ApprovalLockFunc(m model.DocumentApprovalsModel, w *approval.Workflow) collab.LockFunc {
+	return func(ctx context.Context, messageID string) (bool, error) {
+		a, err := m.FindOneByMessageId(ctx, messageID)
+		switch err {
+		case nil:
+			return w.Locked(a.State), nil
+		case model.ErrNotFound:
+			return false, nil
+		default:
+			return false, err
+		}
 	}
 }
@@ -8,12 +8,15 @@ import (
 	"document_agent/app/llmcenter/cmd/rpc/internal/server"
 	"document_agent/app/llmcenter/cmd/rpc/internal/svc"
 	"document_agent/app/llmcenter/cmd/rpc/pb"
+	"document_agent/pkg/circuit"
 	"document_agent/pkg/interceptor/rpcserver"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/service"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -27,8 +30,27 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	// 使用自己注册的 gRPC 健康检查服务代替 go-zero 内置的，以便按子服务上报各大模型提供方的熔断状态与就绪状态：
+	// 整体状态（服务名为空）随进程启停变化，以提供方名称为服务名的状态仅在其熔断器关闭时为 SERVING，
+	// readiness 的状态由定期执行的依赖检查（数据库、Pandoc、Tesseract 语言包、字体等）决定
+	c.Health = false
+	healthServer := health.NewServer()
+	ctx.Health.Watch(healthServer)
+	for _, p := range ctx.LlmRouter.Providers() {
+		healthServer.SetServingStatus(p.Name, grpc_health_v1.HealthCheckResponse_SERVING)
+		p.Breaker.OnStateChange(func(name string, state circuit.State) {
+			status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			if state == circuit.StateClosed {
+				status = grpc_health_v1.HealthCheckResponse_SERVING
+			}
+			healthServer.SetServingStatus(name, status)
+		})
+	}
+	defer healthServer.Shutdown()
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterLlmCenterServer(grpcServer, server.NewLlmCenterServer(ctx))
+		grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
@@ -36,9 +58,9 @@ func main() {
 	})
 	defer s.Stop()
 
-	//rpc log
-	s.AddUnaryInterceptors(rpcserver.LoggerInterceptor)
-	s.AddStreamInterceptors(rpcserver.StreamLoggerInterceptor)
+	//rpc metrics & log，指标拦截器在外层，记录日志拦截器转换后的业务错误码
+	s.AddUnaryInterceptors(rpcserver.MetricsInterceptor, rpcserver.LoggerInterceptor)
+	s.AddStreamInterceptors(rpcserver.StreamMetricsInterceptor, rpcserver.StreamLoggerInterceptor)
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
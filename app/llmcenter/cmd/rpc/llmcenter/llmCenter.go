@@ -14,39 +14,119 @@ import (
 )
 
 type (
-	ChatCompletionsRequest        = pb.ChatCompletionsRequest
-	ChatCompletionsResponse       = pb.ChatCompletionsResponse
-	ChatResumeRequest             = pb.ChatResumeRequest
-	ChatResumeResponse            = pb.ChatResumeResponse
-	Conversation                  = pb.Conversation
-	ConvertMarkdownLinkRequest    = pb.ConvertMarkdownLinkRequest
-	ConvertMarkdownLinkResponse   = pb.ConvertMarkdownLinkResponse
-	ConvertMarkdownRequest        = pb.ConvertMarkdownRequest
-	ConvertMarkdownResponse       = pb.ConvertMarkdownResponse
-	Document                      = pb.Document
-	EditDocumentRequest           = pb.EditDocumentRequest
-	EditDocumentResponse          = pb.EditDocumentResponse
-	FileInfo                      = pb.FileInfo
-	FileReference                 = pb.FileReference
-	FileUploadRequest             = pb.FileUploadRequest
-	FileUploadResponse            = pb.FileUploadResponse
-	GetConversationDetailRequest  = pb.GetConversationDetailRequest
-	GetConversationDetailResponse = pb.GetConversationDetailResponse
-	GetConversationsRequest       = pb.GetConversationsRequest
-	GetConversationsResponse      = pb.GetConversationsResponse
-	GetDocumentDetailRequest      = pb.GetDocumentDetailRequest
-	GetDocumentDetailResponse     = pb.GetDocumentDetailResponse
-	GetHistoryDataRequest         = pb.GetHistoryDataRequest
-	GetHistoryDataResponse        = pb.GetHistoryDataResponse
-	HistoryData                   = pb.HistoryData
-	InfoItem                      = pb.InfoItem
-	Message                       = pb.Message
-	Reference                     = pb.Reference
-	SSEEndEvent                   = pb.SSEEndEvent
-	SSEInterruptEvent             = pb.SSEInterruptEvent
-	SSEMessageEvent               = pb.SSEMessageEvent
-	UpdateDocumentRequest         = pb.UpdateDocumentRequest
-	UpdateDocumentResponse        = pb.UpdateDocumentResponse
+	AllocateDocNoRequest               = pb.AllocateDocNoRequest
+	AllocateDocNoResponse              = pb.AllocateDocNoResponse
+	ApprovalAction                     = pb.ApprovalAction
+	ApprovalRecord                     = pb.ApprovalRecord
+	ApprovalSigner                     = pb.ApprovalSigner
+	AuditLog                           = pb.AuditLog
+	AuditLogQuery                      = pb.AuditLogQuery
+	ChatCompletionsRequest             = pb.ChatCompletionsRequest
+	ChatCompletionsResponse            = pb.ChatCompletionsResponse
+	ChatResumeRequest                  = pb.ChatResumeRequest
+	ChatResumeResponse                 = pb.ChatResumeResponse
+	CheckDocumentRequest               = pb.CheckDocumentRequest
+	CheckDocumentResponse              = pb.CheckDocumentResponse
+	CheckFileAccessRequest             = pb.CheckFileAccessRequest
+	CheckFileAccessResponse            = pb.CheckFileAccessResponse
+	CollabAck                          = pb.CollabAck
+	CollabClient                       = pb.CollabClient
+	CollabInit                         = pb.CollabInit
+	CollabJoin                         = pb.CollabJoin
+	CollabOperation                    = pb.CollabOperation
+	CollabPresence                     = pb.CollabPresence
+	CollabRemoteOperation              = pb.CollabRemoteOperation
+	CollabRequest                      = pb.CollabRequest
+	CollabReset                        = pb.CollabReset
+	CollabResponse                     = pb.CollabResponse
+	CollabSelection                    = pb.CollabSelection
+	CollabState                        = pb.CollabState
+	CommentAnchor                      = pb.CommentAnchor
+	ConfigIssue                        = pb.ConfigIssue
+	Conversation                       = pb.Conversation
+	ConvertMarkdownLinkRequest         = pb.ConvertMarkdownLinkRequest
+	ConvertMarkdownLinkResponse        = pb.ConvertMarkdownLinkResponse
+	ConvertMarkdownRequest             = pb.ConvertMarkdownRequest
+	ConvertMarkdownResponse            = pb.ConvertMarkdownResponse
+	CreateDocumentCommentRequest       = pb.CreateDocumentCommentRequest
+	CreateDocumentCommentResponse      = pb.CreateDocumentCommentResponse
+	CreateDocumentShareRequest         = pb.CreateDocumentShareRequest
+	CreateDocumentShareResponse        = pb.CreateDocumentShareResponse
+	DeleteDocumentRequest              = pb.DeleteDocumentRequest
+	DeleteDocumentResponse             = pb.DeleteDocumentResponse
+	DeleteUsageQuotaRequest            = pb.DeleteUsageQuotaRequest
+	DeleteUsageQuotaResponse           = pb.DeleteUsageQuotaResponse
+	DocNumber                          = pb.DocNumber
+	Document                           = pb.Document
+	DocumentApproval                   = pb.DocumentApproval
+	DocumentComment                    = pb.DocumentComment
+	DocumentShare                      = pb.DocumentShare
+	EditDocumentRequest                = pb.EditDocumentRequest
+	EditDocumentResponse               = pb.EditDocumentResponse
+	ExportAuditLogsRequest             = pb.ExportAuditLogsRequest
+	ExportAuditLogsResponse            = pb.ExportAuditLogsResponse
+	FileInfo                           = pb.FileInfo
+	FileReference                      = pb.FileReference
+	FileUploadRequest                  = pb.FileUploadRequest
+	FileUploadResponse                 = pb.FileUploadResponse
+	FormatFinding                      = pb.FormatFinding
+	GetConversationDetailRequest       = pb.GetConversationDetailRequest
+	GetConversationDetailResponse      = pb.GetConversationDetailResponse
+	GetConversationsRequest            = pb.GetConversationsRequest
+	GetConversationsResponse           = pb.GetConversationsResponse
+	GetDiagnosticsRequest              = pb.GetDiagnosticsRequest
+	GetDiagnosticsResponse             = pb.GetDiagnosticsResponse
+	GetDocumentApprovalRequest         = pb.GetDocumentApprovalRequest
+	GetDocumentApprovalResponse        = pb.GetDocumentApprovalResponse
+	GetDocumentDetailRequest           = pb.GetDocumentDetailRequest
+	GetDocumentDetailResponse          = pb.GetDocumentDetailResponse
+	GetHistoryDataRequest              = pb.GetHistoryDataRequest
+	GetHistoryDataResponse             = pb.GetHistoryDataResponse
+	GetUsageSummaryRequest             = pb.GetUsageSummaryRequest
+	GetUsageSummaryResponse            = pb.GetUsageSummaryResponse
+	HealthCheck                        = pb.HealthCheck
+	HistoryData                        = pb.HistoryData
+	InfoItem                           = pb.InfoItem
+	IssueDocNoRequest                  = pb.IssueDocNoRequest
+	IssueDocNoResponse                 = pb.IssueDocNoResponse
+	ListApprovalTasksRequest           = pb.ListApprovalTasksRequest
+	ListApprovalTasksResponse          = pb.ListApprovalTasksResponse
+	ListAuditLogsRequest               = pb.ListAuditLogsRequest
+	ListAuditLogsResponse              = pb.ListAuditLogsResponse
+	ListDocNosRequest                  = pb.ListDocNosRequest
+	ListDocNosResponse                 = pb.ListDocNosResponse
+	ListDocumentCommentsRequest        = pb.ListDocumentCommentsRequest
+	ListDocumentCommentsResponse       = pb.ListDocumentCommentsResponse
+	ListDocumentSharesRequest          = pb.ListDocumentSharesRequest
+	ListDocumentSharesResponse         = pb.ListDocumentSharesResponse
+	ListMentionedCommentsRequest       = pb.ListMentionedCommentsRequest
+	ListMentionedCommentsResponse      = pb.ListMentionedCommentsResponse
+	ListUsageQuotasRequest             = pb.ListUsageQuotasRequest
+	ListUsageQuotasResponse            = pb.ListUsageQuotasResponse
+	Message                            = pb.Message
+	OpenDocumentShareRequest           = pb.OpenDocumentShareRequest
+	OpenDocumentShareResponse          = pb.OpenDocumentShareResponse
+	QuotaStatus                        = pb.QuotaStatus
+	Reference                          = pb.Reference
+	ReleaseDocNoRequest                = pb.ReleaseDocNoRequest
+	ReleaseDocNoResponse               = pb.ReleaseDocNoResponse
+	ResolveDocumentCommentRequest      = pb.ResolveDocumentCommentRequest
+	ResolveDocumentCommentResponse     = pb.ResolveDocumentCommentResponse
+	RevokeDocumentShareRequest         = pb.RevokeDocumentShareRequest
+	RevokeDocumentShareResponse        = pb.RevokeDocumentShareResponse
+	SSECheckEvent                      = pb.SSECheckEvent
+	SSEEndEvent                        = pb.SSEEndEvent
+	SSEInterruptEvent                  = pb.SSEInterruptEvent
+	SSEMessageEvent                    = pb.SSEMessageEvent
+	SetUsageQuotaRequest               = pb.SetUsageQuotaRequest
+	SetUsageQuotaResponse              = pb.SetUsageQuotaResponse
+	TextOp                             = pb.TextOp
+	TransitionDocumentApprovalRequest  = pb.TransitionDocumentApprovalRequest
+	TransitionDocumentApprovalResponse = pb.TransitionDocumentApprovalResponse
+	UpdateDocumentRequest              = pb.UpdateDocumentRequest
+	UpdateDocumentResponse             = pb.UpdateDocumentResponse
+	UsageItem                          = pb.UsageItem
+	UsageQuota                         = pb.UsageQuota
 
 	LlmCenter interface {
 		// RPC 方法: ChatCompletions
@@ -71,6 +151,58 @@ type (
 		ConvertMarkdown(ctx context.Context, in *ConvertMarkdownRequest, opts ...grpc.CallOption) (*ConvertMarkdownResponse, error)
 		// RPC 方法: DownloadFileLinkRequest
 		ConvertMarkdownLink(ctx context.Context, in *ConvertMarkdownLinkRequest, opts ...grpc.CallOption) (*ConvertMarkdownLinkResponse, error)
+		// RPC 方法: CheckDocument
+		CheckDocument(ctx context.Context, in *CheckDocumentRequest, opts ...grpc.CallOption) (*CheckDocumentResponse, error)
+		// RPC 方法: DeleteDocument
+		DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+		// RPC 方法: ListAuditLogs
+		ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+		// RPC 方法: ExportAuditLogs
+		ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (*ExportAuditLogsResponse, error)
+		// RPC 方法: CheckFileAccess
+		CheckFileAccess(ctx context.Context, in *CheckFileAccessRequest, opts ...grpc.CallOption) (*CheckFileAccessResponse, error)
+		// RPC 方法: GetUsageSummary
+		GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error)
+		// RPC 方法: ListUsageQuotas
+		ListUsageQuotas(ctx context.Context, in *ListUsageQuotasRequest, opts ...grpc.CallOption) (*ListUsageQuotasResponse, error)
+		// RPC 方法: SetUsageQuota
+		SetUsageQuota(ctx context.Context, in *SetUsageQuotaRequest, opts ...grpc.CallOption) (*SetUsageQuotaResponse, error)
+		// RPC 方法: DeleteUsageQuota
+		DeleteUsageQuota(ctx context.Context, in *DeleteUsageQuotaRequest, opts ...grpc.CallOption) (*DeleteUsageQuotaResponse, error)
+		// RPC 方法: CreateDocumentShare
+		CreateDocumentShare(ctx context.Context, in *CreateDocumentShareRequest, opts ...grpc.CallOption) (*CreateDocumentShareResponse, error)
+		// RPC 方法: ListDocumentShares
+		ListDocumentShares(ctx context.Context, in *ListDocumentSharesRequest, opts ...grpc.CallOption) (*ListDocumentSharesResponse, error)
+		// RPC 方法: RevokeDocumentShare
+		RevokeDocumentShare(ctx context.Context, in *RevokeDocumentShareRequest, opts ...grpc.CallOption) (*RevokeDocumentShareResponse, error)
+		// RPC 方法: OpenDocumentShare
+		OpenDocumentShare(ctx context.Context, in *OpenDocumentShareRequest, opts ...grpc.CallOption) (*OpenDocumentShareResponse, error)
+		// RPC 方法: CreateDocumentComment
+		CreateDocumentComment(ctx context.Context, in *CreateDocumentCommentRequest, opts ...grpc.CallOption) (*CreateDocumentCommentResponse, error)
+		// RPC 方法: ListDocumentComments
+		ListDocumentComments(ctx context.Context, in *ListDocumentCommentsRequest, opts ...grpc.CallOption) (*ListDocumentCommentsResponse, error)
+		// RPC 方法: ResolveDocumentComment
+		ResolveDocumentComment(ctx context.Context, in *ResolveDocumentCommentRequest, opts ...grpc.CallOption) (*ResolveDocumentCommentResponse, error)
+		// RPC 方法: ListMentionedComments
+		ListMentionedComments(ctx context.Context, in *ListMentionedCommentsRequest, opts ...grpc.CallOption) (*ListMentionedCommentsResponse, error)
+		// RPC 方法: GetDocumentApproval
+		GetDocumentApproval(ctx context.Context, in *GetDocumentApprovalRequest, opts ...grpc.CallOption) (*GetDocumentApprovalResponse, error)
+		// RPC 方法: TransitionDocumentApproval
+		TransitionDocumentApproval(ctx context.Context, in *TransitionDocumentApprovalRequest, opts ...grpc.CallOption) (*TransitionDocumentApprovalResponse, error)
+		// RPC 方法: ListApprovalTasks
+		ListApprovalTasks(ctx context.Context, in *ListApprovalTasksRequest, opts ...grpc.CallOption) (*ListApprovalTasksResponse, error)
+		// RPC 方法: AllocateDocNo
+		AllocateDocNo(ctx context.Context, in *AllocateDocNoRequest, opts ...grpc.CallOption) (*AllocateDocNoResponse, error)
+		// RPC 方法: ReleaseDocNo
+		ReleaseDocNo(ctx context.Context, in *ReleaseDocNoRequest, opts ...grpc.CallOption) (*ReleaseDocNoResponse, error)
+		// RPC 方法: IssueDocNo
+		IssueDocNo(ctx context.Context, in *IssueDocNoRequest, opts ...grpc.CallOption) (*IssueDocNoResponse, error)
+		// RPC 方法: ListDocNos
+		ListDocNos(ctx context.Context, in *ListDocNosRequest, opts ...grpc.CallOption) (*ListDocNosResponse, error)
+		// RPC 方法: CollabDocument
+		CollabDocument(ctx context.Context, opts ...grpc.CallOption) (pb.LlmCenter_CollabDocumentClient, error)
+		// RPC 方法: GetDiagnostics
+		GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error)
 	}
 
 	defaultLlmCenter struct {
@@ -149,3 +281,159 @@ func (m *defaultLlmCenter) ConvertMarkdownLink(ctx context.Context, in *ConvertM
 	client := pb.NewLlmCenterClient(m.cli.Conn())
 	return client.ConvertMarkdownLink(ctx, in, opts...)
 }
+
+// RPC 方法: CheckDocument
+func (m *defaultLlmCenter) CheckDocument(ctx context.Context, in *CheckDocumentRequest, opts ...grpc.CallOption) (*CheckDocumentResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.CheckDocument(ctx, in, opts...)
+}
+
+// RPC 方法: DeleteDocument
+func (m *defaultLlmCenter) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.DeleteDocument(ctx, in, opts...)
+}
+
+// RPC 方法: ListAuditLogs
+func (m *defaultLlmCenter) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListAuditLogs(ctx, in, opts...)
+}
+
+// RPC 方法: ExportAuditLogs
+func (m *defaultLlmCenter) ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (*ExportAuditLogsResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ExportAuditLogs(ctx, in, opts...)
+}
+
+// RPC 方法: CheckFileAccess
+func (m *defaultLlmCenter) CheckFileAccess(ctx context.Context, in *CheckFileAccessRequest, opts ...grpc.CallOption) (*CheckFileAccessResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.CheckFileAccess(ctx, in, opts...)
+}
+
+// RPC 方法: GetUsageSummary
+func (m *defaultLlmCenter) GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.GetUsageSummary(ctx, in, opts...)
+}
+
+// RPC 方法: ListUsageQuotas
+func (m *defaultLlmCenter) ListUsageQuotas(ctx context.Context, in *ListUsageQuotasRequest, opts ...grpc.CallOption) (*ListUsageQuotasResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListUsageQuotas(ctx, in, opts...)
+}
+
+// RPC 方法: SetUsageQuota
+func (m *defaultLlmCenter) SetUsageQuota(ctx context.Context, in *SetUsageQuotaRequest, opts ...grpc.CallOption) (*SetUsageQuotaResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.SetUsageQuota(ctx, in, opts...)
+}
+
+// RPC 方法: DeleteUsageQuota
+func (m *defaultLlmCenter) DeleteUsageQuota(ctx context.Context, in *DeleteUsageQuotaRequest, opts ...grpc.CallOption) (*DeleteUsageQuotaResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.DeleteUsageQuota(ctx, in, opts...)
+}
+
+// RPC 方法: CreateDocumentShare
+func (m *defaultLlmCenter) CreateDocumentShare(ctx context.Context, in *CreateDocumentShareRequest, opts ...grpc.CallOption) (*CreateDocumentShareResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.CreateDocumentShare(ctx, in, opts...)
+}
+
+// RPC 方法: ListDocumentShares
+func (m *defaultLlmCenter) ListDocumentShares(ctx context.Context, in *ListDocumentSharesRequest, opts ...grpc.CallOption) (*ListDocumentSharesResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListDocumentShares(ctx, in, opts...)
+}
+
+// RPC 方法: RevokeDocumentShare
+func (m *defaultLlmCenter) RevokeDocumentShare(ctx context.Context, in *RevokeDocumentShareRequest, opts ...grpc.CallOption) (*RevokeDocumentShareResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.RevokeDocumentShare(ctx, in, opts...)
+}
+
+// RPC 方法: OpenDocumentShare
+func (m *defaultLlmCenter) OpenDocumentShare(ctx context.Context, in *OpenDocumentShareRequest, opts ...grpc.CallOption) (*OpenDocumentShareResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.OpenDocumentShare(ctx, in, opts...)
+}
+
+// RPC 方法: CreateDocumentComment
+func (m *defaultLlmCenter) CreateDocumentComment(ctx context.Context, in *CreateDocumentCommentRequest, opts ...grpc.CallOption) (*CreateDocumentCommentResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.CreateDocumentComment(ctx, in, opts...)
+}
+
+// RPC 方法: ListDocumentComments
+func (m *defaultLlmCenter) ListDocumentComments(ctx context.Context, in *ListDocumentCommentsRequest, opts ...grpc.CallOption) (*ListDocumentCommentsResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListDocumentComments(ctx, in, opts...)
+}
+
+// RPC 方法: ResolveDocumentComment
+func (m *defaultLlmCenter) ResolveDocumentComment(ctx context.Context, in *ResolveDocumentCommentRequest, opts ...grpc.CallOption) (*ResolveDocumentCommentResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ResolveDocumentComment(ctx, in, opts...)
+}
+
+// RPC 方法: ListMentionedComments
+func (m *defaultLlmCenter) ListMentionedComments(ctx context.Context, in *ListMentionedCommentsRequest, opts ...grpc.CallOption) (*ListMentionedCommentsResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListMentionedComments(ctx, in, opts...)
+}
+
+// RPC 方法: GetDocumentApproval
+func (m *defaultLlmCenter) GetDocumentApproval(ctx context.Context, in *GetDocumentApprovalRequest, opts ...grpc.CallOption) (*GetDocumentApprovalResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.GetDocumentApproval(ctx, in, opts...)
+}
+
+// RPC 方法: TransitionDocumentApproval
+func (m *defaultLlmCenter) TransitionDocumentApproval(ctx context.Context, in *TransitionDocumentApprovalRequest, opts ...grpc.CallOption) (*TransitionDocumentApprovalResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.TransitionDocumentApproval(ctx, in, opts...)
+}
+
+// RPC 方法: ListApprovalTasks
+func (m *defaultLlmCenter) ListApprovalTasks(ctx context.Context, in *ListApprovalTasksRequest, opts ...grpc.CallOption) (*ListApprovalTasksResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListApprovalTasks(ctx, in, opts...)
+}
+
+// RPC 方法: AllocateDocNo
+func (m *defaultLlmCenter) AllocateDocNo(ctx context.Context, in *AllocateDocNoRequest, opts ...grpc.CallOption) (*AllocateDocNoResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.AllocateDocNo(ctx, in, opts...)
+}
+
+// RPC 方法: ReleaseDocNo
+func (m *defaultLlmCenter) ReleaseDocNo(ctx context.Context, in *ReleaseDocNoRequest, opts ...grpc.CallOption) (*ReleaseDocNoResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ReleaseDocNo(ctx, in, opts...)
+}
+
+// RPC 方法: IssueDocNo
+func (m *defaultLlmCenter) IssueDocNo(ctx context.Context, in *IssueDocNoRequest, opts ...grpc.CallOption) (*IssueDocNoResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.IssueDocNo(ctx, in, opts...)
+}
+
+// RPC 方法: ListDocNos
+func (m *defaultLlmCenter) ListDocNos(ctx context.Context, in *ListDocNosRequest, opts ...grpc.CallOption) (*ListDocNosResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.ListDocNos(ctx, in, opts...)
+}
+
+// RPC 方法: CollabDocument
+func (m *defaultLlmCenter) CollabDocument(ctx context.Context, opts ...grpc.CallOption) (pb.LlmCenter_CollabDocumentClient, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.CollabDocument(ctx, opts...)
+}
+
+// RPC 方法: GetDiagnostics
+func (m *defaultLlmCenter) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error) {
+	client := pb.NewLlmCenterClient(m.cli.Conn())
+	return client.GetDiagnostics(ctx, in, opts...)
+}
@@ -30,12 +30,13 @@ type ChatCompletionsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	UserId           int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                 //api层传来的用户id
 	ConversationId   string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`          // 可选: 现有会话ID。如果为空，将创建新会话。
-	Documenttype     string                 `protobuf:"bytes,2,opt,name=documenttype,proto3" json:"documenttype,omitempty"`                                    // 文章类型
+	Documenttype     string                 `protobuf:"bytes,2,opt,name=documenttype,proto3" json:"documenttype,omitempty"`                                    // 文章类型，为空时使用用户资料中的默认文章类型
 	Information      string                 `protobuf:"bytes,7,opt,name=information,proto3" json:"information,omitempty"`                                      // 基本信息
 	Requests         string                 `protobuf:"bytes,8,opt,name=requests,proto3" json:"requests,omitempty"`                                            // 特殊要求
 	UseKnowledgeBase bool                   `protobuf:"varint,3,opt,name=use_knowledge_base,json=useKnowledgeBase,proto3" json:"use_knowledge_base,omitempty"` // 可选: 是否使用自定义知识库。
 	KnowledgeBaseId  string                 `protobuf:"bytes,4,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`     // 可选: 如果 use_knowledge_base 为 true，则需要提供知识库ID。
 	References       []*Reference           `protobuf:"bytes,5,rep,name=references,proto3" json:"references,omitempty"`                                        // 可选: 引用列表，例如引用的文件。
+	WorkspaceId      int64                  `protobuf:"varint,9,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`                  // 可选: 新建会话所属的团队空间ID，0 表示个人空间；继续已有会话时忽略
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -126,6 +127,13 @@ func (x *ChatCompletionsRequest) GetReferences() []*Reference {
 	return nil
 }
 
+func (x *ChatCompletionsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
 // 响应流: ChatCompletions 的流式响应体
 // 使用 oneof 来模拟 SSE 中的不同 event 类型。
 type ChatCompletionsResponse struct {
@@ -235,6 +243,7 @@ type ChatResumeRequest struct {
 	TemplateId     string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`             // 可选: 如果用户在这一步选择了模板。
 	Documenttype   string                 `protobuf:"bytes,5,opt,name=documenttype,proto3" json:"documenttype,omitempty"`                           // 新增：续写的文档类型
 	References     []*Reference           `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty"`                               // 新增：附件引用（图片/文档）
+	FormatCheck    bool                   `protobuf:"varint,7,opt,name=format_check,json=formatCheck,proto3" json:"format_check,omitempty"`         // 可选: 生成结束后是否进行公文格式检查
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -311,6 +320,13 @@ func (x *ChatResumeRequest) GetReferences() []*Reference {
 	return nil
 }
 
+func (x *ChatResumeRequest) GetFormatCheck() bool {
+	if x != nil {
+		return x.FormatCheck
+	}
+	return false
+}
+
 // 响应流: ChatResume 的流式响应体
 type ChatResumeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -318,6 +334,7 @@ type ChatResumeResponse struct {
 	//
 	//	*ChatResumeResponse_Message
 	//	*ChatResumeResponse_End
+	//	*ChatResumeResponse_Check
 	Event         isChatResumeResponse_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -378,6 +395,15 @@ func (x *ChatResumeResponse) GetEnd() *SSEEndEvent {
 	return nil
 }
 
+func (x *ChatResumeResponse) GetCheck() *SSECheckEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatResumeResponse_Check); ok {
+			return x.Check
+		}
+	}
+	return nil
+}
+
 type isChatResumeResponse_Event interface {
 	isChatResumeResponse_Event()
 }
@@ -390,15 +416,22 @@ type ChatResumeResponse_End struct {
 	End *SSEEndEvent `protobuf:"bytes,2,opt,name=end,proto3,oneof"` // 对应 event: end
 }
 
+type ChatResumeResponse_Check struct {
+	Check *SSECheckEvent `protobuf:"bytes,3,opt,name=check,proto3,oneof"` // 对应 event: check
+}
+
 func (*ChatResumeResponse_Message) isChatResumeResponse_Event() {}
 
 func (*ChatResumeResponse_End) isChatResumeResponse_Event() {}
 
+func (*ChatResumeResponse_Check) isChatResumeResponse_Event() {}
+
 // 请求: 获取用户所有会话列表
 // 通常 user_id 从 gRPC 的 metadata (类似 HTTP Header) 中获取，所以请求体为空。
 type GetConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 可以选择在这里传递 user_id
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 可以选择在这里传递 user_id
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 可选: 团队空间ID，0 表示个人空间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -440,6 +473,13 @@ func (x *GetConversationsRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetConversationsRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
 // 响应: 会话列表
 type GetConversationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -489,6 +529,7 @@ func (x *GetConversationsResponse) GetData() []*Conversation {
 type GetConversationDetailRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 从路径中获取的会话ID
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // api层传来的用户id
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -530,6 +571,13 @@ func (x *GetConversationDetailRequest) GetConversationId() string {
 	return ""
 }
 
+func (x *GetConversationDetailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 响应: 单个会话的详细信息
 type GetConversationDetailResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -595,6 +643,7 @@ func (x *GetConversationDetailResponse) GetHistory() []*Message {
 type GetDocumentDetailRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // api层传来的用户id
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -636,6 +685,13 @@ func (x *GetDocumentDetailRequest) GetConversationId() string {
 	return ""
 }
 
+func (x *GetDocumentDetailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 单个文档
 type Document struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -754,6 +810,7 @@ func (x *GetDocumentDetailResponse) GetDocuments() []*Document {
 type GetHistoryDataRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // api层传来的用户id
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -795,6 +852,13 @@ func (x *GetHistoryDataRequest) GetConversationId() string {
 	return ""
 }
 
+func (x *GetHistoryDataRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 响应: 历史数据列表
 type GetHistoryDataResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -1002,6 +1066,8 @@ type EditDocumentRequest struct {
 	Prompt           string                 `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
 	UseKnowledgeBase bool                   `protobuf:"varint,5,opt,name=use_knowledge_base,json=useKnowledgeBase,proto3" json:"use_knowledge_base,omitempty"`
 	KnowledgeBaseId  string                 `protobuf:"bytes,6,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	AddressComments  bool                   `protobuf:"varint,7,opt,name=address_comments,json=addressComments,proto3" json:"address_comments,omitempty"` // 处理全部未解决的批注: 批注与回复作为修改提示, 此时 prompt 可为空, 填写时作为补充要求
+	FormatCheck      bool                   `protobuf:"varint,8,opt,name=format_check,json=formatCheck,proto3" json:"format_check,omitempty"`             // 可选: 修改保存后是否进行公文格式检查
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1078,12 +1144,27 @@ func (x *EditDocumentRequest) GetKnowledgeBaseId() string {
 	return ""
 }
 
+func (x *EditDocumentRequest) GetAddressComments() bool {
+	if x != nil {
+		return x.AddressComments
+	}
+	return false
+}
+
+func (x *EditDocumentRequest) GetFormatCheck() bool {
+	if x != nil {
+		return x.FormatCheck
+	}
+	return false
+}
+
 type EditDocumentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Event:
 	//
 	//	*EditDocumentResponse_Message
 	//	*EditDocumentResponse_End
+	//	*EditDocumentResponse_Check
 	Event         isEditDocumentResponse_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1144,6 +1225,15 @@ func (x *EditDocumentResponse) GetEnd() *SSEEndEvent {
 	return nil
 }
 
+func (x *EditDocumentResponse) GetCheck() *SSECheckEvent {
+	if x != nil {
+		if x, ok := x.Event.(*EditDocumentResponse_Check); ok {
+			return x.Check
+		}
+	}
+	return nil
+}
+
 type isEditDocumentResponse_Event interface {
 	isEditDocumentResponse_Event()
 }
@@ -1156,15 +1246,23 @@ type EditDocumentResponse_End struct {
 	End *SSEEndEvent `protobuf:"bytes,2,opt,name=end,proto3,oneof"`
 }
 
+type EditDocumentResponse_Check struct {
+	Check *SSECheckEvent `protobuf:"bytes,3,opt,name=check,proto3,oneof"`
+}
+
 func (*EditDocumentResponse_Message) isEditDocumentResponse_Event() {}
 
 func (*EditDocumentResponse_End) isEditDocumentResponse_Event() {}
 
+func (*EditDocumentResponse_Check) isEditDocumentResponse_Event() {}
+
 type UpdateDocumentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	Prompt         string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	UserId         int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // api层传来的用户id
+	Base           *string                `protobuf:"bytes,5,opt,name=base,proto3,oneof" json:"base,omitempty"`              // 客户端开始编辑时加载的文档内容，保存时以此为基准与期间其他人的修改合并；未传时以当前内容为基准，即整篇覆盖
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1220,6 +1318,20 @@ func (x *UpdateDocumentRequest) GetPrompt() string {
 	return ""
 }
 
+func (x *UpdateDocumentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateDocumentRequest) GetBase() string {
+	if x != nil && x.Base != nil {
+		return *x.Base
+	}
+	return ""
+}
+
 type UpdateDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1265,12 +1377,17 @@ func (x *UpdateDocumentResponse) GetSuccess() bool {
 }
 
 type ConvertMarkdownRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Markdown      string                 `protobuf:"bytes,1,opt,name=markdown,proto3" json:"markdown,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`               // "pdf" | "docx"
-	Information   []*InfoItem            `protobuf:"bytes,3,rep,name=information,proto3" json:"information,omitempty"` // 新：标题/文号等扩展字段
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Markdown         string                 `protobuf:"bytes,1,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                                      // "pdf" | "docx"
+	Information      []*InfoItem            `protobuf:"bytes,3,rep,name=information,proto3" json:"information,omitempty"`                                        // 新：标题/文号等扩展字段
+	UserId           int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // api层传来的用户id
+	ConversationId   string                 `protobuf:"bytes,5,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`            // 可选: 导出内容所属会话ID（用于审计）
+	MessageId        string                 `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                           // 可选: 导出内容对应的文档ID（用于审计）
+	DocNoWorkspaceId int64                  `protobuf:"varint,7,opt,name=doc_no_workspace_id,json=docNoWorkspaceId,proto3" json:"doc_no_workspace_id,omitempty"` // 可选: 传入 doc_no_prefix 时从文档所属团队的发文字号登记中为文档分配字号，须传 conversation_id 与 message_id；传入时须与文档所属团队一致
+	DocNoPrefix      string                 `protobuf:"bytes,8,opt,name=doc_no_prefix,json=docNoPrefix,proto3" json:"doc_no_prefix,omitempty"`                   // 可选: 发文机关代字，如 某政；分配的字号优先于 information 中的 docNo
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ConvertMarkdownRequest) Reset() {
@@ -1324,11 +1441,47 @@ func (x *ConvertMarkdownRequest) GetInformation() []*InfoItem {
 	return nil
 }
 
+func (x *ConvertMarkdownRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConvertMarkdownRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConvertMarkdownRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ConvertMarkdownRequest) GetDocNoWorkspaceId() int64 {
+	if x != nil {
+		return x.DocNoWorkspaceId
+	}
+	return 0
+}
+
+func (x *ConvertMarkdownRequest) GetDocNoPrefix() string {
+	if x != nil {
+		return x.DocNoPrefix
+	}
+	return ""
+}
+
 type ConvertMarkdownResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	DocNo         string                 `protobuf:"bytes,4,opt,name=doc_no,json=docNo,proto3" json:"doc_no,omitempty"` // 本次为文档分配或沿用的发文字号，未传 doc_no_prefix 时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1384,6 +1537,13 @@ func (x *ConvertMarkdownResponse) GetData() []byte {
 	return nil
 }
 
+func (x *ConvertMarkdownResponse) GetDocNo() string {
+	if x != nil {
+		return x.DocNo
+	}
+	return ""
+}
+
 type InfoItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // "title" | "docNo"（大小写不敏感）
@@ -1436,33 +1596,30 @@ func (x *InfoItem) GetContant() string {
 	return ""
 }
 
-// 请求流: 文件上传
-// 客户端流的第一个消息必须是 FileInfo，后续消息为文件数据块。
-type FileUploadRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Data:
-	//
-	//	*FileUploadRequest_Info
-	//	*FileUploadRequest_Chunk
-	Data          isFileUploadRequest_Data `protobuf_oneof:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 请求: 公文格式检查
+type CheckDocumentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // api层传来的用户id
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 文档所属会话ID
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // documents 表中的文档ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *FileUploadRequest) Reset() {
-	*x = FileUploadRequest{}
+func (x *CheckDocumentRequest) Reset() {
+	*x = CheckDocumentRequest{}
 	mi := &file_llmcenter_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileUploadRequest) String() string {
+func (x *CheckDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileUploadRequest) ProtoMessage() {}
+func (*CheckDocumentRequest) ProtoMessage() {}
 
-func (x *FileUploadRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_llmcenter_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1474,74 +1631,59 @@ func (x *FileUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileUploadRequest.ProtoReflect.Descriptor instead.
-func (*FileUploadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckDocumentRequest.ProtoReflect.Descriptor instead.
+func (*CheckDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_llmcenter_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FileUploadRequest) GetData() isFileUploadRequest_Data {
+func (x *CheckDocumentRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Data
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *FileUploadRequest) GetInfo() *FileInfo {
+func (x *CheckDocumentRequest) GetConversationId() string {
 	if x != nil {
-		if x, ok := x.Data.(*FileUploadRequest_Info); ok {
-			return x.Info
-		}
+		return x.ConversationId
 	}
-	return nil
+	return ""
 }
 
-func (x *FileUploadRequest) GetChunk() []byte {
+func (x *CheckDocumentRequest) GetMessageId() string {
 	if x != nil {
-		if x, ok := x.Data.(*FileUploadRequest_Chunk); ok {
-			return x.Chunk
-		}
+		return x.MessageId
 	}
-	return nil
-}
-
-type isFileUploadRequest_Data interface {
-	isFileUploadRequest_Data()
-}
-
-type FileUploadRequest_Info struct {
-	Info *FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"` // 文件元信息
-}
-
-type FileUploadRequest_Chunk struct {
-	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // 文件数据块
+	return ""
 }
 
-func (*FileUploadRequest_Info) isFileUploadRequest_Data() {}
-
-func (*FileUploadRequest_Chunk) isFileUploadRequest_Data() {}
-
-// 消息: 文件元信息
-type FileInfo struct {
+// 响应: 公文格式检查结果
+type CheckDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // 原始文件名
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"` // 是否没有 error 级别的问题
+	ErrorCount    int64                  `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	WarningCount  int64                  `protobuf:"varint,4,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	InfoCount     int64                  `protobuf:"varint,5,opt,name=info_count,json=infoCount,proto3" json:"info_count,omitempty"`
+	Findings      []*FormatFinding       `protobuf:"bytes,6,rep,name=findings,proto3" json:"findings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
+func (x *CheckDocumentResponse) Reset() {
+	*x = CheckDocumentResponse{}
 	mi := &file_llmcenter_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) String() string {
+func (x *CheckDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*CheckDocumentResponse) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
+func (x *CheckDocumentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_llmcenter_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1553,43 +1695,77 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckDocumentResponse.ProtoReflect.Descriptor instead.
+func (*CheckDocumentResponse) Descriptor() ([]byte, []int) {
 	return file_llmcenter_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FileInfo) GetFileName() string {
+func (x *CheckDocumentResponse) GetMessageId() string {
 	if x != nil {
-		return x.FileName
+		return x.MessageId
 	}
 	return ""
 }
 
-// 响应: 文件上传成功
-type FileUploadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`       // 后端生成的唯一文件ID
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // 原始文件名
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // 文件的可访问URL
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                   // 成功消息
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CheckDocumentResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
 }
 
-func (x *FileUploadResponse) Reset() {
-	*x = FileUploadResponse{}
+func (x *CheckDocumentResponse) GetErrorCount() int64 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *CheckDocumentResponse) GetWarningCount() int64 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *CheckDocumentResponse) GetInfoCount() int64 {
+	if x != nil {
+		return x.InfoCount
+	}
+	return 0
+}
+
+func (x *CheckDocumentResponse) GetFindings() []*FormatFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// 请求: 删除文档
+type DeleteDocumentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // api层传来的用户id
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 文档所属会话ID
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // documents 表中的文档ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
 	mi := &file_llmcenter_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileUploadResponse) String() string {
+func (x *DeleteDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileUploadResponse) ProtoMessage() {}
+func (*DeleteDocumentRequest) ProtoMessage() {}
 
-func (x *FileUploadResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_llmcenter_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1601,62 +1777,54 @@ func (x *FileUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileUploadResponse.ProtoReflect.Descriptor instead.
-func (*FileUploadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
 	return file_llmcenter_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *FileUploadResponse) GetFileId() string {
-	if x != nil {
-		return x.FileId
-	}
-	return ""
-}
-
-func (x *FileUploadResponse) GetFileName() string {
+func (x *DeleteDocumentRequest) GetUserId() int64 {
 	if x != nil {
-		return x.FileName
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *FileUploadResponse) GetUrl() string {
+func (x *DeleteDocumentRequest) GetConversationId() string {
 	if x != nil {
-		return x.Url
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *FileUploadResponse) GetMessage() string {
+func (x *DeleteDocumentRequest) GetMessageId() string {
 	if x != nil {
-		return x.Message
+		return x.MessageId
 	}
 	return ""
 }
 
-// 结构: 对话中引用的对象
-type Reference struct {
+// 响应: 删除文档
+type DeleteDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                   // 引用类型, 例如: "file"
-	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // 文件ID
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Reference) Reset() {
-	*x = Reference{}
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
 	mi := &file_llmcenter_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reference) String() string {
+func (x *DeleteDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reference) ProtoMessage() {}
+func (*DeleteDocumentResponse) ProtoMessage() {}
 
-func (x *Reference) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_llmcenter_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1668,14 +1836,5469 @@ func (x *Reference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
-func (*Reference) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
 	return file_llmcenter_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Reference) GetType() string {
+func (x *DeleteDocumentResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
+	}
+	return false
+}
+
+// 结构: 单条格式检查结果
+type FormatFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`             // 规则: heading | doc_number | date | attachment | addressee
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`     // 严重程度: error | warning | info
+	Line          int64                  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`            // 行号（从 1 开始，0 表示全文级问题）
+	Column        int64                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`        // 列号（按字符计，从 1 开始）
+	Excerpt       string                 `protobuf:"bytes,5,opt,name=excerpt,proto3" json:"excerpt,omitempty"`       // 原文片段
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`       // 问题描述
+	Suggestion    string                 `protobuf:"bytes,7,opt,name=suggestion,proto3" json:"suggestion,omitempty"` // 修改建议
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatFinding) Reset() {
+	*x = FormatFinding{}
+	mi := &file_llmcenter_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatFinding) ProtoMessage() {}
+
+func (x *FormatFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatFinding.ProtoReflect.Descriptor instead.
+func (*FormatFinding) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FormatFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *FormatFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *FormatFinding) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *FormatFinding) GetColumn() int64 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *FormatFinding) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *FormatFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FormatFinding) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+// 审计记录查询条件，零值表示不过滤
+type AuditLogQuery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // 操作用户ID
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话ID
+	Action         string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                       // 操作类型: generate | resume | edit | update | export | public_download | delete | share | share_access | comment | approval | doc_no | collab
+	StartTime      int64                  `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`               // 起始时间（Unix 秒，包含）
+	EndTime        int64                  `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                     // 结束时间（Unix 秒，不包含）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditLogQuery) Reset() {
+	*x = AuditLogQuery{}
+	mi := &file_llmcenter_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogQuery) ProtoMessage() {}
+
+func (x *AuditLogQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogQuery.ProtoReflect.Descriptor instead.
+func (*AuditLogQuery) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuditLogQuery) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuditLogQuery) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AuditLogQuery) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 请求: 分页查询审计记录
+type ListAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *AuditLogQuery         `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int64                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从 1 开始
+	PageSize      int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页条数，最大 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_llmcenter_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAuditLogsRequest) GetQuery() *AuditLogQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 响应: 审计记录列表
+type ListAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Items         []*AuditLog            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_llmcenter_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAuditLogsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetItems() []*AuditLog {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 请求: 导出审计记录
+type ExportAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *AuditLogQuery         `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogsRequest) Reset() {
+	*x = ExportAuditLogsRequest{}
+	mi := &file_llmcenter_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogsRequest) ProtoMessage() {}
+
+func (x *ExportAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportAuditLogsRequest) GetQuery() *AuditLogQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+// 响应: 导出的 CSV 文件
+type ExportAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogsResponse) Reset() {
+	*x = ExportAuditLogsResponse{}
+	mi := &file_llmcenter_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogsResponse) ProtoMessage() {}
+
+func (x *ExportAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportAuditLogsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 结构: 单条审计记录
+type AuditLog struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action         string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ConversationId string                 `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	TargetId       string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 文档/消息ID 或导出文件名
+	ClientIp       string                 `protobuf:"bytes,6,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	HashBefore     string                 `protobuf:"bytes,8,opt,name=hash_before,json=hashBefore,proto3" json:"hash_before,omitempty"` // 操作前内容的 SHA-256
+	HashAfter      string                 `protobuf:"bytes,9,opt,name=hash_after,json=hashAfter,proto3" json:"hash_after,omitempty"`    // 操作后内容的 SHA-256
+	Detail         string                 `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 格式: 2006-01-02 15:04:05
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_llmcenter_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AuditLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetHashBefore() string {
+	if x != nil {
+		return x.HashBefore
+	}
+	return ""
+}
+
+func (x *AuditLog) GetHashAfter() string {
+	if x != nil {
+		return x.HashAfter
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 请求: 查询本人用量
+type GetUsageSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // api层传来的用户id
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 可选: 起始日期 2006-01-02（包含），默认本月第一天
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 可选: 结束日期 2006-01-02（包含），默认今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryRequest) Reset() {
+	*x = GetUsageSummaryRequest{}
+	mi := &file_llmcenter_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryRequest) ProtoMessage() {}
+
+func (x *GetUsageSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUsageSummaryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageSummaryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetUsageSummaryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 响应: 本人用量
+type GetUsageSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UsageItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 按日期、调用类型的明细
+	Total         *UsageItem             `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"` // 查询区间合计（date 与 call_type 为空）
+	Quota         *QuotaStatus           `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"` // 当前生效配额的使用情况
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryResponse) Reset() {
+	*x = GetUsageSummaryResponse{}
+	mi := &file_llmcenter_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryResponse) ProtoMessage() {}
+
+func (x *GetUsageSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUsageSummaryResponse) GetItems() []*UsageItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryResponse) GetTotal() *UsageItem {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryResponse) GetQuota() *QuotaStatus {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// 结构: 单日单类型的用量
+type UsageItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                         // 2006-01-02
+	CallType      string                 `protobuf:"bytes,2,opt,name=call_type,json=callType,proto3" json:"call_type,omitempty"` // generate | resume | edit
+	RequestCount  int64                  `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	SuccessCount  int64                  `protobuf:"varint,4,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  int64                  `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	PromptChars   int64                  `protobuf:"varint,6,opt,name=prompt_chars,json=promptChars,proto3" json:"prompt_chars,omitempty"`       // 提示词字符数（含携带的历史消息）
+	ResponseChars int64                  `protobuf:"varint,7,opt,name=response_chars,json=responseChars,proto3" json:"response_chars,omitempty"` // 模型输出字符数
+	AvgLatencyMs  int64                  `protobuf:"varint,8,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`  // 平均耗时（毫秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageItem) Reset() {
+	*x = UsageItem{}
+	mi := &file_llmcenter_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageItem) ProtoMessage() {}
+
+func (x *UsageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageItem.ProtoReflect.Descriptor instead.
+func (*UsageItem) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UsageItem) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UsageItem) GetCallType() string {
+	if x != nil {
+		return x.CallType
+	}
+	return ""
+}
+
+func (x *UsageItem) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *UsageItem) GetSuccessCount() int64 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *UsageItem) GetFailureCount() int64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *UsageItem) GetPromptChars() int64 {
+	if x != nil {
+		return x.PromptChars
+	}
+	return 0
+}
+
+func (x *UsageItem) GetResponseChars() int64 {
+	if x != nil {
+		return x.ResponseChars
+	}
+	return 0
+}
+
+func (x *UsageItem) GetAvgLatencyMs() int64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+// 结构: 当前配额的使用情况，limit 为 0 表示不限；字符数为提示词与输出之和
+type QuotaStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DailyRequestsUsed    int64                  `protobuf:"varint,1,opt,name=daily_requests_used,json=dailyRequestsUsed,proto3" json:"daily_requests_used,omitempty"`
+	DailyRequestsLimit   int64                  `protobuf:"varint,2,opt,name=daily_requests_limit,json=dailyRequestsLimit,proto3" json:"daily_requests_limit,omitempty"`
+	MonthlyRequestsUsed  int64                  `protobuf:"varint,3,opt,name=monthly_requests_used,json=monthlyRequestsUsed,proto3" json:"monthly_requests_used,omitempty"`
+	MonthlyRequestsLimit int64                  `protobuf:"varint,4,opt,name=monthly_requests_limit,json=monthlyRequestsLimit,proto3" json:"monthly_requests_limit,omitempty"`
+	DailyCharsUsed       int64                  `protobuf:"varint,5,opt,name=daily_chars_used,json=dailyCharsUsed,proto3" json:"daily_chars_used,omitempty"`
+	DailyCharsLimit      int64                  `protobuf:"varint,6,opt,name=daily_chars_limit,json=dailyCharsLimit,proto3" json:"daily_chars_limit,omitempty"`
+	MonthlyCharsUsed     int64                  `protobuf:"varint,7,opt,name=monthly_chars_used,json=monthlyCharsUsed,proto3" json:"monthly_chars_used,omitempty"`
+	MonthlyCharsLimit    int64                  `protobuf:"varint,8,opt,name=monthly_chars_limit,json=monthlyCharsLimit,proto3" json:"monthly_chars_limit,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuotaStatus) Reset() {
+	*x = QuotaStatus{}
+	mi := &file_llmcenter_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaStatus) ProtoMessage() {}
+
+func (x *QuotaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaStatus.ProtoReflect.Descriptor instead.
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QuotaStatus) GetDailyRequestsUsed() int64 {
+	if x != nil {
+		return x.DailyRequestsUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetDailyRequestsLimit() int64 {
+	if x != nil {
+		return x.DailyRequestsLimit
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMonthlyRequestsUsed() int64 {
+	if x != nil {
+		return x.MonthlyRequestsUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMonthlyRequestsLimit() int64 {
+	if x != nil {
+		return x.MonthlyRequestsLimit
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetDailyCharsUsed() int64 {
+	if x != nil {
+		return x.DailyCharsUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetDailyCharsLimit() int64 {
+	if x != nil {
+		return x.DailyCharsLimit
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMonthlyCharsUsed() int64 {
+	if x != nil {
+		return x.MonthlyCharsUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMonthlyCharsLimit() int64 {
+	if x != nil {
+		return x.MonthlyCharsLimit
+	}
+	return 0
+}
+
+// 结构: 用量配额。用户级配额优先；没有用户级配额时取其各角色配额中最宽松的一项；各上限为 0 表示不限
+type UsageQuota struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectType     string                 `protobuf:"bytes,2,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`              // user | role
+	Subject         string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`                                         // 用户ID 或角色编码
+	DailyRequests   int64                  `protobuf:"varint,4,opt,name=daily_requests,json=dailyRequests,proto3" json:"daily_requests,omitempty"`       // 每日调用次数上限
+	MonthlyRequests int64                  `protobuf:"varint,5,opt,name=monthly_requests,json=monthlyRequests,proto3" json:"monthly_requests,omitempty"` // 每月调用次数上限
+	DailyChars      int64                  `protobuf:"varint,6,opt,name=daily_chars,json=dailyChars,proto3" json:"daily_chars,omitempty"`                // 每日字符数上限
+	MonthlyChars    int64                  `protobuf:"varint,7,opt,name=monthly_chars,json=monthlyChars,proto3" json:"monthly_chars,omitempty"`          // 每月字符数上限
+	UpdatedAt       string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // 格式: 2006-01-02 15:04:05
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UsageQuota) Reset() {
+	*x = UsageQuota{}
+	mi := &file_llmcenter_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageQuota) ProtoMessage() {}
+
+func (x *UsageQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageQuota.ProtoReflect.Descriptor instead.
+func (*UsageQuota) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UsageQuota) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetSubjectType() string {
+	if x != nil {
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *UsageQuota) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UsageQuota) GetDailyRequests() int64 {
+	if x != nil {
+		return x.DailyRequests
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetMonthlyRequests() int64 {
+	if x != nil {
+		return x.MonthlyRequests
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetDailyChars() int64 {
+	if x != nil {
+		return x.DailyChars
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetMonthlyChars() int64 {
+	if x != nil {
+		return x.MonthlyChars
+	}
+	return 0
+}
+
+func (x *UsageQuota) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 请求: 查询全部配额
+type ListUsageQuotasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageQuotasRequest) Reset() {
+	*x = ListUsageQuotasRequest{}
+	mi := &file_llmcenter_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageQuotasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageQuotasRequest) ProtoMessage() {}
+
+func (x *ListUsageQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageQuotasRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{38}
+}
+
+// 响应: 全部配额
+type ListUsageQuotasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UsageQuota          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageQuotasResponse) Reset() {
+	*x = ListUsageQuotasResponse{}
+	mi := &file_llmcenter_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageQuotasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageQuotasResponse) ProtoMessage() {}
+
+func (x *ListUsageQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageQuotasResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUsageQuotasResponse) GetItems() []*UsageQuota {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 请求: 设置配额（按 subject_type + subject 覆盖，id 与 updated_at 忽略）
+type SetUsageQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *UsageQuota            `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUsageQuotaRequest) Reset() {
+	*x = SetUsageQuotaRequest{}
+	mi := &file_llmcenter_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUsageQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsageQuotaRequest) ProtoMessage() {}
+
+func (x *SetUsageQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsageQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetUsageQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetUsageQuotaRequest) GetQuota() *UsageQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// 响应: 设置后的配额
+type SetUsageQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *UsageQuota            `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUsageQuotaResponse) Reset() {
+	*x = SetUsageQuotaResponse{}
+	mi := &file_llmcenter_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUsageQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsageQuotaResponse) ProtoMessage() {}
+
+func (x *SetUsageQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsageQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetUsageQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetUsageQuotaResponse) GetQuota() *UsageQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// 请求: 删除配额
+type DeleteUsageQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUsageQuotaRequest) Reset() {
+	*x = DeleteUsageQuotaRequest{}
+	mi := &file_llmcenter_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUsageQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUsageQuotaRequest) ProtoMessage() {}
+
+func (x *DeleteUsageQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUsageQuotaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUsageQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteUsageQuotaRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 响应: 删除配额
+type DeleteUsageQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUsageQuotaResponse) Reset() {
+	*x = DeleteUsageQuotaResponse{}
+	mi := &file_llmcenter_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUsageQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUsageQuotaResponse) ProtoMessage() {}
+
+func (x *DeleteUsageQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUsageQuotaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUsageQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteUsageQuotaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 结构: 分享链接
+type DocumentShare struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                // 分享的文档
+	ConversationId string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 文档所属会话
+	UserId         int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // 创建者
+	Url            string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`                                             // 带签名的分享链接
+	HasPassword    bool                   `protobuf:"varint,6,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`         // 是否需要访问密码
+	AllowDownload  bool                   `protobuf:"varint,7,opt,name=allow_download,json=allowDownload,proto3" json:"allow_download,omitempty"`   // 是否允许下载 DOCX/PDF
+	ExpireAt       int64                  `protobuf:"varint,8,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`                  // 过期时间 (Unix 秒)
+	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                       // active | expired | revoked
+	ViewCount      int64                  `protobuf:"varint,10,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`              // 查看次数
+	DownloadCount  int64                  `protobuf:"varint,11,opt,name=download_count,json=downloadCount,proto3" json:"download_count,omitempty"`  // 下载次数
+	CreatedAt      int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`              // 创建时间 (Unix 秒)
+	RevokedAt      int64                  `protobuf:"varint,13,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`              // 撤销时间 (Unix 秒)，未撤销为 0
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocumentShare) Reset() {
+	*x = DocumentShare{}
+	mi := &file_llmcenter_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentShare) ProtoMessage() {}
+
+func (x *DocumentShare) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentShare.ProtoReflect.Descriptor instead.
+func (*DocumentShare) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DocumentShare) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DocumentShare) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DocumentShare) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DocumentShare) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *DocumentShare) GetAllowDownload() bool {
+	if x != nil {
+		return x.AllowDownload
+	}
+	return false
+}
+
+func (x *DocumentShare) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DocumentShare) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetDownloadCount() int64 {
+	if x != nil {
+		return x.DownloadCount
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DocumentShare) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+// 请求: 创建分享链接
+type CreateDocumentShareRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId  string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`     // 可选: 文档所属会话，指定时校验文档属于该会话
+	MessageId       string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                    // 文档ID
+	ExpireSeconds   int64                  `protobuf:"varint,4,opt,name=expire_seconds,json=expireSeconds,proto3" json:"expire_seconds,omitempty"`       // 有效期，0 表示使用默认值，超过上限时按上限
+	Password        string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`                                       // 可选: 访问密码
+	DisableDownload bool                   `protobuf:"varint,6,opt,name=disable_download,json=disableDownload,proto3" json:"disable_download,omitempty"` // 是否禁止下载 DOCX/PDF
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateDocumentShareRequest) Reset() {
+	*x = CreateDocumentShareRequest{}
+	mi := &file_llmcenter_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentShareRequest) ProtoMessage() {}
+
+func (x *CreateDocumentShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentShareRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateDocumentShareRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateDocumentShareRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *CreateDocumentShareRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CreateDocumentShareRequest) GetExpireSeconds() int64 {
+	if x != nil {
+		return x.ExpireSeconds
+	}
+	return 0
+}
+
+func (x *CreateDocumentShareRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateDocumentShareRequest) GetDisableDownload() bool {
+	if x != nil {
+		return x.DisableDownload
+	}
+	return false
+}
+
+// 响应: 创建分享链接
+type CreateDocumentShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         *DocumentShare         `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentShareResponse) Reset() {
+	*x = CreateDocumentShareResponse{}
+	mi := &file_llmcenter_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentShareResponse) ProtoMessage() {}
+
+func (x *CreateDocumentShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentShareResponse.ProtoReflect.Descriptor instead.
+func (*CreateDocumentShareResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateDocumentShareResponse) GetShare() *DocumentShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// 请求: 查询文档的分享链接
+type ListDocumentSharesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDocumentSharesRequest) Reset() {
+	*x = ListDocumentSharesRequest{}
+	mi := &file_llmcenter_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentSharesRequest) ProtoMessage() {}
+
+func (x *ListDocumentSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentSharesRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListDocumentSharesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListDocumentSharesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListDocumentSharesRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// 响应: 查询文档的分享链接
+type ListDocumentSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DocumentShare       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 按创建时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentSharesResponse) Reset() {
+	*x = ListDocumentSharesResponse{}
+	mi := &file_llmcenter_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentSharesResponse) ProtoMessage() {}
+
+func (x *ListDocumentSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentSharesResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListDocumentSharesResponse) GetItems() []*DocumentShare {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 请求: 撤销分享链接
+type RevokeDocumentShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDocumentShareRequest) Reset() {
+	*x = RevokeDocumentShareRequest{}
+	mi := &file_llmcenter_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDocumentShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDocumentShareRequest) ProtoMessage() {}
+
+func (x *RevokeDocumentShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDocumentShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDocumentShareRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokeDocumentShareRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeDocumentShareRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 响应: 撤销分享链接
+type RevokeDocumentShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDocumentShareResponse) Reset() {
+	*x = RevokeDocumentShareResponse{}
+	mi := &file_llmcenter_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDocumentShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDocumentShareResponse) ProtoMessage() {}
+
+func (x *RevokeDocumentShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDocumentShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDocumentShareResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RevokeDocumentShareResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 请求: 通过分享链接访问文档（匿名）
+type OpenDocumentShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // 分享令牌
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`     // html | docx | pdf
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // 访问密码，设置了密码的链接需提供 password 或 unlock 之一
+	Unlock        string                 `protobuf:"bytes,4,opt,name=unlock,proto3" json:"unlock,omitempty"`     // 密码校验通过后返回的凭证，用于后续下载时免再次输入密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDocumentShareRequest) Reset() {
+	*x = OpenDocumentShareRequest{}
+	mi := &file_llmcenter_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDocumentShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDocumentShareRequest) ProtoMessage() {}
+
+func (x *OpenDocumentShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDocumentShareRequest.ProtoReflect.Descriptor instead.
+func (*OpenDocumentShareRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OpenDocumentShareRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareRequest) GetUnlock() string {
+	if x != nil {
+		return x.Unlock
+	}
+	return ""
+}
+
+// 响应: 通过分享链接访问文档
+type OpenDocumentShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`       // 文档首行，用作页面标题
+	Html          string                 `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`         // format 为 html 时返回的正文 HTML 片段（不含原始 HTML，可直接嵌入页面）
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // format 为 docx/pdf 时的文件名
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"` // format 为 docx/pdf 时的文件内容
+	AllowDownload bool                   `protobuf:"varint,6,opt,name=allow_download,json=allowDownload,proto3" json:"allow_download,omitempty"`
+	Unlock        string                 `protobuf:"bytes,7,opt,name=unlock,proto3" json:"unlock,omitempty"`                      // 设置了密码的链接校验通过后返回
+	ExpireAt      int64                  `protobuf:"varint,8,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // 过期时间 (Unix 秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDocumentShareResponse) Reset() {
+	*x = OpenDocumentShareResponse{}
+	mi := &file_llmcenter_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDocumentShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDocumentShareResponse) ProtoMessage() {}
+
+func (x *OpenDocumentShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDocumentShareResponse.ProtoReflect.Descriptor instead.
+func (*OpenDocumentShareResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OpenDocumentShareResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OpenDocumentShareResponse) GetAllowDownload() bool {
+	if x != nil {
+		return x.AllowDownload
+	}
+	return false
+}
+
+func (x *OpenDocumentShareResponse) GetUnlock() string {
+	if x != nil {
+		return x.Unlock
+	}
+	return ""
+}
+
+func (x *OpenDocumentShareResponse) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+// 结构: 批注引用的文本，位置以 Unicode 字符计，左闭右开
+type CommentAnchor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`     // 当前定位到的文本
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // anchored: 文本未变 | fuzzy: 文本已被修改，按近似匹配定位 | orphaned: 文本已被删除，start/end 为最后一次定位的位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentAnchor) Reset() {
+	*x = CommentAnchor{}
+	mi := &file_llmcenter_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentAnchor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAnchor) ProtoMessage() {}
+
+func (x *CommentAnchor) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAnchor.ProtoReflect.Descriptor instead.
+func (*CommentAnchor) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CommentAnchor) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *CommentAnchor) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *CommentAnchor) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CommentAnchor) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 结构: 批注或回复
+type DocumentComment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ParentId       int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 所属批注ID，0 表示首条批注
+	UserId         int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 发表者
+	Content        string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Quote          string                 `protobuf:"bytes,7,opt,name=quote,proto3" json:"quote,omitempty"`                                                   // 发表时引用的原文，为空表示针对全文
+	Anchor         *CommentAnchor         `protobuf:"bytes,8,opt,name=anchor,proto3" json:"anchor,omitempty"`                                                 // 首条批注引用的文本，未引用时为空
+	MentionUserIds []int64                `protobuf:"varint,9,rep,packed,name=mention_user_ids,json=mentionUserIds,proto3" json:"mention_user_ids,omitempty"` // 提及的用户
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                                // 所属批注的状态: open | resolved
+	ResolvedBy     int64                  `protobuf:"varint,11,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt     int64                  `protobuf:"varint,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unix 秒，未解决为 0
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Unix 秒
+	Replies        []*DocumentComment     `protobuf:"bytes,14,rep,name=replies,proto3" json:"replies,omitempty"`                          // 首条批注的回复，按发表顺序
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocumentComment) Reset() {
+	*x = DocumentComment{}
+	mi := &file_llmcenter_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentComment) ProtoMessage() {}
+
+func (x *DocumentComment) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentComment.ProtoReflect.Descriptor instead.
+func (*DocumentComment) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DocumentComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DocumentComment) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DocumentComment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DocumentComment) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *DocumentComment) GetAnchor() *CommentAnchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *DocumentComment) GetMentionUserIds() []int64 {
+	if x != nil {
+		return x.MentionUserIds
+	}
+	return nil
+}
+
+func (x *DocumentComment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DocumentComment) GetResolvedBy() int64 {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DocumentComment) GetReplies() []*DocumentComment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// 请求: 发表批注或回复
+type CreateDocumentCommentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ParentId       int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 回复的批注ID，为 0 时发表新批注
+	Content        string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	AnchorStart    int64                  `protobuf:"varint,6,opt,name=anchor_start,json=anchorStart,proto3" json:"anchor_start,omitempty"` // 引用文本的位置，anchor_end 大于 anchor_start 时有效，回复忽略
+	AnchorEnd      int64                  `protobuf:"varint,7,opt,name=anchor_end,json=anchorEnd,proto3" json:"anchor_end,omitempty"`
+	Quote          string                 `protobuf:"bytes,8,opt,name=quote,proto3" json:"quote,omitempty"`                                                   // 可选: 引用的文本，文档已被他人修改时据此重新定位
+	MentionUserIds []int64                `protobuf:"varint,9,rep,packed,name=mention_user_ids,json=mentionUserIds,proto3" json:"mention_user_ids,omitempty"` // 提及的用户，须能查看该文档
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateDocumentCommentRequest) Reset() {
+	*x = CreateDocumentCommentRequest{}
+	mi := &file_llmcenter_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentCommentRequest) ProtoMessage() {}
+
+func (x *CreateDocumentCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentCommentRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateDocumentCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateDocumentCommentRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *CreateDocumentCommentRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CreateDocumentCommentRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateDocumentCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateDocumentCommentRequest) GetAnchorStart() int64 {
+	if x != nil {
+		return x.AnchorStart
+	}
+	return 0
+}
+
+func (x *CreateDocumentCommentRequest) GetAnchorEnd() int64 {
+	if x != nil {
+		return x.AnchorEnd
+	}
+	return 0
+}
+
+func (x *CreateDocumentCommentRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *CreateDocumentCommentRequest) GetMentionUserIds() []int64 {
+	if x != nil {
+		return x.MentionUserIds
+	}
+	return nil
+}
+
+// 响应: 发表批注或回复
+type CreateDocumentCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *DocumentComment       `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentCommentResponse) Reset() {
+	*x = CreateDocumentCommentResponse{}
+	mi := &file_llmcenter_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentCommentResponse) ProtoMessage() {}
+
+func (x *CreateDocumentCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateDocumentCommentResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateDocumentCommentResponse) GetComment() *DocumentComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 请求: 查询文档的批注
+type ListDocumentCommentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // 可选: open | resolved，为空返回全部
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDocumentCommentsRequest) Reset() {
+	*x = ListDocumentCommentsRequest{}
+	mi := &file_llmcenter_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentCommentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListDocumentCommentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListDocumentCommentsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListDocumentCommentsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ListDocumentCommentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 响应: 查询文档的批注
+type ListDocumentCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DocumentComment     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                           // 首条批注按发表顺序，回复在 replies 中
+	OpenCount     int64                  `protobuf:"varint,2,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"` // 未解决的批注数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentCommentsResponse) Reset() {
+	*x = ListDocumentCommentsResponse{}
+	mi := &file_llmcenter_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentCommentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListDocumentCommentsResponse) GetItems() []*DocumentComment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDocumentCommentsResponse) GetOpenCount() int64 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+// 请求: 标记批注已解决或重新打开
+type ResolveDocumentCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`             // 首条批注ID，传入回复ID时作用于其所属批注
+	Resolved      bool                   `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"` // true 标记已解决，false 重新打开
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDocumentCommentRequest) Reset() {
+	*x = ResolveDocumentCommentRequest{}
+	mi := &file_llmcenter_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDocumentCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDocumentCommentRequest) ProtoMessage() {}
+
+func (x *ResolveDocumentCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDocumentCommentRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDocumentCommentRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResolveDocumentCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResolveDocumentCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveDocumentCommentRequest) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+// 响应: 标记批注已解决或重新打开
+type ResolveDocumentCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *DocumentComment       `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDocumentCommentResponse) Reset() {
+	*x = ResolveDocumentCommentResponse{}
+	mi := &file_llmcenter_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDocumentCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDocumentCommentResponse) ProtoMessage() {}
+
+func (x *ResolveDocumentCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDocumentCommentResponse.ProtoReflect.Descriptor instead.
+func (*ResolveDocumentCommentResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResolveDocumentCommentResponse) GetComment() *DocumentComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 请求: 查询提及当前用户的批注
+type ListMentionedCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OpenOnly      bool                   `protobuf:"varint,2,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"` // 只返回未解决的
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // 默认 50，最多 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionedCommentsRequest) Reset() {
+	*x = ListMentionedCommentsRequest{}
+	mi := &file_llmcenter_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionedCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionedCommentsRequest) ProtoMessage() {}
+
+func (x *ListMentionedCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionedCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionedCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListMentionedCommentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListMentionedCommentsRequest) GetOpenOnly() bool {
+	if x != nil {
+		return x.OpenOnly
+	}
+	return false
+}
+
+func (x *ListMentionedCommentsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 响应: 查询提及当前用户的批注
+type ListMentionedCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DocumentComment     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 按提及时间倒序，不含回复列表；已无权查看或已删除的文档不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionedCommentsResponse) Reset() {
+	*x = ListMentionedCommentsResponse{}
+	mi := &file_llmcenter_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionedCommentsResponse) ProtoMessage() {}
+
+func (x *ListMentionedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListMentionedCommentsResponse) GetItems() []*DocumentComment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 结构: 当前用户可执行的审批操作
+type ApprovalAction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Action          string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Label           string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	ToState         string                 `protobuf:"bytes,3,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
+	NeedAssignee    bool                   `protobuf:"varint,4,opt,name=need_assignee,json=needAssignee,proto3" json:"need_assignee,omitempty"`          // 须指定下一步的审批人
+	CommentRequired bool                   `protobuf:"varint,5,opt,name=comment_required,json=commentRequired,proto3" json:"comment_required,omitempty"` // 须填写审批意见
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApprovalAction) Reset() {
+	*x = ApprovalAction{}
+	mi := &file_llmcenter_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalAction) ProtoMessage() {}
+
+func (x *ApprovalAction) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalAction.ProtoReflect.Descriptor instead.
+func (*ApprovalAction) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApprovalAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApprovalAction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ApprovalAction) GetToState() string {
+	if x != nil {
+		return x.ToState
+	}
+	return ""
+}
+
+func (x *ApprovalAction) GetNeedAssignee() bool {
+	if x != nil {
+		return x.NeedAssignee
+	}
+	return false
+}
+
+func (x *ApprovalAction) GetCommentRequired() bool {
+	if x != nil {
+		return x.CommentRequired
+	}
+	return false
+}
+
+// 结构: 签署人，导出时标注在版头
+type ApprovalSigner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // 签署名目，如 核稿人、签发人
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	SignedAt      int64                  `protobuf:"varint,4,opt,name=signed_at,json=signedAt,proto3" json:"signed_at,omitempty"` // Unix 秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalSigner) Reset() {
+	*x = ApprovalSigner{}
+	mi := &file_llmcenter_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalSigner) ProtoMessage() {}
+
+func (x *ApprovalSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalSigner.ProtoReflect.Descriptor instead.
+func (*ApprovalSigner) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApprovalSigner) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ApprovalSigner) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApprovalSigner) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ApprovalSigner) GetSignedAt() int64 {
+	if x != nil {
+		return x.SignedAt
+	}
+	return 0
+}
+
+// 结构: 一次审批流转
+type ApprovalRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ActionLabel   string                 `protobuf:"bytes,3,opt,name=action_label,json=actionLabel,proto3" json:"action_label,omitempty"`
+	FromState     string                 `protobuf:"bytes,4,opt,name=from_state,json=fromState,proto3" json:"from_state,omitempty"`
+	ToState       string                 `protobuf:"bytes,5,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
+	UserId        int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 执行者
+	UserName      string                 `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`        // 执行时的昵称
+	AssigneeId    int64                  `protobuf:"varint,8,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // 流转后的审批人
+	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`                          // 审批意见
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // Unix 秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalRecord) Reset() {
+	*x = ApprovalRecord{}
+	mi := &file_llmcenter_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRecord) ProtoMessage() {}
+
+func (x *ApprovalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalRecord.ProtoReflect.Descriptor instead.
+func (*ApprovalRecord) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ApprovalRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetActionLabel() string {
+	if x != nil {
+		return x.ActionLabel
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetFromState() string {
+	if x != nil {
+		return x.FromState
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetToState() string {
+	if x != nil {
+		return x.ToState
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApprovalRecord) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *ApprovalRecord) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApprovalRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 结构: 文档的审批状态
+type DocumentApproval struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // 未进入审批流程的文档为初始状态
+	StateLabel     string                 `protobuf:"bytes,4,opt,name=state_label,json=stateLabel,proto3" json:"state_label,omitempty"`
+	Locked         bool                   `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`                              // 处于锁定状态，文档不能修改
+	AssigneeId     int64                  `protobuf:"varint,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`    // 当前审批人，0 表示无
+	SubmitterId    int64                  `protobuf:"varint,7,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"` // 最近一次提交审批的用户
+	Version        int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                            // 流转次数，流转时可传回用于检查状态是否已变化
+	UpdatedAt      int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // Unix 秒，未进入审批流程为 0
+	Actions        []*ApprovalAction      `protobuf:"bytes,10,rep,name=actions,proto3" json:"actions,omitempty"`                            // 当前用户可执行的操作
+	Signers        []*ApprovalSigner      `protobuf:"bytes,11,rep,name=signers,proto3" json:"signers,omitempty"`                            // 本轮审批的签署人，退回拟稿后重新计算
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocumentApproval) Reset() {
+	*x = DocumentApproval{}
+	mi := &file_llmcenter_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentApproval) ProtoMessage() {}
+
+func (x *DocumentApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentApproval.ProtoReflect.Descriptor instead.
+func (*DocumentApproval) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DocumentApproval) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DocumentApproval) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DocumentApproval) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DocumentApproval) GetStateLabel() string {
+	if x != nil {
+		return x.StateLabel
+	}
+	return ""
+}
+
+func (x *DocumentApproval) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *DocumentApproval) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *DocumentApproval) GetSubmitterId() int64 {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return 0
+}
+
+func (x *DocumentApproval) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DocumentApproval) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DocumentApproval) GetActions() []*ApprovalAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *DocumentApproval) GetSigners() []*ApprovalSigner {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+// 请求: 查询文档的审批状态
+type GetDocumentApprovalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDocumentApprovalRequest) Reset() {
+	*x = GetDocumentApprovalRequest{}
+	mi := &file_llmcenter_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentApprovalRequest) ProtoMessage() {}
+
+func (x *GetDocumentApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentApprovalRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDocumentApprovalRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDocumentApprovalRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *GetDocumentApprovalRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// 响应: 查询文档的审批状态
+type GetDocumentApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *DocumentApproval      `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	History       []*ApprovalRecord      `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"` // 按流转顺序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentApprovalResponse) Reset() {
+	*x = GetDocumentApprovalResponse{}
+	mi := &file_llmcenter_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentApprovalResponse) ProtoMessage() {}
+
+func (x *GetDocumentApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentApprovalResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDocumentApprovalResponse) GetApproval() *DocumentApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *GetDocumentApprovalResponse) GetHistory() []*ApprovalRecord {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// 请求: 执行审批流转
+type TransitionDocumentApprovalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Action         string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	AssigneeId     int64                  `protobuf:"varint,5,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // 进入须指定审批人的状态时必填，须为可以查看该文档的其他用户
+	Comment        string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`                          // 审批意见，最多 500 字
+	Version        int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`                         // 可选: 查看时的 version，与当前不一致时返回审批状态已变化
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransitionDocumentApprovalRequest) Reset() {
+	*x = TransitionDocumentApprovalRequest{}
+	mi := &file_llmcenter_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionDocumentApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionDocumentApprovalRequest) ProtoMessage() {}
+
+func (x *TransitionDocumentApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionDocumentApprovalRequest.ProtoReflect.Descriptor instead.
+func (*TransitionDocumentApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TransitionDocumentApprovalRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TransitionDocumentApprovalRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *TransitionDocumentApprovalRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *TransitionDocumentApprovalRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TransitionDocumentApprovalRequest) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *TransitionDocumentApprovalRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *TransitionDocumentApprovalRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 响应: 执行审批流转
+type TransitionDocumentApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *DocumentApproval      `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionDocumentApprovalResponse) Reset() {
+	*x = TransitionDocumentApprovalResponse{}
+	mi := &file_llmcenter_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionDocumentApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionDocumentApprovalResponse) ProtoMessage() {}
+
+func (x *TransitionDocumentApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionDocumentApprovalResponse.ProtoReflect.Descriptor instead.
+func (*TransitionDocumentApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TransitionDocumentApprovalResponse) GetApproval() *DocumentApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// 请求: 查询等待当前用户审批的文档
+type ListApprovalTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 默认 50，最多 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalTasksRequest) Reset() {
+	*x = ListApprovalTasksRequest{}
+	mi := &file_llmcenter_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalTasksRequest) ProtoMessage() {}
+
+func (x *ListApprovalTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalTasksRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListApprovalTasksRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListApprovalTasksRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 响应: 查询等待当前用户审批的文档
+type ListApprovalTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DocumentApproval    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 最近流转的在前；已无权查看或已删除的文档不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalTasksResponse) Reset() {
+	*x = ListApprovalTasksResponse{}
+	mi := &file_llmcenter_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalTasksResponse) ProtoMessage() {}
+
+func (x *ListApprovalTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalTasksResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListApprovalTasksResponse) GetItems() []*DocumentApproval {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 结构: 发文字号
+type DocNumber struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId    int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Prefix         string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"` // 发文机关代字
+	Year           int64                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Seq            int64                  `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`                 // 当年序号，从 1 开始
+	DocNo          string                 `protobuf:"bytes,6,opt,name=doc_no,json=docNo,proto3" json:"doc_no,omitempty"` // 如 某政〔2025〕12号
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`            // reserved | issued | released
+	ConversationId string                 `protobuf:"bytes,8,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,9,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`      // 预留或使用该字号的文档，已释放的为最后一次预留的文档
+	UserId         int64                  `protobuf:"varint,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 最后一次分配、使用或释放的用户
+	IssuedAt       int64                  `protobuf:"varint,11,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`       // Unix 秒，未使用为 0
+	ReleasedAt     int64                  `protobuf:"varint,12,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"` // Unix 秒，未释放过为 0
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Unix 秒
+	UpdatedAt      int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // Unix 秒
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocNumber) Reset() {
+	*x = DocNumber{}
+	mi := &file_llmcenter_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocNumber) ProtoMessage() {}
+
+func (x *DocNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocNumber.ProtoReflect.Descriptor instead.
+func (*DocNumber) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DocNumber) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocNumber) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *DocNumber) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *DocNumber) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DocNumber) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *DocNumber) GetDocNo() string {
+	if x != nil {
+		return x.DocNo
+	}
+	return ""
+}
+
+func (x *DocNumber) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DocNumber) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DocNumber) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DocNumber) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DocNumber) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *DocNumber) GetReleasedAt() int64 {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return 0
+}
+
+func (x *DocNumber) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DocNumber) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// 请求: 为文档分配发文字号
+type AllocateDocNoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId    int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`         // 可选: 发文字号登记为文档所属会话的团队，传入时须与之一致；个人空间的文档不能分配
+	Prefix         string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                                       // 发文机关代字，1~12 个汉字
+	ConversationId string                 `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AllocateDocNoRequest) Reset() {
+	*x = AllocateDocNoRequest{}
+	mi := &file_llmcenter_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateDocNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateDocNoRequest) ProtoMessage() {}
+
+func (x *AllocateDocNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateDocNoRequest.ProtoReflect.Descriptor instead.
+func (*AllocateDocNoRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AllocateDocNoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AllocateDocNoRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *AllocateDocNoRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AllocateDocNoRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AllocateDocNoRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// 响应: 为文档分配发文字号
+type AllocateDocNoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocNo         *DocNumber             `protobuf:"bytes,1,opt,name=doc_no,json=docNo,proto3" json:"doc_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateDocNoResponse) Reset() {
+	*x = AllocateDocNoResponse{}
+	mi := &file_llmcenter_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateDocNoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateDocNoResponse) ProtoMessage() {}
+
+func (x *AllocateDocNoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateDocNoResponse.ProtoReflect.Descriptor instead.
+func (*AllocateDocNoResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AllocateDocNoResponse) GetDocNo() *DocNumber {
+	if x != nil {
+		return x.DocNo
+	}
+	return nil
+}
+
+// 请求: 释放发文字号
+type ReleaseDocNoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDocNoRequest) Reset() {
+	*x = ReleaseDocNoRequest{}
+	mi := &file_llmcenter_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDocNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDocNoRequest) ProtoMessage() {}
+
+func (x *ReleaseDocNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDocNoRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseDocNoRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReleaseDocNoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReleaseDocNoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 响应: 释放发文字号
+type ReleaseDocNoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocNo         *DocNumber             `protobuf:"bytes,1,opt,name=doc_no,json=docNo,proto3" json:"doc_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDocNoResponse) Reset() {
+	*x = ReleaseDocNoResponse{}
+	mi := &file_llmcenter_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDocNoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDocNoResponse) ProtoMessage() {}
+
+func (x *ReleaseDocNoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDocNoResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseDocNoResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReleaseDocNoResponse) GetDocNo() *DocNumber {
+	if x != nil {
+		return x.DocNo
+	}
+	return nil
+}
+
+// 请求: 标记发文字号已使用
+type IssueDocNoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueDocNoRequest) Reset() {
+	*x = IssueDocNoRequest{}
+	mi := &file_llmcenter_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueDocNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueDocNoRequest) ProtoMessage() {}
+
+func (x *IssueDocNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueDocNoRequest.ProtoReflect.Descriptor instead.
+func (*IssueDocNoRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IssueDocNoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IssueDocNoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 响应: 标记发文字号已使用
+type IssueDocNoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocNo         *DocNumber             `protobuf:"bytes,1,opt,name=doc_no,json=docNo,proto3" json:"doc_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueDocNoResponse) Reset() {
+	*x = IssueDocNoResponse{}
+	mi := &file_llmcenter_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueDocNoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueDocNoResponse) ProtoMessage() {}
+
+func (x *IssueDocNoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueDocNoResponse.ProtoReflect.Descriptor instead.
+func (*IssueDocNoResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *IssueDocNoResponse) GetDocNo() *DocNumber {
+	if x != nil {
+		return x.DocNo
+	}
+	return nil
+}
+
+// 请求: 查询发文字号
+type ListDocNosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                      // 可选
+	Year          int64                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`                         // 可选
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                      // 可选: reserved | issued | released
+	Page          int64                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从 1 开始
+	PageSize      int64                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页条数，最大 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocNosRequest) Reset() {
+	*x = ListDocNosRequest{}
+	mi := &file_llmcenter_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocNosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocNosRequest) ProtoMessage() {}
+
+func (x *ListDocNosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocNosRequest.ProtoReflect.Descriptor instead.
+func (*ListDocNosRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListDocNosRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListDocNosRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ListDocNosRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListDocNosRequest) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ListDocNosRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDocNosRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDocNosRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 响应: 查询发文字号
+type ListDocNosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Items         []*DocNumber           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // 按年份倒序、同一代字按序号倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocNosResponse) Reset() {
+	*x = ListDocNosResponse{}
+	mi := &file_llmcenter_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocNosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocNosResponse) ProtoMessage() {}
+
+func (x *ListDocNosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocNosResponse.ProtoReflect.Descriptor instead.
+func (*ListDocNosResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListDocNosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDocNosResponse) GetItems() []*DocNumber {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 结构: 操作分量，三个字段有且只有一个非零
+type TextOp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retain        int64                  `protobuf:"varint,1,opt,name=retain,proto3" json:"retain,omitempty"`
+	Insert        string                 `protobuf:"bytes,2,opt,name=insert,proto3" json:"insert,omitempty"`
+	Delete        int64                  `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextOp) Reset() {
+	*x = TextOp{}
+	mi := &file_llmcenter_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextOp) ProtoMessage() {}
+
+func (x *TextOp) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextOp.ProtoReflect.Descriptor instead.
+func (*TextOp) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TextOp) GetRetain() int64 {
+	if x != nil {
+		return x.Retain
+	}
+	return 0
+}
+
+func (x *TextOp) GetInsert() string {
+	if x != nil {
+		return x.Insert
+	}
+	return ""
+}
+
+func (x *TextOp) GetDelete() int64 {
+	if x != nil {
+		return x.Delete
+	}
+	return 0
+}
+
+// 结构: 光标或选区，anchor 与 head 相同时为光标
+type CollabSelection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anchor        int64                  `protobuf:"varint,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	Head          int64                  `protobuf:"varint,2,opt,name=head,proto3" json:"head,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabSelection) Reset() {
+	*x = CollabSelection{}
+	mi := &file_llmcenter_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabSelection) ProtoMessage() {}
+
+func (x *CollabSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabSelection.ProtoReflect.Descriptor instead.
+func (*CollabSelection) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CollabSelection) GetAnchor() int64 {
+	if x != nil {
+		return x.Anchor
+	}
+	return 0
+}
+
+func (x *CollabSelection) GetHead() int64 {
+	if x != nil {
+		return x.Head
+	}
+	return 0
+}
+
+// 结构: 在线的协同编辑者，同一用户打开多个页面时有多个连接
+type CollabClient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	CanWrite      bool                   `protobuf:"varint,4,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"` // 只有查看权限的用户只能查看与显示光标
+	Selection     *CollabSelection       `protobuf:"bytes,5,opt,name=selection,proto3" json:"selection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabClient) Reset() {
+	*x = CollabClient{}
+	mi := &file_llmcenter_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabClient) ProtoMessage() {}
+
+func (x *CollabClient) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabClient.ProtoReflect.Descriptor instead.
+func (*CollabClient) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CollabClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CollabClient) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CollabClient) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CollabClient) GetCanWrite() bool {
+	if x != nil {
+		return x.CanWrite
+	}
+	return false
+}
+
+func (x *CollabClient) GetSelection() *CollabSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+// 请求: 加入文档的协同编辑，须为连接的首条消息
+type CollabJoin struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 可选: 文档所属会话
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CollabJoin) Reset() {
+	*x = CollabJoin{}
+	mi := &file_llmcenter_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabJoin) ProtoMessage() {}
+
+func (x *CollabJoin) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabJoin.ProtoReflect.Descriptor instead.
+func (*CollabJoin) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CollabJoin) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CollabJoin) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *CollabJoin) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// 请求: 提交编辑操作
+type CollabOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // 操作基于的版本，服务端与之后的操作转换后应用
+	Ops           []*TextOp              `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	Selection     *CollabSelection       `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"` // 可选: 应用操作后本连接的光标
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabOperation) Reset() {
+	*x = CollabOperation{}
+	mi := &file_llmcenter_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabOperation) ProtoMessage() {}
+
+func (x *CollabOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabOperation.ProtoReflect.Descriptor instead.
+func (*CollabOperation) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CollabOperation) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CollabOperation) GetOps() []*TextOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *CollabOperation) GetSelection() *CollabSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+// 请求: 协同编辑连接上的客户端消息
+type CollabRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*CollabRequest_Join
+	//	*CollabRequest_Operation
+	//	*CollabRequest_Selection
+	Event         isCollabRequest_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabRequest) Reset() {
+	*x = CollabRequest{}
+	mi := &file_llmcenter_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabRequest) ProtoMessage() {}
+
+func (x *CollabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabRequest.ProtoReflect.Descriptor instead.
+func (*CollabRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CollabRequest) GetEvent() isCollabRequest_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CollabRequest) GetJoin() *CollabJoin {
+	if x != nil {
+		if x, ok := x.Event.(*CollabRequest_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *CollabRequest) GetOperation() *CollabOperation {
+	if x != nil {
+		if x, ok := x.Event.(*CollabRequest_Operation); ok {
+			return x.Operation
+		}
+	}
+	return nil
+}
+
+func (x *CollabRequest) GetSelection() *CollabSelection {
+	if x != nil {
+		if x, ok := x.Event.(*CollabRequest_Selection); ok {
+			return x.Selection
+		}
+	}
+	return nil
+}
+
+type isCollabRequest_Event interface {
+	isCollabRequest_Event()
+}
+
+type CollabRequest_Join struct {
+	Join *CollabJoin `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type CollabRequest_Operation struct {
+	Operation *CollabOperation `protobuf:"bytes,2,opt,name=operation,proto3,oneof"`
+}
+
+type CollabRequest_Selection struct {
+	Selection *CollabSelection `protobuf:"bytes,3,opt,name=selection,proto3,oneof"` // 光标移动
+}
+
+func (*CollabRequest_Join) isCollabRequest_Event() {}
+
+func (*CollabRequest_Operation) isCollabRequest_Event() {}
+
+func (*CollabRequest_Selection) isCollabRequest_Event() {}
+
+// 事件: init，加入后的文档内容与在线的协同编辑者
+type CollabInit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // 本连接的ID
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CanWrite      bool                   `protobuf:"varint,4,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"`
+	Locked        bool                   `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`  // 文档处于锁定的审批状态，不能修改
+	Clients       []*CollabClient        `protobuf:"bytes,6,rep,name=clients,proto3" json:"clients,omitempty"` // 包括本连接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabInit) Reset() {
+	*x = CollabInit{}
+	mi := &file_llmcenter_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabInit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabInit) ProtoMessage() {}
+
+func (x *CollabInit) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabInit.ProtoReflect.Descriptor instead.
+func (*CollabInit) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CollabInit) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CollabInit) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CollabInit) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CollabInit) GetCanWrite() bool {
+	if x != nil {
+		return x.CanWrite
+	}
+	return false
+}
+
+func (x *CollabInit) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *CollabInit) GetClients() []*CollabClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+// 事件: ack，本连接提交的操作已应用
+type CollabAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // 应用后的版本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabAck) Reset() {
+	*x = CollabAck{}
+	mi := &file_llmcenter_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabAck) ProtoMessage() {}
+
+func (x *CollabAck) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabAck.ProtoReflect.Descriptor instead.
+func (*CollabAck) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CollabAck) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// 事件: operation，其他连接或服务端对文档的修改
+type CollabRemoteOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // 应用后的版本
+	Ops           []*TextOp              `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // 服务端的修改为空
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // collab（协同编辑）| edit（大模型修改）| update（整篇保存）| sync（其他实例保存的修改）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabRemoteOperation) Reset() {
+	*x = CollabRemoteOperation{}
+	mi := &file_llmcenter_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabRemoteOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabRemoteOperation) ProtoMessage() {}
+
+func (x *CollabRemoteOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabRemoteOperation.ProtoReflect.Descriptor instead.
+func (*CollabRemoteOperation) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CollabRemoteOperation) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CollabRemoteOperation) GetOps() []*TextOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *CollabRemoteOperation) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CollabRemoteOperation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CollabRemoteOperation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 事件: presence，协同编辑者加入、离开或移动光标
+type CollabPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *CollabClient          `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Left          bool                   `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabPresence) Reset() {
+	*x = CollabPresence{}
+	mi := &file_llmcenter_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabPresence) ProtoMessage() {}
+
+func (x *CollabPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabPresence.ProtoReflect.Descriptor instead.
+func (*CollabPresence) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CollabPresence) GetClient() *CollabClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *CollabPresence) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+// 事件: state，文档锁定状态变化或已保存
+type CollabState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locked        bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	SavedRevision int64                  `protobuf:"varint,2,opt,name=saved_revision,json=savedRevision,proto3" json:"saved_revision,omitempty"` // 已保存到数据库的版本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabState) Reset() {
+	*x = CollabState{}
+	mi := &file_llmcenter_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabState) ProtoMessage() {}
+
+func (x *CollabState) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabState.ProtoReflect.Descriptor instead.
+func (*CollabState) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CollabState) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *CollabState) GetSavedRevision() int64 {
+	if x != nil {
+		return x.SavedRevision
+	}
+	return 0
+}
+
+// 事件: reset，文档被锁定时丢弃未保存的修改，客户端须以此内容替换本地文档
+type CollabReset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabReset) Reset() {
+	*x = CollabReset{}
+	mi := &file_llmcenter_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabReset) ProtoMessage() {}
+
+func (x *CollabReset) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabReset.ProtoReflect.Descriptor instead.
+func (*CollabReset) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CollabReset) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CollabReset) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 响应: 协同编辑连接上的服务端消息
+type CollabResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*CollabResponse_Init
+	//	*CollabResponse_Ack
+	//	*CollabResponse_Operation
+	//	*CollabResponse_Presence
+	//	*CollabResponse_State
+	//	*CollabResponse_Reset_
+	Event         isCollabResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollabResponse) Reset() {
+	*x = CollabResponse{}
+	mi := &file_llmcenter_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollabResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollabResponse) ProtoMessage() {}
+
+func (x *CollabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollabResponse.ProtoReflect.Descriptor instead.
+func (*CollabResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CollabResponse) GetEvent() isCollabResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetInit() *CollabInit {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_Init); ok {
+			return x.Init
+		}
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetAck() *CollabAck {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetOperation() *CollabRemoteOperation {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_Operation); ok {
+			return x.Operation
+		}
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetPresence() *CollabPresence {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetState() *CollabState {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_State); ok {
+			return x.State
+		}
+	}
+	return nil
+}
+
+func (x *CollabResponse) GetReset_() *CollabReset {
+	if x != nil {
+		if x, ok := x.Event.(*CollabResponse_Reset_); ok {
+			return x.Reset_
+		}
+	}
+	return nil
+}
+
+type isCollabResponse_Event interface {
+	isCollabResponse_Event()
+}
+
+type CollabResponse_Init struct {
+	Init *CollabInit `protobuf:"bytes,1,opt,name=init,proto3,oneof"`
+}
+
+type CollabResponse_Ack struct {
+	Ack *CollabAck `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+}
+
+type CollabResponse_Operation struct {
+	Operation *CollabRemoteOperation `protobuf:"bytes,3,opt,name=operation,proto3,oneof"`
+}
+
+type CollabResponse_Presence struct {
+	Presence *CollabPresence `protobuf:"bytes,4,opt,name=presence,proto3,oneof"`
+}
+
+type CollabResponse_State struct {
+	State *CollabState `protobuf:"bytes,5,opt,name=state,proto3,oneof"`
+}
+
+type CollabResponse_Reset_ struct {
+	Reset_ *CollabReset `protobuf:"bytes,6,opt,name=reset,proto3,oneof"`
+}
+
+func (*CollabResponse_Init) isCollabResponse_Event() {}
+
+func (*CollabResponse_Ack) isCollabResponse_Event() {}
+
+func (*CollabResponse_Operation) isCollabResponse_Event() {}
+
+func (*CollabResponse_Presence) isCollabResponse_Event() {}
+
+func (*CollabResponse_State) isCollabResponse_Event() {}
+
+func (*CollabResponse_Reset_) isCollabResponse_Event() {}
+
+// 请求: 系统诊断
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	mi := &file_llmcenter_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{95}
+}
+
+// 响应: 系统诊断
+type GetDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // 服务名
+	GoVersion     string                 `protobuf:"bytes,2,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`          // 构建使用的 Go 版本
+	Revision      string                 `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`                             // 构建时的 git 提交，工作区有未提交修改时带 -dirty 后缀
+	BuildTime     string                 `protobuf:"bytes,4,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`          // 提交时间
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                 // 全部依赖检查通过时为 ok，否则为 fail
+	Checks        []*HealthCheck         `protobuf:"bytes,6,rep,name=checks,proto3" json:"checks,omitempty"`                                 // 依赖检查结果
+	ConfigIssues  []*ConfigIssue         `protobuf:"bytes,7,rep,name=config_issues,json=configIssues,proto3" json:"config_issues,omitempty"` // 配置问题
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsResponse) Reset() {
+	*x = GetDiagnosticsResponse{}
+	mi := &file_llmcenter_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetDiagnosticsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetChecks() []*HealthCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResponse) GetConfigIssues() []*ConfigIssue {
+	if x != nil {
+		return x.ConfigIssues
+	}
+	return nil
+}
+
+// 结构: 单项依赖检查结果
+type HealthCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // 检查项，例如 mysql、tesseract-langs、font-dir
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                            // ok | fail
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`                            // 版本、可用空间等附加信息
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                              // 失败原因
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // 耗时毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
+	mi := &file_llmcenter_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck) ProtoMessage() {}
+
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *HealthCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// 结构: 配置问题
+type ConfigIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`     // 配置项，例如 Download.SignKey
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 问题说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigIssue) Reset() {
+	*x = ConfigIssue{}
+	mi := &file_llmcenter_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigIssue) ProtoMessage() {}
+
+func (x *ConfigIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigIssue.ProtoReflect.Descriptor instead.
+func (*ConfigIssue) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ConfigIssue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ConfigIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 请求流: 文件上传
+// 客户端流的第一个消息必须是 FileInfo，后续消息为文件数据块。
+type FileUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*FileUploadRequest_Info
+	//	*FileUploadRequest_Chunk
+	Data          isFileUploadRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploadRequest) Reset() {
+	*x = FileUploadRequest{}
+	mi := &file_llmcenter_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadRequest) ProtoMessage() {}
+
+func (x *FileUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadRequest.ProtoReflect.Descriptor instead.
+func (*FileUploadRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FileUploadRequest) GetData() isFileUploadRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileUploadRequest) GetInfo() *FileInfo {
+	if x != nil {
+		if x, ok := x.Data.(*FileUploadRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *FileUploadRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*FileUploadRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isFileUploadRequest_Data interface {
+	isFileUploadRequest_Data()
+}
+
+type FileUploadRequest_Info struct {
+	Info *FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"` // 文件元信息
+}
+
+type FileUploadRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // 文件数据块
+}
+
+func (*FileUploadRequest_Info) isFileUploadRequest_Data() {}
+
+func (*FileUploadRequest_Chunk) isFileUploadRequest_Data() {}
+
+// 消息: 文件元信息
+type FileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`           // 原始文件名
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // api层传来的用户id
+	WorkspaceId   int64                  `protobuf:"varint,3,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 可选: 文件所属的团队空间ID，0 表示个人空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_llmcenter_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FileInfo) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+// 请求: 校验文件读取权限
+type CheckFileAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // api层传来的用户id
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`           // 服务器保存的文件名（stored_name）
+	OwnerOnly     bool                   `protobuf:"varint,3,opt,name=owner_only,json=ownerOnly,proto3" json:"owner_only,omitempty"` // 只允许上传者本人（如设置头像）；未登记的文件返回未找到
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFileAccessRequest) Reset() {
+	*x = CheckFileAccessRequest{}
+	mi := &file_llmcenter_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFileAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFileAccessRequest) ProtoMessage() {}
+
+func (x *CheckFileAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFileAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckFileAccessRequest) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CheckFileAccessRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckFileAccessRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CheckFileAccessRequest) GetOwnerOnly() bool {
+	if x != nil {
+		return x.OwnerOnly
+	}
+	return false
+}
+
+// 响应: 校验文件读取权限
+type CheckFileAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registered    bool                   `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"` // 是否为登记过的上传文件；未登记的文件（如导出文件）不做归属校验
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFileAccessResponse) Reset() {
+	*x = CheckFileAccessResponse{}
+	mi := &file_llmcenter_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFileAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFileAccessResponse) ProtoMessage() {}
+
+func (x *CheckFileAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFileAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckFileAccessResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CheckFileAccessResponse) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+// 响应: 文件上传成功
+type FileUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`       // 后端生成的唯一文件ID
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // 原始文件名
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // 文件的可访问URL
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                   // 成功消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploadResponse) Reset() {
+	*x = FileUploadResponse{}
+	mi := &file_llmcenter_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadResponse) ProtoMessage() {}
+
+func (x *FileUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadResponse.ProtoReflect.Descriptor instead.
+func (*FileUploadResponse) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *FileUploadResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileUploadResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileUploadResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FileUploadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 结构: 对话中引用的对象
+type Reference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                   // 引用类型, 例如: "file"
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // 文件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	mi := &file_llmcenter_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Reference) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
@@ -1693,13 +7316,14 @@ type Conversation struct {
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 会话ID
 	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                         // 会话标题
 	UpdatedAt      string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // 更新时间 (RFC3339 格式的字符串)
+	UserId         int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // 创建者用户ID，用于在团队空间中区分成员
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Conversation) Reset() {
 	*x = Conversation{}
-	mi := &file_llmcenter_proto_msgTypes[26]
+	mi := &file_llmcenter_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +7335,7 @@ func (x *Conversation) String() string {
 func (*Conversation) ProtoMessage() {}
 
 func (x *Conversation) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[26]
+	mi := &file_llmcenter_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,7 +7348,7 @@ func (x *Conversation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
 func (*Conversation) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{26}
+	return file_llmcenter_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *Conversation) GetConversationId() string {
@@ -1748,6 +7372,13 @@ func (x *Conversation) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Conversation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 结构: 单条历史消息
 type Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1762,7 +7393,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_llmcenter_proto_msgTypes[27]
+	mi := &file_llmcenter_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1774,7 +7405,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[27]
+	mi := &file_llmcenter_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,7 +7418,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{27}
+	return file_llmcenter_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *Message) GetId() string {
@@ -1836,7 +7467,7 @@ type SSEMessageEvent struct {
 
 func (x *SSEMessageEvent) Reset() {
 	*x = SSEMessageEvent{}
-	mi := &file_llmcenter_proto_msgTypes[28]
+	mi := &file_llmcenter_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1848,7 +7479,7 @@ func (x *SSEMessageEvent) String() string {
 func (*SSEMessageEvent) ProtoMessage() {}
 
 func (x *SSEMessageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[28]
+	mi := &file_llmcenter_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,7 +7492,7 @@ func (x *SSEMessageEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSEMessageEvent.ProtoReflect.Descriptor instead.
 func (*SSEMessageEvent) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{28}
+	return file_llmcenter_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *SSEMessageEvent) GetChunk() string {
@@ -1885,7 +7516,7 @@ type SSEInterruptEvent struct {
 
 func (x *SSEInterruptEvent) Reset() {
 	*x = SSEInterruptEvent{}
-	mi := &file_llmcenter_proto_msgTypes[29]
+	mi := &file_llmcenter_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1897,7 +7528,7 @@ func (x *SSEInterruptEvent) String() string {
 func (*SSEInterruptEvent) ProtoMessage() {}
 
 func (x *SSEInterruptEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[29]
+	mi := &file_llmcenter_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,7 +7541,7 @@ func (x *SSEInterruptEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSEInterruptEvent.ProtoReflect.Descriptor instead.
 func (*SSEInterruptEvent) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{29}
+	return file_llmcenter_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *SSEInterruptEvent) GetConversationId() string {
@@ -1953,7 +7584,7 @@ type SSEEndEvent struct {
 
 func (x *SSEEndEvent) Reset() {
 	*x = SSEEndEvent{}
-	mi := &file_llmcenter_proto_msgTypes[30]
+	mi := &file_llmcenter_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1965,7 +7596,7 @@ func (x *SSEEndEvent) String() string {
 func (*SSEEndEvent) ProtoMessage() {}
 
 func (x *SSEEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[30]
+	mi := &file_llmcenter_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +7609,7 @@ func (x *SSEEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSEEndEvent.ProtoReflect.Descriptor instead.
 func (*SSEEndEvent) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{30}
+	return file_llmcenter_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *SSEEndEvent) GetConversationId() string {
@@ -1995,6 +7626,52 @@ func (x *SSEEndEvent) GetMessageId() string {
 	return ""
 }
 
+// 事件: check
+// 文档生成并保存后的公文格式检查结果
+type SSECheckEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *CheckDocumentResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSECheckEvent) Reset() {
+	*x = SSECheckEvent{}
+	mi := &file_llmcenter_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSECheckEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSECheckEvent) ProtoMessage() {}
+
+func (x *SSECheckEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_llmcenter_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSECheckEvent.ProtoReflect.Descriptor instead.
+func (*SSECheckEvent) Descriptor() ([]byte, []int) {
+	return file_llmcenter_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SSECheckEvent) GetResult() *CheckDocumentResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type ConvertMarkdownLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`         // "pdf" | "docx"
@@ -2005,7 +7682,7 @@ type ConvertMarkdownLinkRequest struct {
 
 func (x *ConvertMarkdownLinkRequest) Reset() {
 	*x = ConvertMarkdownLinkRequest{}
-	mi := &file_llmcenter_proto_msgTypes[31]
+	mi := &file_llmcenter_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2017,7 +7694,7 @@ func (x *ConvertMarkdownLinkRequest) String() string {
 func (*ConvertMarkdownLinkRequest) ProtoMessage() {}
 
 func (x *ConvertMarkdownLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[31]
+	mi := &file_llmcenter_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,7 +7707,7 @@ func (x *ConvertMarkdownLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConvertMarkdownLinkRequest.ProtoReflect.Descriptor instead.
 func (*ConvertMarkdownLinkRequest) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{31}
+	return file_llmcenter_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *ConvertMarkdownLinkRequest) GetType() string {
@@ -2059,7 +7736,7 @@ type ConvertMarkdownLinkResponse struct {
 
 func (x *ConvertMarkdownLinkResponse) Reset() {
 	*x = ConvertMarkdownLinkResponse{}
-	mi := &file_llmcenter_proto_msgTypes[32]
+	mi := &file_llmcenter_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2071,7 +7748,7 @@ func (x *ConvertMarkdownLinkResponse) String() string {
 func (*ConvertMarkdownLinkResponse) ProtoMessage() {}
 
 func (x *ConvertMarkdownLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llmcenter_proto_msgTypes[32]
+	mi := &file_llmcenter_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +7761,7 @@ func (x *ConvertMarkdownLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConvertMarkdownLinkResponse.ProtoReflect.Descriptor instead.
 func (*ConvertMarkdownLinkResponse) Descriptor() ([]byte, []int) {
-	return file_llmcenter_proto_rawDescGZIP(), []int{32}
+	return file_llmcenter_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *ConvertMarkdownLinkResponse) GetFilename() string {
@@ -2119,7 +7796,7 @@ var File_llmcenter_proto protoreflect.FileDescriptor
 
 const file_llmcenter_proto_rawDesc = "" +
 	"\n" +
-	"\x0fllmcenter.proto\x12\tllmcenter\"\xcc\x02\n" +
+	"\x0fllmcenter.proto\x12\tllmcenter\"\xef\x02\n" +
 	"\x16ChatCompletionsRequest\x12\x17\n" +
 	"\auser_id\x18\x06 \x01(\x03R\x06userId\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\"\n" +
@@ -2130,12 +7807,13 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\x11knowledge_base_id\x18\x04 \x01(\tR\x0fknowledgeBaseId\x124\n" +
 	"\n" +
 	"references\x18\x05 \x03(\v2\x14.llmcenter.ReferenceR\n" +
-	"references\"\xc4\x01\n" +
+	"references\x12!\n" +
+	"\fworkspace_id\x18\t \x01(\x03R\vworkspaceId\"\xc4\x01\n" +
 	"\x17ChatCompletionsResponse\x126\n" +
 	"\amessage\x18\x01 \x01(\v2\x1a.llmcenter.SSEMessageEventH\x00R\amessage\x12<\n" +
 	"\tinterrupt\x18\x02 \x01(\v2\x1c.llmcenter.SSEInterruptEventH\x00R\tinterrupt\x12*\n" +
 	"\x03end\x18\x03 \x01(\v2\x16.llmcenter.SSEEndEventH\x00R\x03endB\a\n" +
-	"\x05event\"\xea\x01\n" +
+	"\x05event\"\x8d\x02\n" +
 	"\x11ChatResumeRequest\x12\x17\n" +
 	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x18\n" +
@@ -2145,23 +7823,28 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\fdocumenttype\x18\x05 \x01(\tR\fdocumenttype\x124\n" +
 	"\n" +
 	"references\x18\x06 \x03(\v2\x14.llmcenter.ReferenceR\n" +
-	"references\"\x81\x01\n" +
+	"references\x12!\n" +
+	"\fformat_check\x18\a \x01(\bR\vformatCheck\"\xb3\x01\n" +
 	"\x12ChatResumeResponse\x126\n" +
 	"\amessage\x18\x01 \x01(\v2\x1a.llmcenter.SSEMessageEventH\x00R\amessage\x12*\n" +
-	"\x03end\x18\x02 \x01(\v2\x16.llmcenter.SSEEndEventH\x00R\x03endB\a\n" +
-	"\x05event\"2\n" +
+	"\x03end\x18\x02 \x01(\v2\x16.llmcenter.SSEEndEventH\x00R\x03end\x120\n" +
+	"\x05check\x18\x03 \x01(\v2\x18.llmcenter.SSECheckEventH\x00R\x05checkB\a\n" +
+	"\x05event\"U\n" +
 	"\x17GetConversationsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\"G\n" +
 	"\x18GetConversationsResponse\x12+\n" +
-	"\x04data\x18\x01 \x03(\v2\x17.llmcenter.ConversationR\x04data\"G\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.llmcenter.ConversationR\x04data\"`\n" +
 	"\x1cGetConversationDetailRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"\x8c\x01\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x8c\x01\n" +
 	"\x1dGetConversationDetailResponse\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12,\n" +
-	"\ahistory\x18\x03 \x03(\v2\x12.llmcenter.MessageR\ahistory\"C\n" +
+	"\ahistory\x18\x03 \x03(\v2\x12.llmcenter.MessageR\ahistory\"\\\n" +
 	"\x18GetDocumentDetailRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"b\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"b\n" +
 	"\bDocument\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
@@ -2170,9 +7853,10 @@ const file_llmcenter_proto_rawDesc = "" +
 	"created_at\x18\x03 \x01(\tR\tcreatedAt\"w\n" +
 	"\x19GetDocumentDetailResponse\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x121\n" +
-	"\tdocuments\x18\x02 \x03(\v2\x13.llmcenter.DocumentR\tdocuments\"@\n" +
+	"\tdocuments\x18\x02 \x03(\v2\x13.llmcenter.DocumentR\tdocuments\"Y\n" +
 	"\x15GetHistoryDataRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"o\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"o\n" +
 	"\x16GetHistoryDataResponse\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12,\n" +
 	"\x05items\x18\x02 \x03(\v2\x16.llmcenter.HistoryDataR\x05items\"\xe7\x01\n" +
@@ -2190,7 +7874,7 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\rFileReference\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1a\n" +
-	"\bfunction\x18\x03 \x01(\tR\bfunction\"\xe8\x01\n" +
+	"\bfunction\x18\x03 \x01(\tR\bfunction\"\xb6\x02\n" +
 	"\x13EditDocumentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
@@ -2198,35 +7882,494 @@ const file_llmcenter_proto_rawDesc = "" +
 	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x16\n" +
 	"\x06prompt\x18\x04 \x01(\tR\x06prompt\x12,\n" +
 	"\x12use_knowledge_base\x18\x05 \x01(\bR\x10useKnowledgeBase\x12*\n" +
-	"\x11knowledge_base_id\x18\x06 \x01(\tR\x0fknowledgeBaseId\"\x83\x01\n" +
+	"\x11knowledge_base_id\x18\x06 \x01(\tR\x0fknowledgeBaseId\x12)\n" +
+	"\x10address_comments\x18\a \x01(\bR\x0faddressComments\x12!\n" +
+	"\fformat_check\x18\b \x01(\bR\vformatCheck\"\xb5\x01\n" +
 	"\x14EditDocumentResponse\x126\n" +
 	"\amessage\x18\x01 \x01(\v2\x1a.llmcenter.SSEMessageEventH\x00R\amessage\x12*\n" +
-	"\x03end\x18\x02 \x01(\v2\x16.llmcenter.SSEEndEventH\x00R\x03endB\a\n" +
-	"\x05event\"w\n" +
+	"\x03end\x18\x02 \x01(\v2\x16.llmcenter.SSEEndEventH\x00R\x03end\x120\n" +
+	"\x05check\x18\x03 \x01(\v2\x18.llmcenter.SSECheckEventH\x00R\x05checkB\a\n" +
+	"\x05event\"\xb2\x01\n" +
 	"\x15UpdateDocumentRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x16\n" +
-	"\x06prompt\x18\x03 \x01(\tR\x06prompt\"2\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x17\n" +
+	"\x04base\x18\x05 \x01(\tH\x00R\x04base\x88\x01\x01B\a\n" +
+	"\x05_base\"2\n" +
 	"\x16UpdateDocumentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x7f\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb3\x02\n" +
 	"\x16ConvertMarkdownRequest\x12\x1a\n" +
 	"\bmarkdown\x18\x01 \x01(\tR\bmarkdown\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x125\n" +
-	"\vinformation\x18\x03 \x03(\v2\x13.llmcenter.InfoItemR\vinformation\"l\n" +
+	"\vinformation\x18\x03 \x03(\v2\x13.llmcenter.InfoItemR\vinformation\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x05 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x06 \x01(\tR\tmessageId\x12-\n" +
+	"\x13doc_no_workspace_id\x18\a \x01(\x03R\x10docNoWorkspaceId\x12\"\n" +
+	"\rdoc_no_prefix\x18\b \x01(\tR\vdocNoPrefix\"\x83\x01\n" +
 	"\x17ConvertMarkdownResponse\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\fR\x04data\"8\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x15\n" +
+	"\x06doc_no\x18\x04 \x01(\tR\x05docNo\"8\n" +
 	"\bInfoItem\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
-	"\acontant\x18\x02 \x01(\tR\acontant\"^\n" +
+	"\acontant\x18\x02 \x01(\tR\acontant\"w\n" +
+	"\x14CheckDocumentRequest\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\xe9\x01\n" +
+	"\x15CheckDocumentResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x1f\n" +
+	"\verror_count\x18\x03 \x01(\x03R\n" +
+	"errorCount\x12#\n" +
+	"\rwarning_count\x18\x04 \x01(\x03R\fwarningCount\x12\x1d\n" +
+	"\n" +
+	"info_count\x18\x05 \x01(\x03R\tinfoCount\x124\n" +
+	"\bfindings\x18\x06 \x03(\v2\x18.llmcenter.FormatFindingR\bfindings\"x\n" +
+	"\x15DeleteDocumentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"2\n" +
+	"\x16DeleteDocumentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbf\x01\n" +
+	"\rFormatFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x03R\x04line\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\x03R\x06column\x12\x18\n" +
+	"\aexcerpt\x18\x05 \x01(\tR\aexcerpt\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"suggestion\x18\a \x01(\tR\n" +
+	"suggestion\"\xa3\x01\n" +
+	"\rAuditLogQuery\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\x03R\aendTime\"w\n" +
+	"\x14ListAuditLogsRequest\x12.\n" +
+	"\x05query\x18\x01 \x01(\v2\x18.llmcenter.AuditLogQueryR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\"X\n" +
+	"\x15ListAuditLogsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.llmcenter.AuditLogR\x05items\"H\n" +
+	"\x16ExportAuditLogsRequest\x12.\n" +
+	"\x05query\x18\x01 \x01(\v2\x18.llmcenter.AuditLogQueryR\x05query\"l\n" +
+	"\x17ExportAuditLogsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xc4\x02\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12'\n" +
+	"\x0fconversation_id\x18\x04 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1b\n" +
+	"\tclient_ip\x18\x06 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12\x1f\n" +
+	"\vhash_before\x18\b \x01(\tR\n" +
+	"hashBefore\x12\x1d\n" +
+	"\n" +
+	"hash_after\x18\t \x01(\tR\thashAfter\x12\x16\n" +
+	"\x06detail\x18\n" +
+	" \x01(\tR\x06detail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"k\n" +
+	"\x16GetUsageSummaryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\x9f\x01\n" +
+	"\x17GetUsageSummaryResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.llmcenter.UsageItemR\x05items\x12*\n" +
+	"\x05total\x18\x02 \x01(\v2\x14.llmcenter.UsageItemR\x05total\x12,\n" +
+	"\x05quota\x18\x03 \x01(\v2\x16.llmcenter.QuotaStatusR\x05quota\"\x9b\x02\n" +
+	"\tUsageItem\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\tcall_type\x18\x02 \x01(\tR\bcallType\x12#\n" +
+	"\rrequest_count\x18\x03 \x01(\x03R\frequestCount\x12#\n" +
+	"\rsuccess_count\x18\x04 \x01(\x03R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\x05 \x01(\x03R\ffailureCount\x12!\n" +
+	"\fprompt_chars\x18\x06 \x01(\x03R\vpromptChars\x12%\n" +
+	"\x0eresponse_chars\x18\a \x01(\x03R\rresponseChars\x12$\n" +
+	"\x0eavg_latency_ms\x18\b \x01(\x03R\favgLatencyMs\"\x8d\x03\n" +
+	"\vQuotaStatus\x12.\n" +
+	"\x13daily_requests_used\x18\x01 \x01(\x03R\x11dailyRequestsUsed\x120\n" +
+	"\x14daily_requests_limit\x18\x02 \x01(\x03R\x12dailyRequestsLimit\x122\n" +
+	"\x15monthly_requests_used\x18\x03 \x01(\x03R\x13monthlyRequestsUsed\x124\n" +
+	"\x16monthly_requests_limit\x18\x04 \x01(\x03R\x14monthlyRequestsLimit\x12(\n" +
+	"\x10daily_chars_used\x18\x05 \x01(\x03R\x0edailyCharsUsed\x12*\n" +
+	"\x11daily_chars_limit\x18\x06 \x01(\x03R\x0fdailyCharsLimit\x12,\n" +
+	"\x12monthly_chars_used\x18\a \x01(\x03R\x10monthlyCharsUsed\x12.\n" +
+	"\x13monthly_chars_limit\x18\b \x01(\x03R\x11monthlyCharsLimit\"\x90\x02\n" +
+	"\n" +
+	"UsageQuota\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fsubject_type\x18\x02 \x01(\tR\vsubjectType\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12%\n" +
+	"\x0edaily_requests\x18\x04 \x01(\x03R\rdailyRequests\x12)\n" +
+	"\x10monthly_requests\x18\x05 \x01(\x03R\x0fmonthlyRequests\x12\x1f\n" +
+	"\vdaily_chars\x18\x06 \x01(\x03R\n" +
+	"dailyChars\x12#\n" +
+	"\rmonthly_chars\x18\a \x01(\x03R\fmonthlyChars\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x18\n" +
+	"\x16ListUsageQuotasRequest\"F\n" +
+	"\x17ListUsageQuotasResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.llmcenter.UsageQuotaR\x05items\"C\n" +
+	"\x14SetUsageQuotaRequest\x12+\n" +
+	"\x05quota\x18\x01 \x01(\v2\x15.llmcenter.UsageQuotaR\x05quota\"D\n" +
+	"\x15SetUsageQuotaResponse\x12+\n" +
+	"\x05quota\x18\x01 \x01(\v2\x15.llmcenter.UsageQuotaR\x05quota\")\n" +
+	"\x17DeleteUsageQuotaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x18DeleteUsageQuotaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x95\x03\n" +
+	"\rDocumentShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12!\n" +
+	"\fhas_password\x18\x06 \x01(\bR\vhasPassword\x12%\n" +
+	"\x0eallow_download\x18\a \x01(\bR\rallowDownload\x12\x1b\n" +
+	"\texpire_at\x18\b \x01(\x03R\bexpireAt\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\n" +
+	" \x01(\x03R\tviewCount\x12%\n" +
+	"\x0edownload_count\x18\v \x01(\x03R\rdownloadCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\r \x01(\x03R\trevokedAt\"\xeb\x01\n" +
+	"\x1aCreateDocumentShareRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12%\n" +
+	"\x0eexpire_seconds\x18\x04 \x01(\x03R\rexpireSeconds\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12)\n" +
+	"\x10disable_download\x18\x06 \x01(\bR\x0fdisableDownload\"M\n" +
+	"\x1bCreateDocumentShareResponse\x12.\n" +
+	"\x05share\x18\x01 \x01(\v2\x18.llmcenter.DocumentShareR\x05share\"|\n" +
+	"\x19ListDocumentSharesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"L\n" +
+	"\x1aListDocumentSharesResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.llmcenter.DocumentShareR\x05items\"E\n" +
+	"\x1aRevokeDocumentShareRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"7\n" +
+	"\x1bRevokeDocumentShareResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x18OpenDocumentShareRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06unlock\x18\x04 \x01(\tR\x06unlock\"\xf4\x01\n" +
+	"\x19OpenDocumentShareResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12%\n" +
+	"\x0eallow_download\x18\x06 \x01(\bR\rallowDownload\x12\x16\n" +
+	"\x06unlock\x18\a \x01(\tR\x06unlock\x12\x1b\n" +
+	"\texpire_at\x18\b \x01(\x03R\bexpireAt\"c\n" +
+	"\rCommentAnchor\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x03R\x03end\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xda\x03\n" +
+	"\x0fDocumentComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x14\n" +
+	"\x05quote\x18\a \x01(\tR\x05quote\x120\n" +
+	"\x06anchor\x18\b \x01(\v2\x18.llmcenter.CommentAnchorR\x06anchor\x12(\n" +
+	"\x10mention_user_ids\x18\t \x03(\x03R\x0ementionUserIds\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\v \x01(\x03R\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\f \x01(\x03R\n" +
+	"resolvedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x124\n" +
+	"\areplies\x18\x0e \x03(\v2\x1a.llmcenter.DocumentCommentR\areplies\"\xb8\x02\n" +
+	"\x1cCreateDocumentCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12!\n" +
+	"\fanchor_start\x18\x06 \x01(\x03R\vanchorStart\x12\x1d\n" +
+	"\n" +
+	"anchor_end\x18\a \x01(\x03R\tanchorEnd\x12\x14\n" +
+	"\x05quote\x18\b \x01(\tR\x05quote\x12(\n" +
+	"\x10mention_user_ids\x18\t \x03(\x03R\x0ementionUserIds\"U\n" +
+	"\x1dCreateDocumentCommentResponse\x124\n" +
+	"\acomment\x18\x01 \x01(\v2\x1a.llmcenter.DocumentCommentR\acomment\"\x96\x01\n" +
+	"\x1bListDocumentCommentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"o\n" +
+	"\x1cListDocumentCommentsResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.llmcenter.DocumentCommentR\x05items\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x02 \x01(\x03R\topenCount\"d\n" +
+	"\x1dResolveDocumentCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bresolved\x18\x03 \x01(\bR\bresolved\"V\n" +
+	"\x1eResolveDocumentCommentResponse\x124\n" +
+	"\acomment\x18\x01 \x01(\v2\x1a.llmcenter.DocumentCommentR\acomment\"j\n" +
+	"\x1cListMentionedCommentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\topen_only\x18\x02 \x01(\bR\bopenOnly\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"Q\n" +
+	"\x1dListMentionedCommentsResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.llmcenter.DocumentCommentR\x05items\"\xa9\x01\n" +
+	"\x0eApprovalAction\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x19\n" +
+	"\bto_state\x18\x03 \x01(\tR\atoState\x12#\n" +
+	"\rneed_assignee\x18\x04 \x01(\bR\fneedAssignee\x12)\n" +
+	"\x10comment_required\x18\x05 \x01(\bR\x0fcommentRequired\"y\n" +
+	"\x0eApprovalSigner\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1b\n" +
+	"\tsigned_at\x18\x04 \x01(\x03R\bsignedAt\"\xa5\x02\n" +
+	"\x0eApprovalRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12!\n" +
+	"\faction_label\x18\x03 \x01(\tR\vactionLabel\x12\x1d\n" +
+	"\n" +
+	"from_state\x18\x04 \x01(\tR\tfromState\x12\x19\n" +
+	"\bto_state\x18\x05 \x01(\tR\atoState\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\a \x01(\tR\buserName\x12\x1f\n" +
+	"\vassignee_id\x18\b \x01(\x03R\n" +
+	"assigneeId\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"\x90\x03\n" +
+	"\x10DocumentApproval\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1f\n" +
+	"\vstate_label\x18\x04 \x01(\tR\n" +
+	"stateLabel\x12\x16\n" +
+	"\x06locked\x18\x05 \x01(\bR\x06locked\x12\x1f\n" +
+	"\vassignee_id\x18\x06 \x01(\x03R\n" +
+	"assigneeId\x12!\n" +
+	"\fsubmitter_id\x18\a \x01(\x03R\vsubmitterId\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\x123\n" +
+	"\aactions\x18\n" +
+	" \x03(\v2\x19.llmcenter.ApprovalActionR\aactions\x123\n" +
+	"\asigners\x18\v \x03(\v2\x19.llmcenter.ApprovalSignerR\asigners\"}\n" +
+	"\x1aGetDocumentApprovalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"\x8b\x01\n" +
+	"\x1bGetDocumentApprovalResponse\x127\n" +
+	"\bapproval\x18\x01 \x01(\v2\x1b.llmcenter.DocumentApprovalR\bapproval\x123\n" +
+	"\ahistory\x18\x02 \x03(\v2\x19.llmcenter.ApprovalRecordR\ahistory\"\xf1\x01\n" +
+	"!TransitionDocumentApprovalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1f\n" +
+	"\vassignee_id\x18\x05 \x01(\x03R\n" +
+	"assigneeId\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"]\n" +
+	"\"TransitionDocumentApprovalResponse\x127\n" +
+	"\bapproval\x18\x01 \x01(\v2\x1b.llmcenter.DocumentApprovalR\bapproval\"I\n" +
+	"\x18ListApprovalTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"N\n" +
+	"\x19ListApprovalTasksResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.llmcenter.DocumentApprovalR\x05items\"\x88\x03\n" +
+	"\tDocNumber\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04year\x18\x04 \x01(\x03R\x04year\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\x03R\x03seq\x12\x15\n" +
+	"\x06doc_no\x18\x06 \x01(\tR\x05docNo\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x0fconversation_id\x18\b \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\t \x01(\tR\tmessageId\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tissued_at\x18\v \x01(\x03R\bissuedAt\x12\x1f\n" +
+	"\vreleased_at\x18\f \x01(\x03R\n" +
+	"releasedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\"\xb2\x01\n" +
+	"\x14AllocateDocNoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12'\n" +
+	"\x0fconversation_id\x18\x04 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\"D\n" +
+	"\x15AllocateDocNoResponse\x12+\n" +
+	"\x06doc_no\x18\x01 \x01(\v2\x14.llmcenter.DocNumberR\x05docNo\">\n" +
+	"\x13ReleaseDocNoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"C\n" +
+	"\x14ReleaseDocNoResponse\x12+\n" +
+	"\x06doc_no\x18\x01 \x01(\v2\x14.llmcenter.DocNumberR\x05docNo\"<\n" +
+	"\x11IssueDocNoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"A\n" +
+	"\x12IssueDocNoResponse\x12+\n" +
+	"\x06doc_no\x18\x01 \x01(\v2\x14.llmcenter.DocNumberR\x05docNo\"\xc4\x01\n" +
+	"\x11ListDocNosRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04year\x18\x04 \x01(\x03R\x04year\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x03R\bpageSize\"V\n" +
+	"\x12ListDocNosResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.llmcenter.DocNumberR\x05items\"P\n" +
+	"\x06TextOp\x12\x16\n" +
+	"\x06retain\x18\x01 \x01(\x03R\x06retain\x12\x16\n" +
+	"\x06insert\x18\x02 \x01(\tR\x06insert\x12\x16\n" +
+	"\x06delete\x18\x03 \x01(\x03R\x06delete\"=\n" +
+	"\x0fCollabSelection\x12\x16\n" +
+	"\x06anchor\x18\x01 \x01(\x03R\x06anchor\x12\x12\n" +
+	"\x04head\x18\x02 \x01(\x03R\x04head\"\xb8\x01\n" +
+	"\fCollabClient\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1b\n" +
+	"\tcan_write\x18\x04 \x01(\bR\bcanWrite\x128\n" +
+	"\tselection\x18\x05 \x01(\v2\x1a.llmcenter.CollabSelectionR\tselection\"m\n" +
+	"\n" +
+	"CollabJoin\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"\x8c\x01\n" +
+	"\x0fCollabOperation\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12#\n" +
+	"\x03ops\x18\x02 \x03(\v2\x11.llmcenter.TextOpR\x03ops\x128\n" +
+	"\tselection\x18\x03 \x01(\v2\x1a.llmcenter.CollabSelectionR\tselection\"\xbd\x01\n" +
+	"\rCollabRequest\x12+\n" +
+	"\x04join\x18\x01 \x01(\v2\x15.llmcenter.CollabJoinH\x00R\x04join\x12:\n" +
+	"\toperation\x18\x02 \x01(\v2\x1a.llmcenter.CollabOperationH\x00R\toperation\x12:\n" +
+	"\tselection\x18\x03 \x01(\v2\x1a.llmcenter.CollabSelectionH\x00R\tselectionB\a\n" +
+	"\x05event\"\xc7\x01\n" +
+	"\n" +
+	"CollabInit\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
+	"\tcan_write\x18\x04 \x01(\bR\bcanWrite\x12\x16\n" +
+	"\x06locked\x18\x05 \x01(\bR\x06locked\x121\n" +
+	"\aclients\x18\x06 \x03(\v2\x17.llmcenter.CollabClientR\aclients\"'\n" +
+	"\tCollabAck\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"\xa6\x01\n" +
+	"\x15CollabRemoteOperation\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12#\n" +
+	"\x03ops\x18\x02 \x03(\v2\x11.llmcenter.TextOpR\x03ops\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"U\n" +
+	"\x0eCollabPresence\x12/\n" +
+	"\x06client\x18\x01 \x01(\v2\x17.llmcenter.CollabClientR\x06client\x12\x12\n" +
+	"\x04left\x18\x02 \x01(\bR\x04left\"L\n" +
+	"\vCollabState\x12\x16\n" +
+	"\x06locked\x18\x01 \x01(\bR\x06locked\x12%\n" +
+	"\x0esaved_revision\x18\x02 \x01(\x03R\rsavedRevision\"C\n" +
+	"\vCollabReset\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xcb\x02\n" +
+	"\x0eCollabResponse\x12+\n" +
+	"\x04init\x18\x01 \x01(\v2\x15.llmcenter.CollabInitH\x00R\x04init\x12(\n" +
+	"\x03ack\x18\x02 \x01(\v2\x14.llmcenter.CollabAckH\x00R\x03ack\x12@\n" +
+	"\toperation\x18\x03 \x01(\v2 .llmcenter.CollabRemoteOperationH\x00R\toperation\x127\n" +
+	"\bpresence\x18\x04 \x01(\v2\x19.llmcenter.CollabPresenceH\x00R\bpresence\x12.\n" +
+	"\x05state\x18\x05 \x01(\v2\x16.llmcenter.CollabStateH\x00R\x05state\x12.\n" +
+	"\x05reset\x18\x06 \x01(\v2\x16.llmcenter.CollabResetH\x00R\x05resetB\a\n" +
+	"\x05event\"\x17\n" +
+	"\x15GetDiagnosticsRequest\"\x8b\x02\n" +
+	"\x16GetDiagnosticsResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x02 \x01(\tR\tgoVersion\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\tR\brevision\x12\x1d\n" +
+	"\n" +
+	"build_time\x18\x04 \x01(\tR\tbuildTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12.\n" +
+	"\x06checks\x18\x06 \x03(\v2\x16.llmcenter.HealthCheckR\x06checks\x12;\n" +
+	"\rconfig_issues\x18\a \x03(\v2\x16.llmcenter.ConfigIssueR\fconfigIssues\"\x88\x01\n" +
+	"\vHealthCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"=\n" +
+	"\vConfigIssue\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
 	"\x11FileUploadRequest\x12)\n" +
 	"\x04info\x18\x01 \x01(\v2\x13.llmcenter.FileInfoH\x00R\x04info\x12\x16\n" +
 	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
-	"\x04data\"'\n" +
+	"\x04data\"c\n" +
 	"\bFileInfo\x12\x1b\n" +
-	"\tfile_name\x18\x01 \x01(\tR\bfileName\"v\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x03 \x01(\x03R\vworkspaceId\"i\n" +
+	"\x16CheckFileAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"owner_only\x18\x03 \x01(\bR\townerOnly\"9\n" +
+	"\x17CheckFileAccessResponse\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x01 \x01(\bR\n" +
+	"registered\"v\n" +
 	"\x12FileUploadResponse\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x10\n" +
@@ -2234,12 +8377,13 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\amessage\x18\x04 \x01(\tR\amessage\"8\n" +
 	"\tReference\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x17\n" +
-	"\afile_id\x18\x02 \x01(\tR\x06fileId\"l\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"\x85\x01\n" +
 	"\fConversation\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"\x89\x01\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\"\x89\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x18\n" +
@@ -2258,7 +8402,9 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\vSSEEndEvent\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\"L\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"I\n" +
+	"\rSSECheckEvent\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .llmcenter.CheckDocumentResponseR\x06result\"L\n" +
 	"\x1aConvertMarkdownLinkRequest\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
 	"\bmarkdown\x18\x02 \x01(\tR\bmarkdown\"\x82\x01\n" +
@@ -2266,7 +8412,7 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\x12\x10\n" +
-	"\x03url\x18\x04 \x01(\tR\x03url2\xeb\a\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url2\xe0\x1a\n" +
 	"\tLlmCenter\x12Z\n" +
 	"\x0fChatCompletions\x12!.llmcenter.ChatCompletionsRequest\x1a\".llmcenter.ChatCompletionsResponse0\x01\x12K\n" +
 	"\n" +
@@ -2280,7 +8426,35 @@ const file_llmcenter_proto_rawDesc = "" +
 	"\fEditDocument\x12\x1e.llmcenter.EditDocumentRequest\x1a\x1f.llmcenter.EditDocumentResponse0\x01\x12U\n" +
 	"\x0eUpdateDocument\x12 .llmcenter.UpdateDocumentRequest\x1a!.llmcenter.UpdateDocumentResponse\x12X\n" +
 	"\x0fConvertMarkdown\x12!.llmcenter.ConvertMarkdownRequest\x1a\".llmcenter.ConvertMarkdownResponse\x12d\n" +
-	"\x13ConvertMarkdownLink\x12%.llmcenter.ConvertMarkdownLinkRequest\x1a&.llmcenter.ConvertMarkdownLinkResponseB\x06Z\x04./pbb\x06proto3"
+	"\x13ConvertMarkdownLink\x12%.llmcenter.ConvertMarkdownLinkRequest\x1a&.llmcenter.ConvertMarkdownLinkResponse\x12R\n" +
+	"\rCheckDocument\x12\x1f.llmcenter.CheckDocumentRequest\x1a .llmcenter.CheckDocumentResponse\x12U\n" +
+	"\x0eDeleteDocument\x12 .llmcenter.DeleteDocumentRequest\x1a!.llmcenter.DeleteDocumentResponse\x12R\n" +
+	"\rListAuditLogs\x12\x1f.llmcenter.ListAuditLogsRequest\x1a .llmcenter.ListAuditLogsResponse\x12X\n" +
+	"\x0fExportAuditLogs\x12!.llmcenter.ExportAuditLogsRequest\x1a\".llmcenter.ExportAuditLogsResponse\x12X\n" +
+	"\x0fCheckFileAccess\x12!.llmcenter.CheckFileAccessRequest\x1a\".llmcenter.CheckFileAccessResponse\x12X\n" +
+	"\x0fGetUsageSummary\x12!.llmcenter.GetUsageSummaryRequest\x1a\".llmcenter.GetUsageSummaryResponse\x12X\n" +
+	"\x0fListUsageQuotas\x12!.llmcenter.ListUsageQuotasRequest\x1a\".llmcenter.ListUsageQuotasResponse\x12R\n" +
+	"\rSetUsageQuota\x12\x1f.llmcenter.SetUsageQuotaRequest\x1a .llmcenter.SetUsageQuotaResponse\x12[\n" +
+	"\x10DeleteUsageQuota\x12\".llmcenter.DeleteUsageQuotaRequest\x1a#.llmcenter.DeleteUsageQuotaResponse\x12d\n" +
+	"\x13CreateDocumentShare\x12%.llmcenter.CreateDocumentShareRequest\x1a&.llmcenter.CreateDocumentShareResponse\x12a\n" +
+	"\x12ListDocumentShares\x12$.llmcenter.ListDocumentSharesRequest\x1a%.llmcenter.ListDocumentSharesResponse\x12d\n" +
+	"\x13RevokeDocumentShare\x12%.llmcenter.RevokeDocumentShareRequest\x1a&.llmcenter.RevokeDocumentShareResponse\x12^\n" +
+	"\x11OpenDocumentShare\x12#.llmcenter.OpenDocumentShareRequest\x1a$.llmcenter.OpenDocumentShareResponse\x12j\n" +
+	"\x15CreateDocumentComment\x12'.llmcenter.CreateDocumentCommentRequest\x1a(.llmcenter.CreateDocumentCommentResponse\x12g\n" +
+	"\x14ListDocumentComments\x12&.llmcenter.ListDocumentCommentsRequest\x1a'.llmcenter.ListDocumentCommentsResponse\x12m\n" +
+	"\x16ResolveDocumentComment\x12(.llmcenter.ResolveDocumentCommentRequest\x1a).llmcenter.ResolveDocumentCommentResponse\x12j\n" +
+	"\x15ListMentionedComments\x12'.llmcenter.ListMentionedCommentsRequest\x1a(.llmcenter.ListMentionedCommentsResponse\x12d\n" +
+	"\x13GetDocumentApproval\x12%.llmcenter.GetDocumentApprovalRequest\x1a&.llmcenter.GetDocumentApprovalResponse\x12y\n" +
+	"\x1aTransitionDocumentApproval\x12,.llmcenter.TransitionDocumentApprovalRequest\x1a-.llmcenter.TransitionDocumentApprovalResponse\x12^\n" +
+	"\x11ListApprovalTasks\x12#.llmcenter.ListApprovalTasksRequest\x1a$.llmcenter.ListApprovalTasksResponse\x12R\n" +
+	"\rAllocateDocNo\x12\x1f.llmcenter.AllocateDocNoRequest\x1a .llmcenter.AllocateDocNoResponse\x12O\n" +
+	"\fReleaseDocNo\x12\x1e.llmcenter.ReleaseDocNoRequest\x1a\x1f.llmcenter.ReleaseDocNoResponse\x12I\n" +
+	"\n" +
+	"IssueDocNo\x12\x1c.llmcenter.IssueDocNoRequest\x1a\x1d.llmcenter.IssueDocNoResponse\x12I\n" +
+	"\n" +
+	"ListDocNos\x12\x1c.llmcenter.ListDocNosRequest\x1a\x1d.llmcenter.ListDocNosResponse\x12I\n" +
+	"\x0eCollabDocument\x12\x18.llmcenter.CollabRequest\x1a\x19.llmcenter.CollabResponse(\x010\x01\x12U\n" +
+	"\x0eGetDiagnostics\x12 .llmcenter.GetDiagnosticsRequest\x1a!.llmcenter.GetDiagnosticsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_llmcenter_proto_rawDescOnce sync.Once
@@ -2294,86 +8468,266 @@ func file_llmcenter_proto_rawDescGZIP() []byte {
 	return file_llmcenter_proto_rawDescData
 }
 
-var file_llmcenter_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_llmcenter_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
 var file_llmcenter_proto_goTypes = []any{
-	(*ChatCompletionsRequest)(nil),        // 0: llmcenter.ChatCompletionsRequest
-	(*ChatCompletionsResponse)(nil),       // 1: llmcenter.ChatCompletionsResponse
-	(*ChatResumeRequest)(nil),             // 2: llmcenter.ChatResumeRequest
-	(*ChatResumeResponse)(nil),            // 3: llmcenter.ChatResumeResponse
-	(*GetConversationsRequest)(nil),       // 4: llmcenter.GetConversationsRequest
-	(*GetConversationsResponse)(nil),      // 5: llmcenter.GetConversationsResponse
-	(*GetConversationDetailRequest)(nil),  // 6: llmcenter.GetConversationDetailRequest
-	(*GetConversationDetailResponse)(nil), // 7: llmcenter.GetConversationDetailResponse
-	(*GetDocumentDetailRequest)(nil),      // 8: llmcenter.GetDocumentDetailRequest
-	(*Document)(nil),                      // 9: llmcenter.Document
-	(*GetDocumentDetailResponse)(nil),     // 10: llmcenter.GetDocumentDetailResponse
-	(*GetHistoryDataRequest)(nil),         // 11: llmcenter.GetHistoryDataRequest
-	(*GetHistoryDataResponse)(nil),        // 12: llmcenter.GetHistoryDataResponse
-	(*HistoryData)(nil),                   // 13: llmcenter.HistoryData
-	(*FileReference)(nil),                 // 14: llmcenter.FileReference
-	(*EditDocumentRequest)(nil),           // 15: llmcenter.EditDocumentRequest
-	(*EditDocumentResponse)(nil),          // 16: llmcenter.EditDocumentResponse
-	(*UpdateDocumentRequest)(nil),         // 17: llmcenter.UpdateDocumentRequest
-	(*UpdateDocumentResponse)(nil),        // 18: llmcenter.UpdateDocumentResponse
-	(*ConvertMarkdownRequest)(nil),        // 19: llmcenter.ConvertMarkdownRequest
-	(*ConvertMarkdownResponse)(nil),       // 20: llmcenter.ConvertMarkdownResponse
-	(*InfoItem)(nil),                      // 21: llmcenter.InfoItem
-	(*FileUploadRequest)(nil),             // 22: llmcenter.FileUploadRequest
-	(*FileInfo)(nil),                      // 23: llmcenter.FileInfo
-	(*FileUploadResponse)(nil),            // 24: llmcenter.FileUploadResponse
-	(*Reference)(nil),                     // 25: llmcenter.Reference
-	(*Conversation)(nil),                  // 26: llmcenter.Conversation
-	(*Message)(nil),                       // 27: llmcenter.Message
-	(*SSEMessageEvent)(nil),               // 28: llmcenter.SSEMessageEvent
-	(*SSEInterruptEvent)(nil),             // 29: llmcenter.SSEInterruptEvent
-	(*SSEEndEvent)(nil),                   // 30: llmcenter.SSEEndEvent
-	(*ConvertMarkdownLinkRequest)(nil),    // 31: llmcenter.ConvertMarkdownLinkRequest
-	(*ConvertMarkdownLinkResponse)(nil),   // 32: llmcenter.ConvertMarkdownLinkResponse
+	(*ChatCompletionsRequest)(nil),             // 0: llmcenter.ChatCompletionsRequest
+	(*ChatCompletionsResponse)(nil),            // 1: llmcenter.ChatCompletionsResponse
+	(*ChatResumeRequest)(nil),                  // 2: llmcenter.ChatResumeRequest
+	(*ChatResumeResponse)(nil),                 // 3: llmcenter.ChatResumeResponse
+	(*GetConversationsRequest)(nil),            // 4: llmcenter.GetConversationsRequest
+	(*GetConversationsResponse)(nil),           // 5: llmcenter.GetConversationsResponse
+	(*GetConversationDetailRequest)(nil),       // 6: llmcenter.GetConversationDetailRequest
+	(*GetConversationDetailResponse)(nil),      // 7: llmcenter.GetConversationDetailResponse
+	(*GetDocumentDetailRequest)(nil),           // 8: llmcenter.GetDocumentDetailRequest
+	(*Document)(nil),                           // 9: llmcenter.Document
+	(*GetDocumentDetailResponse)(nil),          // 10: llmcenter.GetDocumentDetailResponse
+	(*GetHistoryDataRequest)(nil),              // 11: llmcenter.GetHistoryDataRequest
+	(*GetHistoryDataResponse)(nil),             // 12: llmcenter.GetHistoryDataResponse
+	(*HistoryData)(nil),                        // 13: llmcenter.HistoryData
+	(*FileReference)(nil),                      // 14: llmcenter.FileReference
+	(*EditDocumentRequest)(nil),                // 15: llmcenter.EditDocumentRequest
+	(*EditDocumentResponse)(nil),               // 16: llmcenter.EditDocumentResponse
+	(*UpdateDocumentRequest)(nil),              // 17: llmcenter.UpdateDocumentRequest
+	(*UpdateDocumentResponse)(nil),             // 18: llmcenter.UpdateDocumentResponse
+	(*ConvertMarkdownRequest)(nil),             // 19: llmcenter.ConvertMarkdownRequest
+	(*ConvertMarkdownResponse)(nil),            // 20: llmcenter.ConvertMarkdownResponse
+	(*InfoItem)(nil),                           // 21: llmcenter.InfoItem
+	(*CheckDocumentRequest)(nil),               // 22: llmcenter.CheckDocumentRequest
+	(*CheckDocumentResponse)(nil),              // 23: llmcenter.CheckDocumentResponse
+	(*DeleteDocumentRequest)(nil),              // 24: llmcenter.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),             // 25: llmcenter.DeleteDocumentResponse
+	(*FormatFinding)(nil),                      // 26: llmcenter.FormatFinding
+	(*AuditLogQuery)(nil),                      // 27: llmcenter.AuditLogQuery
+	(*ListAuditLogsRequest)(nil),               // 28: llmcenter.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),              // 29: llmcenter.ListAuditLogsResponse
+	(*ExportAuditLogsRequest)(nil),             // 30: llmcenter.ExportAuditLogsRequest
+	(*ExportAuditLogsResponse)(nil),            // 31: llmcenter.ExportAuditLogsResponse
+	(*AuditLog)(nil),                           // 32: llmcenter.AuditLog
+	(*GetUsageSummaryRequest)(nil),             // 33: llmcenter.GetUsageSummaryRequest
+	(*GetUsageSummaryResponse)(nil),            // 34: llmcenter.GetUsageSummaryResponse
+	(*UsageItem)(nil),                          // 35: llmcenter.UsageItem
+	(*QuotaStatus)(nil),                        // 36: llmcenter.QuotaStatus
+	(*UsageQuota)(nil),                         // 37: llmcenter.UsageQuota
+	(*ListUsageQuotasRequest)(nil),             // 38: llmcenter.ListUsageQuotasRequest
+	(*ListUsageQuotasResponse)(nil),            // 39: llmcenter.ListUsageQuotasResponse
+	(*SetUsageQuotaRequest)(nil),               // 40: llmcenter.SetUsageQuotaRequest
+	(*SetUsageQuotaResponse)(nil),              // 41: llmcenter.SetUsageQuotaResponse
+	(*DeleteUsageQuotaRequest)(nil),            // 42: llmcenter.DeleteUsageQuotaRequest
+	(*DeleteUsageQuotaResponse)(nil),           // 43: llmcenter.DeleteUsageQuotaResponse
+	(*DocumentShare)(nil),                      // 44: llmcenter.DocumentShare
+	(*CreateDocumentShareRequest)(nil),         // 45: llmcenter.CreateDocumentShareRequest
+	(*CreateDocumentShareResponse)(nil),        // 46: llmcenter.CreateDocumentShareResponse
+	(*ListDocumentSharesRequest)(nil),          // 47: llmcenter.ListDocumentSharesRequest
+	(*ListDocumentSharesResponse)(nil),         // 48: llmcenter.ListDocumentSharesResponse
+	(*RevokeDocumentShareRequest)(nil),         // 49: llmcenter.RevokeDocumentShareRequest
+	(*RevokeDocumentShareResponse)(nil),        // 50: llmcenter.RevokeDocumentShareResponse
+	(*OpenDocumentShareRequest)(nil),           // 51: llmcenter.OpenDocumentShareRequest
+	(*OpenDocumentShareResponse)(nil),          // 52: llmcenter.OpenDocumentShareResponse
+	(*CommentAnchor)(nil),                      // 53: llmcenter.CommentAnchor
+	(*DocumentComment)(nil),                    // 54: llmcenter.DocumentComment
+	(*CreateDocumentCommentRequest)(nil),       // 55: llmcenter.CreateDocumentCommentRequest
+	(*CreateDocumentCommentResponse)(nil),      // 56: llmcenter.CreateDocumentCommentResponse
+	(*ListDocumentCommentsRequest)(nil),        // 57: llmcenter.ListDocumentCommentsRequest
+	(*ListDocumentCommentsResponse)(nil),       // 58: llmcenter.ListDocumentCommentsResponse
+	(*ResolveDocumentCommentRequest)(nil),      // 59: llmcenter.ResolveDocumentCommentRequest
+	(*ResolveDocumentCommentResponse)(nil),     // 60: llmcenter.ResolveDocumentCommentResponse
+	(*ListMentionedCommentsRequest)(nil),       // 61: llmcenter.ListMentionedCommentsRequest
+	(*ListMentionedCommentsResponse)(nil),      // 62: llmcenter.ListMentionedCommentsResponse
+	(*ApprovalAction)(nil),                     // 63: llmcenter.ApprovalAction
+	(*ApprovalSigner)(nil),                     // 64: llmcenter.ApprovalSigner
+	(*ApprovalRecord)(nil),                     // 65: llmcenter.ApprovalRecord
+	(*DocumentApproval)(nil),                   // 66: llmcenter.DocumentApproval
+	(*GetDocumentApprovalRequest)(nil),         // 67: llmcenter.GetDocumentApprovalRequest
+	(*GetDocumentApprovalResponse)(nil),        // 68: llmcenter.GetDocumentApprovalResponse
+	(*TransitionDocumentApprovalRequest)(nil),  // 69: llmcenter.TransitionDocumentApprovalRequest
+	(*TransitionDocumentApprovalResponse)(nil), // 70: llmcenter.TransitionDocumentApprovalResponse
+	(*ListApprovalTasksRequest)(nil),           // 71: llmcenter.ListApprovalTasksRequest
+	(*ListApprovalTasksResponse)(nil),          // 72: llmcenter.ListApprovalTasksResponse
+	(*DocNumber)(nil),                          // 73: llmcenter.DocNumber
+	(*AllocateDocNoRequest)(nil),               // 74: llmcenter.AllocateDocNoRequest
+	(*AllocateDocNoResponse)(nil),              // 75: llmcenter.AllocateDocNoResponse
+	(*ReleaseDocNoRequest)(nil),                // 76: llmcenter.ReleaseDocNoRequest
+	(*ReleaseDocNoResponse)(nil),               // 77: llmcenter.ReleaseDocNoResponse
+	(*IssueDocNoRequest)(nil),                  // 78: llmcenter.IssueDocNoRequest
+	(*IssueDocNoResponse)(nil),                 // 79: llmcenter.IssueDocNoResponse
+	(*ListDocNosRequest)(nil),                  // 80: llmcenter.ListDocNosRequest
+	(*ListDocNosResponse)(nil),                 // 81: llmcenter.ListDocNosResponse
+	(*TextOp)(nil),                             // 82: llmcenter.TextOp
+	(*CollabSelection)(nil),                    // 83: llmcenter.CollabSelection
+	(*CollabClient)(nil),                       // 84: llmcenter.CollabClient
+	(*CollabJoin)(nil),                         // 85: llmcenter.CollabJoin
+	(*CollabOperation)(nil),                    // 86: llmcenter.CollabOperation
+	(*CollabRequest)(nil),                      // 87: llmcenter.CollabRequest
+	(*CollabInit)(nil),                         // 88: llmcenter.CollabInit
+	(*CollabAck)(nil),                          // 89: llmcenter.CollabAck
+	(*CollabRemoteOperation)(nil),              // 90: llmcenter.CollabRemoteOperation
+	(*CollabPresence)(nil),                     // 91: llmcenter.CollabPresence
+	(*CollabState)(nil),                        // 92: llmcenter.CollabState
+	(*CollabReset)(nil),                        // 93: llmcenter.CollabReset
+	(*CollabResponse)(nil),                     // 94: llmcenter.CollabResponse
+	(*GetDiagnosticsRequest)(nil),              // 95: llmcenter.GetDiagnosticsRequest
+	(*GetDiagnosticsResponse)(nil),             // 96: llmcenter.GetDiagnosticsResponse
+	(*HealthCheck)(nil),                        // 97: llmcenter.HealthCheck
+	(*ConfigIssue)(nil),                        // 98: llmcenter.ConfigIssue
+	(*FileUploadRequest)(nil),                  // 99: llmcenter.FileUploadRequest
+	(*FileInfo)(nil),                           // 100: llmcenter.FileInfo
+	(*CheckFileAccessRequest)(nil),             // 101: llmcenter.CheckFileAccessRequest
+	(*CheckFileAccessResponse)(nil),            // 102: llmcenter.CheckFileAccessResponse
+	(*FileUploadResponse)(nil),                 // 103: llmcenter.FileUploadResponse
+	(*Reference)(nil),                          // 104: llmcenter.Reference
+	(*Conversation)(nil),                       // 105: llmcenter.Conversation
+	(*Message)(nil),                            // 106: llmcenter.Message
+	(*SSEMessageEvent)(nil),                    // 107: llmcenter.SSEMessageEvent
+	(*SSEInterruptEvent)(nil),                  // 108: llmcenter.SSEInterruptEvent
+	(*SSEEndEvent)(nil),                        // 109: llmcenter.SSEEndEvent
+	(*SSECheckEvent)(nil),                      // 110: llmcenter.SSECheckEvent
+	(*ConvertMarkdownLinkRequest)(nil),         // 111: llmcenter.ConvertMarkdownLinkRequest
+	(*ConvertMarkdownLinkResponse)(nil),        // 112: llmcenter.ConvertMarkdownLinkResponse
 }
 var file_llmcenter_proto_depIdxs = []int32{
-	25, // 0: llmcenter.ChatCompletionsRequest.references:type_name -> llmcenter.Reference
-	28, // 1: llmcenter.ChatCompletionsResponse.message:type_name -> llmcenter.SSEMessageEvent
-	29, // 2: llmcenter.ChatCompletionsResponse.interrupt:type_name -> llmcenter.SSEInterruptEvent
-	30, // 3: llmcenter.ChatCompletionsResponse.end:type_name -> llmcenter.SSEEndEvent
-	25, // 4: llmcenter.ChatResumeRequest.references:type_name -> llmcenter.Reference
-	28, // 5: llmcenter.ChatResumeResponse.message:type_name -> llmcenter.SSEMessageEvent
-	30, // 6: llmcenter.ChatResumeResponse.end:type_name -> llmcenter.SSEEndEvent
-	26, // 7: llmcenter.GetConversationsResponse.data:type_name -> llmcenter.Conversation
-	27, // 8: llmcenter.GetConversationDetailResponse.history:type_name -> llmcenter.Message
-	9,  // 9: llmcenter.GetDocumentDetailResponse.documents:type_name -> llmcenter.Document
-	13, // 10: llmcenter.GetHistoryDataResponse.items:type_name -> llmcenter.HistoryData
-	14, // 11: llmcenter.HistoryData.references:type_name -> llmcenter.FileReference
-	28, // 12: llmcenter.EditDocumentResponse.message:type_name -> llmcenter.SSEMessageEvent
-	30, // 13: llmcenter.EditDocumentResponse.end:type_name -> llmcenter.SSEEndEvent
-	21, // 14: llmcenter.ConvertMarkdownRequest.information:type_name -> llmcenter.InfoItem
-	23, // 15: llmcenter.FileUploadRequest.info:type_name -> llmcenter.FileInfo
-	0,  // 16: llmcenter.LlmCenter.ChatCompletions:input_type -> llmcenter.ChatCompletionsRequest
-	2,  // 17: llmcenter.LlmCenter.ChatResume:input_type -> llmcenter.ChatResumeRequest
-	22, // 18: llmcenter.LlmCenter.FileUpload:input_type -> llmcenter.FileUploadRequest
-	4,  // 19: llmcenter.LlmCenter.GetConversations:input_type -> llmcenter.GetConversationsRequest
-	6,  // 20: llmcenter.LlmCenter.GetConversationDetail:input_type -> llmcenter.GetConversationDetailRequest
-	8,  // 21: llmcenter.LlmCenter.GetDocumentDetail:input_type -> llmcenter.GetDocumentDetailRequest
-	11, // 22: llmcenter.LlmCenter.GetHistoryData:input_type -> llmcenter.GetHistoryDataRequest
-	15, // 23: llmcenter.LlmCenter.EditDocument:input_type -> llmcenter.EditDocumentRequest
-	17, // 24: llmcenter.LlmCenter.UpdateDocument:input_type -> llmcenter.UpdateDocumentRequest
-	19, // 25: llmcenter.LlmCenter.ConvertMarkdown:input_type -> llmcenter.ConvertMarkdownRequest
-	31, // 26: llmcenter.LlmCenter.ConvertMarkdownLink:input_type -> llmcenter.ConvertMarkdownLinkRequest
-	1,  // 27: llmcenter.LlmCenter.ChatCompletions:output_type -> llmcenter.ChatCompletionsResponse
-	3,  // 28: llmcenter.LlmCenter.ChatResume:output_type -> llmcenter.ChatResumeResponse
-	24, // 29: llmcenter.LlmCenter.FileUpload:output_type -> llmcenter.FileUploadResponse
-	5,  // 30: llmcenter.LlmCenter.GetConversations:output_type -> llmcenter.GetConversationsResponse
-	7,  // 31: llmcenter.LlmCenter.GetConversationDetail:output_type -> llmcenter.GetConversationDetailResponse
-	10, // 32: llmcenter.LlmCenter.GetDocumentDetail:output_type -> llmcenter.GetDocumentDetailResponse
-	12, // 33: llmcenter.LlmCenter.GetHistoryData:output_type -> llmcenter.GetHistoryDataResponse
-	16, // 34: llmcenter.LlmCenter.EditDocument:output_type -> llmcenter.EditDocumentResponse
-	18, // 35: llmcenter.LlmCenter.UpdateDocument:output_type -> llmcenter.UpdateDocumentResponse
-	20, // 36: llmcenter.LlmCenter.ConvertMarkdown:output_type -> llmcenter.ConvertMarkdownResponse
-	32, // 37: llmcenter.LlmCenter.ConvertMarkdownLink:output_type -> llmcenter.ConvertMarkdownLinkResponse
-	27, // [27:38] is the sub-list for method output_type
-	16, // [16:27] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	104, // 0: llmcenter.ChatCompletionsRequest.references:type_name -> llmcenter.Reference
+	107, // 1: llmcenter.ChatCompletionsResponse.message:type_name -> llmcenter.SSEMessageEvent
+	108, // 2: llmcenter.ChatCompletionsResponse.interrupt:type_name -> llmcenter.SSEInterruptEvent
+	109, // 3: llmcenter.ChatCompletionsResponse.end:type_name -> llmcenter.SSEEndEvent
+	104, // 4: llmcenter.ChatResumeRequest.references:type_name -> llmcenter.Reference
+	107, // 5: llmcenter.ChatResumeResponse.message:type_name -> llmcenter.SSEMessageEvent
+	109, // 6: llmcenter.ChatResumeResponse.end:type_name -> llmcenter.SSEEndEvent
+	110, // 7: llmcenter.ChatResumeResponse.check:type_name -> llmcenter.SSECheckEvent
+	105, // 8: llmcenter.GetConversationsResponse.data:type_name -> llmcenter.Conversation
+	106, // 9: llmcenter.GetConversationDetailResponse.history:type_name -> llmcenter.Message
+	9,   // 10: llmcenter.GetDocumentDetailResponse.documents:type_name -> llmcenter.Document
+	13,  // 11: llmcenter.GetHistoryDataResponse.items:type_name -> llmcenter.HistoryData
+	14,  // 12: llmcenter.HistoryData.references:type_name -> llmcenter.FileReference
+	107, // 13: llmcenter.EditDocumentResponse.message:type_name -> llmcenter.SSEMessageEvent
+	109, // 14: llmcenter.EditDocumentResponse.end:type_name -> llmcenter.SSEEndEvent
+	110, // 15: llmcenter.EditDocumentResponse.check:type_name -> llmcenter.SSECheckEvent
+	21,  // 16: llmcenter.ConvertMarkdownRequest.information:type_name -> llmcenter.InfoItem
+	26,  // 17: llmcenter.CheckDocumentResponse.findings:type_name -> llmcenter.FormatFinding
+	27,  // 18: llmcenter.ListAuditLogsRequest.query:type_name -> llmcenter.AuditLogQuery
+	32,  // 19: llmcenter.ListAuditLogsResponse.items:type_name -> llmcenter.AuditLog
+	27,  // 20: llmcenter.ExportAuditLogsRequest.query:type_name -> llmcenter.AuditLogQuery
+	35,  // 21: llmcenter.GetUsageSummaryResponse.items:type_name -> llmcenter.UsageItem
+	35,  // 22: llmcenter.GetUsageSummaryResponse.total:type_name -> llmcenter.UsageItem
+	36,  // 23: llmcenter.GetUsageSummaryResponse.quota:type_name -> llmcenter.QuotaStatus
+	37,  // 24: llmcenter.ListUsageQuotasResponse.items:type_name -> llmcenter.UsageQuota
+	37,  // 25: llmcenter.SetUsageQuotaRequest.quota:type_name -> llmcenter.UsageQuota
+	37,  // 26: llmcenter.SetUsageQuotaResponse.quota:type_name -> llmcenter.UsageQuota
+	44,  // 27: llmcenter.CreateDocumentShareResponse.share:type_name -> llmcenter.DocumentShare
+	44,  // 28: llmcenter.ListDocumentSharesResponse.items:type_name -> llmcenter.DocumentShare
+	53,  // 29: llmcenter.DocumentComment.anchor:type_name -> llmcenter.CommentAnchor
+	54,  // 30: llmcenter.DocumentComment.replies:type_name -> llmcenter.DocumentComment
+	54,  // 31: llmcenter.CreateDocumentCommentResponse.comment:type_name -> llmcenter.DocumentComment
+	54,  // 32: llmcenter.ListDocumentCommentsResponse.items:type_name -> llmcenter.DocumentComment
+	54,  // 33: llmcenter.ResolveDocumentCommentResponse.comment:type_name -> llmcenter.DocumentComment
+	54,  // 34: llmcenter.ListMentionedCommentsResponse.items:type_name -> llmcenter.DocumentComment
+	63,  // 35: llmcenter.DocumentApproval.actions:type_name -> llmcenter.ApprovalAction
+	64,  // 36: llmcenter.DocumentApproval.signers:type_name -> llmcenter.ApprovalSigner
+	66,  // 37: llmcenter.GetDocumentApprovalResponse.approval:type_name -> llmcenter.DocumentApproval
+	65,  // 38: llmcenter.GetDocumentApprovalResponse.history:type_name -> llmcenter.ApprovalRecord
+	66,  // 39: llmcenter.TransitionDocumentApprovalResponse.approval:type_name -> llmcenter.DocumentApproval
+	66,  // 40: llmcenter.ListApprovalTasksResponse.items:type_name -> llmcenter.DocumentApproval
+	73,  // 41: llmcenter.AllocateDocNoResponse.doc_no:type_name -> llmcenter.DocNumber
+	73,  // 42: llmcenter.ReleaseDocNoResponse.doc_no:type_name -> llmcenter.DocNumber
+	73,  // 43: llmcenter.IssueDocNoResponse.doc_no:type_name -> llmcenter.DocNumber
+	73,  // 44: llmcenter.ListDocNosResponse.items:type_name -> llmcenter.DocNumber
+	83,  // 45: llmcenter.CollabClient.selection:type_name -> llmcenter.CollabSelection
+	82,  // 46: llmcenter.CollabOperation.ops:type_name -> llmcenter.TextOp
+	83,  // 47: llmcenter.CollabOperation.selection:type_name -> llmcenter.CollabSelection
+	85,  // 48: llmcenter.CollabRequest.join:type_name -> llmcenter.CollabJoin
+	86,  // 49: llmcenter.CollabRequest.operation:type_name -> llmcenter.CollabOperation
+	83,  // 50: llmcenter.CollabRequest.selection:type_name -> llmcenter.CollabSelection
+	84,  // 51: llmcenter.CollabInit.clients:type_name -> llmcenter.CollabClient
+	82,  // 52: llmcenter.CollabRemoteOperation.ops:type_name -> llmcenter.TextOp
+	84,  // 53: llmcenter.CollabPresence.client:type_name -> llmcenter.CollabClient
+	88,  // 54: llmcenter.CollabResponse.init:type_name -> llmcenter.CollabInit
+	89,  // 55: llmcenter.CollabResponse.ack:type_name -> llmcenter.CollabAck
+	90,  // 56: llmcenter.CollabResponse.operation:type_name -> llmcenter.CollabRemoteOperation
+	91,  // 57: llmcenter.CollabResponse.presence:type_name -> llmcenter.CollabPresence
+	92,  // 58: llmcenter.CollabResponse.state:type_name -> llmcenter.CollabState
+	93,  // 59: llmcenter.CollabResponse.reset:type_name -> llmcenter.CollabReset
+	97,  // 60: llmcenter.GetDiagnosticsResponse.checks:type_name -> llmcenter.HealthCheck
+	98,  // 61: llmcenter.GetDiagnosticsResponse.config_issues:type_name -> llmcenter.ConfigIssue
+	100, // 62: llmcenter.FileUploadRequest.info:type_name -> llmcenter.FileInfo
+	23,  // 63: llmcenter.SSECheckEvent.result:type_name -> llmcenter.CheckDocumentResponse
+	0,   // 64: llmcenter.LlmCenter.ChatCompletions:input_type -> llmcenter.ChatCompletionsRequest
+	2,   // 65: llmcenter.LlmCenter.ChatResume:input_type -> llmcenter.ChatResumeRequest
+	99,  // 66: llmcenter.LlmCenter.FileUpload:input_type -> llmcenter.FileUploadRequest
+	4,   // 67: llmcenter.LlmCenter.GetConversations:input_type -> llmcenter.GetConversationsRequest
+	6,   // 68: llmcenter.LlmCenter.GetConversationDetail:input_type -> llmcenter.GetConversationDetailRequest
+	8,   // 69: llmcenter.LlmCenter.GetDocumentDetail:input_type -> llmcenter.GetDocumentDetailRequest
+	11,  // 70: llmcenter.LlmCenter.GetHistoryData:input_type -> llmcenter.GetHistoryDataRequest
+	15,  // 71: llmcenter.LlmCenter.EditDocument:input_type -> llmcenter.EditDocumentRequest
+	17,  // 72: llmcenter.LlmCenter.UpdateDocument:input_type -> llmcenter.UpdateDocumentRequest
+	19,  // 73: llmcenter.LlmCenter.ConvertMarkdown:input_type -> llmcenter.ConvertMarkdownRequest
+	111, // 74: llmcenter.LlmCenter.ConvertMarkdownLink:input_type -> llmcenter.ConvertMarkdownLinkRequest
+	22,  // 75: llmcenter.LlmCenter.CheckDocument:input_type -> llmcenter.CheckDocumentRequest
+	24,  // 76: llmcenter.LlmCenter.DeleteDocument:input_type -> llmcenter.DeleteDocumentRequest
+	28,  // 77: llmcenter.LlmCenter.ListAuditLogs:input_type -> llmcenter.ListAuditLogsRequest
+	30,  // 78: llmcenter.LlmCenter.ExportAuditLogs:input_type -> llmcenter.ExportAuditLogsRequest
+	101, // 79: llmcenter.LlmCenter.CheckFileAccess:input_type -> llmcenter.CheckFileAccessRequest
+	33,  // 80: llmcenter.LlmCenter.GetUsageSummary:input_type -> llmcenter.GetUsageSummaryRequest
+	38,  // 81: llmcenter.LlmCenter.ListUsageQuotas:input_type -> llmcenter.ListUsageQuotasRequest
+	40,  // 82: llmcenter.LlmCenter.SetUsageQuota:input_type -> llmcenter.SetUsageQuotaRequest
+	42,  // 83: llmcenter.LlmCenter.DeleteUsageQuota:input_type -> llmcenter.DeleteUsageQuotaRequest
+	45,  // 84: llmcenter.LlmCenter.CreateDocumentShare:input_type -> llmcenter.CreateDocumentShareRequest
+	47,  // 85: llmcenter.LlmCenter.ListDocumentShares:input_type -> llmcenter.ListDocumentSharesRequest
+	49,  // 86: llmcenter.LlmCenter.RevokeDocumentShare:input_type -> llmcenter.RevokeDocumentShareRequest
+	51,  // 87: llmcenter.LlmCenter.OpenDocumentShare:input_type -> llmcenter.OpenDocumentShareRequest
+	55,  // 88: llmcenter.LlmCenter.CreateDocumentComment:input_type -> llmcenter.CreateDocumentCommentRequest
+	57,  // 89: llmcenter.LlmCenter.ListDocumentComments:input_type -> llmcenter.ListDocumentCommentsRequest
+	59,  // 90: llmcenter.LlmCenter.ResolveDocumentComment:input_type -> llmcenter.ResolveDocumentCommentRequest
+	61,  // 91: llmcenter.LlmCenter.ListMentionedComments:input_type -> llmcenter.ListMentionedCommentsRequest
+	67,  // 92: llmcenter.LlmCenter.GetDocumentApproval:input_type -> llmcenter.GetDocumentApprovalRequest
+	69,  // 93: llmcenter.LlmCenter.TransitionDocumentApproval:input_type -> llmcenter.TransitionDocumentApprovalRequest
+	71,  // 94: llmcenter.LlmCenter.ListApprovalTasks:input_type -> llmcenter.ListApprovalTasksRequest
+	74,  // 95: llmcenter.LlmCenter.AllocateDocNo:input_type -> llmcenter.AllocateDocNoRequest
+	76,  // 96: llmcenter.LlmCenter.ReleaseDocNo:input_type -> llmcenter.ReleaseDocNoRequest
+	78,  // 97: llmcenter.LlmCenter.IssueDocNo:input_type -> llmcenter.IssueDocNoRequest
+	80,  // 98: llmcenter.LlmCenter.ListDocNos:input_type -> llmcenter.ListDocNosRequest
+	87,  // 99: llmcenter.LlmCenter.CollabDocument:input_type -> llmcenter.CollabRequest
+	95,  // 100: llmcenter.LlmCenter.GetDiagnostics:input_type -> llmcenter.GetDiagnosticsRequest
+	1,   // 101: llmcenter.LlmCenter.ChatCompletions:output_type -> llmcenter.ChatCompletionsResponse
+	3,   // 102: llmcenter.LlmCenter.ChatResume:output_type -> llmcenter.ChatResumeResponse
+	103, // 103: llmcenter.LlmCenter.FileUpload:output_type -> llmcenter.FileUploadResponse
+	5,   // 104: llmcenter.LlmCenter.GetConversations:output_type -> llmcenter.GetConversationsResponse
+	7,   // 105: llmcenter.LlmCenter.GetConversationDetail:output_type -> llmcenter.GetConversationDetailResponse
+	10,  // 106: llmcenter.LlmCenter.GetDocumentDetail:output_type -> llmcenter.GetDocumentDetailResponse
+	12,  // 107: llmcenter.LlmCenter.GetHistoryData:output_type -> llmcenter.GetHistoryDataResponse
+	16,  // 108: llmcenter.LlmCenter.EditDocument:output_type -> llmcenter.EditDocumentResponse
+	18,  // 109: llmcenter.LlmCenter.UpdateDocument:output_type -> llmcenter.UpdateDocumentResponse
+	20,  // 110: llmcenter.LlmCenter.ConvertMarkdown:output_type -> llmcenter.ConvertMarkdownResponse
+	112, // 111: llmcenter.LlmCenter.ConvertMarkdownLink:output_type -> llmcenter.ConvertMarkdownLinkResponse
+	23,  // 112: llmcenter.LlmCenter.CheckDocument:output_type -> llmcenter.CheckDocumentResponse
+	25,  // 113: llmcenter.LlmCenter.DeleteDocument:output_type -> llmcenter.DeleteDocumentResponse
+	29,  // 114: llmcenter.LlmCenter.ListAuditLogs:output_type -> llmcenter.ListAuditLogsResponse
+	31,  // 115: llmcenter.LlmCenter.ExportAuditLogs:output_type -> llmcenter.ExportAuditLogsResponse
+	102, // 116: llmcenter.LlmCenter.CheckFileAccess:output_type -> llmcenter.CheckFileAccessResponse
+	34,  // 117: llmcenter.LlmCenter.GetUsageSummary:output_type -> llmcenter.GetUsageSummaryResponse
+	39,  // 118: llmcenter.LlmCenter.ListUsageQuotas:output_type -> llmcenter.ListUsageQuotasResponse
+	41,  // 119: llmcenter.LlmCenter.SetUsageQuota:output_type -> llmcenter.SetUsageQuotaResponse
+	43,  // 120: llmcenter.LlmCenter.DeleteUsageQuota:output_type -> llmcenter.DeleteUsageQuotaResponse
+	46,  // 121: llmcenter.LlmCenter.CreateDocumentShare:output_type -> llmcenter.CreateDocumentShareResponse
+	48,  // 122: llmcenter.LlmCenter.ListDocumentShares:output_type -> llmcenter.ListDocumentSharesResponse
+	50,  // 123: llmcenter.LlmCenter.RevokeDocumentShare:output_type -> llmcenter.RevokeDocumentShareResponse
+	52,  // 124: llmcenter.LlmCenter.OpenDocumentShare:output_type -> llmcenter.OpenDocumentShareResponse
+	56,  // 125: llmcenter.LlmCenter.CreateDocumentComment:output_type -> llmcenter.CreateDocumentCommentResponse
+	58,  // 126: llmcenter.LlmCenter.ListDocumentComments:output_type -> llmcenter.ListDocumentCommentsResponse
+	60,  // 127: llmcenter.LlmCenter.ResolveDocumentComment:output_type -> llmcenter.ResolveDocumentCommentResponse
+	62,  // 128: llmcenter.LlmCenter.ListMentionedComments:output_type -> llmcenter.ListMentionedCommentsResponse
+	68,  // 129: llmcenter.LlmCenter.GetDocumentApproval:output_type -> llmcenter.GetDocumentApprovalResponse
+	70,  // 130: llmcenter.LlmCenter.TransitionDocumentApproval:output_type -> llmcenter.TransitionDocumentApprovalResponse
+	72,  // 131: llmcenter.LlmCenter.ListApprovalTasks:output_type -> llmcenter.ListApprovalTasksResponse
+	75,  // 132: llmcenter.LlmCenter.AllocateDocNo:output_type -> llmcenter.AllocateDocNoResponse
+	77,  // 133: llmcenter.LlmCenter.ReleaseDocNo:output_type -> llmcenter.ReleaseDocNoResponse
+	79,  // 134: llmcenter.LlmCenter.IssueDocNo:output_type -> llmcenter.IssueDocNoResponse
+	81,  // 135: llmcenter.LlmCenter.ListDocNos:output_type -> llmcenter.ListDocNosResponse
+	94,  // 136: llmcenter.LlmCenter.CollabDocument:output_type -> llmcenter.CollabResponse
+	96,  // 137: llmcenter.LlmCenter.GetDiagnostics:output_type -> llmcenter.GetDiagnosticsResponse
+	101, // [101:138] is the sub-list for method output_type
+	64,  // [64:101] is the sub-list for method input_type
+	64,  // [64:64] is the sub-list for extension type_name
+	64,  // [64:64] is the sub-list for extension extendee
+	0,   // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_llmcenter_proto_init() }
@@ -2389,12 +8743,28 @@ func file_llmcenter_proto_init() {
 	file_llmcenter_proto_msgTypes[3].OneofWrappers = []any{
 		(*ChatResumeResponse_Message)(nil),
 		(*ChatResumeResponse_End)(nil),
+		(*ChatResumeResponse_Check)(nil),
 	}
 	file_llmcenter_proto_msgTypes[16].OneofWrappers = []any{
 		(*EditDocumentResponse_Message)(nil),
 		(*EditDocumentResponse_End)(nil),
-	}
-	file_llmcenter_proto_msgTypes[22].OneofWrappers = []any{
+		(*EditDocumentResponse_Check)(nil),
+	}
+	file_llmcenter_proto_msgTypes[17].OneofWrappers = []any{}
+	file_llmcenter_proto_msgTypes[87].OneofWrappers = []any{
+		(*CollabRequest_Join)(nil),
+		(*CollabRequest_Operation)(nil),
+		(*CollabRequest_Selection)(nil),
+	}
+	file_llmcenter_proto_msgTypes[94].OneofWrappers = []any{
+		(*CollabResponse_Init)(nil),
+		(*CollabResponse_Ack)(nil),
+		(*CollabResponse_Operation)(nil),
+		(*CollabResponse_Presence)(nil),
+		(*CollabResponse_State)(nil),
+		(*CollabResponse_Reset_)(nil),
+	}
+	file_llmcenter_proto_msgTypes[99].OneofWrappers = []any{
 		(*FileUploadRequest_Info)(nil),
 		(*FileUploadRequest_Chunk)(nil),
 	}
@@ -2404,7 +8774,7 @@ func file_llmcenter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llmcenter_proto_rawDesc), len(file_llmcenter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   113,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,17 +23,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LlmCenter_ChatCompletions_FullMethodName       = "/llmcenter.LlmCenter/ChatCompletions"
-	LlmCenter_ChatResume_FullMethodName            = "/llmcenter.LlmCenter/ChatResume"
-	LlmCenter_FileUpload_FullMethodName            = "/llmcenter.LlmCenter/FileUpload"
-	LlmCenter_GetConversations_FullMethodName      = "/llmcenter.LlmCenter/GetConversations"
-	LlmCenter_GetConversationDetail_FullMethodName = "/llmcenter.LlmCenter/GetConversationDetail"
-	LlmCenter_GetDocumentDetail_FullMethodName     = "/llmcenter.LlmCenter/GetDocumentDetail"
-	LlmCenter_GetHistoryData_FullMethodName        = "/llmcenter.LlmCenter/GetHistoryData"
-	LlmCenter_EditDocument_FullMethodName          = "/llmcenter.LlmCenter/EditDocument"
-	LlmCenter_UpdateDocument_FullMethodName        = "/llmcenter.LlmCenter/UpdateDocument"
-	LlmCenter_ConvertMarkdown_FullMethodName       = "/llmcenter.LlmCenter/ConvertMarkdown"
-	LlmCenter_ConvertMarkdownLink_FullMethodName   = "/llmcenter.LlmCenter/ConvertMarkdownLink"
+	LlmCenter_ChatCompletions_FullMethodName            = "/llmcenter.LlmCenter/ChatCompletions"
+	LlmCenter_ChatResume_FullMethodName                 = "/llmcenter.LlmCenter/ChatResume"
+	LlmCenter_FileUpload_FullMethodName                 = "/llmcenter.LlmCenter/FileUpload"
+	LlmCenter_GetConversations_FullMethodName           = "/llmcenter.LlmCenter/GetConversations"
+	LlmCenter_GetConversationDetail_FullMethodName      = "/llmcenter.LlmCenter/GetConversationDetail"
+	LlmCenter_GetDocumentDetail_FullMethodName          = "/llmcenter.LlmCenter/GetDocumentDetail"
+	LlmCenter_GetHistoryData_FullMethodName             = "/llmcenter.LlmCenter/GetHistoryData"
+	LlmCenter_EditDocument_FullMethodName               = "/llmcenter.LlmCenter/EditDocument"
+	LlmCenter_UpdateDocument_FullMethodName             = "/llmcenter.LlmCenter/UpdateDocument"
+	LlmCenter_ConvertMarkdown_FullMethodName            = "/llmcenter.LlmCenter/ConvertMarkdown"
+	LlmCenter_ConvertMarkdownLink_FullMethodName        = "/llmcenter.LlmCenter/ConvertMarkdownLink"
+	LlmCenter_CheckDocument_FullMethodName              = "/llmcenter.LlmCenter/CheckDocument"
+	LlmCenter_DeleteDocument_FullMethodName             = "/llmcenter.LlmCenter/DeleteDocument"
+	LlmCenter_ListAuditLogs_FullMethodName              = "/llmcenter.LlmCenter/ListAuditLogs"
+	LlmCenter_ExportAuditLogs_FullMethodName            = "/llmcenter.LlmCenter/ExportAuditLogs"
+	LlmCenter_CheckFileAccess_FullMethodName            = "/llmcenter.LlmCenter/CheckFileAccess"
+	LlmCenter_GetUsageSummary_FullMethodName            = "/llmcenter.LlmCenter/GetUsageSummary"
+	LlmCenter_ListUsageQuotas_FullMethodName            = "/llmcenter.LlmCenter/ListUsageQuotas"
+	LlmCenter_SetUsageQuota_FullMethodName              = "/llmcenter.LlmCenter/SetUsageQuota"
+	LlmCenter_DeleteUsageQuota_FullMethodName           = "/llmcenter.LlmCenter/DeleteUsageQuota"
+	LlmCenter_CreateDocumentShare_FullMethodName        = "/llmcenter.LlmCenter/CreateDocumentShare"
+	LlmCenter_ListDocumentShares_FullMethodName         = "/llmcenter.LlmCenter/ListDocumentShares"
+	LlmCenter_RevokeDocumentShare_FullMethodName        = "/llmcenter.LlmCenter/RevokeDocumentShare"
+	LlmCenter_OpenDocumentShare_FullMethodName          = "/llmcenter.LlmCenter/OpenDocumentShare"
+	LlmCenter_CreateDocumentComment_FullMethodName      = "/llmcenter.LlmCenter/CreateDocumentComment"
+	LlmCenter_ListDocumentComments_FullMethodName       = "/llmcenter.LlmCenter/ListDocumentComments"
+	LlmCenter_ResolveDocumentComment_FullMethodName     = "/llmcenter.LlmCenter/ResolveDocumentComment"
+	LlmCenter_ListMentionedComments_FullMethodName      = "/llmcenter.LlmCenter/ListMentionedComments"
+	LlmCenter_GetDocumentApproval_FullMethodName        = "/llmcenter.LlmCenter/GetDocumentApproval"
+	LlmCenter_TransitionDocumentApproval_FullMethodName = "/llmcenter.LlmCenter/TransitionDocumentApproval"
+	LlmCenter_ListApprovalTasks_FullMethodName          = "/llmcenter.LlmCenter/ListApprovalTasks"
+	LlmCenter_AllocateDocNo_FullMethodName              = "/llmcenter.LlmCenter/AllocateDocNo"
+	LlmCenter_ReleaseDocNo_FullMethodName               = "/llmcenter.LlmCenter/ReleaseDocNo"
+	LlmCenter_IssueDocNo_FullMethodName                 = "/llmcenter.LlmCenter/IssueDocNo"
+	LlmCenter_ListDocNos_FullMethodName                 = "/llmcenter.LlmCenter/ListDocNos"
+	LlmCenter_CollabDocument_FullMethodName             = "/llmcenter.LlmCenter/CollabDocument"
+	LlmCenter_GetDiagnostics_FullMethodName             = "/llmcenter.LlmCenter/GetDiagnostics"
 )
 
 // LlmCenterClient is the client API for LlmCenter service.
@@ -84,6 +110,110 @@ type LlmCenterClient interface {
 	// 对应 API: POST /llmcenter/v1/file/downloadlink
 	// 功能: 将Markdown转为相应格式并返回下载链接
 	ConvertMarkdownLink(ctx context.Context, in *ConvertMarkdownLinkRequest, opts ...grpc.CallOption) (*ConvertMarkdownLinkResponse, error)
+	// RPC 方法: CheckDocument
+	// 对应 API: POST /llmcenter/v1/chat/check
+	// 功能: 按 GB/T 9704 公文格式规范检查指定文档，返回问题位置与修改建议
+	CheckDocument(ctx context.Context, in *CheckDocumentRequest, opts ...grpc.CallOption) (*CheckDocumentResponse, error)
+	// RPC 方法: DeleteDocument
+	// 对应 API: POST /llmcenter/v1/chat/delete
+	// 功能: 删除指定文档
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+	// RPC 方法: ListAuditLogs
+	// 对应 API: GET /llmcenter/v1/admin/audit/logs
+	// 功能: 管理员按用户、会话、操作类型和时间范围分页查询文档操作审计记录
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// RPC 方法: ExportAuditLogs
+	// 对应 API: GET /llmcenter/v1/admin/audit/export
+	// 功能: 管理员按条件将审计记录导出为 CSV
+	ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (*ExportAuditLogsResponse, error)
+	// RPC 方法: CheckFileAccess
+	// 对应 API: GET /llmcenter/v1/files
+	// 功能: 校验用户是否可以读取上传的文件（个人文件仅上传者可读，团队空间文件成员可读）
+	CheckFileAccess(ctx context.Context, in *CheckFileAccessRequest, opts ...grpc.CallOption) (*CheckFileAccessResponse, error)
+	// RPC 方法: GetUsageSummary
+	// 对应 API: GET /llmcenter/v1/usage/summary
+	// 功能: 查询当前用户按日期、调用类型统计的大模型用量及当前配额的使用情况
+	GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error)
+	// RPC 方法: ListUsageQuotas
+	// 对应 API: GET /llmcenter/v1/admin/quotas
+	// 功能: 管理员查询全部用户级与角色级用量配额
+	ListUsageQuotas(ctx context.Context, in *ListUsageQuotasRequest, opts ...grpc.CallOption) (*ListUsageQuotasResponse, error)
+	// RPC 方法: SetUsageQuota
+	// 对应 API: POST /llmcenter/v1/admin/quotas
+	// 功能: 管理员设置用户或角色的用量配额，已存在时覆盖
+	SetUsageQuota(ctx context.Context, in *SetUsageQuotaRequest, opts ...grpc.CallOption) (*SetUsageQuotaResponse, error)
+	// RPC 方法: DeleteUsageQuota
+	// 对应 API: POST /llmcenter/v1/admin/quotas/delete
+	// 功能: 管理员删除用量配额
+	DeleteUsageQuota(ctx context.Context, in *DeleteUsageQuotaRequest, opts ...grpc.CallOption) (*DeleteUsageQuotaResponse, error)
+	// RPC 方法: CreateDocumentShare
+	// 对应 API: POST /llmcenter/v1/shares
+	// 功能: 为最终文档创建免登录的只读分享链接，可设置有效期、访问密码与是否允许下载
+	CreateDocumentShare(ctx context.Context, in *CreateDocumentShareRequest, opts ...grpc.CallOption) (*CreateDocumentShareResponse, error)
+	// RPC 方法: ListDocumentShares
+	// 对应 API: GET /llmcenter/v1/shares
+	// 功能: 查询文档的全部分享链接及其查看、下载次数
+	ListDocumentShares(ctx context.Context, in *ListDocumentSharesRequest, opts ...grpc.CallOption) (*ListDocumentSharesResponse, error)
+	// RPC 方法: RevokeDocumentShare
+	// 对应 API: POST /llmcenter/v1/shares/revoke
+	// 功能: 撤销分享链接，撤销后立即不可访问
+	RevokeDocumentShare(ctx context.Context, in *RevokeDocumentShareRequest, opts ...grpc.CallOption) (*RevokeDocumentShareResponse, error)
+	// RPC 方法: OpenDocumentShare
+	// 对应 API: GET /llmcenter/v1/public/share, GET /llmcenter/v1/public/share/download
+	// 功能: 通过分享令牌查看文档（HTML）或即时导出 DOCX/PDF，并累计查看、下载次数。链接签名由 API 层校验
+	OpenDocumentShare(ctx context.Context, in *OpenDocumentShareRequest, opts ...grpc.CallOption) (*OpenDocumentShareResponse, error)
+	// RPC 方法: CreateDocumentComment
+	// 对应 API: POST /llmcenter/v1/comments
+	// 功能: 对文档中的一段文本发表批注，或回复已有批注，可 @ 提及能查看该文档的用户
+	CreateDocumentComment(ctx context.Context, in *CreateDocumentCommentRequest, opts ...grpc.CallOption) (*CreateDocumentCommentResponse, error)
+	// RPC 方法: ListDocumentComments
+	// 对应 API: GET /llmcenter/v1/comments
+	// 功能: 查询文档的批注及回复，文档修改后重新定位批注引用的文本
+	ListDocumentComments(ctx context.Context, in *ListDocumentCommentsRequest, opts ...grpc.CallOption) (*ListDocumentCommentsResponse, error)
+	// RPC 方法: ResolveDocumentComment
+	// 对应 API: POST /llmcenter/v1/comments/resolve
+	// 功能: 标记批注已解决或重新打开
+	ResolveDocumentComment(ctx context.Context, in *ResolveDocumentCommentRequest, opts ...grpc.CallOption) (*ResolveDocumentCommentResponse, error)
+	// RPC 方法: ListMentionedComments
+	// 对应 API: GET /llmcenter/v1/comments/mentions
+	// 功能: 查询提及当前用户的批注
+	ListMentionedComments(ctx context.Context, in *ListMentionedCommentsRequest, opts ...grpc.CallOption) (*ListMentionedCommentsResponse, error)
+	// RPC 方法: GetDocumentApproval
+	// 对应 API: GET /llmcenter/v1/approvals
+	// 功能: 查询文档的审批状态、当前用户可执行的操作与流转记录
+	GetDocumentApproval(ctx context.Context, in *GetDocumentApprovalRequest, opts ...grpc.CallOption) (*GetDocumentApprovalResponse, error)
+	// RPC 方法: TransitionDocumentApproval
+	// 对应 API: POST /llmcenter/v1/approvals/transition
+	// 功能: 执行审批流转（提交、通过、退回、签发等），锁定状态的文档不能修改
+	TransitionDocumentApproval(ctx context.Context, in *TransitionDocumentApprovalRequest, opts ...grpc.CallOption) (*TransitionDocumentApprovalResponse, error)
+	// RPC 方法: ListApprovalTasks
+	// 对应 API: GET /llmcenter/v1/approvals/tasks
+	// 功能: 查询等待当前用户审批的文档
+	ListApprovalTasks(ctx context.Context, in *ListApprovalTasksRequest, opts ...grpc.CallOption) (*ListApprovalTasksResponse, error)
+	// RPC 方法: AllocateDocNo
+	// 对应 API: POST /llmcenter/v1/docnos
+	// 功能: 按团队与发文机关代字为文档分配当年的下一个发文字号，文档已有预留的字号时直接返回
+	AllocateDocNo(ctx context.Context, in *AllocateDocNoRequest, opts ...grpc.CallOption) (*AllocateDocNoResponse, error)
+	// RPC 方法: ReleaseDocNo
+	// 对应 API: POST /llmcenter/v1/docnos/release
+	// 功能: 释放未使用的发文字号，释放后优先分配给下一份文档
+	ReleaseDocNo(ctx context.Context, in *ReleaseDocNoRequest, opts ...grpc.CallOption) (*ReleaseDocNoResponse, error)
+	// RPC 方法: IssueDocNo
+	// 对应 API: POST /llmcenter/v1/docnos/issue
+	// 功能: 将预留的发文字号标记为已使用，已使用的字号不能释放
+	IssueDocNo(ctx context.Context, in *IssueDocNoRequest, opts ...grpc.CallOption) (*IssueDocNoResponse, error)
+	// RPC 方法: ListDocNos
+	// 对应 API: GET /llmcenter/v1/docnos
+	// 功能: 分页查询团队的发文字号
+	ListDocNos(ctx context.Context, in *ListDocNosRequest, opts ...grpc.CallOption) (*ListDocNosResponse, error)
+	// RPC 方法: CollabDocument
+	// 对应 API: GET /llmcenter/v1/collab/ws (WebSocket)
+	// 功能: 多人实时协同编辑文档，首条消息须为 join；客户端发送编辑操作与光标，服务端推送其他用户的操作与在线状态
+	CollabDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CollabRequest, CollabResponse], error)
+	// RPC 方法: GetDiagnostics
+	// 对应 API: GET /llmcenter/v1/admin/diagnostics
+	// 功能: 管理员查看 RPC 服务的构建版本、依赖检查结果与配置问题
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error)
 }
 
 type llmCenterClient struct {
@@ -234,6 +364,269 @@ func (c *llmCenterClient) ConvertMarkdownLink(ctx context.Context, in *ConvertMa
 	return out, nil
 }
 
+func (c *llmCenterClient) CheckDocument(ctx context.Context, in *CheckDocumentRequest, opts ...grpc.CallOption) (*CheckDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDocumentResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_CheckDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_DeleteDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (*ExportAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuditLogsResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ExportAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) CheckFileAccess(ctx context.Context, in *CheckFileAccessRequest, opts ...grpc.CallOption) (*CheckFileAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckFileAccessResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_CheckFileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageSummaryResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_GetUsageSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListUsageQuotas(ctx context.Context, in *ListUsageQuotasRequest, opts ...grpc.CallOption) (*ListUsageQuotasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsageQuotasResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListUsageQuotas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) SetUsageQuota(ctx context.Context, in *SetUsageQuotaRequest, opts ...grpc.CallOption) (*SetUsageQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUsageQuotaResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_SetUsageQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) DeleteUsageQuota(ctx context.Context, in *DeleteUsageQuotaRequest, opts ...grpc.CallOption) (*DeleteUsageQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUsageQuotaResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_DeleteUsageQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) CreateDocumentShare(ctx context.Context, in *CreateDocumentShareRequest, opts ...grpc.CallOption) (*CreateDocumentShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDocumentShareResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_CreateDocumentShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListDocumentShares(ctx context.Context, in *ListDocumentSharesRequest, opts ...grpc.CallOption) (*ListDocumentSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentSharesResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListDocumentShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) RevokeDocumentShare(ctx context.Context, in *RevokeDocumentShareRequest, opts ...grpc.CallOption) (*RevokeDocumentShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDocumentShareResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_RevokeDocumentShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) OpenDocumentShare(ctx context.Context, in *OpenDocumentShareRequest, opts ...grpc.CallOption) (*OpenDocumentShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenDocumentShareResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_OpenDocumentShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) CreateDocumentComment(ctx context.Context, in *CreateDocumentCommentRequest, opts ...grpc.CallOption) (*CreateDocumentCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDocumentCommentResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_CreateDocumentComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListDocumentComments(ctx context.Context, in *ListDocumentCommentsRequest, opts ...grpc.CallOption) (*ListDocumentCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentCommentsResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListDocumentComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ResolveDocumentComment(ctx context.Context, in *ResolveDocumentCommentRequest, opts ...grpc.CallOption) (*ResolveDocumentCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveDocumentCommentResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ResolveDocumentComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListMentionedComments(ctx context.Context, in *ListMentionedCommentsRequest, opts ...grpc.CallOption) (*ListMentionedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMentionedCommentsResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListMentionedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) GetDocumentApproval(ctx context.Context, in *GetDocumentApprovalRequest, opts ...grpc.CallOption) (*GetDocumentApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentApprovalResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_GetDocumentApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) TransitionDocumentApproval(ctx context.Context, in *TransitionDocumentApprovalRequest, opts ...grpc.CallOption) (*TransitionDocumentApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentApprovalResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_TransitionDocumentApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListApprovalTasks(ctx context.Context, in *ListApprovalTasksRequest, opts ...grpc.CallOption) (*ListApprovalTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalTasksResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListApprovalTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) AllocateDocNo(ctx context.Context, in *AllocateDocNoRequest, opts ...grpc.CallOption) (*AllocateDocNoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllocateDocNoResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_AllocateDocNo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ReleaseDocNo(ctx context.Context, in *ReleaseDocNoRequest, opts ...grpc.CallOption) (*ReleaseDocNoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseDocNoResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ReleaseDocNo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) IssueDocNo(ctx context.Context, in *IssueDocNoRequest, opts ...grpc.CallOption) (*IssueDocNoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueDocNoResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_IssueDocNo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) ListDocNos(ctx context.Context, in *ListDocNosRequest, opts ...grpc.CallOption) (*ListDocNosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocNosResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_ListDocNos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCenterClient) CollabDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CollabRequest, CollabResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LlmCenter_ServiceDesc.Streams[4], LlmCenter_CollabDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CollabRequest, CollabResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LlmCenter_CollabDocumentClient = grpc.BidiStreamingClient[CollabRequest, CollabResponse]
+
+func (c *llmCenterClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, LlmCenter_GetDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LlmCenterServer is the server API for LlmCenter service.
 // All implementations must embed UnimplementedLlmCenterServer
 // for forward compatibility.
@@ -282,6 +675,110 @@ type LlmCenterServer interface {
 	// 对应 API: POST /llmcenter/v1/file/downloadlink
 	// 功能: 将Markdown转为相应格式并返回下载链接
 	ConvertMarkdownLink(context.Context, *ConvertMarkdownLinkRequest) (*ConvertMarkdownLinkResponse, error)
+	// RPC 方法: CheckDocument
+	// 对应 API: POST /llmcenter/v1/chat/check
+	// 功能: 按 GB/T 9704 公文格式规范检查指定文档，返回问题位置与修改建议
+	CheckDocument(context.Context, *CheckDocumentRequest) (*CheckDocumentResponse, error)
+	// RPC 方法: DeleteDocument
+	// 对应 API: POST /llmcenter/v1/chat/delete
+	// 功能: 删除指定文档
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	// RPC 方法: ListAuditLogs
+	// 对应 API: GET /llmcenter/v1/admin/audit/logs
+	// 功能: 管理员按用户、会话、操作类型和时间范围分页查询文档操作审计记录
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// RPC 方法: ExportAuditLogs
+	// 对应 API: GET /llmcenter/v1/admin/audit/export
+	// 功能: 管理员按条件将审计记录导出为 CSV
+	ExportAuditLogs(context.Context, *ExportAuditLogsRequest) (*ExportAuditLogsResponse, error)
+	// RPC 方法: CheckFileAccess
+	// 对应 API: GET /llmcenter/v1/files
+	// 功能: 校验用户是否可以读取上传的文件（个人文件仅上传者可读，团队空间文件成员可读）
+	CheckFileAccess(context.Context, *CheckFileAccessRequest) (*CheckFileAccessResponse, error)
+	// RPC 方法: GetUsageSummary
+	// 对应 API: GET /llmcenter/v1/usage/summary
+	// 功能: 查询当前用户按日期、调用类型统计的大模型用量及当前配额的使用情况
+	GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error)
+	// RPC 方法: ListUsageQuotas
+	// 对应 API: GET /llmcenter/v1/admin/quotas
+	// 功能: 管理员查询全部用户级与角色级用量配额
+	ListUsageQuotas(context.Context, *ListUsageQuotasRequest) (*ListUsageQuotasResponse, error)
+	// RPC 方法: SetUsageQuota
+	// 对应 API: POST /llmcenter/v1/admin/quotas
+	// 功能: 管理员设置用户或角色的用量配额，已存在时覆盖
+	SetUsageQuota(context.Context, *SetUsageQuotaRequest) (*SetUsageQuotaResponse, error)
+	// RPC 方法: DeleteUsageQuota
+	// 对应 API: POST /llmcenter/v1/admin/quotas/delete
+	// 功能: 管理员删除用量配额
+	DeleteUsageQuota(context.Context, *DeleteUsageQuotaRequest) (*DeleteUsageQuotaResponse, error)
+	// RPC 方法: CreateDocumentShare
+	// 对应 API: POST /llmcenter/v1/shares
+	// 功能: 为最终文档创建免登录的只读分享链接，可设置有效期、访问密码与是否允许下载
+	CreateDocumentShare(context.Context, *CreateDocumentShareRequest) (*CreateDocumentShareResponse, error)
+	// RPC 方法: ListDocumentShares
+	// 对应 API: GET /llmcenter/v1/shares
+	// 功能: 查询文档的全部分享链接及其查看、下载次数
+	ListDocumentShares(context.Context, *ListDocumentSharesRequest) (*ListDocumentSharesResponse, error)
+	// RPC 方法: RevokeDocumentShare
+	// 对应 API: POST /llmcenter/v1/shares/revoke
+	// 功能: 撤销分享链接，撤销后立即不可访问
+	RevokeDocumentShare(context.Context, *RevokeDocumentShareRequest) (*RevokeDocumentShareResponse, error)
+	// RPC 方法: OpenDocumentShare
+	// 对应 API: GET /llmcenter/v1/public/share, GET /llmcenter/v1/public/share/download
+	// 功能: 通过分享令牌查看文档（HTML）或即时导出 DOCX/PDF，并累计查看、下载次数。链接签名由 API 层校验
+	OpenDocumentShare(context.Context, *OpenDocumentShareRequest) (*OpenDocumentShareResponse, error)
+	// RPC 方法: CreateDocumentComment
+	// 对应 API: POST /llmcenter/v1/comments
+	// 功能: 对文档中的一段文本发表批注，或回复已有批注，可 @ 提及能查看该文档的用户
+	CreateDocumentComment(context.Context, *CreateDocumentCommentRequest) (*CreateDocumentCommentResponse, error)
+	// RPC 方法: ListDocumentComments
+	// 对应 API: GET /llmcenter/v1/comments
+	// 功能: 查询文档的批注及回复，文档修改后重新定位批注引用的文本
+	ListDocumentComments(context.Context, *ListDocumentCommentsRequest) (*ListDocumentCommentsResponse, error)
+	// RPC 方法: ResolveDocumentComment
+	// 对应 API: POST /llmcenter/v1/comments/resolve
+	// 功能: 标记批注已解决或重新打开
+	ResolveDocumentComment(context.Context, *ResolveDocumentCommentRequest) (*ResolveDocumentCommentResponse, error)
+	// RPC 方法: ListMentionedComments
+	// 对应 API: GET /llmcenter/v1/comments/mentions
+	// 功能: 查询提及当前用户的批注
+	ListMentionedComments(context.Context, *ListMentionedCommentsRequest) (*ListMentionedCommentsResponse, error)
+	// RPC 方法: GetDocumentApproval
+	// 对应 API: GET /llmcenter/v1/approvals
+	// 功能: 查询文档的审批状态、当前用户可执行的操作与流转记录
+	GetDocumentApproval(context.Context, *GetDocumentApprovalRequest) (*GetDocumentApprovalResponse, error)
+	// RPC 方法: TransitionDocumentApproval
+	// 对应 API: POST /llmcenter/v1/approvals/transition
+	// 功能: 执行审批流转（提交、通过、退回、签发等），锁定状态的文档不能修改
+	TransitionDocumentApproval(context.Context, *TransitionDocumentApprovalRequest) (*TransitionDocumentApprovalResponse, error)
+	// RPC 方法: ListApprovalTasks
+	// 对应 API: GET /llmcenter/v1/approvals/tasks
+	// 功能: 查询等待当前用户审批的文档
+	ListApprovalTasks(context.Context, *ListApprovalTasksRequest) (*ListApprovalTasksResponse, error)
+	// RPC 方法: AllocateDocNo
+	// 对应 API: POST /llmcenter/v1/docnos
+	// 功能: 按团队与发文机关代字为文档分配当年的下一个发文字号，文档已有预留的字号时直接返回
+	AllocateDocNo(context.Context, *AllocateDocNoRequest) (*AllocateDocNoResponse, error)
+	// RPC 方法: ReleaseDocNo
+	// 对应 API: POST /llmcenter/v1/docnos/release
+	// 功能: 释放未使用的发文字号，释放后优先分配给下一份文档
+	ReleaseDocNo(context.Context, *ReleaseDocNoRequest) (*ReleaseDocNoResponse, error)
+	// RPC 方法: IssueDocNo
+	// 对应 API: POST /llmcenter/v1/docnos/issue
+	// 功能: 将预留的发文字号标记为已使用，已使用的字号不能释放
+	IssueDocNo(context.Context, *IssueDocNoRequest) (*IssueDocNoResponse, error)
+	// RPC 方法: ListDocNos
+	// 对应 API: GET /llmcenter/v1/docnos
+	// 功能: 分页查询团队的发文字号
+	ListDocNos(context.Context, *ListDocNosRequest) (*ListDocNosResponse, error)
+	// RPC 方法: CollabDocument
+	// 对应 API: GET /llmcenter/v1/collab/ws (WebSocket)
+	// 功能: 多人实时协同编辑文档，首条消息须为 join；客户端发送编辑操作与光标，服务端推送其他用户的操作与在线状态
+	CollabDocument(grpc.BidiStreamingServer[CollabRequest, CollabResponse]) error
+	// RPC 方法: GetDiagnostics
+	// 对应 API: GET /llmcenter/v1/admin/diagnostics
+	// 功能: 管理员查看 RPC 服务的构建版本、依赖检查结果与配置问题
+	GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*GetDiagnosticsResponse, error)
 	mustEmbedUnimplementedLlmCenterServer()
 }
 
@@ -325,6 +822,84 @@ func (UnimplementedLlmCenterServer) ConvertMarkdown(context.Context, *ConvertMar
 func (UnimplementedLlmCenterServer) ConvertMarkdownLink(context.Context, *ConvertMarkdownLinkRequest) (*ConvertMarkdownLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertMarkdownLink not implemented")
 }
+func (UnimplementedLlmCenterServer) CheckDocument(context.Context, *CheckDocumentRequest) (*CheckDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDocument not implemented")
+}
+func (UnimplementedLlmCenterServer) DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocument not implemented")
+}
+func (UnimplementedLlmCenterServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedLlmCenterServer) ExportAuditLogs(context.Context, *ExportAuditLogsRequest) (*ExportAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditLogs not implemented")
+}
+func (UnimplementedLlmCenterServer) CheckFileAccess(context.Context, *CheckFileAccessRequest) (*CheckFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFileAccess not implemented")
+}
+func (UnimplementedLlmCenterServer) GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageSummary not implemented")
+}
+func (UnimplementedLlmCenterServer) ListUsageQuotas(context.Context, *ListUsageQuotasRequest) (*ListUsageQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsageQuotas not implemented")
+}
+func (UnimplementedLlmCenterServer) SetUsageQuota(context.Context, *SetUsageQuotaRequest) (*SetUsageQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsageQuota not implemented")
+}
+func (UnimplementedLlmCenterServer) DeleteUsageQuota(context.Context, *DeleteUsageQuotaRequest) (*DeleteUsageQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUsageQuota not implemented")
+}
+func (UnimplementedLlmCenterServer) CreateDocumentShare(context.Context, *CreateDocumentShareRequest) (*CreateDocumentShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocumentShare not implemented")
+}
+func (UnimplementedLlmCenterServer) ListDocumentShares(context.Context, *ListDocumentSharesRequest) (*ListDocumentSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentShares not implemented")
+}
+func (UnimplementedLlmCenterServer) RevokeDocumentShare(context.Context, *RevokeDocumentShareRequest) (*RevokeDocumentShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDocumentShare not implemented")
+}
+func (UnimplementedLlmCenterServer) OpenDocumentShare(context.Context, *OpenDocumentShareRequest) (*OpenDocumentShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDocumentShare not implemented")
+}
+func (UnimplementedLlmCenterServer) CreateDocumentComment(context.Context, *CreateDocumentCommentRequest) (*CreateDocumentCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocumentComment not implemented")
+}
+func (UnimplementedLlmCenterServer) ListDocumentComments(context.Context, *ListDocumentCommentsRequest) (*ListDocumentCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentComments not implemented")
+}
+func (UnimplementedLlmCenterServer) ResolveDocumentComment(context.Context, *ResolveDocumentCommentRequest) (*ResolveDocumentCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDocumentComment not implemented")
+}
+func (UnimplementedLlmCenterServer) ListMentionedComments(context.Context, *ListMentionedCommentsRequest) (*ListMentionedCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentionedComments not implemented")
+}
+func (UnimplementedLlmCenterServer) GetDocumentApproval(context.Context, *GetDocumentApprovalRequest) (*GetDocumentApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentApproval not implemented")
+}
+func (UnimplementedLlmCenterServer) TransitionDocumentApproval(context.Context, *TransitionDocumentApprovalRequest) (*TransitionDocumentApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionDocumentApproval not implemented")
+}
+func (UnimplementedLlmCenterServer) ListApprovalTasks(context.Context, *ListApprovalTasksRequest) (*ListApprovalTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalTasks not implemented")
+}
+func (UnimplementedLlmCenterServer) AllocateDocNo(context.Context, *AllocateDocNoRequest) (*AllocateDocNoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateDocNo not implemented")
+}
+func (UnimplementedLlmCenterServer) ReleaseDocNo(context.Context, *ReleaseDocNoRequest) (*ReleaseDocNoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDocNo not implemented")
+}
+func (UnimplementedLlmCenterServer) IssueDocNo(context.Context, *IssueDocNoRequest) (*IssueDocNoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueDocNo not implemented")
+}
+func (UnimplementedLlmCenterServer) ListDocNos(context.Context, *ListDocNosRequest) (*ListDocNosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocNos not implemented")
+}
+func (UnimplementedLlmCenterServer) CollabDocument(grpc.BidiStreamingServer[CollabRequest, CollabResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CollabDocument not implemented")
+}
+func (UnimplementedLlmCenterServer) GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*GetDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
 func (UnimplementedLlmCenterServer) mustEmbedUnimplementedLlmCenterServer() {}
 func (UnimplementedLlmCenterServer) testEmbeddedByValue()                   {}
 
@@ -512,52 +1087,609 @@ func _LlmCenter_ConvertMarkdownLink_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// LlmCenter_ServiceDesc is the grpc.ServiceDesc for LlmCenter service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LlmCenter_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "llmcenter.LlmCenter",
-	HandlerType: (*LlmCenterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetConversations",
-			Handler:    _LlmCenter_GetConversations_Handler,
-		},
-		{
-			MethodName: "GetConversationDetail",
-			Handler:    _LlmCenter_GetConversationDetail_Handler,
-		},
-		{
-			MethodName: "GetDocumentDetail",
-			Handler:    _LlmCenter_GetDocumentDetail_Handler,
-		},
-		{
-			MethodName: "GetHistoryData",
-			Handler:    _LlmCenter_GetHistoryData_Handler,
-		},
-		{
-			MethodName: "UpdateDocument",
-			Handler:    _LlmCenter_UpdateDocument_Handler,
-		},
-		{
-			MethodName: "ConvertMarkdown",
-			Handler:    _LlmCenter_ConvertMarkdown_Handler,
-		},
-		{
-			MethodName: "ConvertMarkdownLink",
-			Handler:    _LlmCenter_ConvertMarkdownLink_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ChatCompletions",
-			Handler:       _LlmCenter_ChatCompletions_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ChatResume",
-			Handler:       _LlmCenter_ChatResume_Handler,
-			ServerStreams: true,
+func _LlmCenter_CheckDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).CheckDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_CheckDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).CheckDocument(ctx, req.(*CheckDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ExportAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ExportAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ExportAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ExportAuditLogs(ctx, req.(*ExportAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_CheckFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).CheckFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_CheckFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).CheckFileAccess(ctx, req.(*CheckFileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_GetUsageSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).GetUsageSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_GetUsageSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).GetUsageSummary(ctx, req.(*GetUsageSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListUsageQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListUsageQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListUsageQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListUsageQuotas(ctx, req.(*ListUsageQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_SetUsageQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUsageQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).SetUsageQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_SetUsageQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).SetUsageQuota(ctx, req.(*SetUsageQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_DeleteUsageQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUsageQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).DeleteUsageQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_DeleteUsageQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).DeleteUsageQuota(ctx, req.(*DeleteUsageQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_CreateDocumentShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).CreateDocumentShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_CreateDocumentShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).CreateDocumentShare(ctx, req.(*CreateDocumentShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListDocumentShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListDocumentShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListDocumentShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListDocumentShares(ctx, req.(*ListDocumentSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_RevokeDocumentShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDocumentShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).RevokeDocumentShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_RevokeDocumentShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).RevokeDocumentShare(ctx, req.(*RevokeDocumentShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_OpenDocumentShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDocumentShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).OpenDocumentShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_OpenDocumentShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).OpenDocumentShare(ctx, req.(*OpenDocumentShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_CreateDocumentComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).CreateDocumentComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_CreateDocumentComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).CreateDocumentComment(ctx, req.(*CreateDocumentCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListDocumentComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListDocumentComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListDocumentComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListDocumentComments(ctx, req.(*ListDocumentCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ResolveDocumentComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDocumentCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ResolveDocumentComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ResolveDocumentComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ResolveDocumentComment(ctx, req.(*ResolveDocumentCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListMentionedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListMentionedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListMentionedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListMentionedComments(ctx, req.(*ListMentionedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_GetDocumentApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).GetDocumentApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_GetDocumentApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).GetDocumentApproval(ctx, req.(*GetDocumentApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_TransitionDocumentApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).TransitionDocumentApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_TransitionDocumentApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).TransitionDocumentApproval(ctx, req.(*TransitionDocumentApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListApprovalTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListApprovalTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListApprovalTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListApprovalTasks(ctx, req.(*ListApprovalTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_AllocateDocNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateDocNoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).AllocateDocNo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_AllocateDocNo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).AllocateDocNo(ctx, req.(*AllocateDocNoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ReleaseDocNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDocNoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ReleaseDocNo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ReleaseDocNo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ReleaseDocNo(ctx, req.(*ReleaseDocNoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_IssueDocNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueDocNoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).IssueDocNo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_IssueDocNo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).IssueDocNo(ctx, req.(*IssueDocNoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_ListDocNos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocNosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).ListDocNos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_ListDocNos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).ListDocNos(ctx, req.(*ListDocNosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCenter_CollabDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LlmCenterServer).CollabDocument(&grpc.GenericServerStream[CollabRequest, CollabResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LlmCenter_CollabDocumentServer = grpc.BidiStreamingServer[CollabRequest, CollabResponse]
+
+func _LlmCenter_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCenterServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCenter_GetDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCenterServer).GetDiagnostics(ctx, req.(*GetDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LlmCenter_ServiceDesc is the grpc.ServiceDesc for LlmCenter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LlmCenter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llmcenter.LlmCenter",
+	HandlerType: (*LlmCenterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConversations",
+			Handler:    _LlmCenter_GetConversations_Handler,
+		},
+		{
+			MethodName: "GetConversationDetail",
+			Handler:    _LlmCenter_GetConversationDetail_Handler,
+		},
+		{
+			MethodName: "GetDocumentDetail",
+			Handler:    _LlmCenter_GetDocumentDetail_Handler,
+		},
+		{
+			MethodName: "GetHistoryData",
+			Handler:    _LlmCenter_GetHistoryData_Handler,
+		},
+		{
+			MethodName: "UpdateDocument",
+			Handler:    _LlmCenter_UpdateDocument_Handler,
+		},
+		{
+			MethodName: "ConvertMarkdown",
+			Handler:    _LlmCenter_ConvertMarkdown_Handler,
+		},
+		{
+			MethodName: "ConvertMarkdownLink",
+			Handler:    _LlmCenter_ConvertMarkdownLink_Handler,
+		},
+		{
+			MethodName: "CheckDocument",
+			Handler:    _LlmCenter_CheckDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _LlmCenter_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _LlmCenter_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "ExportAuditLogs",
+			Handler:    _LlmCenter_ExportAuditLogs_Handler,
+		},
+		{
+			MethodName: "CheckFileAccess",
+			Handler:    _LlmCenter_CheckFileAccess_Handler,
+		},
+		{
+			MethodName: "GetUsageSummary",
+			Handler:    _LlmCenter_GetUsageSummary_Handler,
+		},
+		{
+			MethodName: "ListUsageQuotas",
+			Handler:    _LlmCenter_ListUsageQuotas_Handler,
+		},
+		{
+			MethodName: "SetUsageQuota",
+			Handler:    _LlmCenter_SetUsageQuota_Handler,
+		},
+		{
+			MethodName: "DeleteUsageQuota",
+			Handler:    _LlmCenter_DeleteUsageQuota_Handler,
+		},
+		{
+			MethodName: "CreateDocumentShare",
+			Handler:    _LlmCenter_CreateDocumentShare_Handler,
+		},
+		{
+			MethodName: "ListDocumentShares",
+			Handler:    _LlmCenter_ListDocumentShares_Handler,
+		},
+		{
+			MethodName: "RevokeDocumentShare",
+			Handler:    _LlmCenter_RevokeDocumentShare_Handler,
+		},
+		{
+			MethodName: "OpenDocumentShare",
+			Handler:    _LlmCenter_OpenDocumentShare_Handler,
+		},
+		{
+			MethodName: "CreateDocumentComment",
+			Handler:    _LlmCenter_CreateDocumentComment_Handler,
+		},
+		{
+			MethodName: "ListDocumentComments",
+			Handler:    _LlmCenter_ListDocumentComments_Handler,
+		},
+		{
+			MethodName: "ResolveDocumentComment",
+			Handler:    _LlmCenter_ResolveDocumentComment_Handler,
+		},
+		{
+			MethodName: "ListMentionedComments",
+			Handler:    _LlmCenter_ListMentionedComments_Handler,
+		},
+		{
+			MethodName: "GetDocumentApproval",
+			Handler:    _LlmCenter_GetDocumentApproval_Handler,
+		},
+		{
+			MethodName: "TransitionDocumentApproval",
+			Handler:    _LlmCenter_TransitionDocumentApproval_Handler,
+		},
+		{
+			MethodName: "ListApprovalTasks",
+			Handler:    _LlmCenter_ListApprovalTasks_Handler,
+		},
+		{
+			MethodName: "AllocateDocNo",
+			Handler:    _LlmCenter_AllocateDocNo_Handler,
+		},
+		{
+			MethodName: "ReleaseDocNo",
+			Handler:    _LlmCenter_ReleaseDocNo_Handler,
+		},
+		{
+			MethodName: "IssueDocNo",
+			Handler:    _LlmCenter_IssueDocNo_Handler,
+		},
+		{
+			MethodName: "ListDocNos",
+			Handler:    _LlmCenter_ListDocNos_Handler,
+		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _LlmCenter_GetDiagnostics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChatCompletions",
+			Handler:       _LlmCenter_ChatCompletions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ChatResume",
+			Handler:       _LlmCenter_ChatResume_Handler,
+			ServerStreams: true,
 		},
 		{
 			StreamName:    "FileUpload",
@@ -569,6 +1701,12 @@ var LlmCenter_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _LlmCenter_EditDocument_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CollabDocument",
+			Handler:       _LlmCenter_CollabDocument_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "llmcenter.proto",
 }
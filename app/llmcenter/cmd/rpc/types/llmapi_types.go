@@ -29,19 +29,25 @@ type LLMMessage struct {
 
 // LLMApiResponse 是解析星火 API 流式响应的结构
 type LLMApiResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-	ID      string `json:"id"`
-	Choices []struct {
-		Delta struct {
-			Role    string `json:"role"`
-			Content string `json:"content"`
-		} `json:"delta"`
-		FinishReason string `json:"finish_reason"`
-	} `json:"choices"`
+	Code      int           `json:"code"`
+	Message   string        `json:"message"`
+	ID        string        `json:"id"`
+	Choices   []LLMChoice   `json:"choices"`
 	EventData *LLMEventData `json:"event_data,omitempty"`
 }
 
+// LLMChoice 流式响应中的单个候选
+type LLMChoice struct {
+	Delta        LLMDelta `json:"delta"`
+	FinishReason string   `json:"finish_reason"`
+}
+
+// LLMDelta 流式响应的增量内容
+type LLMDelta struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
 // LLMEventData 封装了中断事件
 type LLMEventData struct {
 	EventID   string `json:"event_id"`
@@ -0,0 +1,38 @@
+// 模拟星辰工作流接口，本地开发时将 llmcenter-rpc 的 XingChen.ApiURL、ApiResumeURL 与 UploadURL 指向该服务：
+//
+//	go run ./app/llmcenter/cmd/xingchenmock -addr :8090 -delay 100ms
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"document_agent/pkg/xingchenmock"
+)
+
+var (
+	addr  = flag.String("addr", ":8090", "listen address")
+	delay = flag.Duration("delay", 100*time.Millisecond, "delay before each chunk")
+	reply = flag.String("reply", "# 关于开展安全生产检查的通知\n\n各单位：\n\n为切实做好安全生产工作，现将有关事项通知如下。\n\n某某县人民政府\n\n2025年1月1日", "reply content, streamed line by line")
+)
+
+func main() {
+	flag.Parse()
+
+	var chunks []string
+	for _, line := range strings.SplitAfter(*reply, "\n") {
+		if line != "" {
+			chunks = append(chunks, line)
+		}
+	}
+	sc := xingchenmock.Reply(chunks...)
+	sc.ChunkDelay = *delay
+
+	fmt.Printf("Starting xingchen mock server at %s...\n", *addr)
+	if err := http.ListenAndServe(*addr, xingchenmock.New(sc)); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+// audit_logs 表只允许追加，因此这里手写模型，不提供 Update / Delete 方法（数据库侧另有触发器兜底）。
+
+var (
+	auditLogsFieldNames        = builder.RawFieldNames(&AuditLogs{})
+	auditLogsRows              = strings.Join(auditLogsFieldNames, ",")
+	auditLogsRowsExpectAutoSet = strings.Join(stringx.Remove(auditLogsFieldNames, "`id`", "`created_at`"), ",")
+)
+
+var _ AuditLogsModel = (*defaultAuditLogsModel)(nil)
+
+type (
+	// AuditLogsModel 文档操作审计表
+	AuditLogsModel interface {
+		Insert(ctx context.Context, data *AuditLogs) error
+		FindList(ctx context.Context, filter AuditLogFilter, offset, limit int64) ([]*AuditLogs, error)
+		Count(ctx context.Context, filter AuditLogFilter) (int64, error)
+		FindLatestHashAfter(ctx context.Context, action, targetId string) (string, error)
+		withSession(session sqlx.Session) AuditLogsModel
+	}
+
+	defaultAuditLogsModel struct {
+		conn  sqlx.SqlConn
+		table string
+	}
+
+	AuditLogs struct {
+		Id             int64     `db:"id"`              // 自增主键
+		UserId         int64     `db:"user_id"`         // 操作用户ID (公开下载等匿名操作为 0)
+		Action         string    `db:"action"`          // 操作类型
+		ConversationId string    `db:"conversation_id"` // 关联的会话ID
+		TargetId       string    `db:"target_id"`       // 操作对象ID (文档/消息ID 或导出文件名)
+		ClientIp       string    `db:"client_ip"`       // 客户端IP
+		UserAgent      string    `db:"user_agent"`      // 客户端 User-Agent
+		HashBefore     string    `db:"hash_before"`     // 操作前内容的 SHA-256
+		HashAfter      string    `db:"hash_after"`      // 操作后内容的 SHA-256
+		Detail         string    `db:"detail"`          // 补充说明
+		CreatedAt      time.Time `db:"created_at"`      // 操作时间
+	}
+
+	// AuditLogFilter 审计记录查询条件，零值字段表示不过滤
+	AuditLogFilter struct {
+		UserId         int64
+		ConversationId string
+		Action         string
+		StartTime      time.Time // 包含
+		EndTime        time.Time // 不包含
+	}
+)
+
+// NewAuditLogsModel returns a model for the database table.
+func NewAuditLogsModel(conn sqlx.SqlConn) AuditLogsModel {
+	return &defaultAuditLogsModel{
+		conn:  conn,
+		table: "`audit_logs`",
+	}
+}
+
+func (m *defaultAuditLogsModel) withSession(session sqlx.Session) AuditLogsModel {
+	return NewAuditLogsModel(sqlx.NewSqlConnFromSession(session))
+}
+
+func (m *defaultAuditLogsModel) Insert(ctx context.Context, data *AuditLogs) error {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, auditLogsRowsExpectAutoSet)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.Action, data.ConversationId, data.TargetId,
+		data.ClientIp, data.UserAgent, data.HashBefore, data.HashAfter, data.Detail)
+	return err
+}
+
+// FindList 按时间倒序分页查询
+func (m *defaultAuditLogsModel) FindList(ctx context.Context, filter AuditLogFilter, offset, limit int64) ([]*AuditLogs, error) {
+	where, args := filter.where()
+	query := fmt.Sprintf("select %s from %s%s order by `created_at` desc, `id` desc limit ?, ?", auditLogsRows, m.table, where)
+	args = append(args, offset, limit)
+
+	var resp []*AuditLogs
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+func (m *defaultAuditLogsModel) Count(ctx context.Context, filter AuditLogFilter) (int64, error) {
+	where, args := filter.where()
+	query := fmt.Sprintf("select count(*) from %s%s", m.table, where)
+
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, query, args...)
+	return total, err
+}
+
+// FindLatestHashAfter 查询指定对象最近一条该类型操作记录的 hash_after，无记录时返回 ErrNotFound
+func (m *defaultAuditLogsModel) FindLatestHashAfter(ctx context.Context, action, targetId string) (string, error) {
+	query := fmt.Sprintf("select `hash_after` from %s where `target_id` = ? and `action` = ? order by `id` desc limit 1", m.table)
+
+	var hash string
+	err := m.conn.QueryRowCtx(ctx, &hash, query, targetId, action)
+	switch err {
+	case nil:
+		return hash, nil
+	case sqlx.ErrNotFound:
+		return "", ErrNotFound
+	default:
+		return "", err
+	}
+}
+
+func (f AuditLogFilter) where() (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	if f.UserId > 0 {
+		conds = append(conds, "`user_id` = ?")
+		args = append(args, f.UserId)
+	}
+	if f.ConversationId != "" {
+		conds = append(conds, "`conversation_id` = ?")
+		args = append(args, f.ConversationId)
+	}
+	if f.Action != "" {
+		conds = append(conds, "`action` = ?")
+		args = append(args, f.Action)
+	}
+	if !f.StartTime.IsZero() {
+		conds = append(conds, "`created_at` >= ?")
+		args = append(args, f.StartTime)
+	}
+	if !f.EndTime.IsZero() {
+		conds = append(conds, "`created_at` < ?")
+		args = append(args, f.EndTime)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " where " + strings.Join(conds, " and "), args
+}
@@ -16,6 +16,7 @@ type (
 		conversationsModel
 
 		FindAllByUser(ctx context.Context, userId string) ([]*Conversations, error)
+		FindAllByWorkspace(ctx context.Context, workspaceId int64) ([]*Conversations, error)
 
 		withSession(session sqlx.Session) ConversationsModel
 	}
@@ -36,9 +37,18 @@ func (m *customConversationsModel) withSession(session sqlx.Session) Conversatio
 	return NewConversationsModel(sqlx.NewSqlConnFromSession(session))
 }
 
+// FindAllByUser 查询用户个人空间中的会话，不包含其在团队空间中创建的会话
 func (m *defaultConversationsModel) FindAllByUser(ctx context.Context, userId string) ([]*Conversations, error) {
-	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ?", conversationsRows, m.table)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ? AND `workspace_id` = 0", conversationsRows, m.table)
 	var resp []*Conversations
 	err := m.conn.QueryRowsCtx(ctx, &resp, query, userId)
 	return resp, err
 }
+
+// FindAllByWorkspace 查询团队空间中全部成员创建的会话
+func (m *defaultConversationsModel) FindAllByWorkspace(ctx context.Context, workspaceId int64) ([]*Conversations, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `workspace_id` = ?", conversationsRows, m.table)
+	var resp []*Conversations
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, workspaceId)
+	return resp, err
+}
@@ -39,6 +39,7 @@ type (
 	Conversations struct {
 		ConversationId string         `db:"conversation_id"` // 会话ID (主键, ULID)
 		UserId         int64          `db:"user_id"`         // 关联的用户ID
+		WorkspaceId    int64          `db:"workspace_id"`    // 所属团队空间ID, 0 表示个人空间
 		Title          string         `db:"title"`           // 会话标题
 		Metadata       sql.NullString `db:"metadata"`        // 存储额外的数据，例如模型设置等
 		CreatedAt      time.Time      `db:"created_at"`      // 创建时间
@@ -74,14 +75,14 @@ func (m *defaultConversationsModel) FindOne(ctx context.Context, conversationId
 }
 
 func (m *defaultConversationsModel) Insert(ctx context.Context, data *Conversations) (sql.Result, error) {
-	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, conversationsRowsExpectAutoSet)
-	ret, err := m.conn.ExecCtx(ctx, query, data.ConversationId, data.UserId, data.Title, data.Metadata)
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, conversationsRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.ConversationId, data.UserId, data.WorkspaceId, data.Title, data.Metadata)
 	return ret, err
 }
 
 func (m *defaultConversationsModel) Update(ctx context.Context, data *Conversations) error {
 	query := fmt.Sprintf("update %s set %s where `conversation_id` = ?", m.table, conversationsRowsWithPlaceHolder)
-	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.Title, data.Metadata, data.ConversationId)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.WorkspaceId, data.Title, data.Metadata, data.ConversationId)
 	return err
 }
 
@@ -0,0 +1,184 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ DocNumbersModel = (*customDocNumbersModel)(nil)
+
+// 发文字号状态
+const (
+	DocNoReserved = "reserved" // 已为文档预留，可以释放
+	DocNoIssued   = "issued"   // 已正式使用，不能释放
+	DocNoReleased = "released" // 已释放，优先分配给下一份文档
+)
+
+type (
+	// DocNumbersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDocNumbersModel.
+	DocNumbersModel interface {
+		docNumbersModel
+		Reserve(ctx context.Context, data *DocNumbers, docNo func(seq int64) string) error
+		FindActiveByMessageId(ctx context.Context, messageId string) (*DocNumbers, error)
+		UpdateStatus(ctx context.Context, id int64, from, to string, userId int64) (bool, error)
+		ReleaseByMessageId(ctx context.Context, messageId string, userId int64) error
+		FindList(ctx context.Context, filter DocNumberFilter, offset, limit int64) ([]*DocNumbers, error)
+		Count(ctx context.Context, filter DocNumberFilter) (int64, error)
+	}
+
+	customDocNumbersModel struct {
+		*defaultDocNumbersModel
+	}
+
+	// DocNumberFilter 发文字号查询条件，WorkspaceId 必填，其他零值字段表示不过滤
+	DocNumberFilter struct {
+		WorkspaceId int64
+		Prefix      string
+		Year        int64
+		Status      string
+	}
+)
+
+// NewDocNumbersModel returns a model for the database table.
+func NewDocNumbersModel(conn sqlx.SqlConn) DocNumbersModel {
+	return &customDocNumbersModel{
+		defaultDocNumbersModel: newDocNumbersModel(conn),
+	}
+}
+
+// Reserve 在事务中为文档预留 data.WorkspaceId、Prefix、Year 下的一个发文字号，docNo 按序号生成字号：
+//  1. 文档已预留或使用了该前缀当年的字号时直接返回该字号，重复导出不会重复占号
+//  2. 否则优先重新分配序号最小的已释放字号，避免断号
+//  3. 没有已释放的字号时将序号加一，序号行被锁定到事务结束，并发分配不会重复
+//
+// 成功后 data 为预留的字号
+func (m *customDocNumbersModel) Reserve(ctx context.Context, data *DocNumbers, docNo func(seq int64) string) error {
+	return m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var existing DocNumbers
+		query := fmt.Sprintf("select %s from %s where `message_id` = ? and `workspace_id` = ? and `prefix` = ? and `year` = ? "+
+			"and `status` in (?, ?) order by `id` desc limit 1 for update", docNumbersRows, m.table)
+		err := session.QueryRowCtx(ctx, &existing, query, data.MessageId, data.WorkspaceId, data.Prefix, data.Year, DocNoReserved, DocNoIssued)
+		if err == nil {
+			*data = existing
+			return nil
+		}
+		if err != sqlx.ErrNotFound {
+			return err
+		}
+
+		data.Status = DocNoReserved
+		var released DocNumbers
+		query = fmt.Sprintf("select %s from %s where `workspace_id` = ? and `prefix` = ? and `year` = ? and `status` = ? "+
+			"order by `seq` asc limit 1 for update", docNumbersRows, m.table)
+		err = session.QueryRowCtx(ctx, &released, query, data.WorkspaceId, data.Prefix, data.Year, DocNoReleased)
+		switch err {
+		case nil:
+			data.Id, data.Seq, data.DocNo, data.ReleasedAt = released.Id, released.Seq, released.DocNo, released.ReleasedAt
+			data.CreatedAt = released.CreatedAt
+			query = fmt.Sprintf("update %s set `status` = ?, `message_id` = ?, `conversation_id` = ?, `user_id` = ? where `id` = ?", m.table)
+			_, err = session.ExecCtx(ctx, query, data.Status, data.MessageId, data.ConversationId, data.UserId, data.Id)
+			return err
+		case sqlx.ErrNotFound:
+		default:
+			return err
+		}
+
+		if _, err := session.ExecCtx(ctx, "insert into `doc_number_sequences` (`workspace_id`, `prefix`, `year`, `last_seq`) values (?, ?, ?, 1) "+
+			"on duplicate key update `last_seq` = `last_seq` + 1", data.WorkspaceId, data.Prefix, data.Year); err != nil {
+			return err
+		}
+		if err := session.QueryRowCtx(ctx, &data.Seq, "select `last_seq` from `doc_number_sequences` where `workspace_id` = ? and `prefix` = ? and `year` = ?",
+			data.WorkspaceId, data.Prefix, data.Year); err != nil {
+			return err
+		}
+		data.DocNo = docNo(data.Seq)
+		query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, docNumbersRowsExpectAutoSet)
+		ret, err := session.ExecCtx(ctx, query, data.WorkspaceId, data.Prefix, data.Year, data.Seq, data.DocNo, data.Status,
+			data.MessageId, data.ConversationId, data.UserId, data.IssuedAt, data.ReleasedAt)
+		if err != nil {
+			return err
+		}
+		data.Id, err = ret.LastInsertId()
+		return err
+	})
+}
+
+// FindActiveByMessageId 查询文档最近预留或使用的发文字号
+func (m *customDocNumbersModel) FindActiveByMessageId(ctx context.Context, messageId string) (*DocNumbers, error) {
+	query := fmt.Sprintf("select %s from %s where `message_id` = ? and `status` in (?, ?) order by `id` desc limit 1", docNumbersRows, m.table)
+	var resp DocNumbers
+	err := m.conn.QueryRowCtx(ctx, &resp, query, messageId, DocNoReserved, DocNoIssued)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// UpdateStatus 仅当字号处于 from 状态时改为 to 状态，并记录使用或释放的时间，返回是否已更新
+func (m *customDocNumbersModel) UpdateStatus(ctx context.Context, id int64, from, to string, userId int64) (bool, error) {
+	set := "`status` = ?, `user_id` = ?"
+	switch to {
+	case DocNoIssued:
+		set += ", `issued_at` = now()"
+	case DocNoReleased:
+		set += ", `released_at` = now()"
+	}
+	query := fmt.Sprintf("update %s set %s where `id` = ? and `status` = ?", m.table, set)
+	ret, err := m.conn.ExecCtx(ctx, query, to, userId, id, from)
+	if err != nil {
+		return false, err
+	}
+	n, err := ret.RowsAffected()
+	return n > 0, err
+}
+
+// ReleaseByMessageId 释放文档预留的全部发文字号，已正式使用的不受影响
+func (m *customDocNumbersModel) ReleaseByMessageId(ctx context.Context, messageId string, userId int64) error {
+	query := fmt.Sprintf("update %s set `status` = ?, `user_id` = ?, `released_at` = now() where `message_id` = ? and `status` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, DocNoReleased, userId, messageId, DocNoReserved)
+	return err
+}
+
+// FindList 按年份、序号倒序查询发文字号
+func (m *customDocNumbersModel) FindList(ctx context.Context, filter DocNumberFilter, offset, limit int64) ([]*DocNumbers, error) {
+	where, args := filter.where()
+	query := fmt.Sprintf("select %s from %s%s order by `year` desc, `prefix` asc, `seq` desc limit ?, ?", docNumbersRows, m.table, where)
+	args = append(args, offset, limit)
+	var resp []*DocNumbers
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+func (m *customDocNumbersModel) Count(ctx context.Context, filter DocNumberFilter) (int64, error) {
+	where, args := filter.where()
+	query := fmt.Sprintf("select count(*) from %s%s", m.table, where)
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, query, args...)
+	return total, err
+}
+
+func (f DocNumberFilter) where() (string, []any) {
+	conds := []string{"`workspace_id` = ?"}
+	args := []any{f.WorkspaceId}
+	if f.Prefix != "" {
+		conds = append(conds, "`prefix` = ?")
+		args = append(args, f.Prefix)
+	}
+	if f.Year > 0 {
+		conds = append(conds, "`year` = ?")
+		args = append(args, f.Year)
+	}
+	if f.Status != "" {
+		conds = append(conds, "`status` = ?")
+		args = append(args, f.Status)
+	}
+	return " where " + strings.Join(conds, " and "), args
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ DocumentApprovalsModel = (*customDocumentApprovalsModel)(nil)
+
+// ErrVersionConflict 审批记录已被其他请求修改
+var ErrVersionConflict = errors.New("approval version conflict")
+
+var documentApprovalHistoryRows = strings.Join(builder.RawFieldNames(&DocumentApprovalHistory{}), ",")
+
+type (
+	// DocumentApprovalsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDocumentApprovalsModel.
+	DocumentApprovalsModel interface {
+		documentApprovalsModel
+		Transition(ctx context.Context, data *DocumentApprovals, history *DocumentApprovalHistory) error
+		FindHistory(ctx context.Context, approvalId int64) ([]*DocumentApprovalHistory, error)
+		FindByAssignee(ctx context.Context, assigneeId int64, limit int64) ([]*DocumentApprovals, error)
+	}
+
+	customDocumentApprovalsModel struct {
+		*defaultDocumentApprovalsModel
+	}
+
+	// DocumentApprovalHistory 审批流转记录（document_approval_history 表）
+	DocumentApprovalHistory struct {
+		Id          int64     `db:"id"`
+		ApprovalId  int64     `db:"approval_id"`
+		MessageId   string    `db:"message_id"`
+		Action      string    `db:"action"`
+		FromState   string    `db:"from_state"`
+		ToState     string    `db:"to_state"`
+		UserId      int64     `db:"user_id"`
+		UserName    string    `db:"user_name"` // 执行时的用户昵称
+		AssigneeId  int64     `db:"assignee_id"`
+		Comment     string    `db:"comment"`
+		ContentHash string    `db:"content_hash"`
+		CreatedAt   time.Time `db:"created_at"`
+	}
+)
+
+// NewDocumentApprovalsModel returns a model for the database table.
+func NewDocumentApprovalsModel(conn sqlx.SqlConn) DocumentApprovalsModel {
+	return &customDocumentApprovalsModel{
+		defaultDocumentApprovalsModel: newDocumentApprovalsModel(conn),
+	}
+}
+
+// Transition 在事务中保存流转后的审批状态并记录流转。data.Id 为 0 时新建审批记录；
+// 否则仅当记录的 version 仍为 data.Version 时更新并将 version 加一，已被其他请求修改时返回 ErrVersionConflict。
+// 成功后 data 的 Id、Version 与 history.ApprovalId 为保存后的值
+func (m *customDocumentApprovalsModel) Transition(ctx context.Context, data *DocumentApprovals, history *DocumentApprovalHistory) error {
+	return m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if data.Id == 0 {
+			// 同一文档并发进入审批流程时只有一个请求插入成功
+			data.Version = 1
+			query := fmt.Sprintf("insert ignore into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, documentApprovalsRowsExpectAutoSet)
+			ret, err := session.ExecCtx(ctx, query, data.MessageId, data.ConversationId, data.State, data.AssigneeId, data.SubmitterId, data.ContentHash, data.Version)
+			if err != nil {
+				return err
+			}
+			if n, err := ret.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return ErrVersionConflict
+			}
+			if data.Id, err = ret.LastInsertId(); err != nil {
+				return err
+			}
+		} else {
+			query := fmt.Sprintf("update %s set `state` = ?, `assignee_id` = ?, `submitter_id` = ?, `content_hash` = ?, `version` = `version` + 1 "+
+				"where `id` = ? and `version` = ?", m.table)
+			ret, err := session.ExecCtx(ctx, query, data.State, data.AssigneeId, data.SubmitterId, data.ContentHash, data.Id, data.Version)
+			if err != nil {
+				return err
+			}
+			if n, err := ret.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return ErrVersionConflict
+			}
+			data.Version++
+		}
+
+		history.ApprovalId = data.Id
+		_, err := session.ExecCtx(ctx, "insert into `document_approval_history` (`approval_id`, `message_id`, `action`, `from_state`, `to_state`, "+
+			"`user_id`, `user_name`, `assignee_id`, `comment`, `content_hash`) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			history.ApprovalId, history.MessageId, history.Action, history.FromState, history.ToState,
+			history.UserId, history.UserName, history.AssigneeId, history.Comment, history.ContentHash)
+		return err
+	})
+}
+
+// FindHistory 查询审批的流转记录，按流转顺序
+func (m *customDocumentApprovalsModel) FindHistory(ctx context.Context, approvalId int64) ([]*DocumentApprovalHistory, error) {
+	query := fmt.Sprintf("select %s from `document_approval_history` where `approval_id` = ? order by `id` asc", documentApprovalHistoryRows)
+	var resp []*DocumentApprovalHistory
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, approvalId)
+	return resp, err
+}
+
+// FindByAssignee 查询等待用户审批的文档，最近流转的在前
+func (m *customDocumentApprovalsModel) FindByAssignee(ctx context.Context, assigneeId int64, limit int64) ([]*DocumentApprovals, error) {
+	query := fmt.Sprintf("select %s from %s where `assignee_id` = ? order by `updated_at` desc limit ?", documentApprovalsRows, m.table)
+	var resp []*DocumentApprovals
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, assigneeId, limit)
+	return resp, err
+}
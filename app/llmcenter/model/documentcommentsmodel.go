@@ -0,0 +1,134 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ DocumentCommentsModel = (*customDocumentCommentsModel)(nil)
+
+type (
+	// DocumentCommentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDocumentCommentsModel.
+	DocumentCommentsModel interface {
+		documentCommentsModel
+		InsertWithMentions(ctx context.Context, data *DocumentComments, mentionUserIds []int64) (int64, error)
+		FindByMessageId(ctx context.Context, messageId string) ([]*DocumentComments, error)
+		FindMentions(ctx context.Context, commentIds []int64) ([]*DocumentCommentMention, error)
+		FindMentioned(ctx context.Context, userId int64, openOnly bool, limit int64) ([]*MentionedComment, error)
+		UpdateAnchor(ctx context.Context, data *DocumentComments) error
+		UpdateStatus(ctx context.Context, id int64, status string, resolvedBy int64) error
+		withSession(session sqlx.Session) DocumentCommentsModel
+	}
+
+	customDocumentCommentsModel struct {
+		*defaultDocumentCommentsModel
+	}
+
+	// DocumentCommentMention 批注中提及的用户（document_comment_mentions 表）
+	DocumentCommentMention struct {
+		CommentId int64 `db:"comment_id"`
+		UserId    int64 `db:"user_id"`
+	}
+
+	// MentionedComment 提及某用户的批注及其所属批注的状态
+	MentionedComment struct {
+		DocumentComments
+		ThreadStatus string `db:"thread_status"`
+	}
+)
+
+// NewDocumentCommentsModel returns a model for the database table.
+func NewDocumentCommentsModel(conn sqlx.SqlConn) DocumentCommentsModel {
+	return &customDocumentCommentsModel{
+		defaultDocumentCommentsModel: newDocumentCommentsModel(conn),
+	}
+}
+
+func (m *customDocumentCommentsModel) withSession(session sqlx.Session) DocumentCommentsModel {
+	return NewDocumentCommentsModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// InsertWithMentions 在事务中保存批注及其提及的用户，返回批注ID
+func (m *customDocumentCommentsModel) InsertWithMentions(ctx context.Context, data *DocumentComments, mentionUserIds []int64) (int64, error) {
+	var id int64
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		ret, err := m.withSession(session).Insert(ctx, data)
+		if err != nil {
+			return err
+		}
+		if id, err = ret.LastInsertId(); err != nil {
+			return err
+		}
+		for _, userId := range mentionUserIds {
+			if _, err := session.ExecCtx(ctx, "insert into `document_comment_mentions` (`comment_id`, `message_id`, `user_id`) values (?, ?, ?)",
+				id, data.MessageId, userId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return id, err
+}
+
+// FindByMessageId 查询文档的全部批注与回复，按发表顺序
+func (m *customDocumentCommentsModel) FindByMessageId(ctx context.Context, messageId string) ([]*DocumentComments, error) {
+	query := fmt.Sprintf("select %s from %s where `message_id` = ? order by `id` asc", documentCommentsRows, m.table)
+	var resp []*DocumentComments
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, messageId)
+	return resp, err
+}
+
+// FindMentions 查询批注中提及的用户
+func (m *customDocumentCommentsModel) FindMentions(ctx context.Context, commentIds []int64) ([]*DocumentCommentMention, error) {
+	if len(commentIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(commentIds))
+	for _, id := range commentIds {
+		args = append(args, id)
+	}
+	query := "select `comment_id`, `user_id` from `document_comment_mentions` where `comment_id` in (" +
+		strings.TrimSuffix(strings.Repeat("?,", len(commentIds)), ",") + ") order by `id` asc"
+	var resp []*DocumentCommentMention
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// FindMentioned 查询提及用户的批注，按提及时间倒序；openOnly 时只返回所属批注未解决的
+func (m *customDocumentCommentsModel) FindMentioned(ctx context.Context, userId int64, openOnly bool, limit int64) ([]*MentionedComment, error) {
+	columns := make([]string, 0, len(documentCommentsFieldNames))
+	for _, name := range documentCommentsFieldNames {
+		columns = append(columns, "c."+name)
+	}
+	query := fmt.Sprintf("select %s, t.`status` as `thread_status` from `document_comment_mentions` cm "+
+		"join %s c on c.`id` = cm.`comment_id` "+
+		"join %s t on t.`id` = if(c.`parent_id` = 0, c.`id`, c.`parent_id`) "+
+		"where cm.`user_id` = ?", strings.Join(columns, ","), m.table, m.table)
+	if openOnly {
+		query += " and t.`status` = 'open'"
+	}
+	query += " order by cm.`id` desc limit ?"
+	var resp []*MentionedComment
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, userId, limit)
+	return resp, err
+}
+
+// UpdateAnchor 保存重新定位后的锚点
+func (m *customDocumentCommentsModel) UpdateAnchor(ctx context.Context, data *DocumentComments) error {
+	query := fmt.Sprintf("update %s set `anchor_text` = ?, `anchor_prefix` = ?, `anchor_suffix` = ?, `anchor_start` = ?, `anchor_end` = ?, "+
+		"`anchor_status` = ?, `anchor_hash` = ? where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, data.AnchorText, data.AnchorPrefix, data.AnchorSuffix, data.AnchorStart, data.AnchorEnd,
+		data.AnchorStatus, data.AnchorHash, data.Id)
+	return err
+}
+
+// UpdateStatus 标记批注已解决或重新打开，重新打开时清空解决人与时间
+func (m *customDocumentCommentsModel) UpdateStatus(ctx context.Context, id int64, status string, resolvedBy int64) error {
+	query := fmt.Sprintf("update %s set `status` = ?, `resolved_by` = ?, `resolved_at` = if(? > 0, NOW(), NULL) where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, status, resolvedBy, resolvedBy, id)
+	return err
+}
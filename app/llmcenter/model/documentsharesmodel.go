@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ DocumentSharesModel = (*customDocumentSharesModel)(nil)
+
+type (
+	// DocumentSharesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDocumentSharesModel.
+	DocumentSharesModel interface {
+		documentSharesModel
+		FindByMessageId(ctx context.Context, messageId string) ([]*DocumentShares, error)
+		Revoke(ctx context.Context, id int64) error
+		IncrViewCount(ctx context.Context, id int64) error
+		IncrDownloadCount(ctx context.Context, id int64) error
+		withSession(session sqlx.Session) DocumentSharesModel
+	}
+
+	customDocumentSharesModel struct {
+		*defaultDocumentSharesModel
+	}
+)
+
+// NewDocumentSharesModel returns a model for the database table.
+func NewDocumentSharesModel(conn sqlx.SqlConn) DocumentSharesModel {
+	return &customDocumentSharesModel{
+		defaultDocumentSharesModel: newDocumentSharesModel(conn),
+	}
+}
+
+func (m *customDocumentSharesModel) withSession(session sqlx.Session) DocumentSharesModel {
+	return NewDocumentSharesModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindByMessageId 查询文档的全部分享链接，按创建时间倒序
+func (m *customDocumentSharesModel) FindByMessageId(ctx context.Context, messageId string) ([]*DocumentShares, error) {
+	query := fmt.Sprintf("select %s from %s where `message_id` = ? order by `id` desc", documentSharesRows, m.table)
+	var resp []*DocumentShares
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, messageId)
+	return resp, err
+}
+
+// Revoke 撤销分享链接，已撤销的保留原撤销时间
+func (m *customDocumentSharesModel) Revoke(ctx context.Context, id int64) error {
+	query := fmt.Sprintf("update %s set `revoked_at` = NOW() where `id` = ? and `revoked_at` is null", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, id)
+	return err
+}
+
+// IncrViewCount 查看次数加一
+func (m *customDocumentSharesModel) IncrViewCount(ctx context.Context, id int64) error {
+	query := fmt.Sprintf("update %s set `view_count` = `view_count` + 1 where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, id)
+	return err
+}
+
+// IncrDownloadCount 下载次数加一
+func (m *customDocumentSharesModel) IncrDownloadCount(ctx context.Context, id int64) error {
+	query := fmt.Sprintf("update %s set `download_count` = `download_count` + 1 where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, id)
+	return err
+}
@@ -13,9 +13,10 @@ type (
 	// and implement the added methods in customDocumentsModel.
 	DocumentsModel interface {
 		documentsModel
-		InsertDocument(ctx context.Context, messageID, conversationID, content string) error
+		InsertDocument(ctx context.Context, messageID, conversationID, content, provider, model string) error
 		FindByConversationId(ctx context.Context, conversationId string) ([]*Documents, error)
 		UpdateContent(ctx context.Context, messageID, content string) error
+		SwapContent(ctx context.Context, messageID, oldHash, content string) (bool, error)
 		withSession(session sqlx.Session) DocumentsModel
 	}
 
@@ -35,9 +36,10 @@ func (m *customDocumentsModel) withSession(session sqlx.Session) DocumentsModel
 	return NewDocumentsModel(sqlx.NewSqlConnFromSession(session))
 }
 
-func (m *customDocumentsModel) InsertDocument(ctx context.Context, messageID, conversationID, content string) error {
-	query := fmt.Sprintf("INSERT INTO %s (`message_id`, `conversation_id`, `content`) VALUES (?, ?, ?)", m.table)
-	_, err := m.conn.ExecCtx(ctx, query, messageID, conversationID, content)
+// InsertDocument 保存最终文档，并记录生成该文档的大模型提供方与模型
+func (m *customDocumentsModel) InsertDocument(ctx context.Context, messageID, conversationID, content, provider, model string) error {
+	query := fmt.Sprintf("INSERT INTO %s (`message_id`, `conversation_id`, `content`, `provider`, `model`) VALUES (?, ?, ?, ?, ?)", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, messageID, conversationID, content, provider, model)
 	return err
 }
 
@@ -54,3 +56,15 @@ func (m *defaultDocumentsModel) UpdateContent(ctx context.Context, messageID, co
 	_, err := m.conn.ExecCtx(ctx, query, content, messageID)
 	return err
 }
+
+// SwapContent 仅当文档当前内容的 SHA-256（十六进制）为 oldHash 时更新内容，返回是否已更新。
+// 按哈希比较，避免排序规则忽略大小写与尾部空格导致误判内容未变
+func (m *defaultDocumentsModel) SwapContent(ctx context.Context, messageID, oldHash, content string) (bool, error) {
+	query := "UPDATE documents SET content = ? WHERE message_id = ? AND SHA2(content, 256) = ?"
+	ret, err := m.conn.ExecCtx(ctx, query, content, messageID, oldHash)
+	if err != nil {
+		return false, err
+	}
+	n, err := ret.RowsAffected()
+	return n > 0, err
+}
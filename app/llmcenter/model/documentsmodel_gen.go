@@ -40,6 +40,8 @@ type (
 		MessageId      string    `db:"message_id"`      // 消息ID (主键, ULID)
 		ConversationId string    `db:"conversation_id"` // 关联的会话ID (外键)
 		Content        string    `db:"content"`         // 文章
+		Provider       string    `db:"provider"`        // 生成该文章的大模型提供方
+		Model          string    `db:"model"`           // 生成该文章的模型或工作流
 		CreatedAt      time.Time `db:"created_at"`      // 消息创建时间
 	}
 )
@@ -72,14 +74,14 @@ func (m *defaultDocumentsModel) FindOne(ctx context.Context, messageId string) (
 }
 
 func (m *defaultDocumentsModel) Insert(ctx context.Context, data *Documents) (sql.Result, error) {
-	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, documentsRowsExpectAutoSet)
-	ret, err := m.conn.ExecCtx(ctx, query, data.MessageId, data.ConversationId, data.Content)
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, documentsRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.MessageId, data.ConversationId, data.Content, data.Provider, data.Model)
 	return ret, err
 }
 
 func (m *defaultDocumentsModel) Update(ctx context.Context, data *Documents) error {
 	query := fmt.Sprintf("update %s set %s where `message_id` = ?", m.table, documentsRowsWithPlaceHolder)
-	_, err := m.conn.ExecCtx(ctx, query, data.ConversationId, data.Content, data.MessageId)
+	_, err := m.conn.ExecCtx(ctx, query, data.ConversationId, data.Content, data.Provider, data.Model, data.MessageId)
 	return err
 }
 
@@ -10,9 +10,11 @@ import (
 var _ FilesModel = (*customFilesModel)(nil)
 
 type File struct {
-	Filename   string    `db:"filename"`
-	StoredName string    `db:"stored_name"`
-	CreatedAt  time.Time `db:"created_at"`
+	Filename    string    `db:"filename"`
+	StoredName  string    `db:"stored_name"`
+	UserId      int64     `db:"user_id"`
+	WorkspaceId int64     `db:"workspace_id"`
+	CreatedAt   time.Time `db:"created_at"`
 }
 
 type (
@@ -20,9 +22,10 @@ type (
 	// and implement the added methods in customFilesModel.
 	FilesModel interface {
 		filesModel
-		InsertFile(ctx context.Context, filename, storedName string) error
+		InsertFile(ctx context.Context, filename, storedName string, userId, workspaceId int64) error
 		FindByStoredName(ctx context.Context, storedName string) (*File, error)
 		DeleteByStoredName(ctx context.Context, storedName string) error
+		ListAfter(ctx context.Context, afterStoredName string, limit int) ([]*File, error)
 		withSession(session sqlx.Session) FilesModel
 	}
 
@@ -42,14 +45,14 @@ func (m *customFilesModel) withSession(session sqlx.Session) FilesModel {
 	return NewFilesModel(sqlx.NewSqlConnFromSession(session))
 }
 
-func (m *customFilesModel) InsertFile(ctx context.Context, filename, storedName string) error {
-	query := fmt.Sprintf("INSERT INTO %s (`filename`, `stored_name`) VALUES (?, ?)", m.table)
-	_, err := m.conn.ExecCtx(ctx, query, filename, storedName)
+func (m *customFilesModel) InsertFile(ctx context.Context, filename, storedName string, userId, workspaceId int64) error {
+	query := fmt.Sprintf("INSERT INTO %s (`filename`, `stored_name`, `user_id`, `workspace_id`) VALUES (?, ?, ?, ?)", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, filename, storedName, userId, workspaceId)
 	return err
 }
 
 func (m *defaultFilesModel) FindByStoredName(ctx context.Context, storedName string) (*File, error) {
-	query := "SELECT filename, stored_name, created_at FROM files WHERE stored_name = ? LIMIT 1"
+	query := "SELECT filename, stored_name, user_id, workspace_id, created_at FROM files WHERE stored_name = ? LIMIT 1"
 	var file File
 	err := m.conn.QueryRowCtx(ctx, &file, query, storedName)
 	if err != nil {
@@ -63,3 +66,11 @@ func (m *defaultFilesModel) DeleteByStoredName(ctx context.Context, storedName s
 	_, err := m.conn.ExecCtx(ctx, query, storedName)
 	return err
 }
+
+// ListAfter 按 stored_name 顺序分页遍历文件记录，afterStoredName 为上一页最后一条的 stored_name
+func (m *defaultFilesModel) ListAfter(ctx context.Context, afterStoredName string, limit int) ([]*File, error) {
+	query := "SELECT filename, stored_name, user_id, workspace_id, created_at FROM files WHERE stored_name > ? ORDER BY stored_name LIMIT ?"
+	var files []*File
+	err := m.conn.QueryRowsCtx(ctx, &files, query, afterStoredName, limit)
+	return files, err
+}
@@ -37,9 +37,11 @@ type (
 	}
 
 	Files struct {
-		Filename   string    `db:"filename"`    // 用户上传的原始文件名
-		StoredName string    `db:"stored_name"` // 服务器保存的唯一文件名
-		CreatedAt  time.Time `db:"created_at"`  // 上传时间
+		Filename    string    `db:"filename"`     // 用户上传的原始文件名
+		StoredName  string    `db:"stored_name"`  // 服务器保存的唯一文件名
+		UserId      int64     `db:"user_id"`      // 上传者用户ID, 0 表示早期未记录上传者的文件 (个人空间中不可读)
+		WorkspaceId int64     `db:"workspace_id"` // 所属团队空间ID, 0 表示个人空间
+		CreatedAt   time.Time `db:"created_at"`   // 上传时间
 	}
 )
 
@@ -71,14 +73,14 @@ func (m *defaultFilesModel) FindOne(ctx context.Context, storedName string) (*Fi
 }
 
 func (m *defaultFilesModel) Insert(ctx context.Context, data *Files) (sql.Result, error) {
-	query := fmt.Sprintf("insert into %s (%s) values (?, ?)", m.table, filesRowsExpectAutoSet)
-	ret, err := m.conn.ExecCtx(ctx, query, data.Filename, data.StoredName)
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, filesRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.Filename, data.StoredName, data.UserId, data.WorkspaceId)
 	return ret, err
 }
 
 func (m *defaultFilesModel) Update(ctx context.Context, data *Files) error {
 	query := fmt.Sprintf("update %s set %s where `stored_name` = ?", m.table, filesRowsWithPlaceHolder)
-	_, err := m.conn.ExecCtx(ctx, query, data.Filename, data.StoredName)
+	_, err := m.conn.ExecCtx(ctx, query, data.Filename, data.UserId, data.WorkspaceId, data.StoredName)
 	return err
 }
 
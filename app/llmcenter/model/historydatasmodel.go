@@ -2,18 +2,28 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 var _ HistorydatasModel = (*customHistorydatasModel)(nil)
 
+// HistoryMetadata 历史数据中的引用信息
+type HistoryMetadata struct {
+	MessageId string         `db:"message_id"`
+	Metadata  sql.NullString `db:"metadata"`
+}
+
 type (
 	// HistorydatasModel is an interface to be customized, add more methods here,
 	// and implement the added methods in customHistorydatasModel.
 	HistorydatasModel interface {
 		historydatasModel
 		FindByConversationId(ctx context.Context, conversationId string) ([]*Historydatas, error)
+		ListMetadataSince(ctx context.Context, since time.Time, afterMessageId string, limit int) ([]*HistoryMetadata, error)
 		withSession(session sqlx.Session) HistorydatasModel
 	}
 
@@ -40,3 +50,12 @@ func (m *defaultHistorydatasModel) FindByConversationId(ctx context.Context, con
 	err := m.conn.QueryRowsCtx(ctx, &resp, query, conversationId)
 	return resp, err
 }
+
+// ListMetadataSince 分页查询 since 之后创建且带有引用信息的历史数据，message_id 为 ULID，按其排序即按创建时间排序
+func (m *defaultHistorydatasModel) ListMetadataSince(ctx context.Context, since time.Time, afterMessageId string, limit int) ([]*HistoryMetadata, error) {
+	query := fmt.Sprintf("SELECT message_id, metadata FROM %s WHERE created_at >= ? AND message_id > ? AND metadata IS NOT NULL ORDER BY message_id LIMIT ?", m.table)
+
+	var resp []*HistoryMetadata
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, since, afterMessageId, limit)
+	return resp, err
+}
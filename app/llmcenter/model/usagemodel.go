@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// usage 表按 (user_id, usage_date, call_type) 聚合，每次调用以 upsert 方式累加，因此这里手写模型。
+
+var (
+	usageFieldNames = builder.RawFieldNames(&Usage{})
+	usageRows       = strings.Join(usageFieldNames, ",")
+)
+
+var _ UsageModel = (*defaultUsageModel)(nil)
+
+type (
+	// UsageModel 大模型调用用量表
+	UsageModel interface {
+		Add(ctx context.Context, data *Usage) error
+		FindByUser(ctx context.Context, userId int64, start, end time.Time) ([]*Usage, error)
+		SumByUser(ctx context.Context, userId int64, start, end time.Time) (*UsageTotal, error)
+		withSession(session sqlx.Session) UsageModel
+	}
+
+	defaultUsageModel struct {
+		conn  sqlx.SqlConn
+		table string
+	}
+
+	Usage struct {
+		Id            int64     `db:"id"`             // 自增主键
+		UserId        int64     `db:"user_id"`        // 用户ID
+		UsageDate     time.Time `db:"usage_date"`     // 统计日期
+		CallType      string    `db:"call_type"`      // 调用类型: generate | resume | edit
+		RequestCount  int64     `db:"request_count"`  // 调用次数
+		SuccessCount  int64     `db:"success_count"`  // 成功次数
+		FailureCount  int64     `db:"failure_count"`  // 失败次数
+		PromptChars   int64     `db:"prompt_chars"`   // 提示词字符数
+		ResponseChars int64     `db:"response_chars"` // 模型输出字符数
+		LatencyMs     int64     `db:"latency_ms"`     // 累计耗时 (毫秒)
+		CreatedAt     time.Time `db:"created_at"`     // 创建时间
+		UpdatedAt     time.Time `db:"updated_at"`     // 最后更新时间
+	}
+
+	// UsageTotal 一段时间内的用量合计，Chars 为提示词与输出字符数之和
+	UsageTotal struct {
+		RequestCount int64 `db:"request_count"`
+		Chars        int64 `db:"chars"`
+	}
+)
+
+// NewUsageModel returns a model for the database table.
+func NewUsageModel(conn sqlx.SqlConn) UsageModel {
+	return &defaultUsageModel{
+		conn:  conn,
+		table: "`usage`",
+	}
+}
+
+func (m *defaultUsageModel) withSession(session sqlx.Session) UsageModel {
+	return NewUsageModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// Add 将一次或多次调用的用量累加到当天的聚合记录上，记录不存在时创建
+func (m *defaultUsageModel) Add(ctx context.Context, data *Usage) error {
+	query := fmt.Sprintf("insert into %s (`user_id`, `usage_date`, `call_type`, `request_count`, `success_count`, `failure_count`, `prompt_chars`, `response_chars`, `latency_ms`) "+
+		"values (?, ?, ?, ?, ?, ?, ?, ?, ?) on duplicate key update "+
+		"`request_count` = `request_count` + values(`request_count`), "+
+		"`success_count` = `success_count` + values(`success_count`), "+
+		"`failure_count` = `failure_count` + values(`failure_count`), "+
+		"`prompt_chars` = `prompt_chars` + values(`prompt_chars`), "+
+		"`response_chars` = `response_chars` + values(`response_chars`), "+
+		"`latency_ms` = `latency_ms` + values(`latency_ms`)", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.UsageDate.Format(time.DateOnly), data.CallType,
+		data.RequestCount, data.SuccessCount, data.FailureCount, data.PromptChars, data.ResponseChars, data.LatencyMs)
+	return err
+}
+
+// FindByUser 按日期升序查询用户在 [start, end) 内的用量记录
+func (m *defaultUsageModel) FindByUser(ctx context.Context, userId int64, start, end time.Time) ([]*Usage, error) {
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `usage_date` >= ? and `usage_date` < ? order by `usage_date`, `call_type`", usageRows, m.table)
+	var resp []*Usage
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, userId, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	return resp, err
+}
+
+// SumByUser 统计用户在 [start, end) 内的调用次数与字符数
+func (m *defaultUsageModel) SumByUser(ctx context.Context, userId int64, start, end time.Time) (*UsageTotal, error) {
+	query := fmt.Sprintf("select coalesce(sum(`request_count`), 0) as `request_count`, coalesce(sum(`prompt_chars` + `response_chars`), 0) as `chars` "+
+		"from %s where `user_id` = ? and `usage_date` >= ? and `usage_date` < ?", m.table)
+	var resp UsageTotal
+	err := m.conn.QueryRowCtx(ctx, &resp, query, userId, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	return &resp, err
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 配额对象类型
+const (
+	QuotaSubjectUser = "user"
+	QuotaSubjectRole = "role"
+)
+
+var _ UsageQuotaModel = (*customUsageQuotaModel)(nil)
+
+type (
+	// UsageQuotaModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUsageQuotaModel.
+	UsageQuotaModel interface {
+		usageQuotaModel
+		FindAll(ctx context.Context) ([]*UsageQuota, error)
+		FindBySubjects(ctx context.Context, subjectType string, subjects []string) ([]*UsageQuota, error)
+		withSession(session sqlx.Session) UsageQuotaModel
+	}
+
+	customUsageQuotaModel struct {
+		*defaultUsageQuotaModel
+	}
+)
+
+// NewUsageQuotaModel returns a model for the database table.
+func NewUsageQuotaModel(conn sqlx.SqlConn) UsageQuotaModel {
+	return &customUsageQuotaModel{
+		defaultUsageQuotaModel: newUsageQuotaModel(conn),
+	}
+}
+
+func (m *customUsageQuotaModel) withSession(session sqlx.Session) UsageQuotaModel {
+	return NewUsageQuotaModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindAll 查询全部配额，按对象类型与对象排序
+func (m *customUsageQuotaModel) FindAll(ctx context.Context) ([]*UsageQuota, error) {
+	query := fmt.Sprintf("select %s from %s order by `subject_type`, `subject`", usageQuotaRows, m.table)
+	var resp []*UsageQuota
+	err := m.conn.QueryRowsCtx(ctx, &resp, query)
+	return resp, err
+}
+
+// FindBySubjects 查询同一类型下多个对象的配额
+func (m *customUsageQuotaModel) FindBySubjects(ctx context.Context, subjectType string, subjects []string) ([]*UsageQuota, error) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(subjects)+1)
+	args = append(args, subjectType)
+	for _, s := range subjects {
+		args = append(args, s)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(subjects)), ",")
+	query := fmt.Sprintf("select %s from %s where `subject_type` = ? and `subject` in (%s)", usageQuotaRows, m.table, placeholders)
+	var resp []*UsageQuota
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
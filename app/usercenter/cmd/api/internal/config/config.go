@@ -1,6 +1,10 @@
 package config
 
 import (
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/health"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -10,5 +14,9 @@ type Config struct {
 	JwtAuth struct {
 		AccessSecret string
 	}
+	Redis             redis.RedisConf // 会话吊销列表
 	UsercenterRpcConf zrpc.RpcClientConf
+	LlmCenterRpcConf  zrpc.RpcClientConf // 校验头像文件的归属
+	HealthCheck       health.Config      `json:",optional"` // /readyz 依赖检查
+	ClientInfo        clientinfo.Config  `json:",optional"` // 客户端 IP 解析（可信反向代理）
 }
@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"net/http"
+
+	"document_agent/app/usercenter/cmd/api/internal/logic/admin"
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	xhttp "github.com/zeromicro/x/http"
+)
+
+// set user status
+func SetUserStatusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SetUserStatusReq
+		if err := httpx.Parse(r, &req); err != nil {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewSetUserStatusLogic(r.Context(), svcCtx)
+		resp, err := l.SetUserStatus(&req)
+		if err != nil {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, err)
+		} else {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, resp)
+		}
+	}
+}
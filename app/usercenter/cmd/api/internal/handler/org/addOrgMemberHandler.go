@@ -0,0 +1,31 @@
+package org
+
+import (
+	"net/http"
+
+	"document_agent/app/usercenter/cmd/api/internal/logic/org"
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	xhttp "github.com/zeromicro/x/http"
+)
+
+// add org member
+func AddOrgMemberHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AddOrgMemberReq
+		if err := httpx.Parse(r, &req); err != nil {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, err)
+			return
+		}
+
+		l := org.NewAddOrgMemberLogic(r.Context(), svcCtx)
+		resp, err := l.AddOrgMember(&req)
+		if err != nil {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, err)
+		} else {
+			xhttp.JsonBaseResponseCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package handler
 import (
 	"net/http"
 
+	admin "document_agent/app/usercenter/cmd/api/internal/handler/admin"
+	org "document_agent/app/usercenter/cmd/api/internal/handler/org"
 	user "document_agent/app/usercenter/cmd/api/internal/handler/user"
 	"document_agent/app/usercenter/cmd/api/internal/svc"
 
@@ -13,6 +15,83 @@ import (
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserManage},
+			[]rest.Route{
+				{
+					// list roles
+					Method:  http.MethodGet,
+					Path:    "/roles",
+					Handler: admin.ListRolesHandler(serverCtx),
+				},
+				{
+					// list users
+					Method:  http.MethodGet,
+					Path:    "/users",
+					Handler: admin.ListUsersHandler(serverCtx),
+				},
+				{
+					// assign roles
+					Method:  http.MethodPost,
+					Path:    "/users/roles",
+					Handler: admin.AssignRolesHandler(serverCtx),
+				},
+				{
+					// set user status
+					Method:  http.MethodPost,
+					Path:    "/users/status",
+					Handler: admin.SetUserStatusHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.JwtAuth.AccessSecret),
+		rest.WithPrefix("/usercenter/v1/admin"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// create org
+				Method:  http.MethodPost,
+				Path:    "/orgs",
+				Handler: org.CreateOrgHandler(serverCtx),
+			},
+			{
+				// list orgs
+				Method:  http.MethodGet,
+				Path:    "/orgs",
+				Handler: org.ListOrgsHandler(serverCtx),
+			},
+			{
+				// list org members
+				Method:  http.MethodGet,
+				Path:    "/orgs/members",
+				Handler: org.ListOrgMembersHandler(serverCtx),
+			},
+			{
+				// add org member
+				Method:  http.MethodPost,
+				Path:    "/orgs/members",
+				Handler: org.AddOrgMemberHandler(serverCtx),
+			},
+			{
+				// remove org member
+				Method:  http.MethodPost,
+				Path:    "/orgs/members/remove",
+				Handler: org.RemoveOrgMemberHandler(serverCtx),
+			},
+			{
+				// update org member role
+				Method:  http.MethodPost,
+				Path:    "/orgs/members/role",
+				Handler: org.UpdateOrgMemberHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.JwtAuth.AccessSecret),
+		rest.WithPrefix("/usercenter/v1"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -27,6 +106,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/user/register",
 				Handler: user.RegisterHandler(serverCtx),
 			},
+			{
+				// reset password
+				Method:  http.MethodPost,
+				Path:    "/user/password/reset",
+				Handler: user.ResetPasswordHandler(serverCtx),
+			},
+			{
+				// refresh token
+				Method:  http.MethodPost,
+				Path:    "/user/refresh",
+				Handler: user.RefreshHandler(serverCtx),
+			},
+			{
+				// send sms code
+				Method:  http.MethodPost,
+				Path:    "/user/sms/send",
+				Handler: user.SendSmsCodeHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/usercenter/v1"),
 	)
@@ -39,6 +136,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/user/detail",
 				Handler: user.DetailHandler(serverCtx),
 			},
+			{
+				// get profile
+				Method:  http.MethodGet,
+				Path:    "/user/profile",
+				Handler: user.GetProfileHandler(serverCtx),
+			},
+			{
+				// update profile
+				Method:  http.MethodPost,
+				Path:    "/user/profile",
+				Handler: user.UpdateProfileHandler(serverCtx),
+			},
+			{
+				// change password
+				Method:  http.MethodPost,
+				Path:    "/user/password",
+				Handler: user.ChangePasswordHandler(serverCtx),
+			},
+			{
+				// logout
+				Method:  http.MethodPost,
+				Path:    "/user/logout",
+				Handler: user.LogoutHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.JwtAuth.AccessSecret),
 		rest.WithPrefix("/usercenter/v1"),
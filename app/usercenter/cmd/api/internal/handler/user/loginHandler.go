@@ -6,6 +6,7 @@ import (
 	"document_agent/app/usercenter/cmd/api/internal/logic/user"
 	"document_agent/app/usercenter/cmd/api/internal/svc"
 	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/pkg/ratelimit"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
 	xhttp "github.com/zeromicro/x/http"
@@ -23,6 +24,7 @@ func LoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		l := user.NewLoginLogic(r.Context(), svcCtx)
 		resp, err := l.Login(&req)
 		if err != nil {
+			ratelimit.SetRetryAfter(w, err)
 			xhttp.JsonBaseResponseCtx(r.Context(), w, err)
 		} else {
 			xhttp.JsonBaseResponseCtx(r.Context(), w, resp)
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignRolesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// assign roles
+func NewAssignRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignRolesLogic {
+	return &AssignRolesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AssignRolesLogic) AssignRoles(req *types.AssignRolesReq) (*types.AssignRolesResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	_, err := l.svcCtx.UsercenterRpc.AssignRoles(l.ctx, &usercenter.AssignRolesReq{
+		OperatorId: operatorId,
+		UserId:     req.UserId,
+		Roles:      req.Roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AssignRolesResp{}, nil
+}
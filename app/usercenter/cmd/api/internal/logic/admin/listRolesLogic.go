@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRolesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// list roles
+func NewListRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRolesLogic {
+	return &ListRolesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListRolesLogic) ListRoles(req *types.ListRolesReq) (*types.ListRolesResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	listResp, err := l.svcCtx.UsercenterRpc.ListRoles(l.ctx, &usercenter.ListRolesReq{
+		OperatorId: operatorId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.Role, 0, len(listResp.List))
+	_ = copier.Copy(&list, listResp.List)
+
+	return &types.ListRolesResp{
+		List: list,
+	}, nil
+}
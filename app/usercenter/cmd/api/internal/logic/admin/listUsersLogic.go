@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUsersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// list users
+func NewListUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUsersLogic {
+	return &ListUsersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListUsersLogic) ListUsers(req *types.ListUsersReq) (*types.ListUsersResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	listResp, err := l.svcCtx.UsercenterRpc.ListUsers(l.ctx, &usercenter.ListUsersReq{
+		OperatorId: operatorId,
+		Keyword:    req.Keyword,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.User, 0, len(listResp.List))
+	_ = copier.Copy(&list, listResp.List)
+
+	return &types.ListUsersResp{
+		Total: listResp.Total,
+		List:  list,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetUserStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// set user status
+func NewSetUserStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserStatusLogic {
+	return &SetUserStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetUserStatusLogic) SetUserStatus(req *types.SetUserStatusReq) (*types.SetUserStatusResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	_, err := l.svcCtx.UsercenterRpc.SetUserStatus(l.ctx, &usercenter.SetUserStatusReq{
+		OperatorId: operatorId,
+		UserId:     req.UserId,
+		Status:     req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetUserStatusResp{}, nil
+}
@@ -0,0 +1,49 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddOrgMemberLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// add org member
+func NewAddOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddOrgMemberLogic {
+	return &AddOrgMemberLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddOrgMemberLogic) AddOrgMember(req *types.AddOrgMemberReq) (*types.AddOrgMemberResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	addResp, err := l.svcCtx.UsercenterRpc.AddOrgMember(l.ctx, &usercenter.AddOrgMemberReq{
+		OperatorId: operatorId,
+		OrgId:      req.OrgId,
+		Mobile:     req.Mobile,
+		Role:       req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var member types.OrgMember
+	_ = copier.Copy(&member, addResp.Member)
+
+	return &types.AddOrgMemberResp{
+		Member: member,
+	}, nil
+}
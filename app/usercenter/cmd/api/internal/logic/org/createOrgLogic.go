@@ -0,0 +1,47 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOrgLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// create org
+func NewCreateOrgLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOrgLogic {
+	return &CreateOrgLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateOrgLogic) CreateOrg(req *types.CreateOrgReq) (*types.CreateOrgResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	createResp, err := l.svcCtx.UsercenterRpc.CreateOrganization(l.ctx, &usercenter.CreateOrganizationReq{
+		OperatorId: operatorId,
+		Name:       req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var org types.Organization
+	_ = copier.Copy(&org, createResp.Organization)
+
+	return &types.CreateOrgResp{
+		Organization: org,
+	}, nil
+}
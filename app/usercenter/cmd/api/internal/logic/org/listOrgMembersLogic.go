@@ -0,0 +1,47 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrgMembersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// list org members
+func NewListOrgMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrgMembersLogic {
+	return &ListOrgMembersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListOrgMembersLogic) ListOrgMembers(req *types.ListOrgMembersReq) (*types.ListOrgMembersResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	listResp, err := l.svcCtx.UsercenterRpc.ListOrgMembers(l.ctx, &usercenter.ListOrgMembersReq{
+		OperatorId: operatorId,
+		OrgId:      req.OrgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.OrgMember, 0, len(listResp.List))
+	_ = copier.Copy(&list, listResp.List)
+
+	return &types.ListOrgMembersResp{
+		List: list,
+	}, nil
+}
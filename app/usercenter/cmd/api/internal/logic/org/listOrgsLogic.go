@@ -0,0 +1,46 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrgsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// list orgs
+func NewListOrgsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrgsLogic {
+	return &ListOrgsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListOrgsLogic) ListOrgs(req *types.ListOrgsReq) (*types.ListOrgsResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	listResp, err := l.svcCtx.UsercenterRpc.ListOrganizations(l.ctx, &usercenter.ListOrganizationsReq{
+		OperatorId: operatorId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.Organization, 0, len(listResp.List))
+	_ = copier.Copy(&list, listResp.List)
+
+	return &types.ListOrgsResp{
+		List: list,
+	}, nil
+}
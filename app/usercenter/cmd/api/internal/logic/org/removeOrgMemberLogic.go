@@ -0,0 +1,42 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveOrgMemberLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// remove org member
+func NewRemoveOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveOrgMemberLogic {
+	return &RemoveOrgMemberLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RemoveOrgMemberLogic) RemoveOrgMember(req *types.RemoveOrgMemberReq) (*types.RemoveOrgMemberResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	_, err := l.svcCtx.UsercenterRpc.RemoveOrgMember(l.ctx, &usercenter.RemoveOrgMemberReq{
+		OperatorId: operatorId,
+		OrgId:      req.OrgId,
+		UserId:     req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RemoveOrgMemberResp{}, nil
+}
@@ -0,0 +1,43 @@
+package org
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOrgMemberLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// update org member role
+func NewUpdateOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOrgMemberLogic {
+	return &UpdateOrgMemberLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateOrgMemberLogic) UpdateOrgMember(req *types.UpdateOrgMemberReq) (*types.UpdateOrgMemberResp, error) {
+	operatorId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	_, err := l.svcCtx.UsercenterRpc.UpdateOrgMember(l.ctx, &usercenter.UpdateOrgMemberReq{
+		OperatorId: operatorId,
+		OrgId:      req.OrgId,
+		UserId:     req.UserId,
+		Role:       req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateOrgMemberResp{}, nil
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// get profile
+func NewGetProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProfileLogic {
+	return &GetProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProfileLogic) GetProfile(req *types.GetProfileReq) (*types.GetProfileResp, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	profileResp, err := l.svcCtx.UsercenterRpc.GetUserProfile(l.ctx, &usercenter.GetUserProfileReq{
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var profile types.UserProfile
+	_ = copier.Copy(&profile, profileResp.Profile)
+
+	return &types.GetProfileResp{
+		Profile: profile,
+	}, nil
+}
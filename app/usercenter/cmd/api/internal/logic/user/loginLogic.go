@@ -30,6 +30,7 @@ func (l *LoginLogic) Login(req *types.LoginReq) (*types.LoginResp, error) {
 	loginResp, err := l.svcCtx.UsercenterRpc.Login(l.ctx, &usercenter.LoginReq{
 		Mobile:   req.Mobile,
 		Password: req.Password,
+		SmsCode:  req.SmsCode,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// logout
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LogoutLogic) Logout(req *types.LogoutReq) (*types.LogoutResp, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	_, err := l.svcCtx.UsercenterRpc.Logout(l.ctx, &usercenter.LogoutReq{
+		UserId:     userId,
+		SessionId:  ctxdata.GetSessionIdFromCtx(l.ctx),
+		AllDevices: req.AllDevices,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LogoutResp{}, nil
+}
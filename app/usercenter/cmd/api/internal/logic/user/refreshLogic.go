@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// refresh token
+func NewRefreshLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshLogic {
+	return &RefreshLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshLogic) Refresh(req *types.RefreshReq) (*types.RefreshResp, error) {
+	refreshResp, err := l.svcCtx.UsercenterRpc.RefreshToken(l.ctx, &usercenter.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.RefreshResp
+	_ = copier.Copy(&resp, refreshResp)
+
+	return &resp, nil
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendSmsCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// send sms code
+func NewSendSmsCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendSmsCodeLogic {
+	return &SendSmsCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SendSmsCodeLogic) SendSmsCode(req *types.SendSmsCodeReq) (*types.SendSmsCodeResp, error) {
+	_, err := l.svcCtx.UsercenterRpc.SendSmsCode(l.ctx, &usercenter.SendSmsCodeReq{
+		Mobile: req.Mobile,
+		Scene:  req.Scene,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SendSmsCodeResp{}, nil
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"document_agent/app/llmcenter/cmd/rpc/llmcenter"
+	"document_agent/app/usercenter/cmd/api/internal/svc"
+	"document_agent/app/usercenter/cmd/api/internal/types"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/ctxdata"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// update profile
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateProfileLogic) UpdateProfile(req *types.UpdateProfileReq) (*types.UpdateProfileResp, error) {
+	userId, _ := ctxdata.GetUidFromCtx(l.ctx)
+
+	var in usercenter.UserProfile
+	_ = copier.Copy(&in, req)
+	in.UserId = userId
+
+	// 头像须为本人上传的文件，避免引用他人的上传内容或不存在的文件
+	if avatar := strings.TrimSpace(in.Avatar); avatar != "" {
+		if _, err := l.svcCtx.LLMCenterRpc.CheckFileAccess(l.ctx, &llmcenter.CheckFileAccessRequest{
+			UserId:    userId,
+			FileId:    avatar,
+			OwnerOnly: true,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	profileResp, err := l.svcCtx.UsercenterRpc.UpdateProfile(l.ctx, &usercenter.UpdateProfileReq{
+		Profile: &in,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var profile types.UserProfile
+	_ = copier.Copy(&profile, profileResp.Profile)
+
+	return &types.UpdateProfileResp{
+		Profile: profile,
+	}, nil
+}
@@ -1,20 +1,44 @@
 package svc
 
 import (
+	"document_agent/app/llmcenter/cmd/rpc/llmcenter"
 	"document_agent/app/usercenter/cmd/api/internal/config"
 	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/health"
+	"document_agent/pkg/interceptor/rpcclient"
+	"document_agent/pkg/session"
 
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
 	Config        config.Config
 	UsercenterRpc usercenter.Usercenter
+	LLMCenterRpc  llmcenter.LlmCenter // 校验头像文件的归属
+	UserManage    rest.Middleware
+	Sessions      *session.Checker
+	Health        *health.Checker // /readyz 依赖检查
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	// 客户端 IP 与 User-Agent 通过 metadata 透传给 RPC，用于登录限流
+	rpcClient := zrpc.MustNewClient(c.UsercenterRpcConf,
+		zrpc.WithUnaryClientInterceptor(rpcclient.ClientInfoInterceptor),
+	)
+	rds := redis.MustNewRedis(c.Redis)
 	return &ServiceContext{
 		Config:        c,
-		UsercenterRpc: usercenter.NewUsercenter(zrpc.MustNewClient(c.UsercenterRpcConf)),
+		UsercenterRpc: usercenter.NewUsercenter(rpcClient),
+		LLMCenterRpc:  llmcenter.NewLlmCenter(zrpc.MustNewClient(c.LlmCenterRpcConf)),
+		UserManage:    authz.RequirePerms(authz.PermUserManage),
+		Sessions:      session.NewChecker(rds),
+		Health: health.NewChecker(c.HealthCheck,
+			health.Redis(rds),
+			health.Etcd(c.UsercenterRpcConf.Etcd.Hosts),
+			health.GRPC("usercenter-rpc", rpcClient.Conn()),
+		),
 	}
 }
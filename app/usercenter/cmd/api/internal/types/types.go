@@ -3,32 +3,213 @@
 
 package types
 
+type AddOrgMemberReq struct {
+	OrgId  int64  `json:"orgId"`
+	Mobile string `json:"mobile"`
+	Role   string `json:"role,options=editor|viewer"`
+}
+
+type AddOrgMemberResp struct {
+	Member OrgMember `json:"member"`
+}
+
+type AssignRolesReq struct {
+	UserId int64    `json:"userId"`
+	Roles  []string `json:"roles,optional"`
+}
+
+type AssignRolesResp struct {
+}
+
+type ChangePasswordReq struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+type ChangePasswordResp struct {
+}
+
+type CreateOrgReq struct {
+	Name string `json:"name"`
+}
+
+type CreateOrgResp struct {
+	Organization Organization `json:"organization"`
+}
+
+type GetProfileReq struct {
+}
+
+type GetProfileResp struct {
+	Profile UserProfile `json:"profile"`
+}
+
+type ListOrgMembersReq struct {
+	OrgId int64 `form:"orgId"`
+}
+
+type ListOrgMembersResp struct {
+	List []OrgMember `json:"list"`
+}
+
+type ListOrgsReq struct {
+}
+
+type ListOrgsResp struct {
+	List []Organization `json:"list"`
+}
+
+type ListRolesReq struct {
+}
+
+type ListRolesResp struct {
+	List []Role `json:"list"`
+}
+
+type ListUsersReq struct {
+	Keyword  string `form:"keyword,optional"`
+	Page     int64  `form:"page,default=1"`
+	PageSize int64  `form:"pageSize,default=20"`
+}
+
+type ListUsersResp struct {
+	Total int64  `json:"total"`
+	List  []User `json:"list"`
+}
+
 type LoginReq struct {
 	Mobile   string `json:"mobile"`
-	Password string `json:"password"`
+	Password string `json:"password,optional"`
+	SmsCode  string `json:"smsCode,optional"`
 }
 
 type LoginResp struct {
-	AccessToken  string `json:"accessToken"`
-	AccessExpire int64  `json:"accessExpire"`
-	RefreshAfter int64  `json:"refreshAfter"`
+	AccessToken   string `json:"accessToken"`
+	AccessExpire  int64  `json:"accessExpire"`
+	RefreshAfter  int64  `json:"refreshAfter"`
+	RefreshToken  string `json:"refreshToken"`
+	RefreshExpire int64  `json:"refreshExpire"`
+}
+
+type LogoutReq struct {
+	AllDevices bool `json:"allDevices,optional"`
+}
+
+type LogoutResp struct {
+}
+
+type OrgMember struct {
+	UserId   int64  `json:"userId"`
+	Mobile   string `json:"mobile"`
+	Nickname string `json:"nickname"`
+	Avatar   string `json:"avatar"`
+	Role     string `json:"role"`
+	JoinTime int64  `json:"joinTime"`
+}
+
+type Organization struct {
+	Id         int64  `json:"id"`
+	Name       string `json:"name"`
+	OwnerId    int64  `json:"ownerId"`
+	Role       string `json:"role"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type RefreshReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RefreshResp struct {
+	AccessToken   string `json:"accessToken"`
+	AccessExpire  int64  `json:"accessExpire"`
+	RefreshAfter  int64  `json:"refreshAfter"`
+	RefreshToken  string `json:"refreshToken"`
+	RefreshExpire int64  `json:"refreshExpire"`
 }
 
 type RegisterReq struct {
 	Mobile   string `json:"mobile"`
 	Password string `json:"password"`
+	SmsCode  string `json:"smsCode"`
 }
 
 type RegisterResp struct {
-	AccessToken  string `json:"accessToken"`
-	AccessExpire int64  `json:"accessExpire"`
-	RefreshAfter int64  `json:"refreshAfter"`
+	AccessToken   string `json:"accessToken"`
+	AccessExpire  int64  `json:"accessExpire"`
+	RefreshAfter  int64  `json:"refreshAfter"`
+	RefreshToken  string `json:"refreshToken"`
+	RefreshExpire int64  `json:"refreshExpire"`
+}
+
+type RemoveOrgMemberReq struct {
+	OrgId  int64 `json:"orgId"`
+	UserId int64 `json:"userId"`
+}
+
+type RemoveOrgMemberResp struct {
+}
+
+type ResetPasswordReq struct {
+	Mobile      string `json:"mobile"`
+	SmsCode     string `json:"smsCode"`
+	NewPassword string `json:"newPassword"`
+}
+
+type ResetPasswordResp struct {
+}
+
+type Role struct {
+	Id          int64    `json:"id"`
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type SendSmsCodeReq struct {
+	Mobile string `json:"mobile"`
+	Scene  string `json:"scene,options=register|login|reset_password"`
+}
+
+type SendSmsCodeResp struct {
+}
+
+type SetUserStatusReq struct {
+	UserId int64 `json:"userId"`
+	Status int64 `json:"status,options=0|1"`
+}
+
+type SetUserStatusResp struct {
+}
+
+type UpdateOrgMemberReq struct {
+	OrgId  int64  `json:"orgId"`
+	UserId int64  `json:"userId"`
+	Role   string `json:"role,options=editor|viewer"`
+}
+
+type UpdateOrgMemberResp struct {
+}
+
+type UpdateProfileReq struct {
+	Nickname       string `json:"nickname"`
+	Avatar         string `json:"avatar,optional"`
+	Department     string `json:"department,optional"`
+	Organization   string `json:"organization,optional"`
+	DefaultDocType string `json:"defaultDocType,optional"`
+	HeaderTitle    string `json:"headerTitle,optional"`
+	HeaderDocNo    string `json:"headerDocNo,optional"`
+}
+
+type UpdateProfileResp struct {
+	Profile UserProfile `json:"profile"`
 }
 
 type User struct {
-	Id       int64  `json:"id"`
-	Mobile   string `json:"mobile"`
-	Nickname string `json:"nickname"`
+	Id       int64    `json:"id"`
+	Mobile   string   `json:"mobile"`
+	Nickname string   `json:"nickname"`
+	Status   int64    `json:"status"`
+	Roles    []string `json:"roles"`
 }
 
 type UserInfoReq struct {
@@ -37,3 +218,15 @@ type UserInfoReq struct {
 type UserInfoResp struct {
 	UserInfo User `json:"userInfo"`
 }
+
+type UserProfile struct {
+	UserId         int64  `json:"userId"`
+	Mobile         string `json:"mobile"`
+	Nickname       string `json:"nickname"`
+	Avatar         string `json:"avatar"`
+	Department     string `json:"department"`
+	Organization   string `json:"organization"`
+	DefaultDocType string `json:"defaultDocType"`
+	HeaderTitle    string `json:"headerTitle"`
+	HeaderDocNo    string `json:"headerDocNo"`
+}
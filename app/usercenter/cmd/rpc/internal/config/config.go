@@ -1,14 +1,26 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import (
+	"document_agent/pkg/health"
+	"document_agent/pkg/loginguard"
+	"document_agent/pkg/sms"
+	"document_agent/pkg/verifycode"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
 
 type Config struct {
 	zrpc.RpcServerConf
 	JwtAuth struct {
-		AccessSecret string
-		AccessExpire int64
+		AccessSecret  string
+		AccessExpire  int64
+		RefreshExpire int64 // 刷新令牌有效期（秒），每次刷新后顺延
 	}
 	DB struct {
 		DataSource string
 	}
+	LoginGuard  loginguard.Config `json:",optional"` // 登录失败限流与锁定
+	Sms         sms.Config        `json:",optional"` // 短信服务商
+	SmsCode     verifycode.Config `json:",optional"` // 短信验证码有效期与发送频率
+	HealthCheck health.Config     `json:",optional"` // gRPC 就绪状态的依赖检查
 }
@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddOrgMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddOrgMemberLogic {
+	return &AddOrgMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AddOrgMember 负责人按手机号将已注册用户加入团队
+func (l *AddOrgMemberLogic) AddOrgMember(in *usercenter.AddOrgMemberReq) (*usercenter.AddOrgMemberResp, error) {
+	if !workspace.ValidMemberRole(in.Role) {
+		return nil, fmt.Errorf("AddOrgMember invalid role %q: %w", in.Role, xerr.ErrInvalidParameter)
+	}
+	if _, err := checkOrgAccess(l.ctx, l.svcCtx, in.OperatorId, in.OrgId, workspace.Manage); err != nil {
+		return nil, err
+	}
+
+	user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, in.Mobile)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("AddOrgMember find user db err, mobile:%s, err:%v: %w", in.Mobile, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("AddOrgMember mobile:%s: %w", in.Mobile, xerr.ErrUserNotFound)
+	}
+
+	existing, err := findOrgMember(l.ctx, l.svcCtx, in.OrgId, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("AddOrgMember user %d already in org %d: %w", user.Id, in.OrgId, xerr.ErrOrgMemberExists)
+	}
+
+	member := &model.OrganizationMember{OrgId: in.OrgId, UserId: user.Id, Role: in.Role, CreateTime: time.Now()}
+	if _, err := l.svcCtx.OrgMemberModel.Insert(l.ctx, member); err != nil {
+		return nil, fmt.Errorf("AddOrgMember insert db err, orgId:%d, userId:%d, err:%v: %w", in.OrgId, user.Id, err, xerr.ErrDbError)
+	}
+
+	return &usercenter.AddOrgMemberResp{
+		Member: toOrgMember(member, user),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignRolesLogic {
+	return &AssignRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *AssignRolesLogic) AssignRoles(in *usercenter.AssignRolesReq) (*usercenter.AssignRolesResp, error) {
+	if err := checkOperatorPerm(l.ctx, l.svcCtx, in.OperatorId, authz.PermUserManage); err != nil {
+		return nil, err
+	}
+	// 防止管理员误操作移除自己的管理员角色，导致系统中没有可用的管理员
+	if in.UserId == in.OperatorId && !slices.Contains(in.Roles, authz.RoleAdmin) {
+		return nil, fmt.Errorf("AssignRoles operator %d cannot remove its own admin role: %w", in.OperatorId, xerr.ErrInvalidParameter)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("AssignRoles find user db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("AssignRoles id:%d: %w", in.UserId, xerr.ErrUserNotFound)
+	}
+
+	roleIds := make([]int64, 0, len(in.Roles))
+	for _, code := range in.Roles {
+		role, err := l.svcCtx.RoleModel.FindOneByCode(l.ctx, code)
+		if err != nil && err != model.ErrNotFound {
+			return nil, fmt.Errorf("AssignRoles find role db err, code:%s, err:%v: %w", code, err, xerr.ErrDbError)
+		}
+		if role == nil {
+			return nil, fmt.Errorf("AssignRoles code:%s: %w", code, xerr.ErrRoleNotFound)
+		}
+		if !slices.Contains(roleIds, role.Id) {
+			roleIds = append(roleIds, role.Id)
+		}
+	}
+
+	if err := l.svcCtx.RoleModel.ReplaceUserRoles(l.ctx, in.UserId, roleIds); err != nil {
+		return nil, fmt.Errorf("AssignRoles replace roles db err, userId:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+	// 角色与权限写在 JWT 中，吊销全部会话使旧 token 失效，重新登录后按新角色签发
+	if err := l.svcCtx.SessionStore.RevokeAll(l.ctx, in.UserId); err != nil {
+		return nil, fmt.Errorf("AssignRoles revoke sessions err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrServerCommon)
+	}
+
+	return &usercenter.AssignRolesResp{}, nil
+}
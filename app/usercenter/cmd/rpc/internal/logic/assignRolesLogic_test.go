@@ -0,0 +1,131 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/session"
+	"document_agent/pkg/xerr"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type usersModel struct {
+	model.UserModel
+	users map[int64]*model.User
+}
+
+func (m *usersModel) FindOne(_ context.Context, id int64) (*model.User, error) {
+	if u, ok := m.users[id]; ok {
+		return u, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+// rolesModel 角色表与用户角色关系，角色ID为 roles 中的下标加一
+type rolesModel struct {
+	model.RoleModel
+	roles     []string
+	userRoles map[int64][]int64
+}
+
+func (m *rolesModel) FindOneByCode(_ context.Context, code string) (*model.Role, error) {
+	if i := slices.Index(m.roles, code); i >= 0 {
+		return &model.Role{Id: int64(i + 1), Code: code}, nil
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *rolesModel) FindCodesByUserIds(_ context.Context, userIds []int64) (map[int64][]string, error) {
+	resp := make(map[int64][]string)
+	for _, uid := range userIds {
+		for _, id := range m.userRoles[uid] {
+			resp[uid] = append(resp[uid], m.roles[id-1])
+		}
+	}
+	return resp, nil
+}
+
+func (m *rolesModel) ReplaceUserRoles(_ context.Context, userId int64, roleIds []int64) error {
+	m.userRoles[userId] = roleIds
+	return nil
+}
+
+type permsModel struct {
+	model.PermissionModel
+	perms map[string][]string // 角色编码 -> 权限编码
+}
+
+func (m *permsModel) FindCodesByRoleCodes(_ context.Context, roleCodes []string) ([]string, error) {
+	var resp []string
+	for _, code := range roleCodes {
+		resp = append(resp, m.perms[code]...)
+	}
+	return resp, nil
+}
+
+func TestAssignRolesRevokesSessions(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+	const admin, target = 1, 2
+	svcCtx := &svc.ServiceContext{
+		UserModel: &usersModel{users: map[int64]*model.User{
+			admin:  {Id: admin, Status: model.UserStatusNormal},
+			target: {Id: target, Status: model.UserStatusNormal},
+		}},
+		RoleModel:       &rolesModel{roles: []string{authz.RoleUser, authz.RoleReviewer, authz.RoleAdmin}, userRoles: map[int64][]int64{admin: {3}}},
+		PermissionModel: &permsModel{perms: map[string][]string{authz.RoleAdmin: {authz.PermUserManage}}},
+		SessionStore:    session.NewStore(rds, 3600, 86400),
+	}
+	ctx := context.Background()
+	checker := session.NewChecker(rds)
+
+	// 角色变更前登录的会话
+	sid, refresh, err := svcCtx.SessionStore.Create(ctx, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked, err := checker.IsRevoked(ctx, target, sid); err != nil || revoked {
+		t.Fatalf("IsRevoked before assign = %v, %v", revoked, err)
+	}
+
+	// 没有 user:manage 权限的操作者不能分配角色，会话不受影响
+	_, err = NewAssignRolesLogic(ctx, svcCtx).AssignRoles(&usercenter.AssignRolesReq{OperatorId: target, UserId: target, Roles: []string{authz.RoleAdmin}})
+	if !errors.Is(err, xerr.ErrPermissionDenied) {
+		t.Fatalf("assign without permission err = %v", err)
+	}
+	if revoked, _ := checker.IsRevoked(ctx, target, sid); revoked {
+		t.Fatal("session revoked by a rejected assignment")
+	}
+
+	if _, err := NewAssignRolesLogic(ctx, svcCtx).AssignRoles(&usercenter.AssignRolesReq{OperatorId: admin, UserId: target, Roles: []string{authz.RoleReviewer}}); err != nil {
+		t.Fatalf("AssignRoles: %v", err)
+	}
+
+	// 变更前签发的 access token 与刷新令牌均失效，旧 token 中的角色不再可用
+	if revoked, err := checker.IsRevoked(ctx, target, sid); err != nil || !revoked {
+		t.Fatalf("IsRevoked after assign = %v, %v", revoked, err)
+	}
+	if revoked, err := checker.IsRevoked(ctx, target, ""); err != nil || !revoked {
+		t.Fatalf("token without session id not revoked: %v, %v", revoked, err)
+	}
+	if _, _, _, err := svcCtx.SessionStore.Rotate(ctx, refresh); !errors.Is(err, session.ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate after assign err = %v", err)
+	}
+
+	// 重新登录的会话不受影响
+	newSid, _, err := svcCtx.SessionStore.Create(ctx, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked, _ := checker.IsRevoked(ctx, target, newSid); revoked {
+		t.Fatal("new session revoked")
+	}
+}
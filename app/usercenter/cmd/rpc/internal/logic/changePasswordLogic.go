@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/password"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ChangePasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ChangePassword 校验旧密码后修改密码，修改成功后吊销该用户的全部会话，所有设备需重新登录
+func (l *ChangePasswordLogic) ChangePassword(in *usercenter.ChangePasswordReq) (*usercenter.ChangePasswordResp, error) {
+	if err := password.Validate(in.NewPassword); err != nil {
+		return nil, fmt.Errorf("ChangePassword userId:%d, %v: %w", in.UserId, err, xerr.ErrPasswordPolicy)
+	}
+	if in.NewPassword == in.OldPassword {
+		return nil, fmt.Errorf("ChangePassword userId:%d new password equals old: %w", in.UserId, xerr.ErrInvalidParameter)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("ChangePassword find user db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("ChangePassword id:%d: %w", in.UserId, xerr.ErrUserNotFound)
+	}
+
+	ok, _, err := password.Verify(in.OldPassword, user.Password)
+	if err != nil {
+		return nil, fmt.Errorf("ChangePassword parse hash err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrServerCommon)
+	}
+	if !ok {
+		return nil, fmt.Errorf("ChangePassword old password mismatch, id:%d: %w", in.UserId, xerr.ErrUserPassword)
+	}
+
+	hash, err := password.Hash(in.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("ChangePassword hash password err:%v: %w", err, xerr.ErrServerCommon)
+	}
+	if err := l.svcCtx.UserModel.UpdatePassword(l.ctx, in.UserId, hash); err != nil {
+		return nil, fmt.Errorf("ChangePassword update db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+
+	if err := l.svcCtx.SessionStore.RevokeAll(l.ctx, in.UserId); err != nil {
+		l.Errorf("ChangePassword revoke sessions failed, id:%d, err:%v", in.UserId, err)
+	}
+
+	return &usercenter.ChangePasswordResp{}, nil
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOrganizationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOrganizationLogic {
+	return &CreateOrganizationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateOrganization 创建团队，创建者成为团队负责人
+func (l *CreateOrganizationLogic) CreateOrganization(in *usercenter.CreateOrganizationReq) (*usercenter.CreateOrganizationResp, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxOrgNameLen {
+		return nil, fmt.Errorf("CreateOrganization invalid name %q: %w", in.Name, xerr.ErrInvalidParameter)
+	}
+
+	orgId, err := l.svcCtx.OrgModel.CreateWithOwner(l.ctx, name, in.OperatorId, workspace.RoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("CreateOrganization db err, operatorId:%d, err:%v: %w", in.OperatorId, err, xerr.ErrDbError)
+	}
+
+	org := &model.Organization{Id: orgId, Name: name, OwnerId: in.OperatorId, CreateTime: time.Now()}
+	return &usercenter.CreateOrganizationResp{
+		Organization: toOrganization(org, workspace.RoleOwner),
+	}, nil
+}
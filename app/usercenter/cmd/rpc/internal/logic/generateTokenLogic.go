@@ -29,26 +29,47 @@ func NewGenerateTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Gen
 }
 
 func (l *GenerateTokenLogic) GenerateToken(in *pb.GenerateTokenReq) (*pb.GenerateTokenResp, error) {
+	roles, perms, err := loadRolesAndPerms(l.ctx, l.svcCtx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未指定会话时（登录、注册）创建新会话，刷新令牌只在此时返回
+	sessionId := in.SessionId
+	var refreshToken string
+	if sessionId == "" {
+		sessionId, refreshToken, err = l.svcCtx.SessionStore.Create(l.ctx, in.UserId)
+		if err != nil {
+			return nil, fmt.Errorf("create session err userId:%d, err:%v: %w", in.UserId, err, xerr.ErrGenerateToken)
+		}
+	}
+
 	now := time.Now().Unix()
 	accessExpire := l.svcCtx.Config.JwtAuth.AccessExpire
-	accessToken, err := l.getJwtToken(l.svcCtx.Config.JwtAuth.AccessSecret, now, accessExpire, in.UserId)
+	accessToken, err := l.getJwtToken(l.svcCtx.Config.JwtAuth.AccessSecret, now, accessExpire, in.UserId, sessionId, roles, perms)
 	if err != nil {
 		return nil, fmt.Errorf("getJwtToken err userId:%d, err:%v: %w", in.UserId, err, xerr.ErrGenerateToken)
 	}
 
 	return &pb.GenerateTokenResp{
-		AccessToken:  accessToken,
-		AccessExpire: now + accessExpire,
-		RefreshAfter: now + accessExpire/2,
+		AccessToken:   accessToken,
+		AccessExpire:  now + accessExpire,
+		RefreshAfter:  now + accessExpire/2,
+		RefreshToken:  refreshToken,
+		RefreshExpire: now + l.svcCtx.SessionStore.RefreshExpire(),
+		SessionId:     sessionId,
 	}, nil
 }
 
-func (l *GenerateTokenLogic) getJwtToken(secretKey string, iat, seconds, userId int64) (string, error) {
+func (l *GenerateTokenLogic) getJwtToken(secretKey string, iat, seconds, userId int64, sessionId string, roles, perms []string) (string, error) {
 
 	claims := make(jwt.MapClaims)
 	claims["exp"] = iat + seconds
 	claims["iat"] = iat
 	claims[ctxdata.CtxKeyJwtUserId] = userId
+	claims[ctxdata.CtxKeyJwtSessionId] = sessionId
+	claims[ctxdata.CtxKeyJwtRoles] = roles
+	claims[ctxdata.CtxKeyJwtPerms] = perms
 	token := jwt.New(jwt.SigningMethodHS256)
 	token.Claims = claims
 	return token.SignedString([]byte(secretKey))
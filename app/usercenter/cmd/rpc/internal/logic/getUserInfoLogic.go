@@ -39,6 +39,10 @@ func (l *GetUserInfoLogic) GetUserInfo(in *pb.GetUserInfoReq) (*pb.GetUserInfoRe
 	}
 	var respUser usercenter.User
 	_ = copier.Copy(&respUser, user)
+	respUser.Roles, _, err = loadRolesAndPerms(l.ctx, l.svcCtx, user.Id)
+	if err != nil {
+		return nil, err
+	}
 
 	return &usercenter.GetUserInfoResp{
 		User: &respUser,
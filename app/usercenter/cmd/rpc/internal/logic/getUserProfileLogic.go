@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserProfileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserProfileLogic {
+	return &GetUserProfileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserProfile 查询用户资料，llmcenter 在生成、导出时也通过该接口读取用户的默认值
+func (l *GetUserProfileLogic) GetUserProfile(in *usercenter.GetUserProfileReq) (*usercenter.GetUserProfileResp, error) {
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("GetUserProfile find user db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("GetUserProfile id:%d: %w", in.UserId, xerr.ErrUserNotFound)
+	}
+
+	return &usercenter.GetUserProfileResp{
+		Profile: toUserProfile(user),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package logic
+
+import (
+	"context"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWorkspaceRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetWorkspaceRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWorkspaceRoleLogic {
+	return &GetWorkspaceRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetWorkspaceRole 查询用户在团队空间中的角色，非成员返回空角色而不是错误
+func (l *GetWorkspaceRoleLogic) GetWorkspaceRole(in *usercenter.GetWorkspaceRoleReq) (*usercenter.GetWorkspaceRoleResp, error) {
+	member, err := findOrgMember(l.ctx, l.svcCtx, in.WorkspaceId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return &usercenter.GetWorkspaceRoleResp{}, nil
+	}
+	return &usercenter.GetWorkspaceRoleResp{
+		Role: member.Role,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// listAvatarsMaxLimit 单页最多返回的用户数
+const listAvatarsMaxLimit = 1000
+
+type ListAvatarsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAvatarsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAvatarsLogic {
+	return &ListAvatarsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListAvatars 按用户 id 分页列出头像文件，供 llmcenter 的文件清理保留仍在使用的头像
+func (l *ListAvatarsLogic) ListAvatars(in *usercenter.ListAvatarsReq) (*usercenter.ListAvatarsResp, error) {
+	limit := in.Limit
+	if limit <= 0 || limit > listAvatarsMaxLimit {
+		limit = listAvatarsMaxLimit
+	}
+	users, err := l.svcCtx.UserModel.ListAvatarsAfter(l.ctx, in.AfterUserId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ListAvatars afterUserId:%d, err:%v: %w", in.AfterUserId, err, xerr.ErrDbError)
+	}
+
+	list := make([]*usercenter.UserAvatar, 0, len(users))
+	for _, u := range users {
+		list = append(list, &usercenter.UserAvatar{UserId: u.Id, Avatar: u.Avatar})
+	}
+	return &usercenter.ListAvatarsResp{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrgMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOrgMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrgMembersLogic {
+	return &ListOrgMembersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListOrgMembers 查询团队成员，团队内任意成员均可查看
+func (l *ListOrgMembersLogic) ListOrgMembers(in *usercenter.ListOrgMembersReq) (*usercenter.ListOrgMembersResp, error) {
+	if _, err := checkOrgAccess(l.ctx, l.svcCtx, in.OperatorId, in.OrgId, workspace.Read); err != nil {
+		return nil, err
+	}
+
+	members, err := l.svcCtx.OrgMemberModel.FindByOrgId(l.ctx, in.OrgId)
+	if err != nil {
+		return nil, fmt.Errorf("ListOrgMembers find members db err, orgId:%d, err:%v: %w", in.OrgId, err, xerr.ErrDbError)
+	}
+
+	userIds := make([]int64, 0, len(members))
+	for _, member := range members {
+		userIds = append(userIds, member.UserId)
+	}
+	users, err := l.svcCtx.UserModel.FindByIds(l.ctx, userIds)
+	if err != nil {
+		return nil, fmt.Errorf("ListOrgMembers find users db err, orgId:%d, err:%v: %w", in.OrgId, err, xerr.ErrDbError)
+	}
+	userMap := make(map[int64]*model.User, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
+	}
+
+	list := make([]*usercenter.OrgMember, 0, len(members))
+	for _, member := range members {
+		list = append(list, toOrgMember(member, userMap[member.UserId]))
+	}
+	return &usercenter.ListOrgMembersResp{
+		List: list,
+	}, nil
+}
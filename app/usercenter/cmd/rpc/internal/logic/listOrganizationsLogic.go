@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrganizationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOrganizationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrganizationsLogic {
+	return &ListOrganizationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListOrganizations 查询用户加入的全部团队及其在团队中的角色
+func (l *ListOrganizationsLogic) ListOrganizations(in *usercenter.ListOrganizationsReq) (*usercenter.ListOrganizationsResp, error) {
+	orgs, err := l.svcCtx.OrgModel.FindByMember(l.ctx, in.OperatorId)
+	if err != nil {
+		return nil, fmt.Errorf("ListOrganizations db err, operatorId:%d, err:%v: %w", in.OperatorId, err, xerr.ErrDbError)
+	}
+
+	list := make([]*usercenter.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		list = append(list, toOrganization(&org.Organization, org.Role))
+	}
+	return &usercenter.ListOrganizationsResp{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRolesLogic {
+	return &ListRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListRolesLogic) ListRoles(in *usercenter.ListRolesReq) (*usercenter.ListRolesResp, error) {
+	if err := checkOperatorPerm(l.ctx, l.svcCtx, in.OperatorId, authz.PermUserManage); err != nil {
+		return nil, err
+	}
+
+	roles, err := l.svcCtx.RoleModel.FindAll(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ListRoles find roles db err:%v: %w", err, xerr.ErrDbError)
+	}
+	permMap, err := l.svcCtx.PermissionModel.FindCodesGroupByRole(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ListRoles find permissions db err:%v: %w", err, xerr.ErrDbError)
+	}
+
+	list := make([]*usercenter.Role, 0, len(roles))
+	for _, r := range roles {
+		list = append(list, &usercenter.Role{
+			Id:          r.Id,
+			Code:        r.Code,
+			Name:        r.Name,
+			Permissions: permMap[r.Id],
+		})
+	}
+
+	return &usercenter.ListRolesResp{List: list}, nil
+}
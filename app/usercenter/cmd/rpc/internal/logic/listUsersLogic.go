@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+
+	"github.com/jinzhu/copier"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+)
+
+type ListUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUsersLogic {
+	return &ListUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListUsersLogic) ListUsers(in *usercenter.ListUsersReq) (*usercenter.ListUsersResp, error) {
+	if err := checkOperatorPerm(l.ctx, l.svcCtx, in.OperatorId, authz.PermUserManage); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultUserPageSize
+	}
+	if pageSize > maxUserPageSize {
+		pageSize = maxUserPageSize
+	}
+	keyword := strings.TrimSpace(in.Keyword)
+
+	total, err := l.svcCtx.UserModel.CountByKeyword(l.ctx, keyword)
+	if err != nil {
+		return nil, fmt.Errorf("ListUsers count db err, keyword:%s, err:%v: %w", keyword, err, xerr.ErrDbError)
+	}
+	users, err := l.svcCtx.UserModel.FindPage(l.ctx, keyword, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("ListUsers find db err, keyword:%s, err:%v: %w", keyword, err, xerr.ErrDbError)
+	}
+
+	userIds := make([]int64, 0, len(users))
+	for _, u := range users {
+		userIds = append(userIds, u.Id)
+	}
+	roleMap, err := l.svcCtx.RoleModel.FindCodesByUserIds(l.ctx, userIds)
+	if err != nil {
+		return nil, fmt.Errorf("ListUsers find roles db err:%v: %w", err, xerr.ErrDbError)
+	}
+
+	list := make([]*usercenter.User, 0, len(users))
+	for _, u := range users {
+		var item usercenter.User
+		_ = copier.Copy(&item, u)
+		item.Roles = roleMap[u.Id]
+		if len(item.Roles) == 0 {
+			item.Roles = []string{authz.RoleUser}
+		}
+		list = append(list, &item)
+	}
+
+	return &usercenter.ListUsersResp{
+		Total: total,
+		List:  list,
+	}, nil
+}
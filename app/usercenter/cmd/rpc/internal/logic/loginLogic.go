@@ -2,12 +2,15 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"document_agent/app/usercenter/cmd/rpc/internal/svc"
 	"document_agent/app/usercenter/cmd/rpc/usercenter"
 	"document_agent/app/usercenter/model"
-	"document_agent/pkg/tool"
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/password"
+	"document_agent/pkg/verifycode"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -27,7 +30,7 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 	}
 }
 
-func (l *LoginLogic) loginByMobile(mobile, password string) (int64, error) {
+func (l *LoginLogic) loginByMobile(mobile, pwd string) (int64, error) {
 
 	user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, mobile)
 	if err != nil && err != model.ErrNotFound {
@@ -38,21 +41,82 @@ func (l *LoginLogic) loginByMobile(mobile, password string) (int64, error) {
 		return 0, fmt.Errorf("mobile: %s: %w", mobile, xerr.ErrUserNotFound)
 	}
 
-	if !(tool.Md5ByString(password) == user.Password) {
+	ok, needsRehash, err := password.Verify(pwd, user.Password)
+	if err != nil {
+		return 0, fmt.Errorf("密码哈希解析失败, mobile: %s, err:%v: %w", mobile, err, xerr.ErrServerCommon)
+	}
+	if !ok {
 		return 0, fmt.Errorf("密码匹配出错, mobile: %s: %w", mobile, xerr.ErrUserPassword)
 	}
 
+	if user.Status != model.UserStatusNormal {
+		return 0, fmt.Errorf("账号已禁用, mobile: %s: %w", mobile, xerr.ErrUserDisabled)
+	}
+
+	// 旧的 MD5 哈希或参数过时的哈希，在登录成功时用明文重新生成
+	if needsRehash {
+		l.rehashPassword(user.Id, pwd)
+	}
+
+	return user.Id, nil
+}
+
+// loginBySmsCode 短信验证码登录。未注册的手机号收不到验证码，因此先校验验证码
+func (l *LoginLogic) loginBySmsCode(mobile, code string) (int64, error) {
+	if err := verifySmsCode(l.ctx, l.svcCtx, verifycode.SceneLogin, mobile, code); err != nil {
+		return 0, err
+	}
+
+	user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, mobile)
+	if err != nil && err != model.ErrNotFound {
+		return 0, fmt.Errorf("根据手机号查询用户信息失败，mobile:%s, err:%+v: %w", mobile, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return 0, fmt.Errorf("mobile: %s: %w", mobile, xerr.ErrUserNotFound)
+	}
+	if user.Status != model.UserStatusNormal {
+		return 0, fmt.Errorf("账号已禁用, mobile: %s: %w", mobile, xerr.ErrUserDisabled)
+	}
+
 	return user.Id, nil
 }
 
+// rehashPassword 升级密码哈希，失败不影响本次登录，下次登录会再次尝试
+func (l *LoginLogic) rehashPassword(userId int64, pwd string) {
+	hash, err := password.Hash(pwd)
+	if err == nil {
+		err = l.svcCtx.UserModel.UpdatePassword(l.ctx, userId, hash)
+	}
+	if err != nil {
+		l.Errorf("rehash password failed, userId:%d, err:%v", userId, err)
+	}
+}
+
 func (l *LoginLogic) Login(in *usercenter.LoginReq) (*usercenter.LoginResp, error) {
 
+	clientIP := clientinfo.FromContext(l.ctx).IP
+	if err := l.checkThrottle(in.Mobile, clientIP); err != nil {
+		return nil, err
+	}
+
 	var userId int64
 	var err error
-	userId, err = l.loginByMobile(in.Mobile, in.Password)
+	if in.SmsCode != "" {
+		userId, err = l.loginBySmsCode(in.Mobile, in.SmsCode)
+	} else {
+		userId, err = l.loginByMobile(in.Mobile, in.Password)
+	}
 	if err != nil {
+		if errors.Is(err, xerr.ErrUserPassword) || errors.Is(err, xerr.ErrUserNotFound) || errors.Is(err, xerr.ErrSmsCodeInvalid) {
+			if lockErr := l.recordFailure(in.Mobile, clientIP); lockErr != nil {
+				return nil, lockErr
+			}
+		}
 		return nil, err
 	}
+	if err := l.svcCtx.LoginGuard.Succeed(l.ctx, in.Mobile); err != nil {
+		l.Errorf("clear login failures failed, mobile:%s, err:%v", in.Mobile, err)
+	}
 
 	//2、Generate the token, so that the service doesn't call rpc internally
 	generateTokenLogic := NewGenerateTokenLogic(l.ctx, l.svcCtx)
@@ -64,8 +128,58 @@ func (l *LoginLogic) Login(in *usercenter.LoginReq) (*usercenter.LoginResp, erro
 	}
 
 	return &usercenter.LoginResp{
-		AccessToken:  tokenResp.AccessToken,
-		AccessExpire: tokenResp.AccessExpire,
-		RefreshAfter: tokenResp.RefreshAfter,
+		AccessToken:   tokenResp.AccessToken,
+		AccessExpire:  tokenResp.AccessExpire,
+		RefreshAfter:  tokenResp.RefreshAfter,
+		RefreshToken:  tokenResp.RefreshToken,
+		RefreshExpire: tokenResp.RefreshExpire,
 	}, nil
 }
+
+// checkThrottle 处于锁定或等待期时拒绝登录。Redis 故障时放行，避免影响正常登录
+func (l *LoginLogic) checkThrottle(mobile, clientIP string) error {
+	wait, err := l.svcCtx.LoginGuard.Check(l.ctx, mobile, clientIP)
+	if err != nil {
+		l.Errorf("check login throttle failed, mobile:%s, ip:%s, err:%v", mobile, clientIP, err)
+		return nil
+	}
+	if wait > 0 {
+		return fmt.Errorf("login throttled, mobile:%s, ip:%s: %w", mobile, clientIP,
+			xerr.WithRetryAfter(xerr.ErrLoginTooFrequent, wait))
+	}
+	return nil
+}
+
+// recordFailure 记录登录失败，本次失败触发锁定时写入安全事件并返回锁定错误
+func (l *LoginLogic) recordFailure(mobile, clientIP string) error {
+	lockouts, wait, err := l.svcCtx.LoginGuard.Fail(l.ctx, mobile, clientIP)
+	if err != nil {
+		l.Errorf("record login failure failed, mobile:%s, ip:%s, err:%v", mobile, clientIP, err)
+		return nil
+	}
+	if len(lockouts) == 0 {
+		return nil
+	}
+
+	var userId int64
+	if user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, mobile); err == nil {
+		userId = user.Id
+	}
+	info := clientinfo.FromContext(l.ctx)
+	for _, lockout := range lockouts {
+		err := l.svcCtx.SecurityLogModel.Insert(context.WithoutCancel(l.ctx), &model.SecurityLog{
+			UserId:    userId,
+			Mobile:    mobile,
+			Event:     model.SecurityEventLoginLockout,
+			ClientIp:  info.IP,
+			UserAgent: info.UserAgent,
+			Detail: fmt.Sprintf("%s %s locked for %ds after %d failures",
+				lockout.Scope, lockout.Subject, int64(wait.Seconds()), lockout.Failures),
+		})
+		if err != nil {
+			l.Errorf("write security log failed, mobile:%s, err:%v", mobile, err)
+		}
+	}
+	return fmt.Errorf("login locked, mobile:%s, ip:%s: %w", mobile, clientIP,
+		xerr.WithRetryAfter(xerr.ErrLoginTooFrequent, wait))
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Logout 吊销当前会话，或吊销用户的全部会话
+func (l *LogoutLogic) Logout(in *usercenter.LogoutReq) (*usercenter.LogoutResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("Logout userId is empty: %w", xerr.ErrInvalidParameter)
+	}
+
+	var err error
+	// 旧 token 不携带会话ID，无法定位单个会话，只能全部吊销
+	if in.AllDevices || in.SessionId == "" {
+		err = l.svcCtx.SessionStore.RevokeAll(l.ctx, in.UserId)
+	} else {
+		err = l.svcCtx.SessionStore.Revoke(l.ctx, in.UserId, in.SessionId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Logout revoke session err, userId:%d, sessionId:%s, err:%v: %w", in.UserId, in.SessionId, err, xerr.ErrServerCommon)
+	}
+
+	return &usercenter.LogoutResp{}, nil
+}
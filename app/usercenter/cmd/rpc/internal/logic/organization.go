@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+)
+
+// 团队名称的最大字符数，与 organization 表的列宽一致
+const maxOrgNameLen = 64
+
+// findOrgMember 查询用户在团队中的成员记录，不是成员时返回 nil
+func findOrgMember(ctx context.Context, svcCtx *svc.ServiceContext, orgId, userId int64) (*model.OrganizationMember, error) {
+	member, err := svcCtx.OrgMemberModel.FindOneByOrgIdUserId(ctx, orgId, userId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("findOrgMember db err, orgId:%d, userId:%d, err:%v: %w", orgId, userId, err, xerr.ErrDbError)
+	}
+	return member, nil
+}
+
+// checkOrgAccess 校验操作者是团队成员且角色具备指定访问级别，返回操作者的成员记录。
+// 非成员统一返回团队不存在，避免泄露团队信息
+func checkOrgAccess(ctx context.Context, svcCtx *svc.ServiceContext, operatorId, orgId int64, access workspace.Access) (*model.OrganizationMember, error) {
+	member, err := findOrgMember(ctx, svcCtx, orgId, operatorId)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, fmt.Errorf("checkOrgAccess user %d is not a member of org %d: %w", operatorId, orgId, xerr.ErrOrgNotFound)
+	}
+	if !workspace.Allows(member.Role, access) {
+		return nil, fmt.Errorf("checkOrgAccess user %d with role %s cannot perform %d on org %d: %w",
+			operatorId, member.Role, access, orgId, xerr.ErrPermissionDenied)
+	}
+	return member, nil
+}
+
+func toOrganization(org *model.Organization, role string) *usercenter.Organization {
+	return &usercenter.Organization{
+		Id:         org.Id,
+		Name:       org.Name,
+		OwnerId:    org.OwnerId,
+		Role:       role,
+		CreateTime: org.CreateTime.Unix(),
+	}
+}
+
+func toOrgMember(member *model.OrganizationMember, user *model.User) *usercenter.OrgMember {
+	resp := &usercenter.OrgMember{
+		UserId:   member.UserId,
+		Role:     member.Role,
+		JoinTime: member.CreateTime.Unix(),
+	}
+	if user != nil {
+		resp.Mobile = user.Mobile
+		resp.Nickname = user.Nickname
+		resp.Avatar = user.Avatar
+	}
+	return resp
+}
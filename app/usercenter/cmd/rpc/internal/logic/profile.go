@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+)
+
+// 资料字段的最大字符数，与 user 表的列宽一致
+const (
+	maxNicknameLen     = 32
+	maxAvatarLen       = 255
+	maxDepartmentLen   = 64
+	maxOrganizationLen = 64
+	maxDocTypeLen      = 32
+	maxHeaderLen       = 64
+)
+
+// 头像允许的图片扩展名
+var avatarExts = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true}
+
+func toUserProfile(user *model.User) *usercenter.UserProfile {
+	return &usercenter.UserProfile{
+		UserId:         user.Id,
+		Mobile:         user.Mobile,
+		Nickname:       user.Nickname,
+		Avatar:         user.Avatar,
+		Department:     user.Department,
+		Organization:   user.Organization,
+		DefaultDocType: user.DefaultDocType,
+		HeaderTitle:    user.HeaderTitle,
+		HeaderDocNo:    user.HeaderDocNo,
+	}
+}
+
+// normalizeProfile 去除首尾空白并校验资料字段，返回的 User 只包含资料字段
+func normalizeProfile(in *usercenter.UserProfile) (*model.User, error) {
+	user := &model.User{
+		Id:             in.UserId,
+		Nickname:       strings.TrimSpace(in.Nickname),
+		Avatar:         strings.TrimSpace(in.Avatar),
+		Department:     strings.TrimSpace(in.Department),
+		Organization:   strings.TrimSpace(in.Organization),
+		DefaultDocType: strings.TrimSpace(in.DefaultDocType),
+		HeaderTitle:    strings.TrimSpace(in.HeaderTitle),
+		HeaderDocNo:    strings.TrimSpace(in.HeaderDocNo),
+	}
+
+	if user.Nickname == "" {
+		return nil, fmt.Errorf("nickname is empty")
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"nickname", user.Nickname, maxNicknameLen},
+		{"avatar", user.Avatar, maxAvatarLen},
+		{"department", user.Department, maxDepartmentLen},
+		{"organization", user.Organization, maxOrganizationLen},
+		{"defaultDocType", user.DefaultDocType, maxDocTypeLen},
+		{"headerTitle", user.HeaderTitle, maxHeaderLen},
+		{"headerDocNo", user.HeaderDocNo, maxHeaderLen},
+	} {
+		if utf8.RuneCountInString(f.value) > f.max {
+			return nil, fmt.Errorf("%s exceeds %d characters", f.name, f.max)
+		}
+	}
+
+	// 头像为文件上传接口返回的 url（上传目录下的文件名），只允许图片且不能包含路径
+	if user.Avatar != "" {
+		if strings.ContainsAny(user.Avatar, `/\`) || strings.Contains(user.Avatar, "..") {
+			return nil, fmt.Errorf("avatar %q must be an uploaded file url", user.Avatar)
+		}
+		if !avatarExts[strings.ToLower(path.Ext(user.Avatar))] {
+			return nil, fmt.Errorf("avatar %q is not an image", user.Avatar)
+		}
+	}
+	return user, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+)
+
+// loadRolesAndPerms 查询用户的角色与权限编码，没有角色记录的用户视为普通用户
+func loadRolesAndPerms(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) ([]string, []string, error) {
+	roleMap, err := svcCtx.RoleModel.FindCodesByUserIds(ctx, []int64{userId})
+	if err != nil {
+		return nil, nil, fmt.Errorf("loadRolesAndPerms find roles db err, userId:%d, err:%v: %w", userId, err, xerr.ErrDbError)
+	}
+	roles := roleMap[userId]
+	if len(roles) == 0 {
+		roles = []string{authz.RoleUser}
+	}
+
+	perms, err := svcCtx.PermissionModel.FindCodesByRoleCodes(ctx, roles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loadRolesAndPerms find permissions db err, userId:%d, err:%v: %w", userId, err, xerr.ErrDbError)
+	}
+	return roles, perms, nil
+}
+
+// checkOperatorPerm 以数据库中的最新数据校验操作者的账号状态与权限，
+// 避免仅依赖可能已过时的 JWT 声明
+func checkOperatorPerm(ctx context.Context, svcCtx *svc.ServiceContext, operatorId int64, perm string) error {
+	operator, err := svcCtx.UserModel.FindOne(ctx, operatorId)
+	if err != nil && err != model.ErrNotFound {
+		return fmt.Errorf("checkOperatorPerm find user db err, id:%d, err:%v: %w", operatorId, err, xerr.ErrDbError)
+	}
+	if operator == nil || operator.Status != model.UserStatusNormal {
+		return fmt.Errorf("checkOperatorPerm operator %d not found or disabled: %w", operatorId, xerr.ErrPermissionDenied)
+	}
+
+	_, perms, err := loadRolesAndPerms(ctx, svcCtx, operatorId)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(perms, perm) {
+		return fmt.Errorf("checkOperatorPerm operator %d lacks permission %s: %w", operatorId, perm, xerr.ErrPermissionDenied)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/session"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RefreshToken 使用刷新令牌换取新的 access token，刷新令牌同时轮换
+func (l *RefreshTokenLogic) RefreshToken(in *usercenter.RefreshTokenReq) (*usercenter.RefreshTokenResp, error) {
+	userId, sessionId, refreshToken, err := l.svcCtx.SessionStore.Rotate(l.ctx, in.RefreshToken)
+	switch {
+	case errors.Is(err, session.ErrInvalidRefreshToken):
+		return nil, fmt.Errorf("RefreshToken invalid refresh token: %w", xerr.ErrRefreshTokenExpire)
+	case errors.Is(err, session.ErrRefreshTokenReused):
+		l.Errorf("refresh token reused, session revoked, userId:%d, sessionId:%s", userId, sessionId)
+		return nil, fmt.Errorf("RefreshToken reused userId:%d, sessionId:%s: %w", userId, sessionId, xerr.ErrRefreshTokenReused)
+	case err != nil:
+		return nil, fmt.Errorf("RefreshToken rotate err:%v: %w", err, xerr.ErrServerCommon)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("RefreshToken find user db err, id:%d, err:%v: %w", userId, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("RefreshToken id:%d: %w", userId, xerr.ErrUserNotFound)
+	}
+	if user.Status != model.UserStatusNormal {
+		_ = l.svcCtx.SessionStore.Revoke(l.ctx, userId, sessionId)
+		return nil, fmt.Errorf("RefreshToken user disabled, id:%d: %w", userId, xerr.ErrUserDisabled)
+	}
+
+	// 沿用原会话签发 access token，角色与权限在此时重新加载
+	generateTokenLogic := NewGenerateTokenLogic(l.ctx, l.svcCtx)
+	tokenResp, err := generateTokenLogic.GenerateToken(&usercenter.GenerateTokenReq{
+		UserId:    userId,
+		SessionId: sessionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GenerateToken userId: %d: %w", userId, xerr.ErrGenerateToken)
+	}
+
+	return &usercenter.RefreshTokenResp{
+		AccessToken:   tokenResp.AccessToken,
+		AccessExpire:  tokenResp.AccessExpire,
+		RefreshAfter:  tokenResp.RefreshAfter,
+		RefreshToken:  refreshToken,
+		RefreshExpire: tokenResp.RefreshExpire,
+	}, nil
+}
@@ -7,7 +7,9 @@ import (
 	"document_agent/app/usercenter/cmd/rpc/internal/svc"
 	"document_agent/app/usercenter/cmd/rpc/usercenter"
 	"document_agent/app/usercenter/model"
+	"document_agent/pkg/password"
 	"document_agent/pkg/tool"
+	"document_agent/pkg/verifycode"
 	"document_agent/pkg/xerr"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -29,10 +31,17 @@ func NewRegisterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Register
 
 func (l *RegisterLogic) Register(in *usercenter.RegisterReq) (*usercenter.RegisterResp, error) {
 
-	if len(in.Mobile) != 11 {
+	if !tool.IsValidMobile(in.Mobile) {
 		return nil, fmt.Errorf("Register mobile:%s is not a valid mobile number: %w", in.Mobile, xerr.ErrInvalidParameter)
 	}
+	if err := password.Validate(in.Password); err != nil {
+		return nil, fmt.Errorf("Register mobile:%s, %v: %w", in.Mobile, err, xerr.ErrPasswordPolicy)
+	}
 
+	// 验证码证明注册人持有该手机号。已注册的手机号收不到注册验证码，因此先校验验证码，避免暴露手机号是否已注册
+	if err := verifySmsCode(l.ctx, l.svcCtx, verifycode.SceneRegister, in.Mobile, in.SmsCode); err != nil {
+		return nil, err
+	}
 	user1, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, in.Mobile)
 	if err != nil && err != model.ErrNotFound {
 		return nil, fmt.Errorf("mobile:%s, err:%v: %w", in.Mobile, err, xerr.ErrDbError)
@@ -43,11 +52,13 @@ func (l *RegisterLogic) Register(in *usercenter.RegisterReq) (*usercenter.Regist
 
 	user := new(model.User)
 	user.Mobile = in.Mobile
+	user.Status = model.UserStatusNormal
 	if len(in.Nickname) == 0 {
 		user.Nickname = "用户" + tool.RandomString(4, tool.Letters)
 	}
-	if len(in.Password) > 0 {
-		user.Password = tool.Md5ByString(in.Password)
+	user.Password, err = password.Hash(in.Password)
+	if err != nil {
+		return nil, fmt.Errorf("Register hash password err:%v: %w", err, xerr.ErrUserRegisterFailed)
 	}
 	insertResult, err := l.svcCtx.UserModel.Insert(l.ctx, user)
 	if err != nil {
@@ -68,8 +79,10 @@ func (l *RegisterLogic) Register(in *usercenter.RegisterReq) (*usercenter.Regist
 	}
 
 	return &usercenter.RegisterResp{
-		AccessToken:  tokenResp.AccessToken,
-		AccessExpire: tokenResp.AccessExpire,
-		RefreshAfter: tokenResp.RefreshAfter,
+		AccessToken:   tokenResp.AccessToken,
+		AccessExpire:  tokenResp.AccessExpire,
+		RefreshAfter:  tokenResp.RefreshAfter,
+		RefreshToken:  tokenResp.RefreshToken,
+		RefreshExpire: tokenResp.RefreshExpire,
 	}, nil
 }
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveOrgMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveOrgMemberLogic {
+	return &RemoveOrgMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveOrgMember 负责人移除成员，或成员自己退出团队；负责人不能被移除。
+// 成员此前在团队空间中创建的会话与文件仍归属于团队
+func (l *RemoveOrgMemberLogic) RemoveOrgMember(in *usercenter.RemoveOrgMemberReq) (*usercenter.RemoveOrgMemberResp, error) {
+	access := workspace.Manage
+	if in.UserId == in.OperatorId {
+		access = workspace.Read
+	}
+	if _, err := checkOrgAccess(l.ctx, l.svcCtx, in.OperatorId, in.OrgId, access); err != nil {
+		return nil, err
+	}
+
+	member, err := findOrgMember(l.ctx, l.svcCtx, in.OrgId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, fmt.Errorf("RemoveOrgMember user %d not in org %d: %w", in.UserId, in.OrgId, xerr.ErrOrgMemberNotFound)
+	}
+	if member.Role == workspace.RoleOwner {
+		return nil, fmt.Errorf("RemoveOrgMember user %d is owner of org %d: %w", in.UserId, in.OrgId, xerr.ErrOrgOwnerImmutable)
+	}
+
+	if err := l.svcCtx.OrgMemberModel.Delete(l.ctx, member.Id); err != nil {
+		return nil, fmt.Errorf("RemoveOrgMember delete db err, orgId:%d, userId:%d, err:%v: %w", in.OrgId, in.UserId, err, xerr.ErrDbError)
+	}
+
+	return &usercenter.RemoveOrgMemberResp{}, nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/password"
+	"document_agent/pkg/verifycode"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ResetPassword 通过短信验证码重置密码，重置后吊销该用户的全部会话
+func (l *ResetPasswordLogic) ResetPassword(in *usercenter.ResetPasswordReq) (*usercenter.ResetPasswordResp, error) {
+	if err := password.Validate(in.NewPassword); err != nil {
+		return nil, fmt.Errorf("ResetPassword mobile:%s, %v: %w", in.Mobile, err, xerr.ErrPasswordPolicy)
+	}
+	// 未注册的手机号不会收到验证码，这里先校验验证码，避免暴露手机号是否已注册
+	if err := verifySmsCode(l.ctx, l.svcCtx, verifycode.SceneResetPassword, in.Mobile, in.SmsCode); err != nil {
+		return nil, err
+	}
+
+	user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, in.Mobile)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("ResetPassword find user db err, mobile:%s, err:%v: %w", in.Mobile, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("ResetPassword mobile:%s: %w", in.Mobile, xerr.ErrUserNotFound)
+	}
+
+	hash, err := password.Hash(in.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("ResetPassword hash password err:%v: %w", err, xerr.ErrServerCommon)
+	}
+	if err := l.svcCtx.UserModel.UpdatePassword(l.ctx, user.Id, hash); err != nil {
+		return nil, fmt.Errorf("ResetPassword update db err, id:%d, err:%v: %w", user.Id, err, xerr.ErrDbError)
+	}
+
+	if err := l.svcCtx.SessionStore.RevokeAll(l.ctx, user.Id); err != nil {
+		l.Errorf("ResetPassword revoke sessions failed, id:%d, err:%v", user.Id, err)
+	}
+
+	return &usercenter.ResetPasswordResp{}, nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/clientinfo"
+	"document_agent/pkg/tool"
+	"document_agent/pkg/verifycode"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendSmsCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendSmsCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendSmsCodeLogic {
+	return &SendSmsCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendSmsCode 发送短信验证码。
+// 注册场景下手机号已注册、登录与重置密码场景下手机号未注册时不发送短信，但同样返回成功，避免借此探测手机号是否已注册
+func (l *SendSmsCodeLogic) SendSmsCode(in *usercenter.SendSmsCodeReq) (*usercenter.SendSmsCodeResp, error) {
+	if !tool.IsValidMobile(in.Mobile) {
+		return nil, fmt.Errorf("SendSmsCode mobile:%s is not a valid mobile number: %w", in.Mobile, xerr.ErrInvalidParameter)
+	}
+	if !verifycode.ValidScene(in.Scene) {
+		return nil, fmt.Errorf("SendSmsCode invalid scene:%s: %w", in.Scene, xerr.ErrInvalidParameter)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOneByMobile(l.ctx, in.Mobile)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("SendSmsCode find user db err, mobile:%s, err:%v: %w", in.Mobile, err, xerr.ErrDbError)
+	}
+	if in.Scene == verifycode.SceneRegister && user != nil {
+		l.Infof("SendSmsCode skip registered mobile:%s, scene:%s", in.Mobile, in.Scene)
+		return &usercenter.SendSmsCodeResp{}, nil
+	}
+	if in.Scene != verifycode.SceneRegister && user == nil {
+		l.Infof("SendSmsCode skip unregistered mobile:%s, scene:%s", in.Mobile, in.Scene)
+		return &usercenter.SendSmsCodeResp{}, nil
+	}
+
+	err = l.svcCtx.SmsCode.Send(l.ctx, in.Scene, in.Mobile, clientinfo.FromContext(l.ctx).IP)
+	if err != nil {
+		var limitErr *verifycode.SendLimitError
+		if errors.As(err, &limitErr) {
+			return nil, fmt.Errorf("SendSmsCode mobile:%s, %v: %w", in.Mobile, err,
+				xerr.WithRetryAfter(xerr.ErrSmsSendTooFrequent, limitErr.RetryAfter))
+		}
+		return nil, fmt.Errorf("SendSmsCode mobile:%s, scene:%s, err:%v: %w", in.Mobile, in.Scene, err, xerr.ErrSmsSendFailed)
+	}
+
+	return &usercenter.SendSmsCodeResp{}, nil
+}
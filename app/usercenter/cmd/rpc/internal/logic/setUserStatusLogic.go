@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/authz"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetUserStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserStatusLogic {
+	return &SetUserStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SetUserStatusLogic) SetUserStatus(in *usercenter.SetUserStatusReq) (*usercenter.SetUserStatusResp, error) {
+	if err := checkOperatorPerm(l.ctx, l.svcCtx, in.OperatorId, authz.PermUserManage); err != nil {
+		return nil, err
+	}
+	if in.Status != model.UserStatusNormal && in.Status != model.UserStatusDisabled {
+		return nil, fmt.Errorf("SetUserStatus invalid status:%d: %w", in.Status, xerr.ErrInvalidParameter)
+	}
+	if in.UserId == in.OperatorId && in.Status == model.UserStatusDisabled {
+		return nil, fmt.Errorf("SetUserStatus operator %d cannot disable itself: %w", in.OperatorId, xerr.ErrInvalidParameter)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("SetUserStatus find user db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("SetUserStatus id:%d: %w", in.UserId, xerr.ErrUserNotFound)
+	}
+
+	if err := l.svcCtx.UserModel.UpdateStatus(l.ctx, in.UserId, in.Status); err != nil {
+		return nil, fmt.Errorf("SetUserStatus update db err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrDbError)
+	}
+
+	// 禁用账号后立即踢下线，而不是等 access token 自然过期
+	if in.Status == model.UserStatusDisabled {
+		if err := l.svcCtx.SessionStore.RevokeAll(l.ctx, in.UserId); err != nil {
+			return nil, fmt.Errorf("SetUserStatus revoke sessions err, id:%d, err:%v: %w", in.UserId, err, xerr.ErrServerCommon)
+		}
+	}
+
+	return &usercenter.SetUserStatusResp{}, nil
+}
@@ -0,0 +1,26 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/pkg/verifycode"
+	"document_agent/pkg/xerr"
+)
+
+// verifySmsCode 校验短信验证码，并将 verifycode 的错误转换为业务错误码
+func verifySmsCode(ctx context.Context, svcCtx *svc.ServiceContext, scene, mobile, code string) error {
+	err := svcCtx.SmsCode.Verify(ctx, scene, mobile, code)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, verifycode.ErrInvalidCode):
+		return fmt.Errorf("verify sms code scene:%s, mobile:%s: %w", scene, mobile, xerr.ErrSmsCodeInvalid)
+	case errors.Is(err, verifycode.ErrTooManyAttempts):
+		return fmt.Errorf("verify sms code scene:%s, mobile:%s: %w", scene, mobile, xerr.ErrSmsCodeAttempts)
+	default:
+		return fmt.Errorf("verify sms code scene:%s, mobile:%s, err:%v: %w", scene, mobile, err, xerr.ErrServerCommon)
+	}
+}
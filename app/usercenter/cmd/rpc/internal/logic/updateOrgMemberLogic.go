@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/pkg/workspace"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOrgMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateOrgMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOrgMemberLogic {
+	return &UpdateOrgMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateOrgMember 负责人修改成员角色，负责人自身的角色不能修改
+func (l *UpdateOrgMemberLogic) UpdateOrgMember(in *usercenter.UpdateOrgMemberReq) (*usercenter.UpdateOrgMemberResp, error) {
+	if !workspace.ValidMemberRole(in.Role) {
+		return nil, fmt.Errorf("UpdateOrgMember invalid role %q: %w", in.Role, xerr.ErrInvalidParameter)
+	}
+	if _, err := checkOrgAccess(l.ctx, l.svcCtx, in.OperatorId, in.OrgId, workspace.Manage); err != nil {
+		return nil, err
+	}
+
+	member, err := findOrgMember(l.ctx, l.svcCtx, in.OrgId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, fmt.Errorf("UpdateOrgMember user %d not in org %d: %w", in.UserId, in.OrgId, xerr.ErrOrgMemberNotFound)
+	}
+	if member.Role == workspace.RoleOwner {
+		return nil, fmt.Errorf("UpdateOrgMember user %d is owner of org %d: %w", in.UserId, in.OrgId, xerr.ErrOrgOwnerImmutable)
+	}
+
+	if err := l.svcCtx.OrgMemberModel.UpdateRole(l.ctx, member.Id, in.Role); err != nil {
+		return nil, fmt.Errorf("UpdateOrgMember update db err, orgId:%d, userId:%d, err:%v: %w", in.OrgId, in.UserId, err, xerr.ErrDbError)
+	}
+
+	return &usercenter.UpdateOrgMemberResp{}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"document_agent/app/usercenter/cmd/rpc/internal/svc"
+	"document_agent/app/usercenter/cmd/rpc/usercenter"
+	"document_agent/app/usercenter/model"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateProfile 整体替换用户资料，返回更新后的资料
+func (l *UpdateProfileLogic) UpdateProfile(in *usercenter.UpdateProfileReq) (*usercenter.UpdateProfileResp, error) {
+	if in.Profile == nil {
+		return nil, fmt.Errorf("UpdateProfile profile is nil: %w", xerr.ErrRequestParam)
+	}
+	data, err := normalizeProfile(in.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateProfile userId:%d, %v: %w", in.Profile.UserId, err, xerr.ErrProfileInvalid)
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, data.Id)
+	if err != nil && err != model.ErrNotFound {
+		return nil, fmt.Errorf("UpdateProfile find user db err, id:%d, err:%v: %w", data.Id, err, xerr.ErrDbError)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("UpdateProfile id:%d: %w", data.Id, xerr.ErrUserNotFound)
+	}
+
+	if err := l.svcCtx.UserModel.UpdateProfile(l.ctx, data); err != nil {
+		return nil, fmt.Errorf("UpdateProfile update db err, id:%d, err:%v: %w", data.Id, err, xerr.ErrDbError)
+	}
+
+	data.Mobile = user.Mobile
+	return &usercenter.UpdateProfileResp{
+		Profile: toUserProfile(data),
+	}, nil
+}
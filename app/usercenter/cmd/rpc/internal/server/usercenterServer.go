@@ -38,7 +38,102 @@ func (s *UsercenterServer) GetUserInfo(ctx context.Context, in *pb.GetUserInfoRe
 	return l.GetUserInfo(in)
 }
 
+func (s *UsercenterServer) GetUserProfile(ctx context.Context, in *pb.GetUserProfileReq) (*pb.GetUserProfileResp, error) {
+	l := logic.NewGetUserProfileLogic(ctx, s.svcCtx)
+	return l.GetUserProfile(in)
+}
+
+func (s *UsercenterServer) UpdateProfile(ctx context.Context, in *pb.UpdateProfileReq) (*pb.UpdateProfileResp, error) {
+	l := logic.NewUpdateProfileLogic(ctx, s.svcCtx)
+	return l.UpdateProfile(in)
+}
+
+func (s *UsercenterServer) ChangePassword(ctx context.Context, in *pb.ChangePasswordReq) (*pb.ChangePasswordResp, error) {
+	l := logic.NewChangePasswordLogic(ctx, s.svcCtx)
+	return l.ChangePassword(in)
+}
+
+func (s *UsercenterServer) SendSmsCode(ctx context.Context, in *pb.SendSmsCodeReq) (*pb.SendSmsCodeResp, error) {
+	l := logic.NewSendSmsCodeLogic(ctx, s.svcCtx)
+	return l.SendSmsCode(in)
+}
+
+func (s *UsercenterServer) ResetPassword(ctx context.Context, in *pb.ResetPasswordReq) (*pb.ResetPasswordResp, error) {
+	l := logic.NewResetPasswordLogic(ctx, s.svcCtx)
+	return l.ResetPassword(in)
+}
+
 func (s *UsercenterServer) GenerateToken(ctx context.Context, in *pb.GenerateTokenReq) (*pb.GenerateTokenResp, error) {
 	l := logic.NewGenerateTokenLogic(ctx, s.svcCtx)
 	return l.GenerateToken(in)
 }
+
+func (s *UsercenterServer) RefreshToken(ctx context.Context, in *pb.RefreshTokenReq) (*pb.RefreshTokenResp, error) {
+	l := logic.NewRefreshTokenLogic(ctx, s.svcCtx)
+	return l.RefreshToken(in)
+}
+
+func (s *UsercenterServer) Logout(ctx context.Context, in *pb.LogoutReq) (*pb.LogoutResp, error) {
+	l := logic.NewLogoutLogic(ctx, s.svcCtx)
+	return l.Logout(in)
+}
+
+func (s *UsercenterServer) ListUsers(ctx context.Context, in *pb.ListUsersReq) (*pb.ListUsersResp, error) {
+	l := logic.NewListUsersLogic(ctx, s.svcCtx)
+	return l.ListUsers(in)
+}
+
+func (s *UsercenterServer) SetUserStatus(ctx context.Context, in *pb.SetUserStatusReq) (*pb.SetUserStatusResp, error) {
+	l := logic.NewSetUserStatusLogic(ctx, s.svcCtx)
+	return l.SetUserStatus(in)
+}
+
+func (s *UsercenterServer) AssignRoles(ctx context.Context, in *pb.AssignRolesReq) (*pb.AssignRolesResp, error) {
+	l := logic.NewAssignRolesLogic(ctx, s.svcCtx)
+	return l.AssignRoles(in)
+}
+
+func (s *UsercenterServer) ListRoles(ctx context.Context, in *pb.ListRolesReq) (*pb.ListRolesResp, error) {
+	l := logic.NewListRolesLogic(ctx, s.svcCtx)
+	return l.ListRoles(in)
+}
+
+func (s *UsercenterServer) CreateOrganization(ctx context.Context, in *pb.CreateOrganizationReq) (*pb.CreateOrganizationResp, error) {
+	l := logic.NewCreateOrganizationLogic(ctx, s.svcCtx)
+	return l.CreateOrganization(in)
+}
+
+func (s *UsercenterServer) ListOrganizations(ctx context.Context, in *pb.ListOrganizationsReq) (*pb.ListOrganizationsResp, error) {
+	l := logic.NewListOrganizationsLogic(ctx, s.svcCtx)
+	return l.ListOrganizations(in)
+}
+
+func (s *UsercenterServer) ListOrgMembers(ctx context.Context, in *pb.ListOrgMembersReq) (*pb.ListOrgMembersResp, error) {
+	l := logic.NewListOrgMembersLogic(ctx, s.svcCtx)
+	return l.ListOrgMembers(in)
+}
+
+func (s *UsercenterServer) AddOrgMember(ctx context.Context, in *pb.AddOrgMemberReq) (*pb.AddOrgMemberResp, error) {
+	l := logic.NewAddOrgMemberLogic(ctx, s.svcCtx)
+	return l.AddOrgMember(in)
+}
+
+func (s *UsercenterServer) UpdateOrgMember(ctx context.Context, in *pb.UpdateOrgMemberReq) (*pb.UpdateOrgMemberResp, error) {
+	l := logic.NewUpdateOrgMemberLogic(ctx, s.svcCtx)
+	return l.UpdateOrgMember(in)
+}
+
+func (s *UsercenterServer) RemoveOrgMember(ctx context.Context, in *pb.RemoveOrgMemberReq) (*pb.RemoveOrgMemberResp, error) {
+	l := logic.NewRemoveOrgMemberLogic(ctx, s.svcCtx)
+	return l.RemoveOrgMember(in)
+}
+
+func (s *UsercenterServer) GetWorkspaceRole(ctx context.Context, in *pb.GetWorkspaceRoleReq) (*pb.GetWorkspaceRoleResp, error) {
+	l := logic.NewGetWorkspaceRoleLogic(ctx, s.svcCtx)
+	return l.GetWorkspaceRole(in)
+}
+
+func (s *UsercenterServer) ListAvatars(ctx context.Context, in *pb.ListAvatarsReq) (*pb.ListAvatarsResp, error) {
+	l := logic.NewListAvatarsLogic(ctx, s.svcCtx)
+	return l.ListAvatars(in)
+}
@@ -3,20 +3,46 @@ package svc
 import (
 	"document_agent/app/usercenter/cmd/rpc/internal/config"
 	"document_agent/app/usercenter/model"
+	"document_agent/pkg/health"
+	"document_agent/pkg/loginguard"
+	"document_agent/pkg/session"
+	"document_agent/pkg/sms"
+	"document_agent/pkg/verifycode"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type ServiceContext struct {
-	Config    config.Config
-	UserModel model.UserModel
+	Config           config.Config
+	UserModel        model.UserModel
+	RoleModel        model.RoleModel
+	PermissionModel  model.PermissionModel
+	SecurityLogModel model.SecurityLogModel
+	OrgModel         model.OrganizationModel
+	OrgMemberModel   model.OrganizationMemberModel
+	SessionStore     *session.Store      // 刷新令牌与会话吊销
+	LoginGuard       *loginguard.Guard   // 登录防爆破
+	SmsCode          *verifycode.Manager // 短信验证码
+	Health           *health.Checker     // 依赖检查，结果写入 gRPC 就绪状态
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 
 	sqlConn := sqlx.NewMysql(c.DB.DataSource)
+	redisClient := redis.MustNewRedis(c.Redis.RedisConf)
 
 	return &ServiceContext{
-		Config:    c,
-		UserModel: model.NewUserModel(sqlConn),
+		Config:           c,
+		UserModel:        model.NewUserModel(sqlConn),
+		RoleModel:        model.NewRoleModel(sqlConn),
+		PermissionModel:  model.NewPermissionModel(sqlConn),
+		SecurityLogModel: model.NewSecurityLogModel(sqlConn),
+		OrgModel:         model.NewOrganizationModel(sqlConn),
+		OrgMemberModel:   model.NewOrganizationMemberModel(sqlConn),
+		SessionStore:     session.NewStore(redisClient, c.JwtAuth.AccessExpire, c.JwtAuth.RefreshExpire),
+		LoginGuard:       loginguard.NewGuard(redisClient, c.LoginGuard),
+		SmsCode:          verifycode.NewManager(redisClient, sms.MustNewSender(c.Sms), c.SmsCode),
+		Health:           health.NewChecker(c.HealthCheck, health.MySQL(sqlConn), health.Redis(redisClient), health.Etcd(c.Etcd.Hosts)),
 	}
 }
@@ -27,6 +27,8 @@ type User struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile"`
 	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	Status        int64                  `protobuf:"varint,4,opt,name=status,proto3" json:"status"` // 1 正常, 0 禁用
+	Roles         []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles"`    // 角色编码
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,31 +84,2355 @@ func (x *User) GetNickname() string {
 	return ""
 }
 
+func (x *User) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *User) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 用户资料，default* 与 header* 字段在生成、导出请求未指定时作为默认值
+type UserProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	Mobile         string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile"`
+	Nickname       string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	Avatar         string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar"` // 文件上传接口返回的 url
+	Department     string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department"`
+	Organization   string                 `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization"`
+	DefaultDocType string                 `protobuf:"bytes,7,opt,name=defaultDocType,proto3" json:"defaultDocType"` // 默认文章类型
+	HeaderTitle    string                 `protobuf:"bytes,8,opt,name=headerTitle,proto3" json:"headerTitle"`       // 导出公文默认版头，为空时使用 organization + "文件"
+	HeaderDocNo    string                 `protobuf:"bytes,9,opt,name=headerDocNo,proto3" json:"headerDocNo"`       // 导出公文默认发文字号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_usercenter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserProfile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserProfile) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *UserProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *UserProfile) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDefaultDocType() string {
+	if x != nil {
+		return x.DefaultDocType
+	}
+	return ""
+}
+
+func (x *UserProfile) GetHeaderTitle() string {
+	if x != nil {
+		return x.HeaderTitle
+	}
+	return ""
+}
+
+func (x *UserProfile) GetHeaderDocNo() string {
+	if x != nil {
+		return x.HeaderDocNo
+	}
+	return ""
+}
+
+// 团队，团队ID即 llmcenter 中的 workspace_id
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	OwnerId       int64                  `protobuf:"varint,3,opt,name=ownerId,proto3" json:"ownerId"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role"`              // 当前用户在团队中的角色: owner | editor | viewer
+	CreateTime    int64                  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"` // Unix 时间戳（秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_usercenter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Organization) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Organization) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Organization) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type OrgMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role"`          // owner | editor | viewer
+	JoinTime      int64                  `protobuf:"varint,6,opt,name=joinTime,proto3" json:"joinTime"` // Unix 时间戳（秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgMember) Reset() {
+	*x = OrgMember{}
+	mi := &file_usercenter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMember) ProtoMessage() {}
+
+func (x *OrgMember) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrgMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrgMember) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *OrgMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *OrgMember) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *OrgMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrgMember) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"` // 权限编码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_usercenter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Role) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Role) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 // req 、resp
 type RegisterReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	SmsCode       string                 `protobuf:"bytes,4,opt,name=smsCode,proto3" json:"smsCode"` // 场景为 register 的短信验证码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterReq) Reset() {
+	*x = RegisterReq{}
+	mi := &file_usercenter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReq) ProtoMessage() {}
+
+func (x *RegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
+func (*RegisterReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetSmsCode() string {
+	if x != nil {
+		return x.SmsCode
+	}
+	return ""
+}
+
+type RegisterResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
+	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
+	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	RefreshExpire int64                  `protobuf:"varint,5,opt,name=refreshExpire,proto3" json:"refreshExpire"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResp) Reset() {
+	*x = RegisterResp{}
+	mi := &file_usercenter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp) ProtoMessage() {}
+
+func (x *RegisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp.ProtoReflect.Descriptor instead.
+func (*RegisterResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegisterResp) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RegisterResp) GetAccessExpire() int64 {
+	if x != nil {
+		return x.AccessExpire
+	}
+	return 0
+}
+
+func (x *RegisterResp) GetRefreshAfter() int64 {
+	if x != nil {
+		return x.RefreshAfter
+	}
+	return 0
+}
+
+func (x *RegisterResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RegisterResp) GetRefreshExpire() int64 {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return 0
+}
+
+type LoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	SmsCode       string                 `protobuf:"bytes,3,opt,name=smsCode,proto3" json:"smsCode"` // 非空时使用短信验证码登录（场景为 login），忽略 password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	mi := &file_usercenter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReq) ProtoMessage() {}
+
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoginReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *LoginReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginReq) GetSmsCode() string {
+	if x != nil {
+		return x.SmsCode
+	}
+	return ""
+}
+
+type LoginResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
+	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
+	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	RefreshExpire int64                  `protobuf:"varint,5,opt,name=refreshExpire,proto3" json:"refreshExpire"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResp) Reset() {
+	*x = LoginResp{}
+	mi := &file_usercenter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResp) ProtoMessage() {}
+
+func (x *LoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
+func (*LoginResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginResp) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetAccessExpire() int64 {
+	if x != nil {
+		return x.AccessExpire
+	}
+	return 0
+}
+
+func (x *LoginResp) GetRefreshAfter() int64 {
+	if x != nil {
+		return x.RefreshAfter
+	}
+	return 0
+}
+
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetRefreshExpire() int64 {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return 0
+}
+
+type SendSmsCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
+	Scene         string                 `protobuf:"bytes,2,opt,name=scene,proto3" json:"scene"` // register | login | reset_password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSmsCodeReq) Reset() {
+	*x = SendSmsCodeReq{}
+	mi := &file_usercenter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSmsCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSmsCodeReq) ProtoMessage() {}
+
+func (x *SendSmsCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSmsCodeReq.ProtoReflect.Descriptor instead.
+func (*SendSmsCodeReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SendSmsCodeReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *SendSmsCodeReq) GetScene() string {
+	if x != nil {
+		return x.Scene
+	}
+	return ""
+}
+
+type SendSmsCodeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSmsCodeResp) Reset() {
+	*x = SendSmsCodeResp{}
+	mi := &file_usercenter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSmsCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSmsCodeResp) ProtoMessage() {}
+
+func (x *SendSmsCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSmsCodeResp.ProtoReflect.Descriptor instead.
+func (*SendSmsCodeResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{10}
+}
+
+type ResetPasswordReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
+	SmsCode       string                 `protobuf:"bytes,2,opt,name=smsCode,proto3" json:"smsCode"` // 场景为 reset_password 的短信验证码
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	mi := &file_usercenter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordReq) ProtoMessage() {}
+
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResetPasswordReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetSmsCode() string {
+	if x != nil {
+		return x.SmsCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResp) Reset() {
+	*x = ResetPasswordResp{}
+	mi := &file_usercenter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResp) ProtoMessage() {}
+
+func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{12}
+}
+
+type ChangePasswordReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
+	mi := &file_usercenter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReq) ProtoMessage() {}
+
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangePasswordReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangePasswordReq) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
+	mi := &file_usercenter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResp) ProtoMessage() {}
+
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{14}
+}
+
+type GetUserInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInfoReq) Reset() {
+	*x = GetUserInfoReq{}
+	mi := &file_usercenter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoReq) ProtoMessage() {}
+
+func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
+func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserInfoReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetUserInfoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInfoResp) Reset() {
+	*x = GetUserInfoResp{}
+	mi := &file_usercenter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoResp) ProtoMessage() {}
+
+func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserInfoResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserProfileReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileReq) Reset() {
+	*x = GetUserProfileReq{}
+	mi := &file_usercenter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileReq) ProtoMessage() {}
+
+func (x *GetUserProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileReq.ProtoReflect.Descriptor instead.
+func (*GetUserProfileReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserProfileReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserProfileResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResp) Reset() {
+	*x = GetUserProfileResp{}
+	mi := &file_usercenter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResp) ProtoMessage() {}
+
+func (x *GetUserProfileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResp.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserProfileResp) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// 整体替换资料字段，userId 与 mobile 以外的字段均会写入
+type UpdateProfileReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileReq) Reset() {
+	*x = UpdateProfileReq{}
+	mi := &file_usercenter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileReq) ProtoMessage() {}
+
+func (x *UpdateProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileReq.ProtoReflect.Descriptor instead.
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateProfileReq) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResp) Reset() {
+	*x = UpdateProfileResp{}
+	mi := &file_usercenter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResp) ProtoMessage() {}
+
+func (x *UpdateProfileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResp.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateProfileResp) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GenerateTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId"` // 为空时创建新会话并签发刷新令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTokenReq) Reset() {
+	*x = GenerateTokenReq{}
+	mi := &file_usercenter_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTokenReq) ProtoMessage() {}
+
+func (x *GenerateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTokenReq.ProtoReflect.Descriptor instead.
+func (*GenerateTokenReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GenerateTokenReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GenerateTokenReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GenerateTokenResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
+	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
+	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"` // 仅在创建新会话时返回
+	RefreshExpire int64                  `protobuf:"varint,5,opt,name=refreshExpire,proto3" json:"refreshExpire"`
+	SessionId     string                 `protobuf:"bytes,6,opt,name=sessionId,proto3" json:"sessionId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTokenResp) Reset() {
+	*x = GenerateTokenResp{}
+	mi := &file_usercenter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTokenResp) ProtoMessage() {}
+
+func (x *GenerateTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTokenResp.ProtoReflect.Descriptor instead.
+func (*GenerateTokenResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GenerateTokenResp) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GenerateTokenResp) GetAccessExpire() int64 {
+	if x != nil {
+		return x.AccessExpire
+	}
+	return 0
+}
+
+func (x *GenerateTokenResp) GetRefreshAfter() int64 {
+	if x != nil {
+		return x.RefreshAfter
+	}
+	return 0
+}
+
+func (x *GenerateTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *GenerateTokenResp) GetRefreshExpire() int64 {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return 0
+}
+
+func (x *GenerateTokenResp) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RefreshTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
+	mi := &file_usercenter_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenReq) ProtoMessage() {}
+
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
+	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
+	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	RefreshExpire int64                  `protobuf:"varint,5,opt,name=refreshExpire,proto3" json:"refreshExpire"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResp) Reset() {
+	*x = RefreshTokenResp{}
+	mi := &file_usercenter_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResp) ProtoMessage() {}
+
+func (x *RefreshTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RefreshTokenResp) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetAccessExpire() int64 {
+	if x != nil {
+		return x.AccessExpire
+	}
+	return 0
+}
+
+func (x *RefreshTokenResp) GetRefreshAfter() int64 {
+	if x != nil {
+		return x.RefreshAfter
+	}
+	return 0
+}
+
+func (x *RefreshTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetRefreshExpire() int64 {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return 0
+}
+
+type LogoutReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId"`
+	AllDevices    bool                   `protobuf:"varint,3,opt,name=allDevices,proto3" json:"allDevices"` // 退出所有设备
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
+	mi := &file_usercenter_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutReq) ProtoMessage() {}
+
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LogoutReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogoutReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LogoutReq) GetAllDevices() bool {
+	if x != nil {
+		return x.AllDevices
+	}
+	return false
+}
+
+type LogoutResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResp) Reset() {
+	*x = LogoutResp{}
+	mi := &file_usercenter_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResp) ProtoMessage() {}
+
+func (x *LogoutResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResp.ProtoReflect.Descriptor instead.
+func (*LogoutResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{26}
+}
+
+// 管理员接口：operatorId 为发起操作的管理员，RPC 会再次校验其 user:manage 权限
+type ListUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword"` // 按手机号或昵称模糊匹配
+	Page          int64                  `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+	PageSize      int64                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersReq) Reset() {
+	*x = ListUsersReq{}
+	mi := &file_usercenter_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersReq) ProtoMessage() {}
+
+func (x *ListUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersReq.ProtoReflect.Descriptor instead.
+func (*ListUsersReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListUsersReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListUsersReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListUsersReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersReq) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUsersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List          []*User                `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResp) Reset() {
+	*x = ListUsersResp{}
+	mi := &file_usercenter_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResp) ProtoMessage() {}
+
+func (x *ListUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResp.ProtoReflect.Descriptor instead.
+func (*ListUsersResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListUsersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUsersResp) GetList() []*User {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SetUserStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId"`
+	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status"` // 1 启用, 0 禁用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserStatusReq) Reset() {
+	*x = SetUserStatusReq{}
+	mi := &file_usercenter_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserStatusReq) ProtoMessage() {}
+
+func (x *SetUserStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserStatusReq.ProtoReflect.Descriptor instead.
+func (*SetUserStatusReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetUserStatusReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SetUserStatusReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUserStatusReq) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type SetUserStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserStatusResp) Reset() {
+	*x = SetUserStatusResp{}
+	mi := &file_usercenter_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserStatusResp) ProtoMessage() {}
+
+func (x *SetUserStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserStatusResp.ProtoReflect.Descriptor instead.
+func (*SetUserStatusResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{30}
+}
+
+type AssignRolesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles"` // 角色编码，整体替换用户现有角色
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRolesReq) Reset() {
+	*x = AssignRolesReq{}
+	mi := &file_usercenter_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesReq) ProtoMessage() {}
+
+func (x *AssignRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesReq.ProtoReflect.Descriptor instead.
+func (*AssignRolesReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AssignRolesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AssignRolesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AssignRolesReq) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type AssignRolesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRolesResp) Reset() {
+	*x = AssignRolesResp{}
+	mi := &file_usercenter_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesResp) ProtoMessage() {}
+
+func (x *AssignRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesResp.ProtoReflect.Descriptor instead.
+func (*AssignRolesResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{32}
+}
+
+type ListRolesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesReq) Reset() {
+	*x = ListRolesReq{}
+	mi := &file_usercenter_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesReq) ProtoMessage() {}
+
+func (x *ListRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesReq.ProtoReflect.Descriptor instead.
+func (*ListRolesReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListRolesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type ListRolesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Role                `protobuf:"bytes,1,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResp) Reset() {
+	*x = ListRolesResp{}
+	mi := &file_usercenter_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResp) ProtoMessage() {}
+
+func (x *ListRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResp.ProtoReflect.Descriptor instead.
+func (*ListRolesResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListRolesResp) GetList() []*Role {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 团队接口：operatorId 为发起操作的用户，管理成员需为团队负责人
+type CreateOrganizationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationReq) Reset() {
+	*x = CreateOrganizationReq{}
+	mi := &file_usercenter_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationReq) ProtoMessage() {}
+
+func (x *CreateOrganizationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationReq.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateOrganizationReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateOrganizationReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOrganizationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResp) Reset() {
+	*x = CreateOrganizationResp{}
+	mi := &file_usercenter_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResp) ProtoMessage() {}
+
+func (x *CreateOrganizationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResp.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateOrganizationResp) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type ListOrganizationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsReq) Reset() {
+	*x = ListOrganizationsReq{}
+	mi := &file_usercenter_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsReq) ProtoMessage() {}
+
+func (x *ListOrganizationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsReq.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListOrganizationsReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type ListOrganizationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Organization        `protobuf:"bytes,1,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResp) Reset() {
+	*x = ListOrganizationsResp{}
+	mi := &file_usercenter_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResp) ProtoMessage() {}
+
+func (x *ListOrganizationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResp.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListOrganizationsResp) GetList() []*Organization {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ListOrgMembersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	OrgId         int64                  `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersReq) Reset() {
+	*x = ListOrgMembersReq{}
+	mi := &file_usercenter_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersReq) ProtoMessage() {}
+
+func (x *ListOrgMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersReq.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListOrgMembersReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListOrgMembersReq) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+type ListOrgMembersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*OrgMember           `protobuf:"bytes,1,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersResp) Reset() {
+	*x = ListOrgMembersResp{}
+	mi := &file_usercenter_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersResp) ProtoMessage() {}
+
+func (x *ListOrgMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersResp.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListOrgMembersResp) GetList() []*OrgMember {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AddOrgMemberReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	OrgId         int64                  `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	Mobile        string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile"` // 按手机号添加已注册用户
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role"`     // editor | viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrgMemberReq) Reset() {
+	*x = AddOrgMemberReq{}
+	mi := &file_usercenter_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrgMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrgMemberReq) ProtoMessage() {}
+
+func (x *AddOrgMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrgMemberReq.ProtoReflect.Descriptor instead.
+func (*AddOrgMemberReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddOrgMemberReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AddOrgMemberReq) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *AddOrgMemberReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AddOrgMemberReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddOrgMemberResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrgMember             `protobuf:"bytes,1,opt,name=member,proto3" json:"member"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrgMemberResp) Reset() {
+	*x = AddOrgMemberResp{}
+	mi := &file_usercenter_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrgMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrgMemberResp) ProtoMessage() {}
+
+func (x *AddOrgMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrgMemberResp.ProtoReflect.Descriptor instead.
+func (*AddOrgMemberResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddOrgMemberResp) GetMember() *OrgMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateOrgMemberReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	OrgId         int64                  `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role"` // editor | viewer
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterReq) Reset() {
-	*x = RegisterReq{}
-	mi := &file_usercenter_proto_msgTypes[1]
+func (x *UpdateOrgMemberReq) Reset() {
+	*x = UpdateOrgMemberReq{}
+	mi := &file_usercenter_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterReq) String() string {
+func (x *UpdateOrgMemberReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterReq) ProtoMessage() {}
+func (*UpdateOrgMemberReq) ProtoMessage() {}
 
-func (x *RegisterReq) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[1]
+func (x *UpdateOrgMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,56 +2443,60 @@ func (x *RegisterReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
-func (*RegisterReq) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateOrgMemberReq.ProtoReflect.Descriptor instead.
+func (*UpdateOrgMemberReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RegisterReq) GetMobile() string {
+func (x *UpdateOrgMemberReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.Mobile
+		return x.OperatorId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterReq) GetNickname() string {
+func (x *UpdateOrgMemberReq) GetOrgId() int64 {
 	if x != nil {
-		return x.Nickname
+		return x.OrgId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterReq) GetPassword() string {
+func (x *UpdateOrgMemberReq) GetUserId() int64 {
 	if x != nil {
-		return x.Password
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateOrgMemberReq) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-type RegisterResp struct {
+type UpdateOrgMemberResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
-	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
-	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResp) Reset() {
-	*x = RegisterResp{}
-	mi := &file_usercenter_proto_msgTypes[2]
+func (x *UpdateOrgMemberResp) Reset() {
+	*x = UpdateOrgMemberResp{}
+	mi := &file_usercenter_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResp) String() string {
+func (x *UpdateOrgMemberResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResp) ProtoMessage() {}
+func (*UpdateOrgMemberResp) ProtoMessage() {}
 
-func (x *RegisterResp) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[2]
+func (x *UpdateOrgMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,55 +2507,93 @@ func (x *RegisterResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResp.ProtoReflect.Descriptor instead.
-func (*RegisterResp) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UpdateOrgMemberResp.ProtoReflect.Descriptor instead.
+func (*UpdateOrgMemberResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RegisterResp) GetAccessToken() string {
+// 负责人可移除其他成员，成员也可以移除自己（退出团队）
+type RemoveOrgMemberReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operatorId,proto3" json:"operatorId"`
+	OrgId         int64                  `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrgMemberReq) Reset() {
+	*x = RemoveOrgMemberReq{}
+	mi := &file_usercenter_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrgMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberReq) ProtoMessage() {}
+
+func (x *RemoveOrgMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[45]
 	if x != nil {
-		return x.AccessToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RegisterResp) GetAccessExpire() int64 {
+// Deprecated: Use RemoveOrgMemberReq.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveOrgMemberReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.AccessExpire
+		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *RegisterResp) GetRefreshAfter() int64 {
+func (x *RemoveOrgMemberReq) GetOrgId() int64 {
 	if x != nil {
-		return x.RefreshAfter
+		return x.OrgId
 	}
 	return 0
 }
 
-type LoginReq struct {
+func (x *RemoveOrgMemberReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveOrgMemberResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginReq) Reset() {
-	*x = LoginReq{}
-	mi := &file_usercenter_proto_msgTypes[3]
+func (x *RemoveOrgMemberResp) Reset() {
+	*x = RemoveOrgMemberResp{}
+	mi := &file_usercenter_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginReq) String() string {
+func (x *RemoveOrgMemberResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReq) ProtoMessage() {}
+func (*RemoveOrgMemberResp) ProtoMessage() {}
 
-func (x *LoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[3]
+func (x *RemoveOrgMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,49 +2604,35 @@ func (x *LoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
-func (*LoginReq) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *LoginReq) GetMobile() string {
-	if x != nil {
-		return x.Mobile
-	}
-	return ""
-}
-
-func (x *LoginReq) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use RemoveOrgMemberResp.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{46}
 }
 
-type LoginResp struct {
+// 查询用户在团队空间中的角色，供 llmcenter 校验 workspace 访问权限
+type GetWorkspaceRoleReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
-	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
-	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspaceId,proto3" json:"workspaceId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResp) Reset() {
-	*x = LoginResp{}
-	mi := &file_usercenter_proto_msgTypes[4]
+func (x *GetWorkspaceRoleReq) Reset() {
+	*x = GetWorkspaceRoleReq{}
+	mi := &file_usercenter_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResp) String() string {
+func (x *GetWorkspaceRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResp) ProtoMessage() {}
+func (*GetWorkspaceRoleReq) ProtoMessage() {}
 
-func (x *LoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[4]
+func (x *GetWorkspaceRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,54 +2643,47 @@ func (x *LoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
-func (*LoginResp) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *LoginResp) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
+// Deprecated: Use GetWorkspaceRoleReq.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRoleReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *LoginResp) GetAccessExpire() int64 {
+func (x *GetWorkspaceRoleReq) GetUserId() int64 {
 	if x != nil {
-		return x.AccessExpire
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LoginResp) GetRefreshAfter() int64 {
+func (x *GetWorkspaceRoleReq) GetWorkspaceId() int64 {
 	if x != nil {
-		return x.RefreshAfter
+		return x.WorkspaceId
 	}
 	return 0
 }
 
-type GetUserInfoReq struct {
+type GetWorkspaceRoleResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role"` // 非成员或团队不存在时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoReq) Reset() {
-	*x = GetUserInfoReq{}
-	mi := &file_usercenter_proto_msgTypes[5]
+func (x *GetWorkspaceRoleResp) Reset() {
+	*x = GetWorkspaceRoleResp{}
+	mi := &file_usercenter_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoReq) String() string {
+func (x *GetWorkspaceRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoReq) ProtoMessage() {}
+func (*GetWorkspaceRoleResp) ProtoMessage() {}
 
-func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[5]
+func (x *GetWorkspaceRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,40 +2694,42 @@ func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
-func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetWorkspaceRoleResp.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRoleResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetUserInfoReq) GetId() int64 {
+func (x *GetWorkspaceRoleResp) GetRole() string {
 	if x != nil {
-		return x.Id
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-type GetUserInfoResp struct {
+// 分页列出已设置头像的用户，供文件清理保留头像文件
+type ListAvatarsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	AfterUserId   int64                  `protobuf:"varint,1,opt,name=afterUserId,proto3" json:"afterUserId"` // 上一页最后一个用户的 id，首页为 0
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoResp) Reset() {
-	*x = GetUserInfoResp{}
-	mi := &file_usercenter_proto_msgTypes[6]
+func (x *ListAvatarsReq) Reset() {
+	*x = ListAvatarsReq{}
+	mi := &file_usercenter_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoResp) String() string {
+func (x *ListAvatarsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoResp) ProtoMessage() {}
+func (*ListAvatarsReq) ProtoMessage() {}
 
-func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[6]
+func (x *ListAvatarsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,40 +2740,48 @@ func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
-func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListAvatarsReq.ProtoReflect.Descriptor instead.
+func (*ListAvatarsReq) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetUserInfoResp) GetUser() *User {
+func (x *ListAvatarsReq) GetAfterUserId() int64 {
 	if x != nil {
-		return x.User
+		return x.AfterUserId
 	}
-	return nil
+	return 0
 }
 
-type GenerateTokenReq struct {
+func (x *ListAvatarsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserAvatar struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId"`
+	Avatar        string                 `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar"` // 文件上传接口返回的 url（上传目录下的文件名）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTokenReq) Reset() {
-	*x = GenerateTokenReq{}
-	mi := &file_usercenter_proto_msgTypes[7]
+func (x *UserAvatar) Reset() {
+	*x = UserAvatar{}
+	mi := &file_usercenter_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenReq) String() string {
+func (x *UserAvatar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenReq) ProtoMessage() {}
+func (*UserAvatar) ProtoMessage() {}
 
-func (x *GenerateTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[7]
+func (x *UserAvatar) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,42 +2792,47 @@ func (x *GenerateTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenReq.ProtoReflect.Descriptor instead.
-func (*GenerateTokenReq) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UserAvatar.ProtoReflect.Descriptor instead.
+func (*UserAvatar) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GenerateTokenReq) GetUserId() int64 {
+func (x *UserAvatar) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GenerateTokenResp struct {
+func (x *UserAvatar) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type ListAvatarsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken"`
-	AccessExpire  int64                  `protobuf:"varint,2,opt,name=accessExpire,proto3" json:"accessExpire"`
-	RefreshAfter  int64                  `protobuf:"varint,3,opt,name=refreshAfter,proto3" json:"refreshAfter"`
+	List          []*UserAvatar          `protobuf:"bytes,1,rep,name=list,proto3" json:"list"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTokenResp) Reset() {
-	*x = GenerateTokenResp{}
-	mi := &file_usercenter_proto_msgTypes[8]
+func (x *ListAvatarsResp) Reset() {
+	*x = ListAvatarsResp{}
+	mi := &file_usercenter_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenResp) String() string {
+func (x *ListAvatarsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenResp) ProtoMessage() {}
+func (*ListAvatarsResp) ProtoMessage() {}
 
-func (x *GenerateTokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_usercenter_proto_msgTypes[8]
+func (x *ListAvatarsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usercenter_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,72 +2843,248 @@ func (x *GenerateTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenResp.ProtoReflect.Descriptor instead.
-func (*GenerateTokenResp) Descriptor() ([]byte, []int) {
-	return file_usercenter_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GenerateTokenResp) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *GenerateTokenResp) GetAccessExpire() int64 {
-	if x != nil {
-		return x.AccessExpire
-	}
-	return 0
+// Deprecated: Use ListAvatarsResp.ProtoReflect.Descriptor instead.
+func (*ListAvatarsResp) Descriptor() ([]byte, []int) {
+	return file_usercenter_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GenerateTokenResp) GetRefreshAfter() int64 {
+func (x *ListAvatarsResp) GetList() []*UserAvatar {
 	if x != nil {
-		return x.RefreshAfter
+		return x.List
 	}
-	return 0
+	return nil
 }
 
 var File_usercenter_proto protoreflect.FileDescriptor
 
 const file_usercenter_proto_rawDesc = "" +
 	"\n" +
-	"\x10usercenter.proto\x12\x02pb\"J\n" +
+	"\x10usercenter.proto\x12\x02pb\"x\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x1a\n" +
-	"\bnickname\x18\x03 \x01(\tR\bnickname\"]\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x03R\x06status\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\"\xa1\x02\n" +
+	"\vUserProfile\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1e\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tR\n" +
+	"department\x12\"\n" +
+	"\forganization\x18\x06 \x01(\tR\forganization\x12&\n" +
+	"\x0edefaultDocType\x18\a \x01(\tR\x0edefaultDocType\x12 \n" +
+	"\vheaderTitle\x18\b \x01(\tR\vheaderTitle\x12 \n" +
+	"\vheaderDocNo\x18\t \x01(\tR\vheaderDocNo\"\x80\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aownerId\x18\x03 \x01(\x03R\aownerId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x05 \x01(\x03R\n" +
+	"createTime\"\x9f\x01\n" +
+	"\tOrgMember\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1a\n" +
+	"\bjoinTime\x18\x06 \x01(\x03R\bjoinTime\"`\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"w\n" +
 	"\vRegisterReq\x12\x16\n" +
 	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"x\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x18\n" +
+	"\asmsCode\x18\x04 \x01(\tR\asmsCode\"\xc2\x01\n" +
 	"\fRegisterResp\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
 	"\faccessExpire\x18\x02 \x01(\x03R\faccessExpire\x12\"\n" +
-	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\">\n" +
+	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\x12$\n" +
+	"\rrefreshExpire\x18\x05 \x01(\x03R\rrefreshExpire\"X\n" +
 	"\bLoginReq\x12\x16\n" +
 	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"u\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x18\n" +
+	"\asmsCode\x18\x03 \x01(\tR\asmsCode\"\xbf\x01\n" +
 	"\tLoginResp\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
 	"\faccessExpire\x18\x02 \x01(\x03R\faccessExpire\x12\"\n" +
-	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\" \n" +
+	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\x12$\n" +
+	"\rrefreshExpire\x18\x05 \x01(\x03R\rrefreshExpire\">\n" +
+	"\x0eSendSmsCodeReq\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x14\n" +
+	"\x05scene\x18\x02 \x01(\tR\x05scene\"\x11\n" +
+	"\x0fSendSmsCodeResp\"f\n" +
+	"\x10ResetPasswordReq\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x18\n" +
+	"\asmsCode\x18\x02 \x01(\tR\asmsCode\x12 \n" +
+	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"\x13\n" +
+	"\x11ResetPasswordResp\"o\n" +
+	"\x11ChangePasswordReq\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\voldPassword\x18\x02 \x01(\tR\voldPassword\x12 \n" +
+	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"\x14\n" +
+	"\x12ChangePasswordResp\" \n" +
 	"\x0eGetUserInfoReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"/\n" +
 	"\x0fGetUserInfoResp\x12\x1c\n" +
-	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"*\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"+\n" +
+	"\x11GetUserProfileReq\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"?\n" +
+	"\x12GetUserProfileResp\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.pb.UserProfileR\aprofile\"=\n" +
+	"\x10UpdateProfileReq\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.pb.UserProfileR\aprofile\">\n" +
+	"\x11UpdateProfileResp\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.pb.UserProfileR\aprofile\"H\n" +
 	"\x10GenerateTokenReq\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\"}\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tsessionId\x18\x02 \x01(\tR\tsessionId\"\xe5\x01\n" +
 	"\x11GenerateTokenResp\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
 	"\faccessExpire\x18\x02 \x01(\x03R\faccessExpire\x12\"\n" +
-	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter2\xd7\x01\n" +
+	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\x12$\n" +
+	"\rrefreshExpire\x18\x05 \x01(\x03R\rrefreshExpire\x12\x1c\n" +
+	"\tsessionId\x18\x06 \x01(\tR\tsessionId\"5\n" +
+	"\x0fRefreshTokenReq\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\"\xc6\x01\n" +
+	"\x10RefreshTokenResp\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
+	"\faccessExpire\x18\x02 \x01(\x03R\faccessExpire\x12\"\n" +
+	"\frefreshAfter\x18\x03 \x01(\x03R\frefreshAfter\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\x12$\n" +
+	"\rrefreshExpire\x18\x05 \x01(\x03R\rrefreshExpire\"a\n" +
+	"\tLogoutReq\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tsessionId\x18\x02 \x01(\tR\tsessionId\x12\x1e\n" +
+	"\n" +
+	"allDevices\x18\x03 \x01(\bR\n" +
+	"allDevices\"\f\n" +
+	"\n" +
+	"LogoutResp\"x\n" +
+	"\fListUsersReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x03R\bpageSize\"C\n" +
+	"\rListUsersResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1c\n" +
+	"\x04list\x18\x02 \x03(\v2\b.pb.UserR\x04list\"b\n" +
+	"\x10SetUserStatusReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x03R\x06status\"\x13\n" +
+	"\x11SetUserStatusResp\"^\n" +
+	"\x0eAssignRolesReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"\x11\n" +
+	"\x0fAssignRolesResp\".\n" +
+	"\fListRolesReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\"-\n" +
+	"\rListRolesResp\x12\x1c\n" +
+	"\x04list\x18\x01 \x03(\v2\b.pb.RoleR\x04list\"K\n" +
+	"\x15CreateOrganizationReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"N\n" +
+	"\x16CreateOrganizationResp\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.pb.OrganizationR\forganization\"6\n" +
+	"\x14ListOrganizationsReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\"=\n" +
+	"\x15ListOrganizationsResp\x12$\n" +
+	"\x04list\x18\x01 \x03(\v2\x10.pb.OrganizationR\x04list\"I\n" +
+	"\x11ListOrgMembersReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\"7\n" +
+	"\x12ListOrgMembersResp\x12!\n" +
+	"\x04list\x18\x01 \x03(\v2\r.pb.OrgMemberR\x04list\"s\n" +
+	"\x0fAddOrgMemberReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"9\n" +
+	"\x10AddOrgMemberResp\x12%\n" +
+	"\x06member\x18\x01 \x01(\v2\r.pb.OrgMemberR\x06member\"v\n" +
+	"\x12UpdateOrgMemberReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\x15\n" +
+	"\x13UpdateOrgMemberResp\"b\n" +
+	"\x12RemoveOrgMemberReq\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\x03R\x06userId\"\x15\n" +
+	"\x13RemoveOrgMemberResp\"O\n" +
+	"\x13GetWorkspaceRoleReq\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\vworkspaceId\x18\x02 \x01(\x03R\vworkspaceId\"*\n" +
+	"\x14GetWorkspaceRoleResp\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"H\n" +
+	"\x0eListAvatarsReq\x12 \n" +
+	"\vafterUserId\x18\x01 \x01(\x03R\vafterUserId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"<\n" +
+	"\n" +
+	"UserAvatar\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06avatar\x18\x02 \x01(\tR\x06avatar\"5\n" +
+	"\x0fListAvatarsResp\x12\"\n" +
+	"\x04list\x18\x01 \x03(\v2\x0e.pb.UserAvatarR\x04list2\xe5\n" +
+	"\n" +
 	"\n" +
 	"usercenter\x12$\n" +
 	"\x05login\x12\f.pb.LoginReq\x1a\r.pb.LoginResp\x12-\n" +
 	"\bregister\x12\x0f.pb.RegisterReq\x1a\x10.pb.RegisterResp\x126\n" +
-	"\vgetUserInfo\x12\x12.pb.GetUserInfoReq\x1a\x13.pb.GetUserInfoResp\x12<\n" +
-	"\rgenerateToken\x12\x14.pb.GenerateTokenReq\x1a\x15.pb.GenerateTokenRespB\x06Z\x04./pbb\x06proto3"
+	"\vgetUserInfo\x12\x12.pb.GetUserInfoReq\x1a\x13.pb.GetUserInfoResp\x12?\n" +
+	"\x0egetUserProfile\x12\x15.pb.GetUserProfileReq\x1a\x16.pb.GetUserProfileResp\x12<\n" +
+	"\rupdateProfile\x12\x14.pb.UpdateProfileReq\x1a\x15.pb.UpdateProfileResp\x12?\n" +
+	"\x0echangePassword\x12\x15.pb.ChangePasswordReq\x1a\x16.pb.ChangePasswordResp\x126\n" +
+	"\vsendSmsCode\x12\x12.pb.SendSmsCodeReq\x1a\x13.pb.SendSmsCodeResp\x12<\n" +
+	"\rresetPassword\x12\x14.pb.ResetPasswordReq\x1a\x15.pb.ResetPasswordResp\x12<\n" +
+	"\rgenerateToken\x12\x14.pb.GenerateTokenReq\x1a\x15.pb.GenerateTokenResp\x129\n" +
+	"\frefreshToken\x12\x13.pb.RefreshTokenReq\x1a\x14.pb.RefreshTokenResp\x12'\n" +
+	"\x06logout\x12\r.pb.LogoutReq\x1a\x0e.pb.LogoutResp\x120\n" +
+	"\tlistUsers\x12\x10.pb.ListUsersReq\x1a\x11.pb.ListUsersResp\x12<\n" +
+	"\rsetUserStatus\x12\x14.pb.SetUserStatusReq\x1a\x15.pb.SetUserStatusResp\x126\n" +
+	"\vassignRoles\x12\x12.pb.AssignRolesReq\x1a\x13.pb.AssignRolesResp\x120\n" +
+	"\tlistRoles\x12\x10.pb.ListRolesReq\x1a\x11.pb.ListRolesResp\x12K\n" +
+	"\x12createOrganization\x12\x19.pb.CreateOrganizationReq\x1a\x1a.pb.CreateOrganizationResp\x12H\n" +
+	"\x11listOrganizations\x12\x18.pb.ListOrganizationsReq\x1a\x19.pb.ListOrganizationsResp\x12?\n" +
+	"\x0elistOrgMembers\x12\x15.pb.ListOrgMembersReq\x1a\x16.pb.ListOrgMembersResp\x129\n" +
+	"\faddOrgMember\x12\x13.pb.AddOrgMemberReq\x1a\x14.pb.AddOrgMemberResp\x12B\n" +
+	"\x0fupdateOrgMember\x12\x16.pb.UpdateOrgMemberReq\x1a\x17.pb.UpdateOrgMemberResp\x12B\n" +
+	"\x0fremoveOrgMember\x12\x16.pb.RemoveOrgMemberReq\x1a\x17.pb.RemoveOrgMemberResp\x12E\n" +
+	"\x10getWorkspaceRole\x12\x17.pb.GetWorkspaceRoleReq\x1a\x18.pb.GetWorkspaceRoleResp\x126\n" +
+	"\vlistAvatars\x12\x12.pb.ListAvatarsReq\x1a\x13.pb.ListAvatarsRespB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_usercenter_proto_rawDescOnce sync.Once
@@ -560,33 +3098,124 @@ func file_usercenter_proto_rawDescGZIP() []byte {
 	return file_usercenter_proto_rawDescData
 }
 
-var file_usercenter_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_usercenter_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_usercenter_proto_goTypes = []any{
-	(*User)(nil),              // 0: pb.User
-	(*RegisterReq)(nil),       // 1: pb.RegisterReq
-	(*RegisterResp)(nil),      // 2: pb.RegisterResp
-	(*LoginReq)(nil),          // 3: pb.LoginReq
-	(*LoginResp)(nil),         // 4: pb.LoginResp
-	(*GetUserInfoReq)(nil),    // 5: pb.GetUserInfoReq
-	(*GetUserInfoResp)(nil),   // 6: pb.GetUserInfoResp
-	(*GenerateTokenReq)(nil),  // 7: pb.GenerateTokenReq
-	(*GenerateTokenResp)(nil), // 8: pb.GenerateTokenResp
+	(*User)(nil),                   // 0: pb.User
+	(*UserProfile)(nil),            // 1: pb.UserProfile
+	(*Organization)(nil),           // 2: pb.Organization
+	(*OrgMember)(nil),              // 3: pb.OrgMember
+	(*Role)(nil),                   // 4: pb.Role
+	(*RegisterReq)(nil),            // 5: pb.RegisterReq
+	(*RegisterResp)(nil),           // 6: pb.RegisterResp
+	(*LoginReq)(nil),               // 7: pb.LoginReq
+	(*LoginResp)(nil),              // 8: pb.LoginResp
+	(*SendSmsCodeReq)(nil),         // 9: pb.SendSmsCodeReq
+	(*SendSmsCodeResp)(nil),        // 10: pb.SendSmsCodeResp
+	(*ResetPasswordReq)(nil),       // 11: pb.ResetPasswordReq
+	(*ResetPasswordResp)(nil),      // 12: pb.ResetPasswordResp
+	(*ChangePasswordReq)(nil),      // 13: pb.ChangePasswordReq
+	(*ChangePasswordResp)(nil),     // 14: pb.ChangePasswordResp
+	(*GetUserInfoReq)(nil),         // 15: pb.GetUserInfoReq
+	(*GetUserInfoResp)(nil),        // 16: pb.GetUserInfoResp
+	(*GetUserProfileReq)(nil),      // 17: pb.GetUserProfileReq
+	(*GetUserProfileResp)(nil),     // 18: pb.GetUserProfileResp
+	(*UpdateProfileReq)(nil),       // 19: pb.UpdateProfileReq
+	(*UpdateProfileResp)(nil),      // 20: pb.UpdateProfileResp
+	(*GenerateTokenReq)(nil),       // 21: pb.GenerateTokenReq
+	(*GenerateTokenResp)(nil),      // 22: pb.GenerateTokenResp
+	(*RefreshTokenReq)(nil),        // 23: pb.RefreshTokenReq
+	(*RefreshTokenResp)(nil),       // 24: pb.RefreshTokenResp
+	(*LogoutReq)(nil),              // 25: pb.LogoutReq
+	(*LogoutResp)(nil),             // 26: pb.LogoutResp
+	(*ListUsersReq)(nil),           // 27: pb.ListUsersReq
+	(*ListUsersResp)(nil),          // 28: pb.ListUsersResp
+	(*SetUserStatusReq)(nil),       // 29: pb.SetUserStatusReq
+	(*SetUserStatusResp)(nil),      // 30: pb.SetUserStatusResp
+	(*AssignRolesReq)(nil),         // 31: pb.AssignRolesReq
+	(*AssignRolesResp)(nil),        // 32: pb.AssignRolesResp
+	(*ListRolesReq)(nil),           // 33: pb.ListRolesReq
+	(*ListRolesResp)(nil),          // 34: pb.ListRolesResp
+	(*CreateOrganizationReq)(nil),  // 35: pb.CreateOrganizationReq
+	(*CreateOrganizationResp)(nil), // 36: pb.CreateOrganizationResp
+	(*ListOrganizationsReq)(nil),   // 37: pb.ListOrganizationsReq
+	(*ListOrganizationsResp)(nil),  // 38: pb.ListOrganizationsResp
+	(*ListOrgMembersReq)(nil),      // 39: pb.ListOrgMembersReq
+	(*ListOrgMembersResp)(nil),     // 40: pb.ListOrgMembersResp
+	(*AddOrgMemberReq)(nil),        // 41: pb.AddOrgMemberReq
+	(*AddOrgMemberResp)(nil),       // 42: pb.AddOrgMemberResp
+	(*UpdateOrgMemberReq)(nil),     // 43: pb.UpdateOrgMemberReq
+	(*UpdateOrgMemberResp)(nil),    // 44: pb.UpdateOrgMemberResp
+	(*RemoveOrgMemberReq)(nil),     // 45: pb.RemoveOrgMemberReq
+	(*RemoveOrgMemberResp)(nil),    // 46: pb.RemoveOrgMemberResp
+	(*GetWorkspaceRoleReq)(nil),    // 47: pb.GetWorkspaceRoleReq
+	(*GetWorkspaceRoleResp)(nil),   // 48: pb.GetWorkspaceRoleResp
+	(*ListAvatarsReq)(nil),         // 49: pb.ListAvatarsReq
+	(*UserAvatar)(nil),             // 50: pb.UserAvatar
+	(*ListAvatarsResp)(nil),        // 51: pb.ListAvatarsResp
 }
 var file_usercenter_proto_depIdxs = []int32{
-	0, // 0: pb.GetUserInfoResp.user:type_name -> pb.User
-	3, // 1: pb.usercenter.login:input_type -> pb.LoginReq
-	1, // 2: pb.usercenter.register:input_type -> pb.RegisterReq
-	5, // 3: pb.usercenter.getUserInfo:input_type -> pb.GetUserInfoReq
-	7, // 4: pb.usercenter.generateToken:input_type -> pb.GenerateTokenReq
-	4, // 5: pb.usercenter.login:output_type -> pb.LoginResp
-	2, // 6: pb.usercenter.register:output_type -> pb.RegisterResp
-	6, // 7: pb.usercenter.getUserInfo:output_type -> pb.GetUserInfoResp
-	8, // 8: pb.usercenter.generateToken:output_type -> pb.GenerateTokenResp
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: pb.GetUserInfoResp.user:type_name -> pb.User
+	1,  // 1: pb.GetUserProfileResp.profile:type_name -> pb.UserProfile
+	1,  // 2: pb.UpdateProfileReq.profile:type_name -> pb.UserProfile
+	1,  // 3: pb.UpdateProfileResp.profile:type_name -> pb.UserProfile
+	0,  // 4: pb.ListUsersResp.list:type_name -> pb.User
+	4,  // 5: pb.ListRolesResp.list:type_name -> pb.Role
+	2,  // 6: pb.CreateOrganizationResp.organization:type_name -> pb.Organization
+	2,  // 7: pb.ListOrganizationsResp.list:type_name -> pb.Organization
+	3,  // 8: pb.ListOrgMembersResp.list:type_name -> pb.OrgMember
+	3,  // 9: pb.AddOrgMemberResp.member:type_name -> pb.OrgMember
+	50, // 10: pb.ListAvatarsResp.list:type_name -> pb.UserAvatar
+	7,  // 11: pb.usercenter.login:input_type -> pb.LoginReq
+	5,  // 12: pb.usercenter.register:input_type -> pb.RegisterReq
+	15, // 13: pb.usercenter.getUserInfo:input_type -> pb.GetUserInfoReq
+	17, // 14: pb.usercenter.getUserProfile:input_type -> pb.GetUserProfileReq
+	19, // 15: pb.usercenter.updateProfile:input_type -> pb.UpdateProfileReq
+	13, // 16: pb.usercenter.changePassword:input_type -> pb.ChangePasswordReq
+	9,  // 17: pb.usercenter.sendSmsCode:input_type -> pb.SendSmsCodeReq
+	11, // 18: pb.usercenter.resetPassword:input_type -> pb.ResetPasswordReq
+	21, // 19: pb.usercenter.generateToken:input_type -> pb.GenerateTokenReq
+	23, // 20: pb.usercenter.refreshToken:input_type -> pb.RefreshTokenReq
+	25, // 21: pb.usercenter.logout:input_type -> pb.LogoutReq
+	27, // 22: pb.usercenter.listUsers:input_type -> pb.ListUsersReq
+	29, // 23: pb.usercenter.setUserStatus:input_type -> pb.SetUserStatusReq
+	31, // 24: pb.usercenter.assignRoles:input_type -> pb.AssignRolesReq
+	33, // 25: pb.usercenter.listRoles:input_type -> pb.ListRolesReq
+	35, // 26: pb.usercenter.createOrganization:input_type -> pb.CreateOrganizationReq
+	37, // 27: pb.usercenter.listOrganizations:input_type -> pb.ListOrganizationsReq
+	39, // 28: pb.usercenter.listOrgMembers:input_type -> pb.ListOrgMembersReq
+	41, // 29: pb.usercenter.addOrgMember:input_type -> pb.AddOrgMemberReq
+	43, // 30: pb.usercenter.updateOrgMember:input_type -> pb.UpdateOrgMemberReq
+	45, // 31: pb.usercenter.removeOrgMember:input_type -> pb.RemoveOrgMemberReq
+	47, // 32: pb.usercenter.getWorkspaceRole:input_type -> pb.GetWorkspaceRoleReq
+	49, // 33: pb.usercenter.listAvatars:input_type -> pb.ListAvatarsReq
+	8,  // 34: pb.usercenter.login:output_type -> pb.LoginResp
+	6,  // 35: pb.usercenter.register:output_type -> pb.RegisterResp
+	16, // 36: pb.usercenter.getUserInfo:output_type -> pb.GetUserInfoResp
+	18, // 37: pb.usercenter.getUserProfile:output_type -> pb.GetUserProfileResp
+	20, // 38: pb.usercenter.updateProfile:output_type -> pb.UpdateProfileResp
+	14, // 39: pb.usercenter.changePassword:output_type -> pb.ChangePasswordResp
+	10, // 40: pb.usercenter.sendSmsCode:output_type -> pb.SendSmsCodeResp
+	12, // 41: pb.usercenter.resetPassword:output_type -> pb.ResetPasswordResp
+	22, // 42: pb.usercenter.generateToken:output_type -> pb.GenerateTokenResp
+	24, // 43: pb.usercenter.refreshToken:output_type -> pb.RefreshTokenResp
+	26, // 44: pb.usercenter.logout:output_type -> pb.LogoutResp
+	28, // 45: pb.usercenter.listUsers:output_type -> pb.ListUsersResp
+	30, // 46: pb.usercenter.setUserStatus:output_type -> pb.SetUserStatusResp
+	32, // 47: pb.usercenter.assignRoles:output_type -> pb.AssignRolesResp
+	34, // 48: pb.usercenter.listRoles:output_type -> pb.ListRolesResp
+	36, // 49: pb.usercenter.createOrganization:output_type -> pb.CreateOrganizationResp
+	38, // 50: pb.usercenter.listOrganizations:output_type -> pb.ListOrganizationsResp
+	40, // 51: pb.usercenter.listOrgMembers:output_type -> pb.ListOrgMembersResp
+	42, // 52: pb.usercenter.addOrgMember:output_type -> pb.AddOrgMemberResp
+	44, // 53: pb.usercenter.updateOrgMember:output_type -> pb.UpdateOrgMemberResp
+	46, // 54: pb.usercenter.removeOrgMember:output_type -> pb.RemoveOrgMemberResp
+	48, // 55: pb.usercenter.getWorkspaceRole:output_type -> pb.GetWorkspaceRoleResp
+	51, // 56: pb.usercenter.listAvatars:output_type -> pb.ListAvatarsResp
+	34, // [34:57] is the sub-list for method output_type
+	11, // [11:34] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_usercenter_proto_init() }
@@ -600,7 +3229,7 @@ func file_usercenter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_usercenter_proto_rawDesc), len(file_usercenter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Usercenter_Login_FullMethodName         = "/pb.usercenter/login"
-	Usercenter_Register_FullMethodName      = "/pb.usercenter/register"
-	Usercenter_GetUserInfo_FullMethodName   = "/pb.usercenter/getUserInfo"
-	Usercenter_GenerateToken_FullMethodName = "/pb.usercenter/generateToken"
+	Usercenter_Login_FullMethodName              = "/pb.usercenter/login"
+	Usercenter_Register_FullMethodName           = "/pb.usercenter/register"
+	Usercenter_GetUserInfo_FullMethodName        = "/pb.usercenter/getUserInfo"
+	Usercenter_GetUserProfile_FullMethodName     = "/pb.usercenter/getUserProfile"
+	Usercenter_UpdateProfile_FullMethodName      = "/pb.usercenter/updateProfile"
+	Usercenter_ChangePassword_FullMethodName     = "/pb.usercenter/changePassword"
+	Usercenter_SendSmsCode_FullMethodName        = "/pb.usercenter/sendSmsCode"
+	Usercenter_ResetPassword_FullMethodName      = "/pb.usercenter/resetPassword"
+	Usercenter_GenerateToken_FullMethodName      = "/pb.usercenter/generateToken"
+	Usercenter_RefreshToken_FullMethodName       = "/pb.usercenter/refreshToken"
+	Usercenter_Logout_FullMethodName             = "/pb.usercenter/logout"
+	Usercenter_ListUsers_FullMethodName          = "/pb.usercenter/listUsers"
+	Usercenter_SetUserStatus_FullMethodName      = "/pb.usercenter/setUserStatus"
+	Usercenter_AssignRoles_FullMethodName        = "/pb.usercenter/assignRoles"
+	Usercenter_ListRoles_FullMethodName          = "/pb.usercenter/listRoles"
+	Usercenter_CreateOrganization_FullMethodName = "/pb.usercenter/createOrganization"
+	Usercenter_ListOrganizations_FullMethodName  = "/pb.usercenter/listOrganizations"
+	Usercenter_ListOrgMembers_FullMethodName     = "/pb.usercenter/listOrgMembers"
+	Usercenter_AddOrgMember_FullMethodName       = "/pb.usercenter/addOrgMember"
+	Usercenter_UpdateOrgMember_FullMethodName    = "/pb.usercenter/updateOrgMember"
+	Usercenter_RemoveOrgMember_FullMethodName    = "/pb.usercenter/removeOrgMember"
+	Usercenter_GetWorkspaceRole_FullMethodName   = "/pb.usercenter/getWorkspaceRole"
+	Usercenter_ListAvatars_FullMethodName        = "/pb.usercenter/listAvatars"
 )
 
 // UsercenterClient is the client API for Usercenter service.
@@ -34,7 +53,26 @@ type UsercenterClient interface {
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
 	GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error)
+	GetUserProfile(ctx context.Context, in *GetUserProfileReq, opts ...grpc.CallOption) (*GetUserProfileResp, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeResp, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 	GenerateToken(ctx context.Context, in *GenerateTokenReq, opts ...grpc.CallOption) (*GenerateTokenResp, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
+	ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error)
+	SetUserStatus(ctx context.Context, in *SetUserStatusReq, opts ...grpc.CallOption) (*SetUserStatusResp, error)
+	AssignRoles(ctx context.Context, in *AssignRolesReq, opts ...grpc.CallOption) (*AssignRolesResp, error)
+	ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error)
+	ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersResp, error)
+	AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberResp, error)
+	UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberReq, opts ...grpc.CallOption) (*UpdateOrgMemberResp, error)
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberResp, error)
+	GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleReq, opts ...grpc.CallOption) (*GetWorkspaceRoleResp, error)
+	ListAvatars(ctx context.Context, in *ListAvatarsReq, opts ...grpc.CallOption) (*ListAvatarsResp, error)
 }
 
 type usercenterClient struct {
@@ -75,6 +113,56 @@ func (c *usercenterClient) GetUserInfo(ctx context.Context, in *GetUserInfoReq,
 	return out, nil
 }
 
+func (c *usercenterClient) GetUserProfile(ctx context.Context, in *GetUserProfileReq, opts ...grpc.CallOption) (*GetUserProfileResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserProfileResp)
+	err := c.cc.Invoke(ctx, Usercenter_GetUserProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResp)
+	err := c.cc.Invoke(ctx, Usercenter_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResp)
+	err := c.cc.Invoke(ctx, Usercenter_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendSmsCodeResp)
+	err := c.cc.Invoke(ctx, Usercenter_SendSmsCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResp)
+	err := c.cc.Invoke(ctx, Usercenter_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usercenterClient) GenerateToken(ctx context.Context, in *GenerateTokenReq, opts ...grpc.CallOption) (*GenerateTokenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateTokenResp)
@@ -85,6 +173,146 @@ func (c *usercenterClient) GenerateToken(ctx context.Context, in *GenerateTokenR
 	return out, nil
 }
 
+func (c *usercenterClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, Usercenter_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResp)
+	err := c.cc.Invoke(ctx, Usercenter_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResp)
+	err := c.cc.Invoke(ctx, Usercenter_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) SetUserStatus(ctx context.Context, in *SetUserStatusReq, opts ...grpc.CallOption) (*SetUserStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserStatusResp)
+	err := c.cc.Invoke(ctx, Usercenter_SetUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) AssignRoles(ctx context.Context, in *AssignRolesReq, opts ...grpc.CallOption) (*AssignRolesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRolesResp)
+	err := c.cc.Invoke(ctx, Usercenter_AssignRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResp)
+	err := c.cc.Invoke(ctx, Usercenter_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResp)
+	err := c.cc.Invoke(ctx, Usercenter_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResp)
+	err := c.cc.Invoke(ctx, Usercenter_ListOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrgMembersResp)
+	err := c.cc.Invoke(ctx, Usercenter_ListOrgMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddOrgMemberResp)
+	err := c.cc.Invoke(ctx, Usercenter_AddOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberReq, opts ...grpc.CallOption) (*UpdateOrgMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrgMemberResp)
+	err := c.cc.Invoke(ctx, Usercenter_UpdateOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrgMemberResp)
+	err := c.cc.Invoke(ctx, Usercenter_RemoveOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleReq, opts ...grpc.CallOption) (*GetWorkspaceRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceRoleResp)
+	err := c.cc.Invoke(ctx, Usercenter_GetWorkspaceRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usercenterClient) ListAvatars(ctx context.Context, in *ListAvatarsReq, opts ...grpc.CallOption) (*ListAvatarsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAvatarsResp)
+	err := c.cc.Invoke(ctx, Usercenter_ListAvatars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsercenterServer is the server API for Usercenter service.
 // All implementations must embed UnimplementedUsercenterServer
 // for forward compatibility.
@@ -94,7 +322,26 @@ type UsercenterServer interface {
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	Register(context.Context, *RegisterReq) (*RegisterResp, error)
 	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error)
+	GetUserProfile(context.Context, *GetUserProfileReq) (*GetUserProfileResp, error)
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileResp, error)
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	SendSmsCode(context.Context, *SendSmsCodeReq) (*SendSmsCodeResp, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
 	GenerateToken(context.Context, *GenerateTokenReq) (*GenerateTokenResp, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
+	ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error)
+	SetUserStatus(context.Context, *SetUserStatusReq) (*SetUserStatusResp, error)
+	AssignRoles(context.Context, *AssignRolesReq) (*AssignRolesResp, error)
+	ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error)
+	CreateOrganization(context.Context, *CreateOrganizationReq) (*CreateOrganizationResp, error)
+	ListOrganizations(context.Context, *ListOrganizationsReq) (*ListOrganizationsResp, error)
+	ListOrgMembers(context.Context, *ListOrgMembersReq) (*ListOrgMembersResp, error)
+	AddOrgMember(context.Context, *AddOrgMemberReq) (*AddOrgMemberResp, error)
+	UpdateOrgMember(context.Context, *UpdateOrgMemberReq) (*UpdateOrgMemberResp, error)
+	RemoveOrgMember(context.Context, *RemoveOrgMemberReq) (*RemoveOrgMemberResp, error)
+	GetWorkspaceRole(context.Context, *GetWorkspaceRoleReq) (*GetWorkspaceRoleResp, error)
+	ListAvatars(context.Context, *ListAvatarsReq) (*ListAvatarsResp, error)
 	mustEmbedUnimplementedUsercenterServer()
 }
 
@@ -114,9 +361,66 @@ func (UnimplementedUsercenterServer) Register(context.Context, *RegisterReq) (*R
 func (UnimplementedUsercenterServer) GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
+func (UnimplementedUsercenterServer) GetUserProfile(context.Context, *GetUserProfileReq) (*GetUserProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedUsercenterServer) UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUsercenterServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUsercenterServer) SendSmsCode(context.Context, *SendSmsCodeReq) (*SendSmsCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSmsCode not implemented")
+}
+func (UnimplementedUsercenterServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
 func (UnimplementedUsercenterServer) GenerateToken(context.Context, *GenerateTokenReq) (*GenerateTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateToken not implemented")
 }
+func (UnimplementedUsercenterServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUsercenterServer) Logout(context.Context, *LogoutReq) (*LogoutResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUsercenterServer) ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUsercenterServer) SetUserStatus(context.Context, *SetUserStatusReq) (*SetUserStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserStatus not implemented")
+}
+func (UnimplementedUsercenterServer) AssignRoles(context.Context, *AssignRolesReq) (*AssignRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRoles not implemented")
+}
+func (UnimplementedUsercenterServer) ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedUsercenterServer) CreateOrganization(context.Context, *CreateOrganizationReq) (*CreateOrganizationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedUsercenterServer) ListOrganizations(context.Context, *ListOrganizationsReq) (*ListOrganizationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedUsercenterServer) ListOrgMembers(context.Context, *ListOrgMembersReq) (*ListOrgMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+}
+func (UnimplementedUsercenterServer) AddOrgMember(context.Context, *AddOrgMemberReq) (*AddOrgMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrgMember not implemented")
+}
+func (UnimplementedUsercenterServer) UpdateOrgMember(context.Context, *UpdateOrgMemberReq) (*UpdateOrgMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrgMember not implemented")
+}
+func (UnimplementedUsercenterServer) RemoveOrgMember(context.Context, *RemoveOrgMemberReq) (*RemoveOrgMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (UnimplementedUsercenterServer) GetWorkspaceRole(context.Context, *GetWorkspaceRoleReq) (*GetWorkspaceRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceRole not implemented")
+}
+func (UnimplementedUsercenterServer) ListAvatars(context.Context, *ListAvatarsReq) (*ListAvatarsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvatars not implemented")
+}
 func (UnimplementedUsercenterServer) mustEmbedUnimplementedUsercenterServer() {}
 func (UnimplementedUsercenterServer) testEmbeddedByValue()                    {}
 
@@ -192,6 +496,96 @@ func _Usercenter_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Usercenter_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).GetUserProfile(ctx, req.(*GetUserProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_SendSmsCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSmsCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).SendSmsCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_SendSmsCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).SendSmsCode(ctx, req.(*SendSmsCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Usercenter_GenerateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateTokenReq)
 	if err := dec(in); err != nil {
@@ -210,6 +604,258 @@ func _Usercenter_GenerateToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Usercenter_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ListUsers(ctx, req.(*ListUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_SetUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).SetUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_SetUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).SetUserStatus(ctx, req.(*SetUserStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_AssignRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).AssignRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_AssignRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).AssignRoles(ctx, req.(*AssignRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ListRoles(ctx, req.(*ListRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).CreateOrganization(ctx, req.(*CreateOrganizationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ListOrganizations(ctx, req.(*ListOrganizationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ListOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ListOrgMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ListOrgMembers(ctx, req.(*ListOrgMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_AddOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrgMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).AddOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_AddOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).AddOrgMember(ctx, req.(*AddOrgMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_UpdateOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrgMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).UpdateOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_UpdateOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).UpdateOrgMember(ctx, req.(*UpdateOrgMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_RemoveOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_GetWorkspaceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).GetWorkspaceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_GetWorkspaceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).GetWorkspaceRole(ctx, req.(*GetWorkspaceRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Usercenter_ListAvatars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvatarsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsercenterServer).ListAvatars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Usercenter_ListAvatars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsercenterServer).ListAvatars(ctx, req.(*ListAvatarsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Usercenter_ServiceDesc is the grpc.ServiceDesc for Usercenter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,10 +875,86 @@ var Usercenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getUserInfo",
 			Handler:    _Usercenter_GetUserInfo_Handler,
 		},
+		{
+			MethodName: "getUserProfile",
+			Handler:    _Usercenter_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "updateProfile",
+			Handler:    _Usercenter_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "changePassword",
+			Handler:    _Usercenter_ChangePassword_Handler,
+		},
+		{
+			MethodName: "sendSmsCode",
+			Handler:    _Usercenter_SendSmsCode_Handler,
+		},
+		{
+			MethodName: "resetPassword",
+			Handler:    _Usercenter_ResetPassword_Handler,
+		},
 		{
 			MethodName: "generateToken",
 			Handler:    _Usercenter_GenerateToken_Handler,
 		},
+		{
+			MethodName: "refreshToken",
+			Handler:    _Usercenter_RefreshToken_Handler,
+		},
+		{
+			MethodName: "logout",
+			Handler:    _Usercenter_Logout_Handler,
+		},
+		{
+			MethodName: "listUsers",
+			Handler:    _Usercenter_ListUsers_Handler,
+		},
+		{
+			MethodName: "setUserStatus",
+			Handler:    _Usercenter_SetUserStatus_Handler,
+		},
+		{
+			MethodName: "assignRoles",
+			Handler:    _Usercenter_AssignRoles_Handler,
+		},
+		{
+			MethodName: "listRoles",
+			Handler:    _Usercenter_ListRoles_Handler,
+		},
+		{
+			MethodName: "createOrganization",
+			Handler:    _Usercenter_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "listOrganizations",
+			Handler:    _Usercenter_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "listOrgMembers",
+			Handler:    _Usercenter_ListOrgMembers_Handler,
+		},
+		{
+			MethodName: "addOrgMember",
+			Handler:    _Usercenter_AddOrgMember_Handler,
+		},
+		{
+			MethodName: "updateOrgMember",
+			Handler:    _Usercenter_UpdateOrgMember_Handler,
+		},
+		{
+			MethodName: "removeOrgMember",
+			Handler:    _Usercenter_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "getWorkspaceRole",
+			Handler:    _Usercenter_GetWorkspaceRole_Handler,
+		},
+		{
+			MethodName: "listAvatars",
+			Handler:    _Usercenter_ListAvatars_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "usercenter.proto",
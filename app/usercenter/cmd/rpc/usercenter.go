@@ -14,6 +14,8 @@ import (
 	"github.com/zeromicro/go-zero/core/service"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -26,16 +28,24 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	// 使用自己注册的 gRPC 健康检查服务代替 go-zero 内置的，以便上报就绪状态：
+	// 整体状态（服务名为空）随进程启停变化，readiness 的状态由定期执行的依赖检查决定
+	c.Health = false
+	healthServer := health.NewServer()
+	ctx.Health.Watch(healthServer)
+	defer healthServer.Shutdown()
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterUsercenterServer(grpcServer, server.NewUsercenterServer(ctx))
+		grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
 		}
 	})
 
-	//rpc log
-	s.AddUnaryInterceptors(rpcserver.LoggerInterceptor)
+	//rpc metrics & log，指标拦截器在外层，记录日志拦截器转换后的业务错误码
+	s.AddUnaryInterceptors(rpcserver.MetricsInterceptor, rpcserver.LoggerInterceptor)
 
 	defer s.Stop()
 
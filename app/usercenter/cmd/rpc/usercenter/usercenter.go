@@ -14,21 +14,83 @@ import (
 )
 
 type (
-	GenerateTokenReq  = pb.GenerateTokenReq
-	GenerateTokenResp = pb.GenerateTokenResp
-	GetUserInfoReq    = pb.GetUserInfoReq
-	GetUserInfoResp   = pb.GetUserInfoResp
-	LoginReq          = pb.LoginReq
-	LoginResp         = pb.LoginResp
-	RegisterReq       = pb.RegisterReq
-	RegisterResp      = pb.RegisterResp
-	User              = pb.User
+	AddOrgMemberReq        = pb.AddOrgMemberReq
+	AddOrgMemberResp       = pb.AddOrgMemberResp
+	AssignRolesReq         = pb.AssignRolesReq
+	AssignRolesResp        = pb.AssignRolesResp
+	ChangePasswordReq      = pb.ChangePasswordReq
+	ChangePasswordResp     = pb.ChangePasswordResp
+	CreateOrganizationReq  = pb.CreateOrganizationReq
+	CreateOrganizationResp = pb.CreateOrganizationResp
+	GenerateTokenReq       = pb.GenerateTokenReq
+	GenerateTokenResp      = pb.GenerateTokenResp
+	GetUserInfoReq         = pb.GetUserInfoReq
+	GetUserInfoResp        = pb.GetUserInfoResp
+	GetUserProfileReq      = pb.GetUserProfileReq
+	GetUserProfileResp     = pb.GetUserProfileResp
+	GetWorkspaceRoleReq    = pb.GetWorkspaceRoleReq
+	GetWorkspaceRoleResp   = pb.GetWorkspaceRoleResp
+	ListAvatarsReq         = pb.ListAvatarsReq
+	ListAvatarsResp        = pb.ListAvatarsResp
+	ListOrgMembersReq      = pb.ListOrgMembersReq
+	ListOrgMembersResp     = pb.ListOrgMembersResp
+	ListOrganizationsReq   = pb.ListOrganizationsReq
+	ListOrganizationsResp  = pb.ListOrganizationsResp
+	ListRolesReq           = pb.ListRolesReq
+	ListRolesResp          = pb.ListRolesResp
+	ListUsersReq           = pb.ListUsersReq
+	ListUsersResp          = pb.ListUsersResp
+	LoginReq               = pb.LoginReq
+	LoginResp              = pb.LoginResp
+	LogoutReq              = pb.LogoutReq
+	LogoutResp             = pb.LogoutResp
+	OrgMember              = pb.OrgMember
+	Organization           = pb.Organization
+	RefreshTokenReq        = pb.RefreshTokenReq
+	RefreshTokenResp       = pb.RefreshTokenResp
+	RegisterReq            = pb.RegisterReq
+	RegisterResp           = pb.RegisterResp
+	RemoveOrgMemberReq     = pb.RemoveOrgMemberReq
+	RemoveOrgMemberResp    = pb.RemoveOrgMemberResp
+	ResetPasswordReq       = pb.ResetPasswordReq
+	ResetPasswordResp      = pb.ResetPasswordResp
+	Role                   = pb.Role
+	SendSmsCodeReq         = pb.SendSmsCodeReq
+	SendSmsCodeResp        = pb.SendSmsCodeResp
+	SetUserStatusReq       = pb.SetUserStatusReq
+	SetUserStatusResp      = pb.SetUserStatusResp
+	UpdateOrgMemberReq     = pb.UpdateOrgMemberReq
+	UpdateOrgMemberResp    = pb.UpdateOrgMemberResp
+	UpdateProfileReq       = pb.UpdateProfileReq
+	UpdateProfileResp      = pb.UpdateProfileResp
+	User                   = pb.User
+	UserAvatar             = pb.UserAvatar
+	UserProfile            = pb.UserProfile
 
 	Usercenter interface {
 		Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 		Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
 		GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error)
+		GetUserProfile(ctx context.Context, in *GetUserProfileReq, opts ...grpc.CallOption) (*GetUserProfileResp, error)
+		UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error)
+		ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+		SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeResp, error)
+		ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 		GenerateToken(ctx context.Context, in *GenerateTokenReq, opts ...grpc.CallOption) (*GenerateTokenResp, error)
+		RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+		Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
+		ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error)
+		SetUserStatus(ctx context.Context, in *SetUserStatusReq, opts ...grpc.CallOption) (*SetUserStatusResp, error)
+		AssignRoles(ctx context.Context, in *AssignRolesReq, opts ...grpc.CallOption) (*AssignRolesResp, error)
+		ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error)
+		CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error)
+		ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error)
+		ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersResp, error)
+		AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberResp, error)
+		UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberReq, opts ...grpc.CallOption) (*UpdateOrgMemberResp, error)
+		RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberResp, error)
+		GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleReq, opts ...grpc.CallOption) (*GetWorkspaceRoleResp, error)
+		ListAvatars(ctx context.Context, in *ListAvatarsReq, opts ...grpc.CallOption) (*ListAvatarsResp, error)
 	}
 
 	defaultUsercenter struct {
@@ -57,7 +119,102 @@ func (m *defaultUsercenter) GetUserInfo(ctx context.Context, in *GetUserInfoReq,
 	return client.GetUserInfo(ctx, in, opts...)
 }
 
+func (m *defaultUsercenter) GetUserProfile(ctx context.Context, in *GetUserProfileReq, opts ...grpc.CallOption) (*GetUserProfileResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.GetUserProfile(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.UpdateProfile(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ChangePassword(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.SendSmsCode(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ResetPassword(ctx, in, opts...)
+}
+
 func (m *defaultUsercenter) GenerateToken(ctx context.Context, in *GenerateTokenReq, opts ...grpc.CallOption) (*GenerateTokenResp, error) {
 	client := pb.NewUsercenterClient(m.cli.Conn())
 	return client.GenerateToken(ctx, in, opts...)
 }
+
+func (m *defaultUsercenter) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.RefreshToken(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.Logout(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ListUsers(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) SetUserStatus(ctx context.Context, in *SetUserStatusReq, opts ...grpc.CallOption) (*SetUserStatusResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.SetUserStatus(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) AssignRoles(ctx context.Context, in *AssignRolesReq, opts ...grpc.CallOption) (*AssignRolesResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.AssignRoles(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ListRoles(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.CreateOrganization(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ListOrganizations(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ListOrgMembers(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.AddOrgMember(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberReq, opts ...grpc.CallOption) (*UpdateOrgMemberResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.UpdateOrgMember(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.RemoveOrgMember(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleReq, opts ...grpc.CallOption) (*GetWorkspaceRoleResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.GetWorkspaceRole(ctx, in, opts...)
+}
+
+func (m *defaultUsercenter) ListAvatars(ctx context.Context, in *ListAvatarsReq, opts ...grpc.CallOption) (*ListAvatarsResp, error) {
+	client := pb.NewUsercenterClient(m.cli.Conn())
+	return client.ListAvatars(ctx, in, opts...)
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ OrganizationMemberModel = (*customOrganizationMemberModel)(nil)
+
+type (
+	// OrganizationMemberModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customOrganizationMemberModel.
+	OrganizationMemberModel interface {
+		organizationMemberModel
+		FindByOrgId(ctx context.Context, orgId int64) ([]*OrganizationMember, error)
+		UpdateRole(ctx context.Context, id int64, role string) error
+		withSession(session sqlx.Session) OrganizationMemberModel
+	}
+
+	customOrganizationMemberModel struct {
+		*defaultOrganizationMemberModel
+	}
+)
+
+// NewOrganizationMemberModel returns a model for the database table.
+func NewOrganizationMemberModel(conn sqlx.SqlConn) OrganizationMemberModel {
+	return &customOrganizationMemberModel{
+		defaultOrganizationMemberModel: newOrganizationMemberModel(conn),
+	}
+}
+
+func (m *customOrganizationMemberModel) withSession(session sqlx.Session) OrganizationMemberModel {
+	return NewOrganizationMemberModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindByOrgId 查询团队的全部成员，按加入顺序排列
+func (m *customOrganizationMemberModel) FindByOrgId(ctx context.Context, orgId int64) ([]*OrganizationMember, error) {
+	query := fmt.Sprintf("select %s from %s where `org_id` = ? order by `id` asc", organizationMemberRows, m.table)
+	var resp []*OrganizationMember
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, orgId)
+	return resp, err
+}
+
+func (m *customOrganizationMemberModel) UpdateRole(ctx context.Context, id int64, role string) error {
+	query := fmt.Sprintf("update %s set `role` = ? where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, role, id)
+	return err
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ OrganizationModel = (*customOrganizationModel)(nil)
+
+type (
+	// OrganizationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customOrganizationModel.
+	OrganizationModel interface {
+		organizationModel
+		CreateWithOwner(ctx context.Context, name string, ownerId int64, ownerRole string) (int64, error)
+		FindByMember(ctx context.Context, userId int64) ([]*MemberOrganization, error)
+		withSession(session sqlx.Session) OrganizationModel
+	}
+
+	customOrganizationModel struct {
+		*defaultOrganizationModel
+	}
+
+	// MemberOrganization 用户所在的团队及其在团队中的角色
+	MemberOrganization struct {
+		Organization
+		Role string `db:"role"`
+	}
+)
+
+// NewOrganizationModel returns a model for the database table.
+func NewOrganizationModel(conn sqlx.SqlConn) OrganizationModel {
+	return &customOrganizationModel{
+		defaultOrganizationModel: newOrganizationModel(conn),
+	}
+}
+
+func (m *customOrganizationModel) withSession(session sqlx.Session) OrganizationModel {
+	return NewOrganizationModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// CreateWithOwner 在事务中创建团队，并将创建者加入团队
+func (m *customOrganizationModel) CreateWithOwner(ctx context.Context, name string, ownerId int64, ownerRole string) (int64, error) {
+	var orgId int64
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (`name`, `owner_id`) values (?, ?)", m.table)
+		ret, err := session.ExecCtx(ctx, query, name, ownerId)
+		if err != nil {
+			return err
+		}
+		if orgId, err = ret.LastInsertId(); err != nil {
+			return err
+		}
+		_, err = session.ExecCtx(ctx, "insert into `organization_member` (`org_id`, `user_id`, `role`) values (?, ?, ?)",
+			orgId, ownerId, ownerRole)
+		return err
+	})
+	return orgId, err
+}
+
+// FindByMember 查询用户加入的全部团队，按团队 id 升序
+func (m *customOrganizationModel) FindByMember(ctx context.Context, userId int64) ([]*MemberOrganization, error) {
+	query := "select o.`id`, o.`name`, o.`owner_id`, o.`create_time`, o.`update_time`, om.`role` " +
+		"from `organization_member` om join `organization` o on o.`id` = om.`org_id` " +
+		"where om.`user_id` = ? order by o.`id` asc"
+	var resp []*MemberOrganization
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, userId)
+	return resp, err
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PermissionModel = (*customPermissionModel)(nil)
+
+type (
+	// PermissionModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPermissionModel.
+	PermissionModel interface {
+		permissionModel
+		FindCodesByRoleCodes(ctx context.Context, roleCodes []string) ([]string, error)
+		FindCodesGroupByRole(ctx context.Context) (map[int64][]string, error)
+		withSession(session sqlx.Session) PermissionModel
+	}
+
+	customPermissionModel struct {
+		*defaultPermissionModel
+	}
+)
+
+// NewPermissionModel returns a model for the database table.
+func NewPermissionModel(conn sqlx.SqlConn) PermissionModel {
+	return &customPermissionModel{
+		defaultPermissionModel: newPermissionModel(conn),
+	}
+}
+
+func (m *customPermissionModel) withSession(session sqlx.Session) PermissionModel {
+	return NewPermissionModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindCodesByRoleCodes 查询若干角色拥有的全部权限编码（去重）
+func (m *customPermissionModel) FindCodesByRoleCodes(ctx context.Context, roleCodes []string) ([]string, error) {
+	if len(roleCodes) == 0 {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf("select distinct p.`code` from %s p join `role_permission` rp on rp.`permission_id` = p.`id` join `role` r on r.`id` = rp.`role_id` where r.`code` in (%s) order by p.`code` asc",
+		m.table, placeholders(len(roleCodes)))
+	var resp []string
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, toAnys(roleCodes)...)
+	return resp, err
+}
+
+// FindCodesGroupByRole 查询每个角色的权限编码，key 为角色 id
+func (m *customPermissionModel) FindCodesGroupByRole(ctx context.Context) (map[int64][]string, error) {
+	var rows []struct {
+		RoleId int64  `db:"role_id"`
+		Code   string `db:"code"`
+	}
+	query := fmt.Sprintf("select rp.`role_id`, p.`code` from `role_permission` rp join %s p on p.`id` = rp.`permission_id` order by p.`code` asc", m.table)
+	if err := m.conn.QueryRowsCtx(ctx, &rows, query); err != nil {
+		return nil, err
+	}
+
+	resp := make(map[int64][]string)
+	for _, row := range rows {
+		resp[row.RoleId] = append(resp[row.RoleId], row.Code)
+	}
+	return resp, nil
+}
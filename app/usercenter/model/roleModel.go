@@ -0,0 +1,102 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ RoleModel = (*customRoleModel)(nil)
+
+type (
+	// RoleModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRoleModel.
+	RoleModel interface {
+		roleModel
+		FindAll(ctx context.Context) ([]*Role, error)
+		FindCodesByUserIds(ctx context.Context, userIds []int64) (map[int64][]string, error)
+		ReplaceUserRoles(ctx context.Context, userId int64, roleIds []int64) error
+		withSession(session sqlx.Session) RoleModel
+	}
+
+	customRoleModel struct {
+		*defaultRoleModel
+	}
+)
+
+// NewRoleModel returns a model for the database table.
+func NewRoleModel(conn sqlx.SqlConn) RoleModel {
+	return &customRoleModel{
+		defaultRoleModel: newRoleModel(conn),
+	}
+}
+
+func (m *customRoleModel) withSession(session sqlx.Session) RoleModel {
+	return NewRoleModel(sqlx.NewSqlConnFromSession(session))
+}
+
+func (m *customRoleModel) FindAll(ctx context.Context) ([]*Role, error) {
+	query := fmt.Sprintf("select %s from %s order by `id` asc", roleRows, m.table)
+	var resp []*Role
+	err := m.conn.QueryRowsCtx(ctx, &resp, query)
+	return resp, err
+}
+
+// FindCodesByUserIds 批量查询用户的角色编码，没有角色记录的用户不会出现在结果中
+func (m *customRoleModel) FindCodesByUserIds(ctx context.Context, userIds []int64) (map[int64][]string, error) {
+	resp := make(map[int64][]string, len(userIds))
+	if len(userIds) == 0 {
+		return resp, nil
+	}
+
+	var rows []struct {
+		UserId int64  `db:"user_id"`
+		Code   string `db:"code"`
+	}
+	query := fmt.Sprintf("select ur.`user_id`, r.`code` from `user_role` ur join %s r on r.`id` = ur.`role_id` where ur.`user_id` in (%s) order by r.`id` asc",
+		m.table, placeholders(len(userIds)))
+	if err := m.conn.QueryRowsCtx(ctx, &rows, query, toAnys(userIds)...); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		resp[row.UserId] = append(resp[row.UserId], row.Code)
+	}
+	return resp, nil
+}
+
+// ReplaceUserRoles 在事务中将用户的角色整体替换为 roleIds
+func (m *customRoleModel) ReplaceUserRoles(ctx context.Context, userId int64, roleIds []int64) error {
+	return m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "delete from `user_role` where `user_id` = ?", userId); err != nil {
+			return err
+		}
+		if len(roleIds) == 0 {
+			return nil
+		}
+
+		values := make([]string, 0, len(roleIds))
+		args := make([]any, 0, len(roleIds)*2)
+		for _, id := range roleIds {
+			values = append(values, "(?, ?)")
+			args = append(args, userId, id)
+		}
+		query := "insert into `user_role` (`user_id`, `role_id`) values " + strings.Join(values, ",")
+		_, err := session.ExecCtx(ctx, query, args...)
+		return err
+	})
+}
+
+// placeholders 生成 n 个以逗号分隔的 ? 占位符
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+func toAnys[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
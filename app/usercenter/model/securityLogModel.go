@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+// security_log 表只允许追加，因此这里手写模型，不提供 Update / Delete 方法。
+
+// 安全事件类型
+const (
+	SecurityEventLoginLockout = "login_lockout" // 登录失败次数过多被锁定
+)
+
+var (
+	securityLogFieldNames        = builder.RawFieldNames(&SecurityLog{})
+	securityLogRowsExpectAutoSet = strings.Join(stringx.Remove(securityLogFieldNames, "`id`", "`create_time`"), ",")
+)
+
+var _ SecurityLogModel = (*defaultSecurityLogModel)(nil)
+
+type (
+	// SecurityLogModel 账号安全事件表
+	SecurityLogModel interface {
+		Insert(ctx context.Context, data *SecurityLog) error
+	}
+
+	defaultSecurityLogModel struct {
+		conn  sqlx.SqlConn
+		table string
+	}
+
+	SecurityLog struct {
+		Id         int64     `db:"id"`
+		UserId     int64     `db:"user_id"`    // 关联用户ID, 未知用户或按 IP 锁定时为 0
+		Mobile     string    `db:"mobile"`     // 关联手机号
+		Event      string    `db:"event"`      // 事件类型
+		ClientIp   string    `db:"client_ip"`  // 客户端IP
+		UserAgent  string    `db:"user_agent"` // 客户端 User-Agent
+		Detail     string    `db:"detail"`     // 补充说明
+		CreateTime time.Time `db:"create_time"`
+	}
+)
+
+// NewSecurityLogModel returns a model for the database table.
+func NewSecurityLogModel(conn sqlx.SqlConn) SecurityLogModel {
+	return &defaultSecurityLogModel{
+		conn:  conn,
+		table: "`security_log`",
+	}
+}
+
+func (m *defaultSecurityLogModel) Insert(ctx context.Context, data *SecurityLog) error {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, securityLogRowsExpectAutoSet)
+	_, err := m.conn.ExecCtx(ctx, query, data.UserId, data.Mobile, data.Event, data.ClientIp, data.UserAgent, data.Detail)
+	return err
+}
@@ -1,6 +1,17 @@
 package model
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 账号状态
+const (
+	UserStatusDisabled int64 = 0
+	UserStatusNormal   int64 = 1
+)
 
 var _ UserModel = (*customUserModel)(nil)
 
@@ -9,6 +20,13 @@ type (
 	// and implement the added methods in customUserModel.
 	UserModel interface {
 		userModel
+		FindPage(ctx context.Context, keyword string, offset, limit int64) ([]*User, error)
+		FindByIds(ctx context.Context, ids []int64) ([]*User, error)
+		CountByKeyword(ctx context.Context, keyword string) (int64, error)
+		UpdateStatus(ctx context.Context, id, status int64) error
+		UpdatePassword(ctx context.Context, id int64, password string) error
+		UpdateProfile(ctx context.Context, data *User) error
+		ListAvatarsAfter(ctx context.Context, afterId, limit int64) ([]*User, error)
 		withSession(session sqlx.Session) UserModel
 	}
 
@@ -27,3 +45,70 @@ func NewUserModel(conn sqlx.SqlConn) UserModel {
 func (m *customUserModel) withSession(session sqlx.Session) UserModel {
 	return NewUserModel(sqlx.NewSqlConnFromSession(session))
 }
+
+// FindPage 按 id 升序分页查询未删除的用户，keyword 非空时按手机号或昵称模糊匹配
+func (m *customUserModel) FindPage(ctx context.Context, keyword string, offset, limit int64) ([]*User, error) {
+	where, args := userKeywordWhere(keyword)
+	query := fmt.Sprintf("select %s from %s where %s order by `id` asc limit ?, ?", userRows, m.table, where)
+	args = append(args, offset, limit)
+
+	var resp []*User
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+func (m *customUserModel) FindByIds(ctx context.Context, ids []int64) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := fmt.Sprintf("select %s from %s where `id` in (%s)", userRows, m.table, placeholders(len(ids)))
+	var resp []*User
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, toAnys(ids)...)
+	return resp, err
+}
+
+func (m *customUserModel) CountByKeyword(ctx context.Context, keyword string) (int64, error) {
+	where, args := userKeywordWhere(keyword)
+	query := fmt.Sprintf("select count(*) from %s where %s", m.table, where)
+
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, query, args...)
+	return total, err
+}
+
+func (m *customUserModel) UpdateStatus(ctx context.Context, id, status int64) error {
+	query := fmt.Sprintf("update %s set `status` = ? where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, status, id)
+	return err
+}
+
+func (m *customUserModel) UpdatePassword(ctx context.Context, id int64, password string) error {
+	query := fmt.Sprintf("update %s set `password` = ? where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, password, id)
+	return err
+}
+
+// UpdateProfile 更新用户资料字段（昵称、头像、部门、单位及导出默认值），不影响账号、密码与状态
+func (m *customUserModel) UpdateProfile(ctx context.Context, data *User) error {
+	query := fmt.Sprintf("update %s set `nickname` = ?, `avatar` = ?, `department` = ?, `organization` = ?, "+
+		"`default_doc_type` = ?, `header_title` = ?, `header_doc_no` = ? where `id` = ?", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, data.Nickname, data.Avatar, data.Department, data.Organization,
+		data.DefaultDocType, data.HeaderTitle, data.HeaderDocNo, data.Id)
+	return err
+}
+
+// ListAvatarsAfter 按 id 升序分页查询已设置头像的用户（含已删除的用户），只填充 id 与 avatar
+func (m *customUserModel) ListAvatarsAfter(ctx context.Context, afterId, limit int64) ([]*User, error) {
+	query := fmt.Sprintf("select `id`, `avatar` from %s where `id` > ? and `avatar` != '' order by `id` asc limit ?", m.table)
+	var resp []*User
+	err := m.conn.QueryRowsPartialCtx(ctx, &resp, query, afterId, limit)
+	return resp, err
+}
+
+func userKeywordWhere(keyword string) (string, []any) {
+	if keyword == "" {
+		return "`del_state` = 0", nil
+	}
+	like := "%" + keyword + "%"
+	return "`del_state` = 0 and (`mobile` like ? or `nickname` like ?)", []any{like, like}
+}
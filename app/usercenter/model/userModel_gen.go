@@ -38,14 +38,21 @@ type (
 	}
 
 	User struct {
-		Id         int64        `db:"id"`
-		Mobile     string       `db:"mobile"`
-		Password   string       `db:"password"`
-		Nickname   string       `db:"nickname"`
-		CreateTime time.Time    `db:"create_time"`
-		UpdateTime time.Time    `db:"update_time"`
-		DeleteTime sql.NullTime `db:"delete_time"`
-		DelState   int64        `db:"del_state"`
+		Id             int64        `db:"id"`
+		Mobile         string       `db:"mobile"`
+		Password       string       `db:"password"`
+		Nickname       string       `db:"nickname"`
+		Avatar         string       `db:"avatar"`           // 头像, 文件上传接口返回的 url
+		Department     string       `db:"department"`       // 部门
+		Organization   string       `db:"organization"`     // 单位/组织
+		DefaultDocType string       `db:"default_doc_type"` // 默认文章类型
+		HeaderTitle    string       `db:"header_title"`     // 导出公文默认版头, 如 某某县人民政府文件
+		HeaderDocNo    string       `db:"header_doc_no"`    // 导出公文默认发文字号
+		Status         int64        `db:"status"`           // 账号状态: 1 正常, 0 禁用
+		CreateTime     time.Time    `db:"create_time"`
+		UpdateTime     time.Time    `db:"update_time"`
+		DeleteTime     sql.NullTime `db:"delete_time"`
+		DelState       int64        `db:"del_state"`
 	}
 )
 
@@ -91,14 +98,14 @@ func (m *defaultUserModel) FindOneByMobile(ctx context.Context, mobile string) (
 }
 
 func (m *defaultUserModel) Insert(ctx context.Context, data *User) (sql.Result, error) {
-	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, userRowsExpectAutoSet)
-	ret, err := m.conn.ExecCtx(ctx, query, data.Mobile, data.Password, data.Nickname, data.DeleteTime, data.DelState)
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, userRowsExpectAutoSet)
+	ret, err := m.conn.ExecCtx(ctx, query, data.Mobile, data.Password, data.Nickname, data.Avatar, data.Department, data.Organization, data.DefaultDocType, data.HeaderTitle, data.HeaderDocNo, data.Status, data.DeleteTime, data.DelState)
 	return ret, err
 }
 
 func (m *defaultUserModel) Update(ctx context.Context, newData *User) error {
 	query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, userRowsWithPlaceHolder)
-	_, err := m.conn.ExecCtx(ctx, query, newData.Mobile, newData.Password, newData.Nickname, newData.DeleteTime, newData.DelState, newData.Id)
+	_, err := m.conn.ExecCtx(ctx, query, newData.Mobile, newData.Password, newData.Nickname, newData.Avatar, newData.Department, newData.Organization, newData.DefaultDocType, newData.HeaderTitle, newData.HeaderDocNo, newData.Status, newData.DeleteTime, newData.DelState, newData.Id)
 	return err
 }
 
@@ -31,11 +31,14 @@ DROP TABLE IF EXISTS `conversations`;
 CREATE TABLE `conversations` (
   `conversation_id` VARCHAR(32) NOT NULL COMMENT '会话ID (主键, ULID)',
   `user_id`         bigint NOT NULL COMMENT '关联的用户ID',
+  `workspace_id`    bigint NOT NULL DEFAULT 0 COMMENT '所属团队空间ID (usercenter organization.id), 0 表示个人空间',
   `title`           VARCHAR(255) NOT NULL DEFAULT '' COMMENT '会话标题',
   `metadata`        JSON DEFAULT NULL COMMENT '存储额外的数据，例如模型设置等',
   `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
-  PRIMARY KEY (`conversation_id`)
+  PRIMARY KEY (`conversation_id`),
+  KEY `idx_user_workspace` (`user_id`, `workspace_id`),
+  KEY `idx_workspace_id` (`workspace_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='会话表';
 
 -- --------------------------------------------------
@@ -64,8 +67,11 @@ DROP TABLE IF EXISTS `files`;
 CREATE TABLE `files` (
   `filename` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '用户上传的原始文件名',
   `stored_name` VARCHAR(255) NOT NULL  COMMENT '服务器保存的唯一文件名',
+  `user_id` BIGINT NOT NULL DEFAULT 0 COMMENT '上传者用户ID, 0 表示早期未记录上传者的文件 (个人空间中不可读)',
+  `workspace_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属团队空间ID, 0 表示个人空间',
   `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '上传时间',
-  PRIMARY KEY (`stored_name`)
+  PRIMARY KEY (`stored_name`),
+  KEY `idx_workspace_id` (`workspace_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文件表';
 
 -- --------------------------------------------------
@@ -93,11 +99,248 @@ CREATE TABLE `documents` (
   `message_id`      VARCHAR(32) NOT NULL COMMENT '消息ID (主键, ULID)',
   `conversation_id` VARCHAR(32) NOT NULL COMMENT '关联的会话ID (外键)',
   `content`         TEXT NOT NULL COMMENT '文章',
+  `provider`        VARCHAR(32) NOT NULL DEFAULT '' COMMENT '生成该文章的大模型提供方',
+  `model`           VARCHAR(64) NOT NULL DEFAULT '' COMMENT '生成该文章的模型或工作流',
   `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '消息创建时间',
   PRIMARY KEY (`message_id`),
   -- 为 conversation_id 创建索引以优化查询性能
   KEY `idx_conversation_id` (`conversation_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='最终文档表';
 
+-- --------------------------------------------------
+-- Table structure for audit_logs (文档操作审计表, 仅追加)
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `audit_logs`;
+CREATE TABLE `audit_logs` (
+  `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `user_id`         BIGINT NOT NULL DEFAULT 0 COMMENT '操作用户ID (公开下载等匿名操作为 0)',
+  `action`          VARCHAR(32) NOT NULL COMMENT '操作类型: generate | resume | edit | update | export | public_download | delete | share | share_access | comment | approval | doc_no | collab',
+  `conversation_id` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '关联的会话ID',
+  `target_id`       VARCHAR(255) NOT NULL DEFAULT '' COMMENT '操作对象ID (文档/消息ID 或导出文件名)',
+  `client_ip`       VARCHAR(64) NOT NULL DEFAULT '' COMMENT '客户端IP',
+  `user_agent`      VARCHAR(512) NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+  `hash_before`     CHAR(64) NOT NULL DEFAULT '' COMMENT '操作前内容的 SHA-256 (十六进制)',
+  `hash_after`      CHAR(64) NOT NULL DEFAULT '' COMMENT '操作后内容的 SHA-256 (十六进制)',
+  `detail`          VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '补充说明, 例如导出格式',
+  `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`, `created_at`),
+  KEY `idx_conversation_created` (`conversation_id`, `created_at`),
+  KEY `idx_target_action` (`target_id`, `action`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文档操作审计表';
+
+-- 审计记录只允许追加: 禁止任何修改与删除
+DROP TRIGGER IF EXISTS `trg_audit_logs_no_update`;
+DROP TRIGGER IF EXISTS `trg_audit_logs_no_delete`;
+DELIMITER $$
+CREATE TRIGGER `trg_audit_logs_no_update` BEFORE UPDATE ON `audit_logs`
+FOR EACH ROW
+BEGIN
+  SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
+END$$
+CREATE TRIGGER `trg_audit_logs_no_delete` BEFORE DELETE ON `audit_logs`
+FOR EACH ROW
+BEGIN
+  SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
+END$$
+DELIMITER ;
+
+-- --------------------------------------------------
+-- Table structure for usage (大模型调用用量, 按用户、日期、调用类型聚合)
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `usage`;
+CREATE TABLE `usage` (
+  `id`             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `user_id`        BIGINT NOT NULL COMMENT '用户ID',
+  `usage_date`     DATE NOT NULL COMMENT '统计日期',
+  `call_type`      VARCHAR(16) NOT NULL COMMENT '调用类型: generate | resume | edit',
+  `request_count`  BIGINT NOT NULL DEFAULT 0 COMMENT '调用次数',
+  `success_count`  BIGINT NOT NULL DEFAULT 0 COMMENT '成功次数',
+  `failure_count`  BIGINT NOT NULL DEFAULT 0 COMMENT '失败次数 (含客户端中断)',
+  `prompt_chars`   BIGINT NOT NULL DEFAULT 0 COMMENT '提示词字符数 (含携带的历史消息)',
+  `response_chars` BIGINT NOT NULL DEFAULT 0 COMMENT '模型输出字符数',
+  `latency_ms`     BIGINT NOT NULL DEFAULT 0 COMMENT '累计耗时 (毫秒)',
+  `created_at`     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_date_type` (`user_id`, `usage_date`, `call_type`),
+  KEY `idx_usage_date` (`usage_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='大模型调用用量表';
+
+-- --------------------------------------------------
+-- Table structure for usage_quota (用量配额, 按用户或角色配置)
+-- 用户级配额优先; 没有用户级配额时取其各角色配额中最宽松的一项; 0 表示不限
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `usage_quota`;
+CREATE TABLE `usage_quota` (
+  `id`               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `subject_type`     VARCHAR(8) NOT NULL COMMENT '配额对象类型: user | role',
+  `subject`          VARCHAR(64) NOT NULL COMMENT '用户ID 或角色编码 (usercenter role.code)',
+  `daily_requests`   BIGINT NOT NULL DEFAULT 0 COMMENT '每日调用次数上限, 0 表示不限',
+  `monthly_requests` BIGINT NOT NULL DEFAULT 0 COMMENT '每月调用次数上限, 0 表示不限',
+  `daily_chars`      BIGINT NOT NULL DEFAULT 0 COMMENT '每日字符数上限 (提示词 + 输出), 0 表示不限',
+  `monthly_chars`    BIGINT NOT NULL DEFAULT 0 COMMENT '每月字符数上限 (提示词 + 输出), 0 表示不限',
+  `created_at`       DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`       DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_subject` (`subject_type`, `subject`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用量配额表';
+
+-- --------------------------------------------------
+-- Table structure for document_shares (文档分享链接, 免登录只读查看与下载)
+-- 过期、撤销或文档已删除的链接均不可访问
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `document_shares`;
+CREATE TABLE `document_shares` (
+  `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `token`           VARCHAR(32) NOT NULL COMMENT '分享令牌 (随机串, 出现在分享链接中)',
+  `message_id`      VARCHAR(32) NOT NULL COMMENT '分享的文档 (documents.message_id)',
+  `conversation_id` VARCHAR(32) NOT NULL COMMENT '文档所属会话ID',
+  `user_id`         BIGINT NOT NULL COMMENT '创建者用户ID',
+  `password_hash`   VARCHAR(255) NOT NULL DEFAULT '' COMMENT '访问密码的 argon2id 哈希, 为空表示无需密码',
+  `allow_download`  TINYINT(1) NOT NULL DEFAULT 1 COMMENT '是否允许下载 DOCX/PDF',
+  `expire_at`       DATETIME NOT NULL COMMENT '过期时间',
+  `revoked_at`      DATETIME NULL DEFAULT NULL COMMENT '撤销时间, 为空表示未撤销',
+  `view_count`      BIGINT NOT NULL DEFAULT 0 COMMENT '查看次数',
+  `download_count`  BIGINT NOT NULL DEFAULT 0 COMMENT '下载次数',
+  `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_token` (`token`),
+  KEY `idx_message_id` (`message_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文档分享链接表';
+
+-- --------------------------------------------------
+-- Table structure for document_comments (文档批注与讨论)
+-- 批注锚定到文档中的一段文本, 回复挂在首条批注 (parent_id = 0) 下, 解决状态以首条批注为准。
+-- 锚点位置以 Unicode 字符计, 文档修改后在查询时按 anchor_text 与上下文重新定位。
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `document_comments`;
+CREATE TABLE `document_comments` (
+  `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `message_id`      VARCHAR(32) NOT NULL COMMENT '批注的文档 (documents.message_id)',
+  `conversation_id` VARCHAR(32) NOT NULL COMMENT '文档所属会话ID',
+  `parent_id`       BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '所属批注ID, 0 表示首条批注',
+  `user_id`         BIGINT NOT NULL COMMENT '发表者用户ID',
+  `content`         TEXT NOT NULL COMMENT '批注内容',
+  `quote`           TEXT NOT NULL COMMENT '发表时引用的原文, 为空表示针对全文',
+  `anchor_text`     TEXT NOT NULL COMMENT '当前锚定的文本',
+  `anchor_prefix`   VARCHAR(255) NOT NULL DEFAULT '' COMMENT '锚定文本之前的上下文',
+  `anchor_suffix`   VARCHAR(255) NOT NULL DEFAULT '' COMMENT '锚定文本之后的上下文',
+  `anchor_start`    INT NOT NULL DEFAULT 0 COMMENT '锚定文本的起始位置 (字符)',
+  `anchor_end`      INT NOT NULL DEFAULT 0 COMMENT '锚定文本的结束位置 (字符, 不含)',
+  `anchor_status`   VARCHAR(16) NOT NULL DEFAULT '' COMMENT '定位结果: anchored | fuzzy | orphaned, 无引用时为空',
+  `anchor_hash`     CHAR(64) NOT NULL DEFAULT '' COMMENT '定位时文档内容的 SHA-256, 文档变化后重新定位',
+  `status`          VARCHAR(16) NOT NULL DEFAULT 'open' COMMENT '首条批注的状态: open | resolved',
+  `resolved_by`     BIGINT NOT NULL DEFAULT 0 COMMENT '标记解决的用户ID',
+  `resolved_at`     DATETIME NULL DEFAULT NULL COMMENT '标记解决的时间',
+  `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_message_id` (`message_id`, `parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文档批注表';
+
+-- --------------------------------------------------
+-- Table structure for document_comment_mentions (批注中 @ 提及的用户)
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `document_comment_mentions`;
+CREATE TABLE `document_comment_mentions` (
+  `id`          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `comment_id`  BIGINT UNSIGNED NOT NULL COMMENT '批注ID (document_comments.id)',
+  `message_id`  VARCHAR(32) NOT NULL COMMENT '批注的文档',
+  `user_id`     BIGINT NOT NULL COMMENT '被提及的用户ID',
+  `created_at`  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_comment_user` (`comment_id`, `user_id`),
+  KEY `idx_user_id` (`user_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='批注提及表';
+
+-- --------------------------------------------------
+-- Table structure for document_approvals (文档审批流程的当前状态)
+-- 每个文档一行, 未进入审批流程的文档没有记录。状态与流转在 llmcenter 配置 Approval 中定义,
+-- 流转时按 version 乐观锁更新, 并发流转只有一个成功。
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `document_approvals`;
+CREATE TABLE `document_approvals` (
+  `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `message_id`      VARCHAR(32) NOT NULL COMMENT '审批的文档 (documents.message_id)',
+  `conversation_id` VARCHAR(32) NOT NULL COMMENT '文档所属会话ID',
+  `state`           VARCHAR(32) NOT NULL COMMENT '当前状态, 如 draft | reviewing | signing | approved',
+  `assignee_id`     BIGINT NOT NULL DEFAULT 0 COMMENT '当前审批人用户ID, 0 表示无',
+  `submitter_id`    BIGINT NOT NULL DEFAULT 0 COMMENT '最近一次提交审批的用户ID',
+  `content_hash`    CHAR(64) NOT NULL DEFAULT '' COMMENT '提交审批时文档内容的 SHA-256, 导出内容一致时才标注审批信息',
+  `version`         BIGINT NOT NULL DEFAULT 0 COMMENT '流转次数, 用于乐观锁',
+  `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_message_id` (`message_id`),
+  KEY `idx_assignee_id` (`assignee_id`, `updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文档审批表';
+
+-- --------------------------------------------------
+-- Table structure for document_approval_history (文档审批流转记录)
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `document_approval_history`;
+CREATE TABLE `document_approval_history` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `approval_id`  BIGINT UNSIGNED NOT NULL COMMENT '审批ID (document_approvals.id)',
+  `message_id`   VARCHAR(32) NOT NULL COMMENT '审批的文档',
+  `action`       VARCHAR(32) NOT NULL COMMENT '执行的流转, 如 submit | pass | reject | sign',
+  `from_state`   VARCHAR(32) NOT NULL COMMENT '流转前的状态',
+  `to_state`     VARCHAR(32) NOT NULL COMMENT '流转后的状态',
+  `user_id`      BIGINT NOT NULL COMMENT '执行者用户ID',
+  `user_name`    VARCHAR(64) NOT NULL DEFAULT '' COMMENT '执行时的用户昵称, 作为签署人标注在导出文件上',
+  `assignee_id`  BIGINT NOT NULL DEFAULT 0 COMMENT '流转后的审批人用户ID',
+  `comment`      VARCHAR(500) NOT NULL DEFAULT '' COMMENT '审批意见',
+  `content_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '流转时文档内容的 SHA-256',
+  `created_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_approval_id` (`approval_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='文档审批流转记录表';
+
+-- --------------------------------------------------
+-- Table structure for doc_number_sequences (发文字号序号)
+-- 每个团队、前缀与年份一行, 记录已分配的最大序号; 分配时在事务中锁定该行, 保证序号不重复。
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `doc_number_sequences`;
+CREATE TABLE `doc_number_sequences` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `workspace_id` BIGINT NOT NULL COMMENT '所属团队ID (usercenter organization.id)',
+  `prefix`       VARCHAR(32) NOT NULL COMMENT '发文机关代字, 如 某政、某办发',
+  `year`         INT NOT NULL COMMENT '年份',
+  `last_seq`     BIGINT NOT NULL DEFAULT 0 COMMENT '已分配的最大序号',
+  `created_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_workspace_prefix_year` (`workspace_id`, `prefix`, `year`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='发文字号序号表';
+
+-- --------------------------------------------------
+-- Table structure for doc_numbers (已分配的发文字号)
+-- 预留 (reserved) 的字号可以释放 (released), 释放后的字号优先分配给下一份文档, 避免断号;
+-- 正式使用 (issued) 后不能释放。
+-- --------------------------------------------------
+DROP TABLE IF EXISTS `doc_numbers`;
+CREATE TABLE `doc_numbers` (
+  `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+  `workspace_id`    BIGINT NOT NULL COMMENT '所属团队ID',
+  `prefix`          VARCHAR(32) NOT NULL COMMENT '发文机关代字',
+  `year`            INT NOT NULL COMMENT '年份',
+  `seq`             BIGINT NOT NULL COMMENT '序号',
+  `doc_no`          VARCHAR(64) NOT NULL COMMENT '发文字号, 如 某政〔2025〕12号',
+  `status`          VARCHAR(16) NOT NULL COMMENT '状态: reserved | issued | released',
+  `message_id`      VARCHAR(32) NOT NULL DEFAULT '' COMMENT '使用该字号的文档 (documents.message_id)',
+  `conversation_id` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '文档所属会话ID',
+  `user_id`         BIGINT NOT NULL COMMENT '最近一次分配、使用或释放的用户ID',
+  `issued_at`       DATETIME NULL DEFAULT NULL COMMENT '正式使用的时间',
+  `released_at`     DATETIME NULL DEFAULT NULL COMMENT '最近一次释放的时间',
+  `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '首次分配时间',
+  `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_workspace_prefix_year_seq` (`workspace_id`, `prefix`, `year`, `seq`),
+  KEY `idx_message_id` (`message_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='发文字号表';
+
 -- 重新启用外键约束检查
 SET FOREIGN_KEY_CHECKS = 1;
@@ -20,6 +20,13 @@ CREATE TABLE `user` (
   `mobile` char(11) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
   `password` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
   `nickname` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
+  `avatar` varchar(255) NOT NULL DEFAULT '' COMMENT '头像, 文件上传接口返回的 url',
+  `department` varchar(64) NOT NULL DEFAULT '' COMMENT '部门',
+  `organization` varchar(64) NOT NULL DEFAULT '' COMMENT '单位/组织',
+  `default_doc_type` varchar(32) NOT NULL DEFAULT '' COMMENT '默认文章类型',
+  `header_title` varchar(64) NOT NULL DEFAULT '' COMMENT '导出公文默认版头, 如 某某县人民政府文件',
+  `header_doc_no` varchar(64) NOT NULL DEFAULT '' COMMENT '导出公文默认发文字号',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '账号状态: 1 正常, 0 禁用',
   `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   `delete_time` datetime DEFAULT NULL,
@@ -28,5 +35,131 @@ CREATE TABLE `user` (
   UNIQUE KEY `idx_mobile` (`mobile`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户表';
 
+-- ----------------------------
+-- Table structure for role
+-- ----------------------------
+DROP TABLE IF EXISTS `role`;
+CREATE TABLE `role` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `code` varchar(32) NOT NULL DEFAULT '' COMMENT '角色编码: user | reviewer | admin',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '角色名称',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='角色表';
+
+-- ----------------------------
+-- Table structure for permission
+-- ----------------------------
+DROP TABLE IF EXISTS `permission`;
+CREATE TABLE `permission` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `code` varchar(64) NOT NULL DEFAULT '' COMMENT '权限编码, 格式 资源:操作, 如 audit:read',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '权限名称',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='权限表';
+
+-- ----------------------------
+-- Table structure for role_permission
+-- ----------------------------
+DROP TABLE IF EXISTS `role_permission`;
+CREATE TABLE `role_permission` (
+  `role_id` bigint NOT NULL,
+  `permission_id` bigint NOT NULL,
+  PRIMARY KEY (`role_id`, `permission_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='角色权限关系表';
+
+-- ----------------------------
+-- Table structure for user_role
+-- 没有任何角色记录的用户视为普通用户 (user)
+-- ----------------------------
+DROP TABLE IF EXISTS `user_role`;
+CREATE TABLE `user_role` (
+  `user_id` bigint NOT NULL,
+  `role_id` bigint NOT NULL,
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`, `role_id`),
+  KEY `idx_role_id` (`role_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户角色关系表';
+
+-- ----------------------------
+-- 初始角色与权限（编码需与 pkg/authz 中的常量保持一致）
+-- ----------------------------
+INSERT INTO `role` (`id`, `code`, `name`) VALUES
+  (1, 'user', '普通用户'),
+  (2, 'reviewer', '审核员'),
+  (3, 'admin', '管理员');
+
+INSERT INTO `permission` (`id`, `code`, `name`) VALUES
+  (1, 'user:manage', '用户与角色管理'),
+  (2, 'audit:read', '查看与导出审计记录'),
+  (3, 'document:review', '审核文档'),
+  (4, 'template:manage', '模板管理'),
+  (5, 'quota:manage', '配额管理'),
+  (6, 'file:manage', '上传文件清理管理'),
+  (7, 'system:diagnose', '查看系统诊断信息');
+
+INSERT INTO `role_permission` (`role_id`, `permission_id`) VALUES
+  (2, 2), (2, 3),
+  (3, 1), (3, 2), (3, 3), (3, 4), (3, 5), (3, 6), (3, 7);
+
+-- 指定首个管理员（将手机号替换为实际账号后执行）:
+-- INSERT INTO `user_role` (`user_id`, `role_id`) SELECT `id`, 3 FROM `user` WHERE `mobile` = '13800000000';
+
+-- ----------------------------
+-- Table structure for security_log
+-- 账号安全事件记录 (登录锁定等)，只追加
+-- ----------------------------
+DROP TABLE IF EXISTS `security_log`;
+CREATE TABLE `security_log` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '关联用户ID, 未知用户或按 IP 锁定时为 0',
+  `mobile` char(11) NOT NULL DEFAULT '' COMMENT '关联手机号',
+  `event` varchar(32) NOT NULL DEFAULT '' COMMENT '事件类型, 如 login_lockout',
+  `client_ip` varchar(64) NOT NULL DEFAULT '' COMMENT '客户端IP',
+  `user_agent` varchar(512) NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+  `detail` varchar(1024) NOT NULL DEFAULT '' COMMENT '补充说明',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`, `create_time`),
+  KEY `idx_mobile` (`mobile`, `create_time`),
+  KEY `idx_create_time` (`create_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='账号安全事件表';
+
+-- ----------------------------
+-- Table structure for organization
+-- 团队，团队ID即 llmcenter 中会话、文件的 workspace_id
+-- ----------------------------
+DROP TABLE IF EXISTS `organization`;
+CREATE TABLE `organization` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '团队名称',
+  `owner_id` bigint NOT NULL DEFAULT 0 COMMENT '负责人(创建者)用户ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_owner_id` (`owner_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='团队表';
+
+-- ----------------------------
+-- Table structure for organization_member
+-- ----------------------------
+DROP TABLE IF EXISTS `organization_member`;
+CREATE TABLE `organization_member` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `org_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `role` varchar(16) NOT NULL DEFAULT 'viewer' COMMENT '成员角色: owner | editor | viewer',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_org_user` (`org_id`, `user_id`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='团队成员表';
+
 
 SET FOREIGN_KEY_CHECKS = 1;
\ No newline at end of file
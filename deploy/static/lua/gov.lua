@@ -2,6 +2,7 @@
 -- 识别以下 fenced div（注意是 class，不是 custom-style）：
 --   ::: {.GovTitle}    标题（红色 36pt 加粗，居中）
 --   ::: {.GovDocNo}    文号（黑色 16pt，居中；可调）
+--   ::: {.GovStamp}    审批状态与签发人（黑色 12pt，居中），仅已进入审批流程的文档有
 --   ::: {.GovRedLine}  上边框红线（2pt）
 
 local function stringify_inlines(inlines)
@@ -76,6 +77,11 @@ function Div(el)
     return para_openxml_center(text, '000000', false, 32, eastAsiaFont)  -- 16pt = 32 half-points
   end
 
+  if el.classes:includes('GovStamp') then
+    local text = stringify_inlines(el.content)
+    return para_openxml_center(text, '000000', false, 24, eastAsiaFont)  -- 12pt = 24 half-points
+  end
+
   if el.classes:includes('GovRedLine') then
     return red_line_openxml()
   end
@@ -0,0 +1,193 @@
+// Package approval 定义公文的审批流程：状态、各状态下允许的流转及可执行流转的角色。
+//
+// 流程通过配置定义，未配置时使用 Default（拟稿 → 核稿 → 签发）。文档未进入流程时处于初始状态；
+// 处于锁定状态（Locked）的文档不能修改。流转的执行者按以下角色判断：
+//   - RoleEditor：对文档有编辑权限的用户（个人空间的创建者、团队空间的负责人与编辑）
+//   - RoleAssignee：当前状态指定的审批人
+//   - 其他值：用户中心的 RBAC 角色，如 reviewer、admin
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 流程内置角色
+const (
+	RoleEditor   = "editor"
+	RoleAssignee = "assignee"
+)
+
+// State 审批状态
+type State struct {
+	Name     string
+	Label    string // 显示名称，也用于导出时标注审批状态
+	Locked   bool   `json:",optional"` // 文档处于该状态时不能修改
+	Assignee bool   `json:",optional"` // 进入该状态时须指定审批人
+}
+
+// Transition 状态流转
+type Transition struct {
+	Action          string
+	Label           string
+	From            []string
+	To              string
+	Roles           []string // 可执行该流转的角色，满足其一即可
+	Signer          string   `json:",optional"` // 执行者作为该名目的签署人标注在导出文件上，如“核稿人”
+	CommentRequired bool     `json:",optional"` // 须填写审批意见，如退回
+}
+
+// Config 审批流程配置，States 为空时使用 Default；Initial 为空时使用第一个状态
+type Config struct {
+	Initial     string       `json:",optional"`
+	States      []State      `json:",optional"`
+	Transitions []Transition `json:",optional"`
+}
+
+// Default 默认流程：拟稿 → 核稿 → 签发
+func Default() Config {
+	return Config{
+		Initial: "draft",
+		States: []State{
+			{Name: "draft", Label: "拟稿"},
+			{Name: "reviewing", Label: "核稿", Locked: true, Assignee: true},
+			{Name: "signing", Label: "签发", Locked: true, Assignee: true},
+			{Name: "approved", Label: "已签发", Locked: true},
+		},
+		Transitions: []Transition{
+			{Action: "submit", Label: "提交核稿", From: []string{"draft"}, To: "reviewing", Roles: []string{RoleEditor}},
+			{Action: "withdraw", Label: "撤回", From: []string{"reviewing", "signing"}, To: "draft", Roles: []string{RoleEditor}},
+			{Action: "pass", Label: "核稿通过", From: []string{"reviewing"}, To: "signing", Roles: []string{RoleAssignee}, Signer: "核稿人"},
+			{Action: "reject", Label: "退回修改", From: []string{"reviewing", "signing"}, To: "draft", Roles: []string{RoleAssignee}, CommentRequired: true},
+			{Action: "sign", Label: "签发", From: []string{"signing"}, To: "approved", Roles: []string{RoleAssignee}, Signer: "签发人"},
+			{Action: "revoke", Label: "撤销签发", From: []string{"approved"}, To: "draft", Roles: []string{"admin"}, CommentRequired: true},
+		},
+	}
+}
+
+// Workflow 校验后的审批流程，可并发使用
+type Workflow struct {
+	initial     string
+	states      []State
+	transitions []Transition
+}
+
+// New 校验配置并创建流程
+func New(c Config) (*Workflow, error) {
+	if len(c.States) == 0 {
+		c = Default()
+	}
+	if c.Initial == "" {
+		c.Initial = c.States[0].Name
+	}
+
+	w := &Workflow{initial: c.Initial, states: c.States, transitions: c.Transitions}
+	names := make(map[string]bool, len(c.States))
+	for _, s := range c.States {
+		if s.Name == "" {
+			return nil, errors.New("approval: state name is empty")
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("approval: duplicate state %q", s.Name)
+		}
+		names[s.Name] = true
+	}
+	if !names[c.Initial] {
+		return nil, fmt.Errorf("approval: initial state %q not defined", c.Initial)
+	}
+	if s, _ := w.State(c.Initial); s.Locked {
+		return nil, fmt.Errorf("approval: initial state %q must not be locked", c.Initial)
+	}
+
+	actions := make(map[string]bool, len(c.Transitions))
+	for _, t := range c.Transitions {
+		if t.Action == "" {
+			return nil, errors.New("approval: transition action is empty")
+		}
+		if actions[t.Action] {
+			return nil, fmt.Errorf("approval: duplicate action %q", t.Action)
+		}
+		actions[t.Action] = true
+		if len(t.From) == 0 || len(t.Roles) == 0 {
+			return nil, fmt.Errorf("approval: action %q needs from states and roles", t.Action)
+		}
+		for _, name := range append([]string{t.To}, t.From...) {
+			if !names[name] {
+				return nil, fmt.Errorf("approval: action %q refers to undefined state %q", t.Action, name)
+			}
+		}
+	}
+	return w, nil
+}
+
+// MustNew 创建流程，配置有误时退出进程
+func MustNew(c Config) *Workflow {
+	w, err := New(c)
+	logx.Must(err)
+	return w
+}
+
+// Initial 初始状态，尚未进入流程的文档处于该状态
+func (w *Workflow) Initial() string {
+	return w.initial
+}
+
+// State 按名称查找状态
+func (w *Workflow) State(name string) (State, bool) {
+	for _, s := range w.states {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return State{}, false
+}
+
+// Locked 处于该状态的文档是否不能修改，未定义的状态（如配置变更后遗留的数据）视为锁定
+func (w *Workflow) Locked(name string) bool {
+	s, ok := w.State(name)
+	return !ok || s.Locked
+}
+
+// Transition 查找从 from 状态可执行的 action
+func (w *Workflow) Transition(from, action string) (Transition, bool) {
+	for _, t := range w.transitions {
+		if t.Action == action && slices.Contains(t.From, from) {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Transitions 从 from 状态可执行的全部流转
+func (w *Workflow) Transitions(from string) []Transition {
+	var result []Transition
+	for _, t := range w.transitions {
+		if slices.Contains(t.From, from) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// Action 按名称查找流转，用于解释流转记录
+func (w *Workflow) Action(action string) (Transition, bool) {
+	for _, t := range w.transitions {
+		if t.Action == action {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Allowed 具备 roles 中任一角色的用户能否执行该流转
+func (t Transition) Allowed(roles []string) bool {
+	for _, r := range t.Roles {
+		if slices.Contains(roles, r) {
+			return true
+		}
+	}
+	return false
+}
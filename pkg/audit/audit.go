@@ -0,0 +1,152 @@
+// Package audit 记录文档相关操作的审计日志（只追加），并支持导出 CSV 供合规审查使用。
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/clientinfo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 操作类型
+const (
+	ActionGenerate       = "generate"        // 生成大纲（ChatCompletions）
+	ActionResume         = "resume"          // 续写生成最终文档（ChatResume）
+	ActionEdit           = "edit"            // 大模型修改文档
+	ActionUpdate         = "update"          // 用户手动修改文档
+	ActionExport         = "export"          // 导出 pdf / docx
+	ActionPublicDownload = "public_download" // 通过签名直链下载
+	ActionDelete         = "delete"          // 删除文档
+	ActionShare          = "share"           // 创建或撤销分享链接
+	ActionShareAccess    = "share_access"    // 通过分享链接查看或下载
+	ActionComment        = "comment"         // 发表、回复、解决或重新打开批注
+	ActionApproval       = "approval"        // 审批流转（提交、通过、退回、签发等）
+	ActionDocNo          = "doc_no"          // 分配、使用或释放发文字号
+	ActionCollab         = "collab"          // 协同编辑的修改保存到数据库
+)
+
+// Actions 全部操作类型
+var Actions = []string{ActionGenerate, ActionResume, ActionEdit, ActionUpdate, ActionExport, ActionPublicDownload, ActionDelete, ActionShare, ActionShareAccess, ActionComment, ActionApproval, ActionDocNo, ActionCollab}
+
+// TimeLayout 审计记录中的时间格式
+const TimeLayout = "2006-01-02 15:04:05"
+
+// Entry 一条待写入的审计记录，客户端 IP 与 User-Agent 由 Recorder 从 context 中补齐
+type Entry struct {
+	UserId         int64
+	Action         string
+	ConversationId string
+	TargetId       string
+	HashBefore     string // 使用 Hash / HashBytes 计算，无操作前内容时留空
+	HashAfter      string
+	Detail         string
+}
+
+// Hash 计算文本内容的 SHA-256（十六进制）
+func Hash(content string) string {
+	return HashBytes([]byte(content))
+}
+
+// HashBytes 计算二进制内容的 SHA-256（十六进制）
+func HashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Recorder 审计记录器
+type Recorder struct {
+	model model.AuditLogsModel
+}
+
+// NewRecorder 创建审计记录器
+func NewRecorder(m model.AuditLogsModel) *Recorder {
+	return &Recorder{model: m}
+}
+
+// Record 写入一条审计记录。
+// 写入与请求是否被取消无关（客户端断开后仍需留痕）；写入失败只记录错误日志，不影响业务操作本身。
+func (r *Recorder) Record(ctx context.Context, e Entry) {
+	info := clientinfo.FromContext(ctx)
+	err := r.model.Insert(context.WithoutCancel(ctx), &model.AuditLogs{
+		UserId:         e.UserId,
+		Action:         e.Action,
+		ConversationId: e.ConversationId,
+		TargetId:       e.TargetId,
+		ClientIp:       info.IP,
+		UserAgent:      info.UserAgent,
+		HashBefore:     e.HashBefore,
+		HashAfter:      e.HashAfter,
+		Detail:         e.Detail,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw("write audit log failed",
+			logx.Field("audit", e.Action),
+			logx.Field("userId", e.UserId),
+			logx.Field("conversationId", e.ConversationId),
+			logx.Field("targetId", e.TargetId),
+			logx.Field("err", err.Error()),
+		)
+	}
+}
+
+// ExportHash 查询导出文件在导出时记录的摘要，未找到导出记录时返回空字符串。
+// 公开下载据此记录摘要，不必每次下载都重新读取整个文件计算
+func (r *Recorder) ExportHash(ctx context.Context, name string) (string, error) {
+	hash, err := r.model.FindLatestHashAfter(ctx, ActionExport, name)
+	if errors.Is(err, model.ErrNotFound) {
+		return "", nil
+	}
+	return hash, err
+}
+
+var csvHeader = []string{"id", "created_at", "user_id", "action", "conversation_id", "target_id",
+	"client_ip", "user_agent", "hash_before", "hash_after", "detail"}
+
+// WriteCSV 以 CSV 格式写出审计记录。写入 UTF-8 BOM，便于 Excel 正确识别中文。
+func WriteCSV(w io.Writer, logs []*model.AuditLogs) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, l := range logs {
+		record := []string{
+			strconv.FormatInt(l.Id, 10),
+			l.CreatedAt.Format(TimeLayout),
+			strconv.FormatInt(l.UserId, 10),
+			l.Action,
+			l.ConversationId,
+			csvSafe(l.TargetId),
+			csvSafe(l.ClientIp),
+			csvSafe(l.UserAgent),
+			l.HashBefore,
+			l.HashAfter,
+			csvSafe(l.Detail),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvSafe 防止 CSV 公式注入：以 = + - @ 等开头的单元格在表格软件中会被当作公式执行，
+// User-Agent 等字段来自客户端，需加前缀单引号使其按文本显示
+func csvSafe(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
@@ -0,0 +1,46 @@
+// Package authz 定义角色与权限编码，并提供按权限保护路由的 API 中间件。
+// 角色、权限及其对应关系保存在 usercenter 数据库中，登录时写入 JWT（见 ctxdata.CtxKeyJwtRoles / CtxKeyJwtPerms）。
+package authz
+
+import (
+	"net/http"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+// 角色编码，需与 role 表保持一致
+const (
+	RoleUser     = "user"     // 普通用户，没有任何角色记录的用户即为普通用户
+	RoleReviewer = "reviewer" // 审核员
+	RoleAdmin    = "admin"    // 管理员
+)
+
+// 权限编码，需与 permission 表保持一致
+const (
+	PermUserManage     = "user:manage"     // 用户与角色管理
+	PermAuditRead      = "audit:read"      // 查看与导出审计记录
+	PermDocumentReview = "document:review" // 审核文档
+	PermTemplateManage = "template:manage" // 模板管理
+	PermQuotaManage    = "quota:manage"    // 配额管理
+	PermFileManage     = "file:manage"     // 上传文件清理管理
+	PermSystemDiagnose = "system:diagnose" // 查看系统诊断信息
+)
+
+// RequirePerms 返回一个中间件：当前 JWT 需同时拥有全部指定权限，否则返回 ErrPermissionDenied 错误码。
+// 需配合 jwt 鉴权使用，放在 @server 的 middleware 中。
+func RequirePerms(perms ...string) rest.Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			for _, p := range perms {
+				if !ctxdata.HasPerm(r.Context(), p) {
+					xerr.WriteJson(r.Context(), w, xerr.ErrPermissionDenied)
+					return
+				}
+			}
+			next(w, r)
+		}
+	}
+}
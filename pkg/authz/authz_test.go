@@ -0,0 +1,51 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+)
+
+func TestRequirePerms(t *testing.T) {
+	handler := RequirePerms(PermAuditRead, PermFileManage)(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	tests := []struct {
+		name    string
+		perms   []any // JWT 中的数组声明解析后的类型
+		allowed bool
+	}{
+		{"拥有全部权限", []any{PermAuditRead, PermFileManage, PermQuotaManage}, true},
+		{"缺少部分权限", []any{PermAuditRead}, false},
+		{"没有权限", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r = r.WithContext(context.WithValue(r.Context(), ctxdata.CtxKeyJwtPerms, tt.perms))
+			w := httptest.NewRecorder()
+			handler(w, r)
+			if tt.allowed {
+				if w.Code != http.StatusNoContent {
+					t.Fatalf("status = %d", w.Code)
+				}
+				return
+			}
+			var body struct {
+				Code int    `json:"code"`
+				Msg  string `json:"msg"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %q: %v", w.Body.String(), err)
+			}
+			if body.Code != xerr.Code(xerr.ErrPermissionDenied) || body.Msg == "" {
+				t.Fatalf("body = %+v", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Package circuit 熔断器：连续失败达到阈值后熔断，熔断期间直接拒绝调用；
+// 熔断时长结束后进入半开状态，只放行一个探测请求，探测成功则恢复，失败则重新熔断。
+// 与 go-zero 自适应熔断不同，这里的状态是显式的，便于在健康检查中上报。
+package circuit
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen 熔断中，调用被拒绝
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State 熔断器状态
+type State int
+
+const (
+	StateClosed   State = iota // 正常放行
+	StateOpen                  // 熔断，直接拒绝
+	StateHalfOpen              // 熔断结束，等待探测请求的结果
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Config 熔断配置，未配置的字段使用括号中的默认值
+type Config struct {
+	FailureThreshold int `json:",optional"` // 连续失败多少次后熔断（5）
+	OpenSeconds      int `json:",optional"` // 熔断持续的秒数，之后放行一个探测请求（30）
+}
+
+func (c Config) withDefaults() Config {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = 5
+	}
+	if c.OpenSeconds <= 0 {
+		c.OpenSeconds = 30
+	}
+	return c
+}
+
+// Status 熔断器当前状态
+type Status struct {
+	Name     string
+	State    State
+	Failures int       // 当前连续失败次数
+	OpenedAt time.Time // 最近一次熔断的时间，从未熔断时为零值
+}
+
+// Breaker 熔断器，并发安全
+type Breaker struct {
+	name string
+	cfg  Config
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool // 半开状态下是否已有探测请求在进行
+	onChange []func(name string, state State)
+}
+
+// NewBreaker 创建熔断器
+func NewBreaker(name string, cfg Config) *Breaker {
+	return &Breaker{name: name, cfg: cfg.withDefaults()}
+}
+
+// OnStateChange 注册状态变化回调，回调在持锁外同步执行
+func (b *Breaker) OnStateChange(fn func(name string, state State)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onChange = append(b.onChange, fn)
+}
+
+// Allow 判断是否放行本次调用，放行后需调用 Success 或 Failure 上报结果
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < time.Duration(b.cfg.OpenSeconds)*time.Second {
+			b.mu.Unlock()
+			return ErrOpen
+		}
+		b.probing = true
+		notify := b.setState(StateHalfOpen)
+		b.mu.Unlock()
+		notify()
+		return nil
+	case StateHalfOpen:
+		defer b.mu.Unlock()
+		if b.probing {
+			return ErrOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		b.mu.Unlock()
+		return nil
+	}
+}
+
+// Success 上报调用成功
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	b.failures = 0
+	b.probing = false
+	notify := b.setState(StateClosed)
+	b.mu.Unlock()
+	notify()
+}
+
+// Failure 上报调用失败
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	b.failures++
+	notify := func() {}
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.probing = false
+		b.openedAt = time.Now()
+		notify = b.setState(StateOpen)
+	}
+	b.mu.Unlock()
+	notify()
+}
+
+// Ignore 放行的调用未能说明上游是否可用（如客户端取消）时调用，释放半开状态下的探测名额
+func (b *Breaker) Ignore() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// Status 返回当前状态
+func (b *Breaker) Status() Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Status{Name: b.name, State: b.state, Failures: b.failures, OpenedAt: b.openedAt}
+}
+
+// setState 需持锁调用，返回在释放锁后执行的回调通知
+func (b *Breaker) setState(state State) func() {
+	if b.state == state {
+		return func() {}
+	}
+	b.state = state
+	callbacks := append([]func(string, State){}, b.onChange...)
+	return func() {
+		for _, fn := range callbacks {
+			fn(b.name, state)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package circuit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// expire 将熔断时间提前，模拟熔断时长已经结束
+func expire(b *Breaker) {
+	b.mu.Lock()
+	b.openedAt = b.openedAt.Add(-time.Duration(b.cfg.OpenSeconds) * time.Second)
+	b.mu.Unlock()
+}
+
+func requireState(t *testing.T, b *Breaker, want State) {
+	t.Helper()
+	if got := b.Status().State; got != want {
+		t.Fatalf("state = %v, want %v", got, want)
+	}
+}
+
+func TestBreakerOpen(t *testing.T) {
+	b := NewBreaker("xingchen", Config{FailureThreshold: 3, OpenSeconds: 10})
+	var changes []State
+	b.OnStateChange(func(name string, state State) {
+		if name != "xingchen" {
+			t.Errorf("callback name = %q", name)
+		}
+		changes = append(changes, state)
+	})
+
+	// 未达到阈值前放行，成功会清零连续失败次数
+	b.Failure()
+	b.Failure()
+	b.Success()
+	b.Failure()
+	b.Failure()
+	requireState(t, b, StateClosed)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow below threshold = %v", err)
+	}
+
+	b.Failure()
+	requireState(t, b, StateOpen)
+	if status := b.Status(); status.Failures != 3 || status.OpenedAt.IsZero() {
+		t.Fatalf("status = %+v", status)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("Allow while open = %v", err)
+	}
+	if !reflect.DeepEqual(changes, []State{StateOpen}) {
+		t.Fatalf("state changes = %v", changes)
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name   string
+		report func(b *Breaker)
+		want   State
+	}{
+		{"探测成功后恢复", (*Breaker).Success, StateClosed},
+		{"探测失败后重新熔断", (*Breaker).Failure, StateOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker("xingchen", Config{FailureThreshold: 1, OpenSeconds: 10})
+			var changes []State
+			b.OnStateChange(func(_ string, state State) { changes = append(changes, state) })
+			b.Failure()
+			expire(b)
+
+			// 熔断时长结束后只放行一个探测请求
+			if err := b.Allow(); err != nil {
+				t.Fatalf("probe Allow = %v", err)
+			}
+			requireState(t, b, StateHalfOpen)
+			if err := b.Allow(); !errors.Is(err, ErrOpen) {
+				t.Fatalf("second Allow while probing = %v", err)
+			}
+
+			tt.report(b)
+			requireState(t, b, tt.want)
+			if !reflect.DeepEqual(changes, []State{StateOpen, StateHalfOpen, tt.want}) {
+				t.Fatalf("state changes = %v", changes)
+			}
+			// 重新熔断后重新计时
+			err := b.Allow()
+			if tt.want == StateOpen && !errors.Is(err, ErrOpen) || tt.want == StateClosed && err != nil {
+				t.Fatalf("Allow after probe = %v", err)
+			}
+		})
+	}
+}
+
+func TestBreakerIgnore(t *testing.T) {
+	b := NewBreaker("xingchen", Config{FailureThreshold: 1, OpenSeconds: 10})
+	b.Failure()
+	expire(b)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe Allow = %v", err)
+	}
+
+	// 探测请求被取消，释放名额，保持半开等待下一个探测
+	b.Ignore()
+	requireState(t, b, StateHalfOpen)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow after Ignore = %v", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("second Allow = %v", err)
+	}
+
+	// 关闭状态下 Ignore 不影响失败计数
+	b.Success()
+	b.Ignore()
+	if status := b.Status(); status.State != StateClosed || status.Failures != 0 {
+		t.Fatalf("status = %+v", status)
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	b := NewBreaker("xingchen", Config{})
+	for range 4 {
+		b.Failure()
+	}
+	requireState(t, b, StateClosed)
+	b.Failure()
+	requireState(t, b, StateOpen)
+
+	// 默认熔断 30 秒
+	b.mu.Lock()
+	b.openedAt = b.openedAt.Add(-29 * time.Second)
+	b.mu.Unlock()
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("Allow before 30s = %v", err)
+	}
+	b.mu.Lock()
+	b.openedAt = b.openedAt.Add(-time.Second)
+	b.mu.Unlock()
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow after 30s = %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+// Package clientinfo 在 API 层采集客户端 IP 与 User-Agent，并通过 gRPC metadata 透传给 RPC 层，
+// 供审计等需要来源信息的场景使用。
+package clientinfo
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+// gRPC metadata 键（必须为小写）
+const (
+	MetadataClientIP  = "x-client-ip"
+	MetadataUserAgent = "x-client-user-agent"
+)
+
+// maxUserAgentLen User-Agent 最大保留长度，与 audit_logs.user_agent 列宽一致
+const maxUserAgentLen = 512
+
+type ctxKey struct{}
+
+// Info 客户端来源信息
+type Info struct {
+	IP        string
+	UserAgent string
+}
+
+// NewContext 将客户端信息写入 context
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, ctxKey{}, info)
+}
+
+// FromContext 读取客户端信息：优先取本进程写入的值，其次取 RPC 入站 metadata
+func FromContext(ctx context.Context) Info {
+	if info, ok := ctx.Value(ctxKey{}).(Info); ok {
+		return info
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Info{}
+	}
+	return Info{IP: first(md, MetadataClientIP), UserAgent: first(md, MetadataUserAgent)}
+}
+
+// Config 客户端地址解析配置
+type Config struct {
+	// 可信的反向代理（IP 或 CIDR，如 10.0.0.0/8）。只有直接连接的对端是可信代理时才读取
+	// X-Forwarded-For / X-Real-IP，未配置时客户端 IP 一律取连接的对端地址
+	TrustedProxies []string `json:",optional"`
+}
+
+// Resolver 按可信代理配置解析客户端信息，防止客户端通过伪造请求头冒充其他 IP
+type Resolver struct {
+	proxies []*net.IPNet
+}
+
+// NewResolver 解析可信代理配置
+func NewResolver(c Config) (*Resolver, error) {
+	r := &Resolver{}
+	for _, p := range c.TrustedProxies {
+		p = strings.TrimSpace(p)
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			r.proxies = append(r.proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		r.proxies = append(r.proxies, ipNet)
+	}
+	return r, nil
+}
+
+// MustNewResolver 解析可信代理配置，失败时退出进程
+func MustNewResolver(c Config) *Resolver {
+	r, err := NewResolver(c)
+	logx.Must(err)
+	return r
+}
+
+// FromRequest 从 HTTP 请求中解析客户端信息。
+// 对端不是可信代理时取对端地址；否则从右向左读取 X-Forwarded-For，跳过可信代理，
+// 取第一个不可信的地址（更左侧的地址由客户端自行填写，不可信）。没有 X-Forwarded-For 时取 X-Real-IP
+func (r *Resolver) FromRequest(req *http.Request) Info {
+	ip := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if r.trusted(ip) {
+		if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
+			hops := strings.Split(xff, ",")
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := strings.TrimSpace(hops[i])
+				if net.ParseIP(hop) == nil {
+					break
+				}
+				ip = hop
+				if !r.trusted(hop) {
+					break
+				}
+			}
+		} else if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+			ip = realIP
+		}
+	}
+
+	ua := req.UserAgent()
+	if len(ua) > maxUserAgentLen {
+		ua = ua[:maxUserAgentLen]
+	}
+	return Info{IP: ip, UserAgent: ua}
+}
+
+// Middleware 全局中间件：将客户端信息写入请求 context
+func (r *Resolver) Middleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		next(w, req.WithContext(NewContext(req.Context(), r.FromRequest(req))))
+	}
+}
+
+func (r *Resolver) trusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, p := range r.proxies {
+		if p.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppendToOutgoing 将 context 中的客户端信息追加到 RPC 出站 metadata
+func AppendToOutgoing(ctx context.Context) context.Context {
+	info, ok := ctx.Value(ctxKey{}).(Info)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataClientIP, info.IP, MetadataUserAgent, info.UserAgent)
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package clientinfo
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolverFromRequest(t *testing.T) {
+	r, err := NewResolver(Config{TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1", "fd00::/8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		realIP     string
+		want       string
+	}{
+		{"直连客户端", "203.0.113.5:1234", "", "", "203.0.113.5"},
+		{"直连客户端伪造请求头", "203.0.113.5:1234", "1.2.3.4", "5.6.7.8", "203.0.113.5"},
+		{"经过可信代理", "10.0.0.2:80", "198.51.100.7", "", "198.51.100.7"},
+		{"客户端在左侧伪造地址", "10.0.0.2:80", "1.2.3.4, 198.51.100.7", "", "198.51.100.7"},
+		{"多级可信代理", "10.0.0.2:80", "1.2.3.4, 198.51.100.7, 192.168.1.1, 10.1.1.1", "", "198.51.100.7"},
+		{"全部为可信代理", "10.0.0.2:80", "10.1.1.1, 10.2.2.2", "", "10.1.1.1"},
+		{"无法解析的地址", "10.0.0.2:80", "198.51.100.7, unknown", "", "10.0.0.2"},
+		{"可信代理只设置 X-Real-IP", "10.0.0.2:80", "", "198.51.100.7", "198.51.100.7"},
+		{"IPv6", "[fd00::1]:80", "2001:db8::1", "", "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				req.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if got := r.FromRequest(req).IP; got != tt.want {
+				t.Fatalf("IP = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolverWithoutProxies(t *testing.T) {
+	r := MustNewResolver(Config{})
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.2:80"
+	req.Header.Set("X-Forwarded-For", "198.51.100.7")
+	if got := r.FromRequest(req).IP; got != "10.0.0.2" {
+		t.Fatalf("IP = %q", got)
+	}
+}
+
+func TestNewResolverInvalid(t *testing.T) {
+	for _, p := range []string{"10.0.0.0/33", "proxy.local"} {
+		if _, err := NewResolver(Config{TrustedProxies: []string{p}}); err == nil {
+			t.Errorf("NewResolver(%q) should fail", p)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"document_agent/pkg/xerr"
 	"encoding/json"
+	"slices"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -11,6 +12,15 @@ import (
 // CtxKeyJwtUserId get uid from ctx
 var CtxKeyJwtUserId = "jwtUserId"
 
+// CtxKeyJwtRoles get role codes from ctx
+var CtxKeyJwtRoles = "jwtRoles"
+
+// CtxKeyJwtPerms get permission codes from ctx
+var CtxKeyJwtPerms = "jwtPerms"
+
+// CtxKeyJwtSessionId get session id from ctx
+var CtxKeyJwtSessionId = "jwtSid"
+
 func GetUidFromCtx(ctx context.Context) (int64, error) {
 	var uid int64
 	if jsonUid, ok := ctx.Value(CtxKeyJwtUserId).(json.Number); ok {
@@ -23,3 +33,47 @@ func GetUidFromCtx(ctx context.Context) (int64, error) {
 	}
 	return uid, nil
 }
+
+// GetSessionIdFromCtx 读取 JWT 中的会话ID，旧 token 不携带时返回空串
+func GetSessionIdFromCtx(ctx context.Context) string {
+	sid, _ := ctx.Value(CtxKeyJwtSessionId).(string)
+	return sid
+}
+
+// GetRolesFromCtx 读取 JWT 中的角色编码
+func GetRolesFromCtx(ctx context.Context) []string {
+	return getStringsFromCtx(ctx, CtxKeyJwtRoles)
+}
+
+// GetPermsFromCtx 读取 JWT 中的权限编码
+func GetPermsFromCtx(ctx context.Context) []string {
+	return getStringsFromCtx(ctx, CtxKeyJwtPerms)
+}
+
+// HasRole 当前用户是否拥有指定角色
+func HasRole(ctx context.Context, role string) bool {
+	return slices.Contains(GetRolesFromCtx(ctx), role)
+}
+
+// HasPerm 当前用户是否拥有指定权限
+func HasPerm(ctx context.Context, perm string) bool {
+	return slices.Contains(GetPermsFromCtx(ctx), perm)
+}
+
+// getStringsFromCtx JWT 中的数组声明解析后为 []interface{}，这里统一转换为 []string
+func getStringsFromCtx(ctx context.Context, key string) []string {
+	switch v := ctx.Value(key).(type) {
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,340 @@
+// Package filecleaner 上传文件清理：以 files 表为准判断文件是否过期，并双向清理没有记录的磁盘文件
+// 与磁盘文件已丢失的记录；仍被近期历史数据引用的文件、Protector 返回的文件（如用户头像）与团队空间的文件会保留。多个 API 副本通过 etcd 或 Redis
+// 主节点锁选出一个实例执行清理，每次运行的统计写入 Redis 供管理接口查询。
+package filecleaner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"document_agent/app/llmcenter/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "llmcenter:filecleaner:"
+
+// 分页读取数据库的批大小
+const pageSize = 500
+
+// Config 文件清理配置，未配置的字段使用括号中的默认值
+type Config struct {
+	Enable             bool
+	Dir                string // 上传目录，需与 RPC 的 Upload.BaseDir 指向同一目录
+	RetentionDays      int    // 文件记录创建超过该天数后删除
+	IntervalMinutes    int    // 清理间隔
+	MaxSizeMB          int64  // 0 不限制，>0 则超过大小也删
+	UseEtcdLock        bool   `json:",optional"` // 使用 etcd 主节点锁，否则使用 Redis
+	LockKey            string `json:",optional"` // 主节点锁的键（/locks/filecleaner）
+	LockTTL            int    `json:",optional"` // etcd 租约秒数，主节点失联后其他副本在该时间后接替（60）
+	DryRun             bool   `json:",optional"` // 演练模式：只统计与记录日志，不删除任何文件和记录
+	ReferenceDays      int    `json:",optional"` // 被该天数内的历史数据引用的文件不删除（30）
+	OrphanGraceMinutes int    `json:",optional"` // 孤立文件与孤立记录超过该时长才清理，避免误删上传中的文件，需大于下载链接有效期（60）
+	WorkspaceFiles     bool   `json:",optional"` // 团队空间的文件也按保留天数与大小清理，默认保留
+}
+
+func (c Config) withDefaults() Config {
+	if c.LockKey == "" {
+		c.LockKey = "/locks/filecleaner"
+	}
+	if c.LockTTL <= 0 {
+		c.LockTTL = 60
+	}
+	if c.IntervalMinutes <= 0 {
+		c.IntervalMinutes = 60
+	}
+	if c.ReferenceDays <= 0 {
+		c.ReferenceDays = 30
+	}
+	if c.OrphanGraceMinutes <= 0 {
+		c.OrphanGraceMinutes = 60
+	}
+	return c
+}
+
+// Protector 返回仍在使用、不能清理的文件（stored_name），如用户头像。返回错误时本次清理中止
+type Protector func(ctx context.Context) (map[string]struct{}, error)
+
+// Cleaner 文件清理器
+type Cleaner struct {
+	cfg        Config
+	files      model.FilesModel
+	histories  model.HistorydatasModel
+	protectors []Protector
+	rds        *redis.Redis
+	lock       Lock
+	instance   string
+}
+
+// NewCleaner 创建文件清理器。etcdHosts 仅在 UseEtcdLock 时使用
+func NewCleaner(cfg Config, files model.FilesModel, histories model.HistorydatasModel, rds *redis.Redis, etcdHosts []string, protectors ...Protector) *Cleaner {
+	cfg = cfg.withDefaults()
+	var lock Lock
+	if cfg.UseEtcdLock {
+		lock = NewEtcdLock(etcdHosts, cfg.LockKey, cfg.LockTTL)
+	} else {
+		// Redis 锁每次清理时续期，有效期需覆盖一个清理间隔
+		lock = NewRedisLock(rds, keyPrefix+"lock:"+cfg.LockKey, cfg.IntervalMinutes*60+cfg.LockTTL)
+	}
+	instance, _ := os.Hostname()
+	return &Cleaner{
+		cfg:        cfg,
+		files:      files,
+		histories:  histories,
+		protectors: protectors,
+		rds:        rds,
+		lock:       lock,
+		instance:   instance,
+	}
+}
+
+// Config 返回补全默认值后的配置
+func (c *Cleaner) Config() Config {
+	return c.cfg
+}
+
+// Start 按间隔循环执行清理，只有主节点实际执行
+func (c *Cleaner) Start() {
+	c.tick()
+
+	tk := time.NewTicker(time.Duration(c.cfg.IntervalMinutes) * time.Minute)
+	defer tk.Stop()
+
+	for range tk.C {
+		c.tick()
+	}
+}
+
+func (c *Cleaner) tick() {
+	ctx := context.Background()
+	leader, err := c.lock.Hold(ctx)
+	if err != nil {
+		logx.Errorf("FileCleaner: hold leader lock %s failed: %v", c.cfg.LockKey, err)
+		return
+	}
+	if !leader {
+		logx.Infof("FileCleaner: not leader, skip")
+		return
+	}
+
+	stats, err := c.RunOnce(ctx)
+	if err != nil {
+		logx.Errorf("FileCleaner: run failed: %v", err)
+	}
+	if err := saveStats(ctx, c.rds, stats); err != nil {
+		logx.Errorf("FileCleaner: save stats failed: %v", err)
+	}
+}
+
+// RecentRuns 返回最近 limit 条运行记录，按时间倒序
+func (c *Cleaner) RecentRuns(ctx context.Context, limit int) ([]*Stats, error) {
+	return loadStats(ctx, c.rds, limit)
+}
+
+// RunOnce 执行一次清理，出错中止时返回已完成部分的统计
+func (c *Cleaner) RunOnce(ctx context.Context) (*Stats, error) {
+	log := logx.WithContext(ctx)
+	now := time.Now()
+	stats := &Stats{Instance: c.instance, DryRun: c.cfg.DryRun, StartedAt: now.Unix()}
+	defer func() {
+		stats.DurationMs = time.Since(now).Milliseconds()
+	}()
+
+	err := c.run(ctx, now, stats)
+	if err != nil {
+		stats.Error = err.Error()
+	}
+	log.Infof("FileCleaner: dryRun=%t, rows=%d, files=%d, expired=%d, referenced=%d, orphanFiles=%d, orphanRows=%d, failed=%d, freed=%s",
+		stats.DryRun, stats.ScannedRows, stats.ScannedFiles, stats.Expired, stats.Referenced,
+		stats.OrphanFiles, stats.OrphanRows, stats.Failed, byteCountIEC(stats.FreedBytes))
+	return stats, err
+}
+
+func (c *Cleaner) run(ctx context.Context, now time.Time, stats *Stats) error {
+	referenced, err := c.referencedFiles(ctx, now.AddDate(0, 0, -c.cfg.ReferenceDays))
+	if err != nil {
+		return fmt.Errorf("load referenced files: %w", err)
+	}
+	for _, protect := range c.protectors {
+		protected, err := protect(ctx)
+		if err != nil {
+			return fmt.Errorf("load protected files: %w", err)
+		}
+		for name := range protected {
+			referenced[name] = struct{}{}
+		}
+	}
+	rows, err := c.loadRows(ctx)
+	if err != nil {
+		return fmt.Errorf("load file rows: %w", err)
+	}
+	stats.ScannedRows = int64(len(rows))
+
+	entries, err := os.ReadDir(c.cfg.Dir)
+	if err != nil {
+		return fmt.Errorf("read dir %s: %w", c.cfg.Dir, err)
+	}
+
+	retention := time.Duration(c.cfg.RetentionDays) * 24 * time.Hour
+	grace := time.Duration(c.cfg.OrphanGraceMinutes) * time.Minute
+	maxSize := c.cfg.MaxSizeMB * 1024 * 1024
+
+	onDisk := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		name := entry.Name()
+		onDisk[name] = struct{}{}
+		stats.ScannedFiles++
+
+		row, tracked := rows[name]
+		if !tracked {
+			// 上传失败残留的文件，或导出生成的临时文件
+			if now.Sub(info.ModTime()) < grace {
+				continue
+			}
+			if c.removeFile(ctx, stats, name, info.Size()) {
+				stats.OrphanFiles++
+			}
+			continue
+		}
+
+		expired := now.Sub(row.CreatedAt) > retention
+		oversize := maxSize > 0 && info.Size() > maxSize
+		if !expired && !oversize {
+			continue
+		}
+		if _, ok := referenced[name]; ok || (row.WorkspaceId != 0 && !c.cfg.WorkspaceFiles) {
+			stats.Referenced++
+			continue
+		}
+		// 先删文件再删记录：文件删除失败时保留记录，下次重试
+		if c.removeFile(ctx, stats, name, info.Size()) && c.removeRow(ctx, stats, name) {
+			stats.Expired++
+		}
+	}
+
+	for name, row := range rows {
+		if _, ok := onDisk[name]; ok {
+			continue
+		}
+		// 上传时先写记录再写文件，新记录可能还没有文件
+		if now.Sub(row.CreatedAt) < grace {
+			continue
+		}
+		if c.removeRow(ctx, stats, name) {
+			stats.OrphanRows++
+		}
+	}
+	return nil
+}
+
+// removeFile 删除磁盘文件，文件已不存在视为成功
+func (c *Cleaner) removeFile(ctx context.Context, stats *Stats, name string, size int64) bool {
+	log := logx.WithContext(ctx)
+	if c.cfg.DryRun {
+		log.Infof("FileCleaner: [dry-run] delete file %s", name)
+		stats.FreedBytes += size
+		return true
+	}
+	if err := os.Remove(filepath.Join(c.cfg.Dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Errorf("FileCleaner: delete file %s failed: %v", name, err)
+		stats.Failed++
+		return false
+	}
+	log.Infof("FileCleaner: deleted file %s", name)
+	stats.FreedBytes += size
+	return true
+}
+
+// removeRow 删除文件记录
+func (c *Cleaner) removeRow(ctx context.Context, stats *Stats, name string) bool {
+	log := logx.WithContext(ctx)
+	if c.cfg.DryRun {
+		log.Infof("FileCleaner: [dry-run] delete row stored_name=%s", name)
+		return true
+	}
+	if err := c.files.DeleteByStoredName(ctx, name); err != nil {
+		log.Errorf("FileCleaner: delete row stored_name=%s failed: %v", name, err)
+		stats.Failed++
+		return false
+	}
+	log.Infof("FileCleaner: deleted row stored_name=%s", name)
+	return true
+}
+
+// loadRows 读取全部文件记录，返回 stored_name 到记录的映射
+func (c *Cleaner) loadRows(ctx context.Context) (map[string]*model.File, error) {
+	rows := make(map[string]*model.File)
+	after := ""
+	for {
+		files, err := c.files.ListAfter(ctx, after, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			rows[f.StoredName] = f
+		}
+		if len(files) < pageSize {
+			return rows, nil
+		}
+		after = files[len(files)-1].StoredName
+	}
+}
+
+// metadataRef 历史数据 metadata 中的引用项
+type metadataRef struct {
+	FileId string `json:"file_id"`
+}
+
+// referencedFiles 返回 since 之后的历史数据引用的文件
+func (c *Cleaner) referencedFiles(ctx context.Context, since time.Time) (map[string]struct{}, error) {
+	referenced := make(map[string]struct{})
+	after := ""
+	for {
+		items, err := c.histories.ListMetadataSince(ctx, since, after, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			var refs []metadataRef
+			if err := json.Unmarshal([]byte(item.Metadata.String), &refs); err != nil {
+				continue
+			}
+			for _, ref := range refs {
+				if ref.FileId != "" {
+					referenced[ref.FileId] = struct{}{}
+				}
+			}
+		}
+		if len(items) < pageSize {
+			return referenced, nil
+		}
+		after = items[len(items)-1].MessageId
+	}
+}
+
+func byteCountIEC(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
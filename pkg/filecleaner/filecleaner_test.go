@@ -0,0 +1,111 @@
+package filecleaner
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/model"
+)
+
+type filesModel struct {
+	model.FilesModel
+	rows    []*model.File
+	deleted []string
+}
+
+func (m *filesModel) ListAfter(_ context.Context, after string, limit int) ([]*model.File, error) {
+	var out []*model.File
+	for _, f := range m.rows {
+		if f.StoredName > after && len(out) < limit {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
+
+func (m *filesModel) DeleteByStoredName(_ context.Context, name string) error {
+	m.deleted = append(m.deleted, name)
+	return nil
+}
+
+type historiesModel struct {
+	model.HistorydatasModel
+	items []*model.HistoryMetadata
+}
+
+func (m historiesModel) ListMetadataSince(context.Context, time.Time, string, int) ([]*model.HistoryMetadata, error) {
+	return m.items, nil
+}
+
+func TestRunOnceKeepsProtectedFiles(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().AddDate(0, 0, -10)
+	files := &filesModel{}
+	for _, row := range []struct {
+		name      string
+		workspace int64
+	}{{"a-expired.txt", 0}, {"b-referenced.txt", 0}, {"c-avatar.png", 0}, {"d-workspace.txt", 7}} {
+		if err := os.WriteFile(filepath.Join(dir, row.name), []byte("data"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		files.rows = append(files.rows, &model.File{StoredName: row.name, WorkspaceId: row.workspace, CreatedAt: old})
+	}
+	histories := historiesModel{items: []*model.HistoryMetadata{
+		{MessageId: "m1", Metadata: sql.NullString{String: `[{"file_id":"b-referenced.txt"}]`, Valid: true}},
+	}}
+	avatars := func(context.Context) (map[string]struct{}, error) {
+		return map[string]struct{}{"c-avatar.png": {}}, nil
+	}
+
+	c := NewCleaner(Config{Dir: dir, RetentionDays: 1}, files, histories, nil, nil, avatars)
+	stats, err := c.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if stats.Expired != 1 || stats.Referenced != 3 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	if !slices.Equal(files.deleted, []string{"a-expired.txt"}) {
+		t.Fatalf("deleted rows = %v", files.deleted)
+	}
+	for _, name := range []string{"b-referenced.txt", "c-avatar.png", "d-workspace.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+
+	// 开启 WorkspaceFiles 后团队空间的文件按保留天数清理
+	c = NewCleaner(Config{Dir: dir, RetentionDays: 1, WorkspaceFiles: true}, files, histories, nil, nil, avatars)
+	if stats, err = c.RunOnce(context.Background()); err != nil || stats.Expired != 1 {
+		t.Fatalf("stats = %+v, err = %v", stats, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "d-workspace.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("workspace file not removed: %v", err)
+	}
+}
+
+func TestRunOnceAbortsWhenProtectorFails(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "avatar.png"), []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	files := &filesModel{rows: []*model.File{{StoredName: "avatar.png", CreatedAt: time.Now().AddDate(0, 0, -10)}}}
+	failing := func(context.Context) (map[string]struct{}, error) {
+		return nil, errors.New("usercenter unavailable")
+	}
+
+	c := NewCleaner(Config{Dir: dir, RetentionDays: 1}, files, historiesModel{}, nil, nil, failing)
+	stats, err := c.RunOnce(context.Background())
+	if err == nil || stats.Error == "" {
+		t.Fatal("want error when protected files cannot be loaded")
+	}
+	if len(files.deleted) != 0 {
+		t.Fatalf("deleted rows = %v", files.deleted)
+	}
+}
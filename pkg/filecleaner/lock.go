@@ -0,0 +1,98 @@
+package filecleaner
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Lock 主节点锁。多个副本中只有持有锁的实例执行清理，持有者失联后由其他副本接替
+type Lock interface {
+	// Hold 获取或续期锁，返回当前实例是否为主节点
+	Hold(ctx context.Context) (bool, error)
+}
+
+// redisLock 基于 Redis 的主节点锁，每次 Hold 续期一次，有效期需大于清理间隔
+type redisLock struct {
+	lock *redis.RedisLock
+}
+
+// NewRedisLock 创建 Redis 主节点锁，ttl 为锁的有效期（秒）
+func NewRedisLock(rds *redis.Redis, key string, ttl int) Lock {
+	lock := redis.NewRedisLock(rds, key)
+	lock.SetExpire(ttl)
+	return &redisLock{lock: lock}
+}
+
+func (l *redisLock) Hold(ctx context.Context) (bool, error) {
+	// 已持有时 AcquireCtx 只刷新有效期
+	return l.lock.AcquireCtx(ctx)
+}
+
+// etcdLock 基于 etcd 的主节点锁，租约由会话自动续期，进程退出后 ttl 秒内释放
+type etcdLock struct {
+	hosts []string
+	key   string
+	ttl   int
+
+	mu      sync.Mutex
+	client  *clientv3.Client
+	session *concurrency.Session
+	mutex   *concurrency.Mutex
+	held    bool
+}
+
+// NewEtcdLock 创建 etcd 主节点锁，连接在首次 Hold 时建立
+func NewEtcdLock(hosts []string, key string, ttl int) Lock {
+	return &etcdLock{hosts: hosts, key: key, ttl: ttl}
+}
+
+func (l *etcdLock) Hold(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.session != nil {
+		select {
+		case <-l.session.Done():
+			// 租约已过期，锁可能已被其他副本获取，重新竞争
+			l.session, l.mutex, l.held = nil, nil, false
+		default:
+			if l.held {
+				return true, nil
+			}
+		}
+	}
+
+	if l.client == nil {
+		client, err := clientv3.New(clientv3.Config{
+			Endpoints:   l.hosts,
+			DialTimeout: 5 * time.Second,
+		})
+		if err != nil {
+			return false, err
+		}
+		l.client = client
+	}
+	if l.session == nil {
+		session, err := concurrency.NewSession(l.client, concurrency.WithTTL(l.ttl), concurrency.WithContext(context.Background()))
+		if err != nil {
+			return false, err
+		}
+		l.session = session
+		l.mutex = concurrency.NewMutex(session, l.key)
+	}
+
+	if err := l.mutex.TryLock(ctx); err != nil {
+		if errors.Is(err, concurrency.ErrLocked) {
+			return false, nil
+		}
+		return false, err
+	}
+	l.held = true
+	return true, nil
+}
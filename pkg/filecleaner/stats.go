@@ -0,0 +1,64 @@
+package filecleaner
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	runsKey = keyPrefix + "runs"
+	// 保留的运行记录条数
+	maxRuns = 50
+)
+
+// Stats 一次清理的统计。演练模式下各计数表示将要删除的数量
+type Stats struct {
+	Instance     string `json:"instance"`      // 执行清理的实例（主机名）
+	DryRun       bool   `json:"dry_run"`       // 是否为演练模式
+	StartedAt    int64  `json:"started_at"`    // 开始时间（Unix 秒）
+	DurationMs   int64  `json:"duration_ms"`   // 耗时
+	ScannedRows  int64  `json:"scanned_rows"`  // 扫描的文件记录数
+	ScannedFiles int64  `json:"scanned_files"` // 扫描的磁盘文件数
+	Expired      int64  `json:"expired"`       // 过期或超限而删除的文件
+	Referenced   int64  `json:"referenced"`    // 已过期但仍被近期历史数据或头像引用、或属于团队空间而保留的文件
+	OrphanFiles  int64  `json:"orphan_files"`  // 没有文件记录的磁盘文件
+	OrphanRows   int64  `json:"orphan_rows"`   // 磁盘文件已不存在的文件记录
+	Failed       int64  `json:"failed"`        // 删除失败的文件或记录
+	FreedBytes   int64  `json:"freed_bytes"`   // 释放的磁盘空间
+	Error        string `json:"error"`         // 清理中止的原因
+}
+
+// saveStats 写入运行记录，只保留最近 maxRuns 条
+func saveStats(ctx context.Context, rds *redis.Redis, stats *Stats) error {
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return rds.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, runsKey, data)
+		pipe.LTrim(ctx, runsKey, 0, maxRuns-1)
+		return nil
+	})
+}
+
+// loadStats 读取最近 limit 条运行记录，按时间倒序
+func loadStats(ctx context.Context, rds *redis.Redis, limit int) ([]*Stats, error) {
+	if limit <= 0 || limit > maxRuns {
+		limit = maxRuns
+	}
+	items, err := rds.LrangeCtx(ctx, runsKey, 0, limit-1)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]*Stats, 0, len(items))
+	for _, item := range items {
+		var stats Stats
+		if err := json.Unmarshal([]byte(item), &stats); err != nil {
+			continue
+		}
+		runs = append(runs, &stats)
+	}
+	return runs, nil
+}
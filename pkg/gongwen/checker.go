@@ -0,0 +1,138 @@
+// Package gongwen 提供基于规则的党政机关公文（GB/T 9704-2012）格式检查。
+// 检查对象为大模型生成并保存在 documents 表中的 Markdown 文本。
+package gongwen
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// 规则标识
+const (
+	RuleHeading    = "heading"    // 结构层次序数
+	RuleDocNumber  = "doc_number" // 发文字号
+	RuleDate       = "date"       // 成文日期
+	RuleAttachment = "attachment" // 附件说明
+	RuleAddressee  = "addressee"  // 主送机关
+)
+
+// 严重程度
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Finding 单条检查结果
+type Finding struct {
+	Rule       string // 规则标识，见 Rule* 常量
+	Severity   string // 严重程度，见 Severity* 常量
+	Line       int    // 行号（从 1 开始，0 表示全文级问题）
+	Column     int    // 列号（按字符计，从 1 开始）
+	Excerpt    string // 原文片段
+	Message    string // 问题描述
+	Suggestion string // 修改建议
+}
+
+// line 预处理后的单行文本
+type line struct {
+	no     int    // 原始行号
+	raw    string // 原始内容
+	text   string // 去掉 Markdown 标记与首尾空白后的内容
+	offset int    // text 在 raw 中的起始字符偏移
+}
+
+// Check 对 Markdown 正文执行全部规则，结果按行号、列号排序。
+func Check(markdown string) []Finding {
+	lines := splitLines(markdown)
+
+	var findings []Finding
+	findings = append(findings, checkHeadings(lines)...)
+	findings = append(findings, checkDocNumber(lines)...)
+	findings = append(findings, checkDates(lines)...)
+	findings = append(findings, checkAttachments(lines)...)
+	findings = append(findings, checkAddressee(lines)...)
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+	return findings
+}
+
+// CountBySeverity 统计各严重程度的数量
+func CountBySeverity(findings []Finding) map[string]int {
+	counts := make(map[string]int, 3)
+	for _, f := range findings {
+		counts[f.Severity]++
+	}
+	return counts
+}
+
+// splitLines 统一换行符并剥离每行的 Markdown 修饰（标题井号、引用、加粗、全角空格缩进）。
+// 与导出流程一致，字面量 "\n" 也视为换行。
+func splitLines(markdown string) []line {
+	s := strings.ReplaceAll(markdown, "\r\n", "\n")
+	s = strings.ReplaceAll(s, `\n`, "\n")
+
+	rawLines := strings.Split(s, "\n")
+	out := make([]line, 0, len(rawLines))
+	for i, raw := range rawLines {
+		text, offset := stripMarkdown(raw)
+		out = append(out, line{no: i + 1, raw: raw, text: text, offset: offset})
+	}
+	return out
+}
+
+// stripMarkdown 去掉行首的 Markdown 标记，返回正文及其在原行中的字符偏移
+func stripMarkdown(raw string) (string, int) {
+	text := raw
+	for {
+		trimmed := strings.TrimLeft(text, " \t　")
+		trimmed = strings.TrimLeft(trimmed, "#>")
+		trimmed = strings.TrimPrefix(trimmed, "**")
+		trimmed = strings.TrimPrefix(trimmed, "__")
+		if trimmed == text {
+			break
+		}
+		text = trimmed
+	}
+	offset := utf8.RuneCountInString(raw) - utf8.RuneCountInString(text)
+
+	text = strings.TrimRight(text, " \t　")
+	text = strings.TrimSuffix(text, "**")
+	text = strings.TrimSuffix(text, "__")
+	text = strings.TrimRight(text, "\\")
+	return text, offset
+}
+
+// nonEmpty 返回非空行
+func nonEmpty(lines []line) []line {
+	out := make([]line, 0, len(lines))
+	for _, l := range lines {
+		if l.text != "" {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// runeCol 将 text 中的字节下标换算为原行中的列号（从 1 开始）
+func (l line) runeCol(byteIdx int) int {
+	if byteIdx > len(l.text) {
+		byteIdx = len(l.text)
+	}
+	return l.offset + utf8.RuneCountInString(l.text[:byteIdx]) + 1
+}
+
+// excerpt 截取不超过 40 个字符的原文片段
+func excerpt(s string) string {
+	r := []rune(s)
+	if len(r) > 40 {
+		return string(r[:40]) + "…"
+	}
+	return s
+}
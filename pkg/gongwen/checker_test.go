@@ -0,0 +1,143 @@
+package gongwen
+
+import (
+	"strings"
+	"testing"
+)
+
+// wellFormed 符合各项规则的完整公文，不应产生任何检查结果
+const wellFormed = `某县人民政府文件
+
+某政发〔2025〕12号
+
+# 关于做好防汛工作的通知
+
+各乡镇人民政府，县政府各部门：
+
+一、提高思想认识
+
+（一）压实责任
+
+1. 落实包保制度
+
+（1）每日巡查
+
+（2）及时上报
+
+2. 加强值班值守
+
+（二）做好预案
+
+二、强化物资保障
+
+附件：1.防汛物资清单
+2.值班安排表
+
+某县人民政府
+2025年7月1日`
+
+func TestCheckWellFormed(t *testing.T) {
+	if findings := Check(wellFormed); len(findings) != 0 {
+		t.Fatalf("findings = %+v", findings)
+	}
+}
+
+func TestCheckRules(t *testing.T) {
+	// 每个用例只改动 wellFormed 中的一行，期望产生对应的检查结果
+	tests := []struct {
+		name       string
+		old, new   string
+		rule       string
+		severity   string
+		line       int
+		suggestion string
+	}{
+		{"第一层序数使用逗号", "一、提高思想认识", "一，提高思想认识", RuleHeading, SeverityWarning, 9, "一、提高思想认识"},
+		{"第二层序数使用半角括号", "（一）压实责任", "(一)压实责任", RuleHeading, SeverityWarning, 11, "（一）压实责任"},
+		{"第二层序数后加顿号", "（二）做好预案", "（二）、做好预案", RuleHeading, SeverityWarning, 21, "（二）做好预案"},
+		{"第三层序数使用顿号", "2. 加强值班值守", "2、加强值班值守", RuleHeading, SeverityWarning, 19, "2.加强值班值守"},
+		{"第四层序数缺少左括号", "（2）及时上报", "2）及时上报", RuleHeading, SeverityWarning, 17, "（2）及时上报"},
+		{"序数不连续", "二、强化物资保障", "三、强化物资保障", RuleHeading, SeverityWarning, 23, "二、强化物资保障"},
+		{"结构层次跳级", "（一）压实责任", "1.压实责任", RuleHeading, SeverityInfo, 11, ""},
+		{"发文字号使用方括号", "某政发〔2025〕12号", "某政发[2025]12号", RuleDocNumber, SeverityError, 3, "某政发〔2025〕12号"},
+		{"发文字号加第字且编虚位", "某政发〔2025〕12号", "某政发〔2025〕第012号", RuleDocNumber, SeverityError, 3, "某政发〔2025〕12号"},
+		{"发文字号年份简写", "某政发〔2025〕12号", "某政发〔25〕12号", RuleDocNumber, SeverityError, 3, "某政发〔2025〕12号"},
+		{"成文日期使用中文数字", "2025年7月1日", "二〇二五年七月一日", RuleDate, SeverityError, 29, "2025年7月1日"},
+		{"成文日期使用短横线", "2025年7月1日", "2025-07-01", RuleDate, SeverityError, 29, "2025年7月1日"},
+		{"成文日期编虚位", "2025年7月1日", "2025年07月01日", RuleDate, SeverityError, 29, "2025年7月1日"},
+		{"附件使用半角冒号", "附件：1.防汛物资清单", "附件:1.防汛物资清单", RuleAttachment, SeverityWarning, 25, "附件：1.防汛物资清单"},
+		{"附件序号使用顿号", "2.值班安排表", "2、值班安排表", RuleAttachment, SeverityWarning, 26, "2.值班安排表"},
+		{"附件名称加书名号", "2.值班安排表", "2.《值班安排表》", RuleAttachment, SeverityWarning, 26, "值班安排表"},
+		{"附件名称后加句号", "2.值班安排表", "2.值班安排表。", RuleAttachment, SeverityWarning, 26, "值班安排表"},
+		{"主送机关使用半角冒号", "各乡镇人民政府，县政府各部门：", "各乡镇人民政府，县政府各部门:", RuleAddressee, SeverityWarning, 7, "各乡镇人民政府，县政府各部门："},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(wellFormed, tt.old) {
+				t.Fatalf("%q not in document", tt.old)
+			}
+			findings := Check(strings.Replace(wellFormed, tt.old, tt.new, 1))
+			for _, f := range findings {
+				if f.Rule == tt.rule && f.Severity == tt.severity && f.Line == tt.line &&
+					(tt.suggestion == "" || f.Suggestion == tt.suggestion) {
+					return
+				}
+			}
+			t.Fatalf("no %s/%s finding at line %d with suggestion %q in %+v", tt.rule, tt.severity, tt.line, tt.suggestion, findings)
+		})
+	}
+}
+
+func TestCheckDocumentLevel(t *testing.T) {
+	findings := Check("关于做好防汛工作的通知\n\n请各单位做好防汛工作。")
+	want := map[string]bool{RuleDocNumber: false, RuleDate: false, RuleAddressee: false}
+	for _, f := range findings {
+		if _, ok := want[f.Rule]; ok && f.Severity == SeverityInfo {
+			want[f.Rule] = true
+		}
+	}
+	for rule, found := range want {
+		if !found {
+			t.Errorf("missing %s info finding in %+v", rule, findings)
+		}
+	}
+	if counts := CountBySeverity(findings); counts[SeverityError] != 0 || counts[SeverityInfo] != len(findings) {
+		t.Fatalf("counts = %v", counts)
+	}
+}
+
+func TestCheckColumn(t *testing.T) {
+	// 列号按字符计，并计入被剥离的 Markdown 标记
+	for _, f := range Check("**  一，总体要求**") {
+		if f.Rule == RuleHeading {
+			if f.Line != 1 || f.Column != 5 || f.Suggestion != "一、总体要求" {
+				t.Fatalf("finding = %+v", f)
+			}
+			return
+		}
+	}
+	t.Fatal("missing heading finding")
+}
+
+func TestNumerals(t *testing.T) {
+	for n := 1; n <= 99; n++ {
+		if got := cnToInt(intToCn(n)); got != n {
+			t.Fatalf("cnToInt(intToCn(%d)) = %d", n, got)
+		}
+	}
+	if cnToInt("百") != 0 || intToCn(100) != "" {
+		t.Fatal("out of range numerals should not convert")
+	}
+	if got := cnDigitsToArabic("二〇二五"); got != "2025" {
+		t.Fatalf("cnDigitsToArabic = %q", got)
+	}
+}
+
+func TestFormatDocNo(t *testing.T) {
+	if got := FormatDocNo("某政发", 2025, 12); got != "某政发〔2025〕12号" {
+		t.Fatalf("FormatDocNo = %q", got)
+	}
+	if !ValidDocNoPrefix("某政发") || ValidDocNoPrefix("ab") || ValidDocNoPrefix("") {
+		t.Fatal("ValidDocNoPrefix")
+	}
+}
@@ -0,0 +1,19 @@
+package gongwen
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// docNoPrefixRe 发文机关代字，与格式检查中识别发文字号的规则一致
+var docNoPrefixRe = regexp.MustCompile(`^\p{Han}{1,12}$`)
+
+// ValidDocNoPrefix 判断是否为合法的发文机关代字（1~12 个汉字）
+func ValidDocNoPrefix(prefix string) bool {
+	return docNoPrefixRe.MatchString(prefix)
+}
+
+// FormatDocNo 按 GB/T 9704-2012 生成发文字号：年份使用六角括号，序号不编虚位，如 “某政〔2025〕12号”
+func FormatDocNo(prefix string, year int, seq int64) string {
+	return fmt.Sprintf("%s〔%d〕%d号", prefix, year, seq)
+}
@@ -0,0 +1,60 @@
+package gongwen
+
+import "strings"
+
+var cnDigits = map[rune]int{
+	'〇': 0, '零': 0, '一': 1, '二': 2, '三': 3, '四': 4,
+	'五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+}
+
+var cnDigitChars = []string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// cnToInt 将 1~99 的中文序数（如 “十二”、“二十”）转换为整数，无法识别时返回 0
+func cnToInt(s string) int {
+	r := []rune(s)
+	switch {
+	case len(r) == 0:
+		return 0
+	case len(r) == 1 && r[0] == '十':
+		return 10
+	case len(r) == 1:
+		return cnDigits[r[0]]
+	case len(r) == 2 && r[0] == '十':
+		return 10 + cnDigits[r[1]]
+	case len(r) == 2 && r[1] == '十':
+		return cnDigits[r[0]] * 10
+	case len(r) == 3 && r[1] == '十':
+		return cnDigits[r[0]]*10 + cnDigits[r[2]]
+	}
+	return 0
+}
+
+// intToCn 将 1~99 的整数转换为中文序数
+func intToCn(n int) string {
+	switch {
+	case n <= 0 || n > 99:
+		return ""
+	case n < 10:
+		return cnDigitChars[n]
+	case n == 10:
+		return "十"
+	case n < 20:
+		return "十" + cnDigitChars[n%10]
+	case n%10 == 0:
+		return cnDigitChars[n/10] + "十"
+	}
+	return cnDigitChars[n/10] + "十" + cnDigitChars[n%10]
+}
+
+// cnDigitsToArabic 逐位转换中文数字（用于年份，如 “二〇二五” → “2025”）
+func cnDigitsToArabic(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		d, ok := cnDigits[c]
+		if !ok {
+			return ""
+		}
+		b.WriteByte(byte('0' + d))
+	}
+	return b.String()
+}
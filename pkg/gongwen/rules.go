@@ -0,0 +1,613 @@
+package gongwen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const cnNum = `[一二三四五六七八九十]{1,3}`
+
+// ---------------------------------------------------------------------------
+// 结构层次序数：一、 → （一） → 1. → （1）
+// ---------------------------------------------------------------------------
+
+var headingLevelNames = [5]string{"", "第一层", "第二层", "第三层", "第四层"}
+
+type headingPattern struct {
+	re    *regexp.Regexp
+	level int
+	cn    bool   // 序号是否为中文数字
+	issue string // 为空表示写法正确
+}
+
+// 顺序敏感：先匹配错误写法，再匹配正确写法；全角括号的正确写法须在半角括号的错误写法之前匹配
+var headingPatterns = []headingPattern{
+	{regexp.MustCompile(`^（(` + cnNum + `)）[、.．，,]`), 2, true, "“（一）”后不加标点符号"},
+	{regexp.MustCompile(`^（(` + cnNum + `)）`), 2, true, ""},
+	{regexp.MustCompile(`^[(（](` + cnNum + `)[)）]`), 2, true, "第二层序数应使用全角括号“（一）”"},
+	{regexp.MustCompile(`^(` + cnNum + `)）`), 2, true, "第二层序数缺少左括号，应为“（一）”"},
+	{regexp.MustCompile(`^(` + cnNum + `)(?:[.．，,：:]|[ 　]+)`), 1, true, "第一层序数应使用“一、”（顿号）"},
+	{regexp.MustCompile(`^(` + cnNum + `)、`), 1, true, ""},
+	{regexp.MustCompile(`^（(\d{1,2})）[、.．，,]`), 4, false, "“（1）”后不加标点符号"},
+	{regexp.MustCompile(`^（(\d{1,2})）`), 4, false, ""},
+	{regexp.MustCompile(`^[(（](\d{1,2})[)）]`), 4, false, "第四层序数应使用全角括号“（1）”"},
+	{regexp.MustCompile(`^(\d{1,2})）`), 4, false, "第四层序数缺少左括号，应为“（1）”"},
+	{regexp.MustCompile(`^(\d{1,2})[、．，,]`), 3, false, "第三层序数应使用阿拉伯数字加半角圆点“1.”"},
+	{regexp.MustCompile(`^(\d{1,2})\.(?:[^\d]|$)`), 3, false, ""},
+}
+
+type heading struct {
+	level int
+	num   int
+	end   int // 序号（含标点）在 text 中的结束字节下标
+	issue string
+}
+
+// classifyHeading 识别行首的层次序数，未识别时 level 为 0
+func classifyHeading(text string) heading {
+	for _, p := range headingPatterns {
+		m := p.re.FindStringSubmatchIndex(text)
+		if m == nil {
+			continue
+		}
+		numStr := text[m[2]:m[3]]
+		n := 0
+		if p.cn {
+			n = cnToInt(numStr)
+		} else {
+			n, _ = strconv.Atoi(numStr)
+		}
+		if n == 0 {
+			continue
+		}
+		end := m[1]
+		// 正确的三层序数会多匹配一个正文字符，这里回退
+		if p.level == 3 && p.issue == "" && end > m[3]+1 {
+			end = m[3] + 1
+		}
+		return heading{level: p.level, num: n, end: end, issue: p.issue}
+	}
+	return heading{}
+}
+
+// headingPrefix 生成标准层次序数
+func headingPrefix(level, n int) string {
+	switch level {
+	case 1:
+		return intToCn(n) + "、"
+	case 2:
+		return "（" + intToCn(n) + "）"
+	case 3:
+		return strconv.Itoa(n) + "."
+	case 4:
+		return "（" + strconv.Itoa(n) + "）"
+	}
+	return ""
+}
+
+func checkHeadings(lines []line) []Finding {
+	var (
+		findings  []Finding
+		counters  [5]int
+		lastLevel int
+		skip      = attachmentItemLines(lines)
+	)
+
+	for _, l := range lines {
+		if l.text == "" || skip[l.no] {
+			continue
+		}
+		h := classifyHeading(l.text)
+		if h.level == 0 {
+			continue
+		}
+		rest := strings.TrimLeft(l.text[h.end:], " 　")
+		got := l.text[:h.end]
+
+		if h.issue != "" {
+			findings = append(findings, Finding{
+				Rule:       RuleHeading,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(0),
+				Excerpt:    excerpt(l.text),
+				Message:    h.issue,
+				Suggestion: headingPrefix(h.level, h.num) + rest,
+			})
+		}
+
+		if expected := counters[h.level] + 1; h.num != expected {
+			findings = append(findings, Finding{
+				Rule:       RuleHeading,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(0),
+				Excerpt:    excerpt(got),
+				Message:    fmt.Sprintf("%s序数不连续，此处应为“%s”", headingLevelNames[h.level], headingPrefix(h.level, expected)),
+				Suggestion: headingPrefix(h.level, expected) + rest,
+			})
+		}
+
+		if lastLevel > 0 && h.level > lastLevel+1 {
+			findings = append(findings, Finding{
+				Rule:     RuleHeading,
+				Severity: SeverityInfo,
+				Line:     l.no,
+				Column:   l.runeCol(0),
+				Excerpt:  excerpt(got),
+				Message: fmt.Sprintf("结构层次跳级：%s之后直接使用%s",
+					headingLevelNames[lastLevel], headingLevelNames[h.level]),
+				Suggestion: fmt.Sprintf("按“一、”“（一）”“1.”“（1）”依次使用，此处宜先使用%s序数", headingLevelNames[lastLevel+1]),
+			})
+		}
+
+		counters[h.level] = h.num
+		for j := h.level + 1; j < len(counters); j++ {
+			counters[j] = 0
+		}
+		lastLevel = h.level
+	}
+	return findings
+}
+
+// ---------------------------------------------------------------------------
+// 发文字号：发文机关代字〔年份〕序号号，如 “国办发〔2025〕12号”
+// ---------------------------------------------------------------------------
+
+var (
+	docNumberRe     = regexp.MustCompile(`(\p{Han}{1,12})\s*([〔【\[［(（])\s*([0-9〇零一二三四五六七八九]{2,4})\s*([〕】\]］)）])\s*(第?)\s*(\d{1,4})\s*号`)
+	docNumberLineRe = regexp.MustCompile(`^` + docNumberRe.String() + `$`)
+)
+
+// isDocNumberLine 判断整行是否为发文字号
+func isDocNumberLine(text string) bool {
+	return docNumberLineRe.MatchString(text)
+}
+
+func checkDocNumber(lines []line) []Finding {
+	var (
+		findings []Finding
+		found    bool
+	)
+
+	for _, l := range lines {
+		if l.text == "" {
+			continue
+		}
+		standalone := isDocNumberLine(l.text)
+		if standalone {
+			found = true
+		}
+
+		for _, m := range docNumberRe.FindAllStringSubmatchIndex(l.text, -1) {
+			org := l.text[m[2]:m[3]]
+			open, year, closing := l.text[m[4]:m[5]], l.text[m[6]:m[7]], l.text[m[8]:m[9]]
+			di, seq := l.text[m[10]:m[11]], l.text[m[12]:m[13]]
+
+			var issues []string
+			if open != "〔" || closing != "〕" {
+				issues = append(issues, "年份应使用六角括号“〔〕”")
+			}
+			if arabic := cnDigitsToArabic(year); arabic != "" {
+				issues = append(issues, "年份应使用阿拉伯数字")
+				year = arabic
+			}
+			if len(year) == 2 {
+				issues = append(issues, "年份应标全称")
+				year = "20" + year
+			} else if len(year) != 4 {
+				continue
+			}
+			if di != "" {
+				issues = append(issues, "发文顺序号前不加“第”字")
+			}
+			if n, _ := strconv.Atoi(seq); strconv.Itoa(n) != seq {
+				issues = append(issues, "发文顺序号不编虚位")
+				seq = strconv.Itoa(n)
+			}
+			if len(issues) == 0 {
+				continue
+			}
+
+			severity := SeverityWarning
+			if standalone {
+				severity = SeverityError
+			}
+			findings = append(findings, Finding{
+				Rule:       RuleDocNumber,
+				Severity:   severity,
+				Line:       l.no,
+				Column:     l.runeCol(m[0]),
+				Excerpt:    excerpt(l.text[m[0]:m[1]]),
+				Message:    "发文字号格式不规范：" + strings.Join(issues, "；"),
+				Suggestion: fmt.Sprintf("%s〔%s〕%s号", org, year, seq),
+			})
+		}
+	}
+
+	if !found {
+		findings = append(findings, Finding{
+			Rule:       RuleDocNumber,
+			Severity:   SeverityInfo,
+			Message:    "未检测到独立成行的发文字号",
+			Suggestion: "在版头标注发文字号，如“某政发〔2025〕1号”，或在导出时通过 docNo 字段补充",
+		})
+	}
+	return findings
+}
+
+// ---------------------------------------------------------------------------
+// 成文日期：用阿拉伯数字将年、月、日标全，年份标全称，月、日不编虚位
+// ---------------------------------------------------------------------------
+
+var (
+	cnDateRe      = regexp.MustCompile(`([〇零一二三四五六七八九]{4})年(` + cnNum + `)月(?:(` + cnNum + `)日)?`)
+	numericDateRe = regexp.MustCompile(`(^|[^\d])(\d{4})[-/.](\d{1,2})[-/.](\d{1,2})(?:[^\d]|$)`)
+	arabicDateRe  = regexp.MustCompile(`(^|[^\d])(\d{2}|\d{4})年(\d{1,2})月(?:(\d{1,2})日)?`)
+)
+
+// signatureWindow 文末视为落款区（发文机关署名、成文日期）的非空行数
+const signatureWindow = 3
+
+func formatDate(year, month, day string) string {
+	m, _ := strconv.Atoi(month)
+	s := fmt.Sprintf("%s年%d月", year, m)
+	if day != "" {
+		d, _ := strconv.Atoi(day)
+		s += fmt.Sprintf("%d日", d)
+	}
+	return s
+}
+
+func checkDates(lines []line) []Finding {
+	var findings []Finding
+
+	ne := nonEmpty(lines)
+	inSignature := make(map[int]bool, signatureWindow)
+	for i := len(ne) - 1; i >= 0 && i >= len(ne)-signatureWindow; i-- {
+		inSignature[ne[i].no] = true
+	}
+	signatureHasDate := false
+
+	report := func(l line, start, end int, message, suggestion string) {
+		severity := SeverityWarning
+		if inSignature[l.no] {
+			severity = SeverityError
+		}
+		findings = append(findings, Finding{
+			Rule:       RuleDate,
+			Severity:   severity,
+			Line:       l.no,
+			Column:     l.runeCol(start),
+			Excerpt:    excerpt(l.text[start:end]),
+			Message:    message,
+			Suggestion: suggestion,
+		})
+	}
+
+	for _, l := range ne {
+		hasDate := false
+
+		for _, m := range cnDateRe.FindAllStringSubmatchIndex(l.text, -1) {
+			hasDate = true
+			year := cnDigitsToArabic(l.text[m[2]:m[3]])
+			month := strconv.Itoa(cnToInt(l.text[m[4]:m[5]]))
+			day := ""
+			if m[6] >= 0 {
+				day = strconv.Itoa(cnToInt(l.text[m[6]:m[7]]))
+			}
+			report(l, m[0], m[1], "日期应使用阿拉伯数字标注", formatDate(year, month, day))
+		}
+
+		for _, m := range numericDateRe.FindAllStringSubmatchIndex(l.text, -1) {
+			hasDate = true
+			start, end := m[4], m[9]
+			report(l, start, end, "日期应采用“××××年×月×日”的形式",
+				formatDate(l.text[m[4]:m[5]], l.text[m[6]:m[7]], l.text[m[8]:m[9]]))
+		}
+
+		for _, m := range arabicDateRe.FindAllStringSubmatchIndex(l.text, -1) {
+			hasDate = true
+			year, month := l.text[m[4]:m[5]], l.text[m[6]:m[7]]
+			day := ""
+			if m[8] >= 0 {
+				day = l.text[m[8]:m[9]]
+			}
+
+			var issues []string
+			if len(year) == 2 {
+				issues = append(issues, "年份应标全称")
+				year = "20" + year
+			}
+			if strings.HasPrefix(month, "0") || strings.HasPrefix(day, "0") {
+				issues = append(issues, "月、日不编虚位")
+			}
+			if len(issues) > 0 {
+				report(l, m[4], m[1], "日期格式不规范："+strings.Join(issues, "；"), formatDate(year, month, day))
+			}
+		}
+
+		if hasDate && inSignature[l.no] {
+			signatureHasDate = true
+		}
+	}
+
+	if len(ne) > 0 && !signatureHasDate {
+		findings = append(findings, Finding{
+			Rule:       RuleDate,
+			Severity:   SeverityInfo,
+			Message:    "文末未检测到成文日期",
+			Suggestion: "在发文机关署名下一行标注成文日期，如“2025年7月1日”",
+		})
+	}
+	return findings
+}
+
+// ---------------------------------------------------------------------------
+// 附件说明：“附件：1.××××”，多个附件按序号逐行排列，名称后不加标点
+// ---------------------------------------------------------------------------
+
+var (
+	attachmentRe     = regexp.MustCompile(`^附件\s*(\d*)\s*([:：]?)\s*(.*)$`)
+	attachmentItemRe = regexp.MustCompile(`^(\d{1,2})\s*([.．、,，]?)\s*(.*)$`)
+)
+
+// attachmentTrailing 附件名称末尾不应出现的标点
+const attachmentTrailing = "。．.，,；;：:、"
+
+// attachmentItemLines 返回附件说明中后续附件名称所在的行号
+func attachmentItemLines(lines []line) map[int]bool {
+	items := make(map[int]bool)
+	for i := 0; i < len(lines); i++ {
+		m := attachmentRe.FindStringSubmatch(lines[i].text)
+		if m == nil || m[2] == "" && m[3] == "" {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if lines[j].text == "" {
+				continue
+			}
+			if !isAttachmentItem(lines[j].text) {
+				break
+			}
+			items[lines[j].no] = true
+			i = j
+		}
+	}
+	return items
+}
+
+func isAttachmentItem(text string) bool {
+	m := attachmentItemRe.FindStringSubmatch(text)
+	return m != nil && m[2] != "" && m[3] != ""
+}
+
+func checkAttachments(lines []line) []Finding {
+	var findings []Finding
+
+	for i := 0; i < len(lines); i++ {
+		l := lines[i]
+		m := attachmentRe.FindStringSubmatchIndex(l.text)
+		if m == nil {
+			continue
+		}
+		label, colon, first := l.text[m[2]:m[3]], l.text[m[4]:m[5]], l.text[m[6]:m[7]]
+
+		// “附件” 或 “附件1” 单独成行，是附件页的版记，不是附件说明
+		if first == "" && colon == "" {
+			continue
+		}
+		if label != "" && colon == "" {
+			continue
+		}
+		// 无冒号且像一句正文（较长或含句内标点）时，视为以“附件”开头的普通段落
+		if colon == "" && (utf8.RuneCountInString(first) > 30 || strings.ContainsAny(first, "，。；")) {
+			continue
+		}
+
+		switch colon {
+		case "":
+			findings = append(findings, Finding{
+				Rule:       RuleAttachment,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(0),
+				Excerpt:    excerpt(l.text),
+				Message:    "“附件”后应使用全角冒号",
+				Suggestion: "附件：" + first,
+			})
+		case ":":
+			findings = append(findings, Finding{
+				Rule:       RuleAttachment,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(m[4]),
+				Excerpt:    excerpt(l.text),
+				Message:    "“附件”后应使用全角冒号“：”",
+				Suggestion: "附件：" + first,
+			})
+		}
+
+		// 收集附件名称：首个名称与冒号同行，其余逐行排列
+		type item struct {
+			l     line
+			start int
+			text  string
+		}
+		var items []item
+		if first != "" {
+			items = append(items, item{l: l, start: m[6], text: first})
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if lines[j].text == "" {
+				continue
+			}
+			if !isAttachmentItem(lines[j].text) {
+				break
+			}
+			items = append(items, item{l: lines[j], start: 0, text: lines[j].text})
+			i = j
+		}
+
+		numbered := 0
+		for idx, it := range items {
+			im := attachmentItemRe.FindStringSubmatch(it.text)
+			name := it.text
+			if im != nil && im[2] != "" {
+				numbered++
+				name = im[3]
+				if im[2] != "." {
+					findings = append(findings, Finding{
+						Rule:       RuleAttachment,
+						Severity:   SeverityWarning,
+						Line:       it.l.no,
+						Column:     it.l.runeCol(it.start),
+						Excerpt:    excerpt(it.text),
+						Message:    "附件序号应使用阿拉伯数字加半角圆点",
+						Suggestion: fmt.Sprintf("%s.%s", im[1], name),
+					})
+				}
+			} else if len(items) > 1 && idx == 0 {
+				findings = append(findings, Finding{
+					Rule:       RuleAttachment,
+					Severity:   SeverityWarning,
+					Line:       it.l.no,
+					Column:     it.l.runeCol(it.start),
+					Excerpt:    excerpt(it.text),
+					Message:    "有多个附件时应使用阿拉伯数字标注附件顺序号",
+					Suggestion: "1." + name,
+				})
+			}
+
+			if bare := strings.TrimRight(name, attachmentTrailing); strings.HasPrefix(bare, "《") && strings.HasSuffix(bare, "》") {
+				findings = append(findings, Finding{
+					Rule:       RuleAttachment,
+					Severity:   SeverityWarning,
+					Line:       it.l.no,
+					Column:     it.l.runeCol(it.start),
+					Excerpt:    excerpt(it.text),
+					Message:    "附件名称不加书名号",
+					Suggestion: strings.TrimSuffix(strings.TrimPrefix(bare, "《"), "》"),
+				})
+			}
+			if r, _ := utf8.DecodeLastRuneInString(name); strings.ContainsRune(attachmentTrailing, r) {
+				findings = append(findings, Finding{
+					Rule:       RuleAttachment,
+					Severity:   SeverityWarning,
+					Line:       it.l.no,
+					Column:     it.l.runeCol(len(it.l.text) - utf8.RuneLen(r)),
+					Excerpt:    excerpt(it.text),
+					Message:    "附件名称后不加标点符号",
+					Suggestion: strings.TrimRight(name, attachmentTrailing),
+				})
+			}
+		}
+
+		if len(items) == 1 && numbered == 1 {
+			findings = append(findings, Finding{
+				Rule:       RuleAttachment,
+				Severity:   SeverityInfo,
+				Line:       items[0].l.no,
+				Column:     items[0].l.runeCol(items[0].start),
+				Excerpt:    excerpt(items[0].text),
+				Message:    "只有一个附件时不标注顺序号",
+				Suggestion: "附件：" + attachmentItemRe.FindStringSubmatch(items[0].text)[3],
+			})
+		}
+	}
+	return findings
+}
+
+// ---------------------------------------------------------------------------
+// 主送机关：编排于标题下空一行位置，居左顶格，最后一个机关名称后标全角冒号
+// ---------------------------------------------------------------------------
+
+var (
+	organRe = regexp.MustCompile(`各|政府|委员会|局|厅|办公室|部门|单位|公司|中心|学校|学院|街道|乡|镇|委|院|所|处`)
+
+	// addresseeWindow 标题后检查主送机关的非空行数
+	addresseeWindow = 5
+)
+
+// isAddresseeCandidate 判断一行是否形似主送机关
+func isAddresseeCandidate(text string) bool {
+	if utf8.RuneCountInString(text) > 60 {
+		return false
+	}
+	if !strings.HasSuffix(text, "：") && !strings.HasSuffix(text, ":") {
+		return false
+	}
+	if strings.HasPrefix(text, "附件") || classifyHeading(text).level != 0 {
+		return false
+	}
+	return organRe.MatchString(text)
+}
+
+func checkAddressee(lines []line) []Finding {
+	ne := nonEmpty(lines)
+
+	// 跳过版头（发文机关标志、发文字号），定位标题
+	titleIdx := -1
+	for i, l := range ne {
+		if isDocNumberLine(l.text) || strings.HasSuffix(l.text, "文件") {
+			continue
+		}
+		titleIdx = i
+		break
+	}
+	if titleIdx < 0 {
+		return nil
+	}
+
+	// 标题下方的发文字号不占用主送机关的位置
+	expected := titleIdx + 1
+	for expected < len(ne) && isDocNumberLine(ne[expected].text) {
+		expected++
+	}
+
+	for i := titleIdx + 1; i < len(ne) && i <= expected+addresseeWindow; i++ {
+		l := ne[i]
+		if !isAddresseeCandidate(l.text) {
+			continue
+		}
+
+		var findings []Finding
+		name := strings.TrimSuffix(strings.TrimSuffix(l.text, "："), ":")
+		if i != expected {
+			findings = append(findings, Finding{
+				Rule:       RuleAddressee,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(0),
+				Excerpt:    excerpt(l.text),
+				Message:    "主送机关应位于标题之下、正文之前",
+				Suggestion: fmt.Sprintf("将“%s：”移至标题下一行", name),
+			})
+		}
+		if strings.HasSuffix(l.text, ":") {
+			findings = append(findings, Finding{
+				Rule:       RuleAddressee,
+				Severity:   SeverityWarning,
+				Line:       l.no,
+				Column:     l.runeCol(len(l.text) - 1),
+				Excerpt:    excerpt(l.text),
+				Message:    "主送机关最后一个机关名称后应标全角冒号",
+				Suggestion: name + "：",
+			})
+		}
+		return findings
+	}
+
+	return []Finding{{
+		Rule:       RuleAddressee,
+		Severity:   SeverityInfo,
+		Line:       ne[titleIdx].no,
+		Column:     ne[titleIdx].runeCol(0),
+		Excerpt:    excerpt(ne[titleIdx].text),
+		Message:    "标题之后未检测到主送机关（公告、通告等普发性公文可不标注）",
+		Suggestion: "在标题下一行居左顶格标注主送机关，如“各乡镇人民政府，县政府各部门：”",
+	}}
+}
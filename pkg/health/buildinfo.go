@@ -0,0 +1,41 @@
+package health
+
+import "runtime/debug"
+
+// BuildInfo 当前二进制的构建信息，从 Go 嵌入的构建元数据中读取；未在 git 工作区中构建时版本字段为空
+type BuildInfo struct {
+	GoVersion string
+	Revision  string // git 提交，构建时工作区有未提交的修改则带 -dirty 后缀
+	Time      string // 提交时间
+}
+
+// ReadBuildInfo 读取构建信息
+func ReadBuildInfo() BuildInfo {
+	var info BuildInfo
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	info.GoVersion = bi.GoVersion
+	var modified bool
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			info.Revision = s.Value
+		case "vcs.time":
+			info.Time = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if modified && info.Revision != "" {
+		info.Revision += "-dirty"
+	}
+	return info
+}
+
+// Issue 配置检查发现的问题
+type Issue struct {
+	Field   string
+	Message string
+}
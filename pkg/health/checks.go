@@ -0,0 +1,245 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// MySQL 检查数据库连接，detail 为 MySQL 版本
+func MySQL(conn sqlx.SqlConn) Check {
+	return Check{Name: "mysql", Run: func(ctx context.Context) (string, error) {
+		db, err := conn.RawDB()
+		if err != nil {
+			return "", err
+		}
+		var version string
+		if err := db.QueryRowContext(ctx, "SELECT VERSION()").Scan(&version); err != nil {
+			return "", err
+		}
+		return version, nil
+	}}
+}
+
+// Redis 检查 Redis 连接
+func Redis(rds *redis.Redis) Check {
+	return Check{Name: "redis", Run: func(ctx context.Context) (string, error) {
+		if !rds.PingCtx(ctx) {
+			return "", errors.New("ping failed")
+		}
+		return "", nil
+	}}
+}
+
+// Etcd 检查 etcd 集群，至少一个节点可用即通过，detail 为各节点的版本。客户端在首次检查时创建并复用；
+// 未配置 etcd（直连 RPC）时视为通过
+func Etcd(hosts []string) Check {
+	var (
+		mu  sync.Mutex
+		cli *clientv3.Client
+	)
+	client := func() (*clientv3.Client, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if cli != nil {
+			return cli, nil
+		}
+		c, err := clientv3.New(clientv3.Config{Endpoints: hosts, DialTimeout: 3 * time.Second})
+		if err != nil {
+			return nil, err
+		}
+		cli = c
+		return cli, nil
+	}
+
+	return Check{Name: "etcd", Run: func(ctx context.Context) (string, error) {
+		if len(hosts) == 0 {
+			return "not configured", nil
+		}
+		c, err := client()
+		if err != nil {
+			return "", err
+		}
+		var details, errs []string
+		for _, ep := range hosts {
+			status, err := c.Status(ctx, ep)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", ep, err))
+				continue
+			}
+			details = append(details, fmt.Sprintf("%s v%s", ep, status.Version))
+		}
+		if len(details) == 0 {
+			return "", errors.New(strings.Join(errs, "; "))
+		}
+		return strings.Join(details, ", "), nil
+	}}
+}
+
+// GRPC 通过 gRPC 健康检查服务检查下游 RPC 服务是否存活
+func GRPC(name string, conn *grpc.ClientConn) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return "", err
+		}
+		if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+			return "", fmt.Errorf("status %s", resp.Status)
+		}
+		return "", nil
+	}}
+}
+
+// Dir 检查目录存在且可写，并且所在磁盘的可用空间不少于 minFree 字节，detail 为可用空间
+func Dir(name, path string, minFree int64) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		if path == "" {
+			return "", errors.New("not configured")
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		if !info.IsDir() {
+			return "", fmt.Errorf("%s is not a directory", path)
+		}
+
+		f, err := os.CreateTemp(path, ".healthcheck-*")
+		if err != nil {
+			return "", fmt.Errorf("not writable: %w", err)
+		}
+		f.Close()
+		os.Remove(f.Name())
+
+		free, err := diskFree(path)
+		if err != nil {
+			return "", err
+		}
+		detail := fmt.Sprintf("%s free", formatBytes(free))
+		if free < minFree {
+			return detail, fmt.Errorf("only %s free, want at least %s", formatBytes(free), formatBytes(minFree))
+		}
+		return detail, nil
+	}}
+}
+
+// File 检查配置的文件存在且可读，如 Pandoc Lua 过滤器
+func File(name, path string) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		if path == "" {
+			return "", errors.New("not configured")
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			return "", err
+		}
+		if info.IsDir() {
+			return "", fmt.Errorf("%s is a directory", path)
+		}
+		return path, nil
+	}}
+}
+
+// FontDir 检查字体目录中至少有一个字体文件，detail 为字体文件名
+func FontDir(name, path string) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		if path == "" {
+			return "", errors.New("not configured")
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return "", err
+		}
+		var fonts []string
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".ttf", ".otf", ".ttc":
+				fonts = append(fonts, e.Name())
+			}
+		}
+		if len(fonts) == 0 {
+			return "", fmt.Errorf("no font files in %s", path)
+		}
+		return strings.Join(fonts, ", "), nil
+	}}
+}
+
+// Command 检查外部命令已安装，并以 versionArgs 运行获取版本，detail 为输出的第一行
+func Command(name string, versionArgs ...string) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		path, err := exec.LookPath(name)
+		if err != nil {
+			return "", err
+		}
+		out, err := exec.CommandContext(ctx, path, versionArgs...).CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("%s %s: %w", name, strings.Join(versionArgs, " "), err)
+		}
+		line, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+		return strings.TrimSpace(line), nil
+	}}
+}
+
+// TesseractLangs 检查 Tesseract 已安装指定的语言包（traineddata），detail 为已安装的全部语言
+func TesseractLangs(langs ...string) Check {
+	return Check{Name: "tesseract-langs", Run: func(ctx context.Context) (string, error) {
+		out, err := exec.CommandContext(ctx, "tesseract", "--list-langs").CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("tesseract --list-langs: %w", err)
+		}
+		// 第一行为 List of available languages in "...tessdata/" (N):
+		installed := make(map[string]bool)
+		var available []string
+		for _, line := range strings.Split(string(bytes.TrimSpace(out)), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "List of available languages") {
+				continue
+			}
+			installed[line] = true
+			available = append(available, line)
+		}
+
+		detail := strings.Join(available, ", ")
+		var missing []string
+		for _, lang := range langs {
+			if !installed[lang] {
+				missing = append(missing, lang)
+			}
+		}
+		if len(missing) > 0 {
+			return detail, fmt.Errorf("missing language packs: %s", strings.Join(missing, ", "))
+		}
+		return detail, nil
+	}}
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
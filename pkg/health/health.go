@@ -0,0 +1,233 @@
+// Package health 服务健康检查。
+//
+// API 服务在业务端口上提供 /healthz（存活：进程可以响应请求）与 /readyz（就绪：全部依赖检查通过，否则返回 503，
+// 只返回各项检查是否通过）；
+// RPC 服务注册 gRPC 健康检查服务，整体状态（服务名为空）表示进程存活，服务名为 ReadinessService 的状态
+// 由定期执行的依赖检查决定。依赖检查的结果同时用于管理员诊断接口。
+package health
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ReadinessService gRPC 健康检查中表示就绪状态的服务名
+const ReadinessService = "readiness"
+
+// 检查结果状态
+const (
+	StatusOk   = "ok"
+	StatusFail = "fail"
+)
+
+// Config 健康检查配置，未配置的字段使用括号中的默认值
+type Config struct {
+	TimeoutMs       int   `json:",optional"` // 单项检查的超时毫秒数（3000）
+	IntervalSeconds int   `json:",optional"` // RPC 服务刷新 gRPC 就绪状态的间隔秒数（10）
+	MinFreeMB       int64 `json:",optional"` // 上传目录所在磁盘的最小可用空间 MB（1024）
+}
+
+func (c Config) withDefaults() Config {
+	if c.TimeoutMs <= 0 {
+		c.TimeoutMs = 3000
+	}
+	if c.IntervalSeconds <= 0 {
+		c.IntervalSeconds = 10
+	}
+	if c.MinFreeMB <= 0 {
+		c.MinFreeMB = 1024
+	}
+	return c
+}
+
+// Check 单项依赖检查，Run 返回的 detail 为版本号、可用空间等附加信息
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) (detail string, err error)
+}
+
+// Result 单项检查结果
+type Result struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"` // ok | fail
+	Detail     string `json:"detail,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Report 一次完整检查的结果
+type Report struct {
+	Status string   `json:"status"` // 全部检查通过时为 ok
+	Checks []Result `json:"checks"`
+}
+
+// Summary 对外公开的检查结果，只包含总体状态与各项检查是否通过
+type Summary struct {
+	Status string          `json:"status"`
+	Checks []SummaryResult `json:"checks"`
+}
+
+// SummaryResult 单项检查是否通过，不含版本号、错误信息等细节
+type SummaryResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // ok | fail
+}
+
+// Summary 去掉检查细节，用于不需要鉴权的 /readyz；完整结果只通过管理员诊断接口返回
+func (r Report) Summary() Summary {
+	checks := make([]SummaryResult, len(r.Checks))
+	for i, res := range r.Checks {
+		checks[i] = SummaryResult{Name: res.Name, Status: res.Status}
+	}
+	return Summary{Status: r.Status, Checks: checks}
+}
+
+// Ok 全部检查是否通过
+func (r Report) Ok() bool {
+	return r.Status == StatusOk
+}
+
+// Failed 未通过的检查名称
+func (r Report) Failed() []string {
+	var names []string
+	for _, res := range r.Checks {
+		if res.Status != StatusOk {
+			names = append(names, res.Name)
+		}
+	}
+	return names
+}
+
+// Checker 按顺序登记依赖检查，执行时并发进行
+type Checker struct {
+	cfg    Config
+	checks []Check
+}
+
+// NewChecker 创建检查器
+func NewChecker(cfg Config, checks ...Check) *Checker {
+	return &Checker{cfg: cfg.withDefaults(), checks: checks}
+}
+
+// Add 追加检查项
+func (c *Checker) Add(checks ...Check) {
+	c.checks = append(c.checks, checks...)
+}
+
+// MinFreeBytes 上传目录所在磁盘的最小可用空间
+func (c *Checker) MinFreeBytes() int64 {
+	return c.cfg.MinFreeMB << 20
+}
+
+// Run 执行全部检查，每项检查单独计算超时
+func (c *Checker) Run(ctx context.Context) Report {
+	results := make([]Result, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOk, Checks: results}
+	for _, res := range results {
+		if res.Status != StatusOk {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, check Check) (result Result) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.cfg.TimeoutMs)*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result = Result{Name: check.Name, Status: StatusOk}
+	defer func() {
+		if p := recover(); p != nil {
+			result.Status, result.Error = StatusFail, "panic during check"
+			logx.WithContext(ctx).Errorf("health check %s panic: %v", check.Name, p)
+		}
+		result.DurationMs = time.Since(start).Milliseconds()
+	}()
+
+	detail, err := check.Run(ctx)
+	result.Detail = detail
+	if err != nil {
+		result.Status, result.Error = StatusFail, err.Error()
+	}
+	return result
+}
+
+// Routes 返回 /healthz 与 /readyz 路由，注册在 API 服务的业务端口上，不需要鉴权
+func (c *Checker) Routes() []rest.Route {
+	return []rest.Route{
+		{Method: http.MethodGet, Path: "/healthz", Handler: LivenessHandler},
+		{Method: http.MethodGet, Path: "/readyz", Handler: c.ReadinessHandler},
+	}
+}
+
+// LivenessHandler 存活探针，能响应即表示存活，不检查任何依赖
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	httpx.OkJsonCtx(r.Context(), w, Summary{Status: StatusOk, Checks: []SummaryResult{}})
+}
+
+// ReadinessHandler 就绪探针，执行全部依赖检查，有检查未通过时返回 503。
+// 响应只包含各项检查是否通过，错误信息记录在日志中
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Run(r.Context())
+	if !report.Ok() {
+		var failed []string
+		for _, res := range report.Checks {
+			if res.Status != StatusOk {
+				failed = append(failed, res.Name+": "+res.Error)
+			}
+		}
+		logx.WithContext(r.Context()).Errorf("readiness check failed: %s", strings.Join(failed, "; "))
+		httpx.WriteJsonCtx(r.Context(), w, http.StatusServiceUnavailable, report.Summary())
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, report.Summary())
+}
+
+// Watch 定期执行全部检查，并将结果写入 gRPC 健康检查服务中 ReadinessService 的状态；首次检查完成前为 NOT_SERVING
+func (c *Checker) Watch(hs *health.Server) {
+	hs.SetServingStatus(ReadinessService, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(time.Duration(c.cfg.IntervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		// 只在未通过的检查项变化时记录日志，避免依赖故障期间每次检查都输出
+		var lastFailed string
+		for {
+			report := c.Run(context.Background())
+			status := grpc_health_v1.HealthCheckResponse_SERVING
+			failed := strings.Join(report.Failed(), ", ")
+			if !report.Ok() {
+				status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+				if failed != lastFailed {
+					logx.Errorf("readiness check failed: %s", failed)
+				}
+			} else if lastFailed != "" {
+				logx.Infof("readiness restored")
+			}
+			lastFailed = failed
+			hs.SetServingStatus(ReadinessService, status)
+			<-ticker.C
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadinessHandlerHidesDetails(t *testing.T) {
+	c := NewChecker(Config{},
+		Check{Name: "pandoc", Run: func(context.Context) (string, error) { return "pandoc 3.1.9", nil }},
+		Check{Name: "mysql", Run: func(context.Context) (string, error) {
+			return "", errors.New("dial tcp 10.0.0.3:3306: connection refused")
+		}},
+	)
+
+	w := httptest.NewRecorder()
+	c.ReadinessHandler(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("code = %d", w.Code)
+	}
+	body := strings.TrimSpace(w.Body.String())
+	want := `{"status":"fail","checks":[{"name":"pandoc","status":"ok"},{"name":"mysql","status":"fail"}]}`
+	if body != want {
+		t.Fatalf("body = %s", body)
+	}
+
+	report := c.Run(context.Background())
+	if report.Checks[0].Detail != "pandoc 3.1.9" || report.Checks[1].Error == "" {
+		t.Fatalf("full report should keep details: %+v", report.Checks)
+	}
+}
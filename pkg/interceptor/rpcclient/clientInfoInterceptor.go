@@ -0,0 +1,19 @@
+package rpcclient
+
+import (
+	"context"
+
+	"document_agent/pkg/clientinfo"
+
+	"google.golang.org/grpc"
+)
+
+// ClientInfoInterceptor 将 API 层采集的客户端 IP 与 User-Agent 透传给下游 RPC
+func ClientInfoInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(clientinfo.AppendToOutgoing(ctx), method, req, reply, cc, opts...)
+}
+
+// StreamClientInfoInterceptor 流式调用版本的 ClientInfoInterceptor
+func StreamClientInfoInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(clientinfo.AppendToOutgoing(ctx), desc, cc, method, opts...)
+}
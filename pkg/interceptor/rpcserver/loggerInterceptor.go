@@ -3,11 +3,12 @@ package rpcserver
 import (
 	"context"
 	"errors"
+
+	"document_agent/pkg/xerr"
+
 	"github.com/zeromicro/go-zero/core/logx"
 	xerror "github.com/zeromicro/x/errors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func LoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
@@ -20,7 +21,7 @@ func LoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnarySer
 			logx.WithContext(ctx).Errorf("【RPC-SRV-ERR】 %+v", err)
 
 			// 将自定义错误转换为 gRPC 的 status error
-			err = status.Error(codes.Code(codeErr.Code), codeErr.Msg)
+			err = xerr.StatusError(codeErr, err)
 		} else {
 			// 如果不是自定义错误，只记录错误日志
 			logx.WithContext(ctx).Errorf("【RPC-SRV-ERR】 %+v", err)
@@ -0,0 +1,42 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"document_agent/pkg/metrics"
+
+	xerror "github.com/zeromicro/x/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsInterceptor 记录 RPC 调用的耗时与业务错误码，需在 LoggerInterceptor 之前添加
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	start := time.Now()
+	resp, err = handler(ctx, req)
+	metrics.ObserveRPC(info.FullMethod, errorCode(err), start)
+	return resp, err
+}
+
+// StreamMetricsInterceptor 记录流式 RPC 从开始到流结束的耗时与业务错误码，需在 StreamLoggerInterceptor 之前添加
+func StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	metrics.ObserveRPC(info.FullMethod, errorCode(err), start)
+	return err
+}
+
+// errorCode 自定义错误取业务错误码，其余取 gRPC 状态码；日志拦截器已将自定义错误转换为以业务错误码为状态码的 status error
+func errorCode(err error) string {
+	if err == nil {
+		return "0"
+	}
+	var codeErr *xerror.CodeMsg
+	if errors.As(err, &codeErr) {
+		return strconv.Itoa(codeErr.Code)
+	}
+	return strconv.Itoa(int(status.Code(err)))
+}
@@ -4,11 +4,11 @@ package rpcserver
 import (
 	"errors"
 
+	"document_agent/pkg/xerr"
+
 	"github.com/zeromicro/go-zero/core/logx"
 	xerror "github.com/zeromicro/x/errors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // StreamLoggerInterceptor 是一个 gRPC 流式服务器拦截器，用于记录日志和处理错误
@@ -24,7 +24,7 @@ func StreamLoggerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.S
 			// 记录完整的自定义错误信息
 			logx.WithContext(ss.Context()).Errorf("【RPC-STREAM-ERR】 %+v", err)
 			// 将自定义错误转换为 gRPC 的 status error
-			err = status.Error(codes.Code(codeErr.Code), codeErr.Msg)
+			err = xerr.StatusError(codeErr, err)
 		} else {
 			// 记录其他未知错误
 			logx.WithContext(ss.Context()).Errorf("【RPC-STREAM-ERR】 %+v", err)
@@ -0,0 +1,193 @@
+// Package loginguard 基于 Redis 的登录防爆破：按手机号和客户端 IP 统计滑动窗口内的失败次数，
+// 失败达到阈值后逐次增加等待时间，超过上限则临时锁定。状态保存在 Redis 中，多个 RPC 副本共享。
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "usercenter:login:"
+
+// Config 登录防护配置，时间单位均为秒，未配置的字段使用括号中的默认值
+type Config struct {
+	Window            int `json:",optional"` // 统计失败次数的滑动窗口（900）
+	MaxMobileFailures int `json:",optional"` // 同一手机号在窗口内允许的失败次数，达到后锁定该手机号（5）
+	MaxIPFailures     int `json:",optional"` // 同一 IP 在窗口内允许的失败次数，达到后锁定该 IP（20）
+	DelayAfter        int `json:",optional"` // 手机号失败达到该次数后，每次失败需等待 2^(n-DelayAfter) 秒才能再试（3）
+	MaxDelay          int `json:",optional"` // 单次等待上限（60）
+	LockDuration      int `json:",optional"` // 锁定时长（900）
+}
+
+func (c Config) withDefaults() Config {
+	setDefault := func(v *int, def int) {
+		if *v <= 0 {
+			*v = def
+		}
+	}
+	setDefault(&c.Window, 900)
+	setDefault(&c.MaxMobileFailures, 5)
+	setDefault(&c.MaxIPFailures, 20)
+	setDefault(&c.DelayAfter, 3)
+	setDefault(&c.MaxDelay, 60)
+	setDefault(&c.LockDuration, 900)
+	return c
+}
+
+// 锁定维度
+const (
+	ScopeMobile = "mobile"
+	ScopeIP     = "ip"
+)
+
+// recordFailureScript 记录一次失败并返回窗口内的失败次数。
+// KEYS[1] 失败记录 zset；ARGV[1] 当前毫秒时间戳；ARGV[2] 窗口毫秒数；ARGV[3] 唯一成员
+var recordFailureScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 0, tonumber(ARGV[1]) - tonumber(ARGV[2]))
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return redis.call('ZCARD', KEYS[1])
+`)
+
+// maxTTLScript 返回多个键中最大的剩余秒数，均不存在时返回 0
+var maxTTLScript = redis.NewScript(`
+local max = 0
+for _, key in ipairs(KEYS) do
+	local ttl = redis.call('TTL', key)
+	if ttl > max then
+		max = ttl
+	end
+end
+return max
+`)
+
+// Lockout 一次新触发的锁定
+type Lockout struct {
+	Scope    string // ScopeMobile / ScopeIP
+	Subject  string // 被锁定的手机号或 IP
+	Failures int64  // 触发锁定时窗口内的失败次数
+}
+
+// Guard 登录防护
+type Guard struct {
+	rds *redis.Redis
+	cfg Config
+}
+
+// NewGuard 创建登录防护
+func NewGuard(rds *redis.Redis, cfg Config) *Guard {
+	return &Guard{rds: rds, cfg: cfg.withDefaults()}
+}
+
+// Check 在校验密码前调用，返回需要等待的时间；为 0 表示允许本次尝试
+func (g *Guard) Check(ctx context.Context, mobile, ip string) (time.Duration, error) {
+	keys := []string{lockKey(ScopeMobile, mobile), waitKey(mobile)}
+	if ip != "" {
+		keys = append(keys, lockKey(ScopeIP, ip))
+	}
+	val, err := g.rds.ScriptRunCtx(ctx, maxTTLScript, keys)
+	if err != nil {
+		return 0, err
+	}
+	ttl, _ := val.(int64)
+	return time.Duration(ttl) * time.Second, nil
+}
+
+// Fail 记录一次登录失败，返回本次新触发的锁定以及下次可重试前需要等待的时间
+func (g *Guard) Fail(ctx context.Context, mobile, ip string) ([]Lockout, time.Duration, error) {
+	var (
+		lockouts []Lockout
+		wait     time.Duration
+	)
+
+	failures, err := g.recordFailure(ctx, ScopeMobile, mobile)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case failures >= int64(g.cfg.MaxMobileFailures):
+		if err = g.lock(ctx, ScopeMobile, mobile); err != nil {
+			return nil, 0, err
+		}
+		lockouts = append(lockouts, Lockout{Scope: ScopeMobile, Subject: mobile, Failures: failures})
+		wait = time.Duration(g.cfg.LockDuration) * time.Second
+	case failures >= int64(g.cfg.DelayAfter):
+		delay := g.delay(failures)
+		if err = g.rds.SetexCtx(ctx, waitKey(mobile), "1", delay); err != nil {
+			return nil, 0, err
+		}
+		wait = time.Duration(delay) * time.Second
+	}
+
+	if ip == "" {
+		return lockouts, wait, nil
+	}
+	failures, err = g.recordFailure(ctx, ScopeIP, ip)
+	if err != nil {
+		return nil, 0, err
+	}
+	if failures >= int64(g.cfg.MaxIPFailures) {
+		if err = g.lock(ctx, ScopeIP, ip); err != nil {
+			return nil, 0, err
+		}
+		lockouts = append(lockouts, Lockout{Scope: ScopeIP, Subject: ip, Failures: failures})
+		wait = time.Duration(g.cfg.LockDuration) * time.Second
+	}
+	return lockouts, wait, nil
+}
+
+// Succeed 登录成功后清除该手机号的失败记录；IP 维度的记录保留，避免同一 IP 轮换账号试探
+func (g *Guard) Succeed(ctx context.Context, mobile string) error {
+	_, err := g.rds.DelCtx(ctx, failuresKey(ScopeMobile, mobile), waitKey(mobile))
+	return err
+}
+
+func (g *Guard) recordFailure(ctx context.Context, scope, subject string) (int64, error) {
+	now := time.Now().UnixMilli()
+	member := strconv.FormatInt(now, 10) + "-" + strconv.FormatUint(rand.Uint64(), 36)
+	val, err := g.rds.ScriptRunCtx(ctx, recordFailureScript, []string{failuresKey(scope, subject)},
+		now, int64(g.cfg.Window)*1000, member)
+	if err != nil {
+		return 0, err
+	}
+	failures, ok := val.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected failure count %v", val)
+	}
+	return failures, nil
+}
+
+// lock 锁定并清空失败记录，锁定结束后重新计数
+func (g *Guard) lock(ctx context.Context, scope, subject string) error {
+	if err := g.rds.SetexCtx(ctx, lockKey(scope, subject), "1", g.cfg.LockDuration); err != nil {
+		return err
+	}
+	_, err := g.rds.DelCtx(ctx, failuresKey(scope, subject))
+	return err
+}
+
+// delay 第 n 次失败后的等待秒数：2^(n-DelayAfter)，不超过 MaxDelay
+func (g *Guard) delay(failures int64) int {
+	shift := failures - int64(g.cfg.DelayAfter)
+	if shift >= 30 {
+		return g.cfg.MaxDelay
+	}
+	return min(1<<shift, g.cfg.MaxDelay)
+}
+
+func failuresKey(scope, subject string) string {
+	return keyPrefix + "failures:" + scope + ":" + subject
+}
+
+func lockKey(scope, subject string) string {
+	return keyPrefix + "lock:" + scope + ":" + subject
+}
+
+func waitKey(mobile string) string {
+	return keyPrefix + "wait:" + mobile
+}
@@ -0,0 +1,196 @@
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+var testConfig = Config{
+	Window:            60,
+	MaxMobileFailures: 5,
+	MaxIPFailures:     8,
+	DelayAfter:        3,
+	MaxDelay:          4,
+	LockDuration:      300,
+}
+
+func newTestGuard(t *testing.T) (*miniredis.Miniredis, *Guard) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+	return mr, NewGuard(rds, testConfig)
+}
+
+func mustCheck(t *testing.T, g *Guard, mobile, ip string) time.Duration {
+	t.Helper()
+	wait, err := g.Check(context.Background(), mobile, ip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wait
+}
+
+func mustFail(t *testing.T, g *Guard, mobile, ip string) ([]Lockout, time.Duration) {
+	t.Helper()
+	lockouts, wait, err := g.Fail(context.Background(), mobile, ip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lockouts, wait
+}
+
+func TestMobileThresholds(t *testing.T) {
+	mr, g := newTestGuard(t)
+	const mobile, ip = "13800000000", "10.0.0.1"
+
+	// 前两次失败不需要等待，之后按 2^(n-3) 秒递增，达到 5 次锁定
+	steps := []struct {
+		wait     time.Duration
+		lockouts []Lockout
+	}{
+		{0, nil},
+		{0, nil},
+		{time.Second, nil},
+		{2 * time.Second, nil},
+		{300 * time.Second, []Lockout{{Scope: ScopeMobile, Subject: mobile, Failures: 5}}},
+	}
+	for i, step := range steps {
+		lockouts, wait := mustFail(t, g, mobile, ip)
+		if wait != step.wait || !reflect.DeepEqual(lockouts, step.lockouts) {
+			t.Fatalf("failure #%d = %v, %v; want %v, %v", i+1, lockouts, wait, step.lockouts, step.wait)
+		}
+		if got := mustCheck(t, g, mobile, ip); got != step.wait {
+			t.Fatalf("Check after failure #%d = %v, want %v", i+1, got, step.wait)
+		}
+		// 等待结束后允许再次尝试
+		if step.lockouts == nil && step.wait > 0 {
+			mr.FastForward(step.wait)
+			if got := mustCheck(t, g, mobile, ip); got != 0 {
+				t.Fatalf("Check after waiting #%d = %v", i+1, got)
+			}
+		}
+	}
+
+	// 锁定期间同一手机号换 IP 仍被拒绝，其他手机号不受影响
+	if got := mustCheck(t, g, mobile, "10.0.0.2"); got != 300*time.Second {
+		t.Fatalf("Check from another IP = %v", got)
+	}
+	if got := mustCheck(t, g, "13900000000", ip); got != 0 {
+		t.Fatalf("Check another mobile = %v", got)
+	}
+
+	// 锁定结束后重新计数
+	mr.FastForward(300 * time.Second)
+	if got := mustCheck(t, g, mobile, ip); got != 0 {
+		t.Fatalf("Check after lock expiry = %v", got)
+	}
+	if lockouts, wait := mustFail(t, g, mobile, ip); wait != 0 || lockouts != nil {
+		t.Fatalf("first failure after lock = %v, %v", lockouts, wait)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	g := NewGuard(nil, Config{DelayAfter: 3, MaxDelay: 60})
+	tests := []struct {
+		failures int64
+		want     int
+	}{
+		{3, 1},
+		{4, 2},
+		{8, 32},
+		{9, 60},
+		{40, 60},
+	}
+	for _, tt := range tests {
+		if got := g.delay(tt.failures); got != tt.want {
+			t.Errorf("delay(%d) = %d, want %d", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	mr, g := newTestGuard(t)
+	const mobile = "13800000000"
+	mustFail(t, g, mobile, "")
+	mustFail(t, g, mobile, "")
+
+	// 超出窗口的失败不再计数
+	mr.FastForward(time.Duration(testConfig.Window) * time.Second)
+	if _, wait := mustFail(t, g, mobile, ""); wait != 0 {
+		t.Fatalf("failure after window = %v", wait)
+	}
+}
+
+func TestIPScope(t *testing.T) {
+	mr, g := newTestGuard(t)
+	const ip = "10.0.0.1"
+
+	// 同一 IP 轮换手机号，每个手机号只失败一次，达到 IP 阈值后锁定该 IP
+	for i := range testConfig.MaxIPFailures {
+		lockouts, wait := mustFail(t, g, fmt.Sprintf("1380000000%d", i), ip)
+		if i < testConfig.MaxIPFailures-1 {
+			if wait != 0 || lockouts != nil {
+				t.Fatalf("failure #%d = %v, %v", i+1, lockouts, wait)
+			}
+			continue
+		}
+		want := []Lockout{{Scope: ScopeIP, Subject: ip, Failures: int64(testConfig.MaxIPFailures)}}
+		if wait != 300*time.Second || !reflect.DeepEqual(lockouts, want) {
+			t.Fatalf("IP lockout = %v, %v", lockouts, wait)
+		}
+	}
+
+	// 锁定的 IP 对任何手机号生效，其他 IP 上的同一手机号不受影响
+	if got := mustCheck(t, g, "13900000000", ip); got != 300*time.Second {
+		t.Fatalf("Check from locked IP = %v", got)
+	}
+	if got := mustCheck(t, g, "13800000000", "10.0.0.2"); got != 0 {
+		t.Fatalf("Check from another IP = %v", got)
+	}
+	// 未取得客户端 IP 时只按手机号判断
+	if got := mustCheck(t, g, "13900000000", ""); got != 0 {
+		t.Fatalf("Check without IP = %v", got)
+	}
+
+	mr.FastForward(300 * time.Second)
+	if got := mustCheck(t, g, "13900000000", ip); got != 0 {
+		t.Fatalf("Check after IP lock expiry = %v", got)
+	}
+}
+
+func TestSucceed(t *testing.T) {
+	_, g := newTestGuard(t)
+	ctx := context.Background()
+	const mobile, ip = "13800000000", "10.0.0.1"
+	for range 3 {
+		mustFail(t, g, mobile, ip)
+	}
+	if got := mustCheck(t, g, mobile, ip); got == 0 {
+		t.Fatal("no wait after 3 failures")
+	}
+
+	// 登录成功清除手机号的失败记录与等待
+	if err := g.Succeed(ctx, mobile); err != nil {
+		t.Fatal(err)
+	}
+	if got := mustCheck(t, g, mobile, ip); got != 0 {
+		t.Fatalf("Check after Succeed = %v", got)
+	}
+	if _, wait := mustFail(t, g, mobile, ""); wait != 0 {
+		t.Fatalf("failure after Succeed = %v", wait)
+	}
+
+	// IP 维度的失败记录保留：此前 3 次 + 之后其他手机号的失败累计达到阈值
+	for i := range testConfig.MaxIPFailures - 4 {
+		mustFail(t, g, fmt.Sprintf("1390000000%d", i), ip)
+	}
+	if lockouts, _ := mustFail(t, g, "13700000000", ip); len(lockouts) != 1 || lockouts[0].Scope != ScopeIP {
+		t.Fatalf("IP failures cleared by Succeed: %v", lockouts)
+	}
+}
@@ -0,0 +1,133 @@
+// Package metrics 业务 Prometheus 指标：RPC 调用、大模型首包与生成耗时、上游错误码、Pandoc 转换与 OCR 耗时以及文档缓存命中率。
+// 指标注册到 Prometheus 默认注册表，由 go-zero DevServer 的 /metrics 端点输出；未开启 DevServer 时不采集。
+package metrics
+
+import (
+	"time"
+
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+const namespace = "document_agent"
+
+// 结果标签取值
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+	ResultHit   = "hit"
+	ResultMiss  = "miss"
+)
+
+// 秒级耗时分桶：覆盖从几十毫秒的 RPC 到数分钟的长文生成
+var secondsBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 20, 30, 60, 120, 300}
+
+var (
+	rpcDuration = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: namespace,
+		Subsystem: "rpc_server",
+		Name:      "duration_seconds",
+		Help:      "rpc server handling duration, streaming calls last until the stream ends",
+		Labels:    []string{"method", "code"},
+		Buckets:   secondsBuckets,
+	})
+	rpcHandled = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: namespace,
+		Subsystem: "rpc_server",
+		Name:      "handled_total",
+		Help:      "rpc server handled calls by business error code",
+		Labels:    []string{"method", "code"},
+	})
+
+	llmFirstChunk = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "first_chunk_seconds",
+		Help:      "time from the start of an llm call to the first content chunk, including retries",
+		Labels:    []string{"provider", "call"},
+		Buckets:   secondsBuckets,
+	})
+	llmDuration = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "generation_seconds",
+		Help:      "llm call duration until the stream ends, including retries",
+		Labels:    []string{"provider", "call", "result"},
+		Buckets:   secondsBuckets,
+	})
+	llmUpstreamErrors = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "upstream_errors_total",
+		Help:      "llm upstream errors by provider and code (http_<status>, network or the upstream error code)",
+		Labels:    []string{"provider", "code"},
+	})
+
+	pandocDuration = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: namespace,
+		Subsystem: "pandoc",
+		Name:      "duration_seconds",
+		Help:      "pandoc conversion duration",
+		Labels:    []string{"format", "result"},
+		Buckets:   secondsBuckets,
+	})
+	ocrDuration = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: namespace,
+		Subsystem: "ocr",
+		Name:      "duration_seconds",
+		Help:      "tesseract ocr duration",
+		Labels:    []string{"result"},
+		Buckets:   secondsBuckets,
+	})
+
+	documentCache = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: namespace,
+		Subsystem: "document_cache",
+		Name:      "requests_total",
+		Help:      "document cache lookups by result",
+		Labels:    []string{"result"},
+	})
+)
+
+// Result 按错误返回结果标签
+func Result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultOK
+}
+
+// ObserveRPC 记录一次 RPC 调用，code 为业务错误码或 gRPC 状态码，成功为 0
+func ObserveRPC(method, code string, start time.Time) {
+	rpcDuration.ObserveFloat(time.Since(start).Seconds(), method, code)
+	rpcHandled.Inc(method, code)
+}
+
+// ObserveLLMFirstChunk 记录大模型调用的首包耗时
+func ObserveLLMFirstChunk(provider, call string, d time.Duration) {
+	llmFirstChunk.ObserveFloat(d.Seconds(), provider, call)
+}
+
+// ObserveLLMGeneration 记录大模型调用的总耗时。全部提供方都失败时 provider 为最后尝试的提供方
+func ObserveLLMGeneration(provider, call string, start time.Time, err error) {
+	llmDuration.ObserveFloat(time.Since(start).Seconds(), provider, call, Result(err))
+}
+
+// IncLLMUpstreamError 记录一次上游错误
+func IncLLMUpstreamError(provider, code string) {
+	llmUpstreamErrors.Inc(provider, code)
+}
+
+// ObservePandoc 记录一次 Pandoc 转换
+func ObservePandoc(format string, start time.Time, err error) {
+	pandocDuration.ObserveFloat(time.Since(start).Seconds(), format, Result(err))
+}
+
+// ObserveOCR 记录一次 OCR 识别
+func ObserveOCR(start time.Time, err error) {
+	ocrDuration.ObserveFloat(time.Since(start).Seconds(), Result(err))
+}
+
+// IncDocumentCache 记录一次文档缓存查询，result 为 ResultHit 或 ResultMiss
+func IncDocumentCache(result string) {
+	documentCache.Inc(result)
+}
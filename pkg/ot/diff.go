@@ -0,0 +1,116 @@
+package ot
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDiffCells 按行比较时 LCS 表的最大单元数，超过时将首尾相同部分之外的内容整体替换
+const maxDiffCells = 1_000_000
+
+// Diff 计算将 a 修改为 b 的操作。先去掉首尾相同的部分，再按行求最长公共子序列，
+// 修改的行内同样只替换首尾相同部分之外的字符，使整篇改写（如大模型修改）也只影响实际改动的位置，
+// 与其他用户同时进行的修改可以保留
+func Diff(a, b string) Operation {
+	var o Operation
+	prefix := commonPrefix(a, b)
+	suffix := commonSuffix(a[len(prefix):], b[len(prefix):])
+	o = o.Retain(utf8.RuneCountInString(prefix))
+
+	la := strings.SplitAfter(a[len(prefix):len(a)-len(suffix)], "\n")
+	lb := strings.SplitAfter(b[len(prefix):len(b)-len(suffix)], "\n")
+	if len(la)*len(lb) > maxDiffCells {
+		o = replace(o, strings.Join(la, ""), strings.Join(lb, ""))
+	} else {
+		o = diffLines(o, la, lb)
+	}
+	return o.Retain(utf8.RuneCountInString(suffix))
+}
+
+// diffLines 按行的最长公共子序列追加操作，相邻的删除行与插入行按字符细化
+func diffLines(o Operation, a, b []string) Operation {
+	n, m := len(a), len(b)
+	// lcs[i][j] 为 a[i:] 与 b[j:] 的最长公共子序列长度
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var del, ins strings.Builder
+	flush := func() {
+		o = replace(o, del.String(), ins.String())
+		del.Reset()
+		ins.Reset()
+	}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			flush()
+			o = o.Retain(utf8.RuneCountInString(a[i]))
+			i, j = i+1, j+1
+		case j < m && (i == n || lcs[i][j+1] >= lcs[i+1][j]):
+			ins.WriteString(b[j])
+			j++
+		default:
+			del.WriteString(a[i])
+			i++
+		}
+	}
+	flush()
+	return o
+}
+
+// replace 追加将 a 替换为 b 的操作，保留首尾相同的字符
+func replace(o Operation, a, b string) Operation {
+	prefix := commonPrefix(a, b)
+	suffix := commonSuffix(a[len(prefix):], b[len(prefix):])
+	return o.Retain(utf8.RuneCountInString(prefix)).
+		Insert(b[len(prefix) : len(b)-len(suffix)]).
+		Delete(utf8.RuneCountInString(a[len(prefix) : len(a)-len(suffix)])).
+		Retain(utf8.RuneCountInString(suffix))
+}
+
+// Merge 三方合并：content 与 head 都由 base 修改而来，返回在 head 上应用 content 相对 base 的修改的操作。
+// 同一位置的插入，head 中的在前；双方删除的文本只删除一次
+func Merge(base, head, content string) (Operation, error) {
+	_, op, err := Transform(Diff(base, head), Diff(base, content))
+	return op, err
+}
+
+// commonPrefix 返回 a、b 的最长公共前缀，不拆分多字节字符
+func commonPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) {
+		ra, size := utf8.DecodeRuneInString(a[n:])
+		rb, _ := utf8.DecodeRuneInString(b[n:])
+		if ra != rb {
+			break
+		}
+		n += size
+	}
+	return a[:n]
+}
+
+// commonSuffix 返回 a、b 的最长公共后缀，不拆分多字节字符
+func commonSuffix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) {
+		ra, size := utf8.DecodeLastRuneInString(a[:len(a)-n])
+		rb, _ := utf8.DecodeLastRuneInString(b[:len(b)-n])
+		if ra != rb {
+			break
+		}
+		n += size
+	}
+	return a[len(a)-n:]
+}
@@ -0,0 +1,111 @@
+package ot
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{"相同", "各部门：", "各部门："},
+		{"空文档", "", "# 通知\n"},
+		{"清空", "# 通知\n", ""},
+		{"修改一行中的字", "# 通知\n定于下周三召开会议。\n特此通知。", "# 通知\n定于本周五召开会议。\n特此通知。"},
+		{"增删整行", "甲\n乙\n丙\n", "甲\n丁\n丙\n戊\n"},
+		{"emoji 与扩展区汉字", "😀𠀀a", "😀b𠀀a😀"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Diff(tt.a, tt.b)
+			if got, err := o.Apply(tt.a); err != nil || got != tt.b {
+				t.Fatalf("Diff(%q, %q).Apply = %q, %v", tt.a, tt.b, got, err)
+			}
+			if tt.a == tt.b && !o.IsNoop() {
+				t.Fatalf("Diff of equal strings = %v", o)
+			}
+		})
+	}
+
+	// 只替换实际改动的字符
+	o := Diff("定于下周三召开会议", "定于本周五召开会议")
+	want := Operation{}.Retain(2).Insert("本周五").Delete(3).Retain(4)
+	if len(o) != len(want) || o[1] != want[1] || o[2] != want[2] {
+		t.Fatalf("Diff = %v, want %v", o, want)
+	}
+}
+
+func TestDiffRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for i := range 2000 {
+		a := randString(rnd, rnd.Intn(30))
+		b, _ := randOperation(rnd, a).Apply(a)
+		if got, err := Diff(a, b).Apply(a); err != nil || got != b {
+			t.Fatalf("#%d Diff(%q, %q).Apply = %q, %v", i, a, b, got, err)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	const base = "# 通知\n定于下周召开会议。\n请准时参加。\n"
+	tests := []struct {
+		name          string
+		head, content string
+		want          string
+	}{
+		{"修改不同的行", strings.Replace(base, "下周", "本周五", 1), strings.Replace(base, "请准时", "请各单位准时", 1),
+			"# 通知\n定于本周五召开会议。\n请各单位准时参加。\n"},
+		{"同一位置插入时 head 在前", strings.Replace(base, "会议", "全体会议", 1), strings.Replace(base, "会议", "年度会议", 1),
+			"# 通知\n定于下周召开全体年度会议。\n请准时参加。\n"},
+		{"双方删除同一行", strings.Replace(base, "请准时参加。\n", "", 1), strings.Replace(base, "请准时参加。\n", "", 1),
+			"# 通知\n定于下周召开会议。\n"},
+		{"head 未修改", base, strings.Replace(base, "通知", "紧急通知", 1), "# 紧急通知\n定于下周召开会议。\n请准时参加。\n"},
+		{"content 未修改", strings.Replace(base, "通知", "紧急通知", 1), base, "# 紧急通知\n定于下周召开会议。\n请准时参加。\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, err := Merge(base, tt.head, tt.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := op.Apply(tt.head); err != nil || got != tt.want {
+				t.Fatalf("merged = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for i := range 1000 {
+		base := randString(rnd, rnd.Intn(30))
+		head, _ := randOperation(rnd, base).Apply(base)
+		content, _ := randOperation(rnd, base).Apply(base)
+		op, err := Merge(base, head, content)
+		if err != nil {
+			t.Fatalf("#%d Merge(%q, %q, %q): %v", i, base, head, content, err)
+		}
+		if _, err := op.Apply(head); err != nil {
+			t.Fatalf("#%d merged operation does not apply to head: %v", i, err)
+		}
+		// 一方未修改时结果为另一方
+		if op, _ := Merge(base, base, content); mustApply(t, op, base) != content {
+			t.Fatalf("#%d Merge(base, base, content) != content", i)
+		}
+		if op, _ := Merge(base, head, base); mustApply(t, op, head) != head {
+			t.Fatalf("#%d Merge(base, head, base) != head", i)
+		}
+	}
+}
+
+func mustApply(t *testing.T, o Operation, doc string) string {
+	t.Helper()
+	got, err := o.Apply(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
@@ -0,0 +1,281 @@
+// Package ot 纯文本的操作转换（Operational Transformation），用于多人同时编辑同一文档。
+//
+// 一个操作（Operation）从头到尾遍历文档，由保留、插入、删除三种分量组成，
+// 位置与长度均以 Unicode 字符（rune）计，与批注锚点一致。
+// 服务端按版本号顺序应用操作，客户端基于旧版本提交的操作先与其后的操作转换再应用，
+// 转换规则与 ot.js 的 TextOperation 相同：同一位置的插入，Transform 的第一个参数在前。
+//
+// JSON 格式与 ot.js 一致：正整数为保留，字符串为插入，负整数为删除，如 [3, "abc", -2]。
+package ot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	ErrBaseLength = errors.New("operation base length does not match document")
+	ErrInvalid    = errors.New("invalid operation")
+)
+
+// Op 操作分量，Retain、Insert、Delete 有且只有一个非零
+type Op struct {
+	Retain int
+	Insert string
+	Delete int
+}
+
+// Operation 作用于整个文档的操作，由构造方法保证相邻分量不同类型，且相邻的插入在删除之前
+type Operation []Op
+
+// Retain 追加保留 n 个字符
+func (o Operation) Retain(n int) Operation {
+	if n <= 0 {
+		return o
+	}
+	if last := len(o) - 1; last >= 0 && o[last].Retain > 0 {
+		o[last].Retain += n
+		return o
+	}
+	return append(o, Op{Retain: n})
+}
+
+// Insert 追加插入文本
+func (o Operation) Insert(s string) Operation {
+	if s == "" {
+		return o
+	}
+	last := len(o) - 1
+	if last >= 0 && o[last].Insert != "" {
+		o[last].Insert += s
+		return o
+	}
+	// 插入与删除相邻时插入在前，保证同一编辑只有一种表示
+	if last >= 0 && o[last].Delete > 0 {
+		if last > 0 && o[last-1].Insert != "" {
+			o[last-1].Insert += s
+			return o
+		}
+		o = append(o, o[last])
+		o[last] = Op{Insert: s}
+		return o
+	}
+	return append(o, Op{Insert: s})
+}
+
+// Delete 追加删除 n 个字符
+func (o Operation) Delete(n int) Operation {
+	if n <= 0 {
+		return o
+	}
+	if last := len(o) - 1; last >= 0 && o[last].Delete > 0 {
+		o[last].Delete += n
+		return o
+	}
+	return append(o, Op{Delete: n})
+}
+
+// BaseLen 操作要求的文档长度
+func (o Operation) BaseLen() int {
+	n := 0
+	for _, op := range o {
+		n += op.Retain + op.Delete
+	}
+	return n
+}
+
+// TargetLen 应用操作后的文档长度
+func (o Operation) TargetLen() int {
+	n := 0
+	for _, op := range o {
+		n += op.Retain + utf8.RuneCountInString(op.Insert)
+	}
+	return n
+}
+
+// IsNoop 操作不修改文档
+func (o Operation) IsNoop() bool {
+	return len(o) == 0 || (len(o) == 1 && o[0].Retain > 0)
+}
+
+// Validate 检查每个分量有且只有一个非零值
+func (o Operation) Validate() error {
+	for i, op := range o {
+		set := 0
+		if op.Retain > 0 {
+			set++
+		}
+		if op.Insert != "" {
+			set++
+		}
+		if op.Delete > 0 {
+			set++
+		}
+		if set != 1 || op.Retain < 0 || op.Delete < 0 {
+			return fmt.Errorf("component %d: %w", i, ErrInvalid)
+		}
+	}
+	return nil
+}
+
+// Apply 将操作应用到文档
+func (o Operation) Apply(doc string) (string, error) {
+	src := []rune(doc)
+	if o.BaseLen() != len(src) {
+		return "", fmt.Errorf("base length %d, document length %d: %w", o.BaseLen(), len(src), ErrBaseLength)
+	}
+	dst := make([]rune, 0, o.TargetLen())
+	pos := 0
+	for _, op := range o {
+		switch {
+		case op.Retain > 0:
+			dst = append(dst, src[pos:pos+op.Retain]...)
+			pos += op.Retain
+		case op.Insert != "":
+			dst = append(dst, []rune(op.Insert)...)
+		case op.Delete > 0:
+			pos += op.Delete
+		}
+	}
+	return string(dst), nil
+}
+
+// Transform 转换基于同一文档的两个并发操作 a、b，返回 a'、b'，
+// 使 b 之后应用 a' 与 a 之后应用 b' 得到相同的文档；同一位置的插入，a 的在前
+func Transform(a, b Operation) (Operation, Operation, error) {
+	if a.BaseLen() != b.BaseLen() {
+		return nil, nil, fmt.Errorf("transform base length %d and %d: %w", a.BaseLen(), b.BaseLen(), ErrBaseLength)
+	}
+	var a1, b1 Operation
+	ia, ib := iter{ops: a}, iter{ops: b}
+	opA, opB := ia.next(), ib.next()
+	for opA != nil || opB != nil {
+		if opA != nil && opA.Insert != "" {
+			a1 = a1.Insert(opA.Insert)
+			b1 = b1.Retain(utf8.RuneCountInString(opA.Insert))
+			opA = ia.next()
+			continue
+		}
+		if opB != nil && opB.Insert != "" {
+			a1 = a1.Retain(utf8.RuneCountInString(opB.Insert))
+			b1 = b1.Insert(opB.Insert)
+			opB = ib.next()
+			continue
+		}
+		if opA == nil || opB == nil {
+			return nil, nil, fmt.Errorf("transform ran out of components: %w", ErrInvalid)
+		}
+
+		n := min(opA.Retain+opA.Delete, opB.Retain+opB.Delete)
+		switch {
+		case opA.Retain > 0 && opB.Retain > 0:
+			a1, b1 = a1.Retain(n), b1.Retain(n)
+		case opA.Delete > 0 && opB.Retain > 0:
+			a1 = a1.Delete(n)
+		case opA.Retain > 0 && opB.Delete > 0:
+			b1 = b1.Delete(n)
+		}
+		// 双方删除同一段文本时都不需要再删除
+		opA, opB = ia.consume(opA, n), ib.consume(opB, n)
+	}
+	return a1, b1, nil
+}
+
+// TransformIndex 计算文档中的位置（如其他用户的光标）在应用操作后的位置，插入点恰好在该位置时位置后移
+func TransformIndex(o Operation, index int) int {
+	pos, out := 0, index
+	for _, op := range o {
+		if pos > index {
+			break
+		}
+		switch {
+		case op.Retain > 0:
+			pos += op.Retain
+		case op.Insert != "":
+			out += utf8.RuneCountInString(op.Insert)
+		case op.Delete > 0:
+			out -= min(op.Delete, index-pos)
+			pos += op.Delete
+		}
+	}
+	return out
+}
+
+// iter 逐个读取操作分量，保留与删除可以只消耗一部分
+type iter struct {
+	ops Operation
+	i   int
+}
+
+func (it *iter) next() *Op {
+	if it.i >= len(it.ops) {
+		return nil
+	}
+	op := it.ops[it.i]
+	it.i++
+	return &op
+}
+
+// consume 消耗当前保留或删除分量的 n 个字符，用完时读取下一个
+func (it *iter) consume(op *Op, n int) *Op {
+	if op.Retain > 0 {
+		op.Retain -= n
+		if op.Retain > 0 {
+			return op
+		}
+	} else {
+		op.Delete -= n
+		if op.Delete > 0 {
+			return op
+		}
+	}
+	return it.next()
+}
+
+// MarshalJSON 编码为 ot.js 格式
+func (o Operation) MarshalJSON() ([]byte, error) {
+	out := make([]any, 0, len(o))
+	for _, op := range o {
+		switch {
+		case op.Retain > 0:
+			out = append(out, op.Retain)
+		case op.Insert != "":
+			out = append(out, op.Insert)
+		default:
+			out = append(out, -op.Delete)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON 解码 ot.js 格式并合并相邻的同类分量
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var out Operation
+	for i, r := range raw {
+		var s string
+		if err := json.Unmarshal(r, &s); err == nil {
+			if s == "" {
+				return fmt.Errorf("component %d is an empty insert: %w", i, ErrInvalid)
+			}
+			out = out.Insert(s)
+			continue
+		}
+		var n int
+		if err := json.Unmarshal(r, &n); err != nil || n == 0 {
+			return fmt.Errorf("component %d is neither a non-zero integer nor a string: %w", i, ErrInvalid)
+		}
+		if n > 0 {
+			out = out.Retain(n)
+		} else {
+			out = out.Delete(-n)
+		}
+	}
+	*o = out
+	return nil
+}
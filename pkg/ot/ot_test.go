@@ -0,0 +1,180 @@
+package ot
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+// alphabet 随机文档使用的字符，包含多字节的汉字与 emoji
+var alphabet = []rune("ab\n公文通知😀𠀀")
+
+func randString(rnd *rand.Rand, n int) string {
+	r := make([]rune, n)
+	for i := range r {
+		r[i] = alphabet[rnd.Intn(len(alphabet))]
+	}
+	return string(r)
+}
+
+// randOperation 生成作用于 doc 的随机操作
+func randOperation(rnd *rand.Rand, doc string) Operation {
+	var o Operation
+	left := utf8.RuneCountInString(doc)
+	for left > 0 {
+		n := 1 + rnd.Intn(min(left, 5))
+		switch rnd.Intn(3) {
+		case 0:
+			o = o.Retain(n)
+			left -= n
+		case 1:
+			o = o.Delete(n)
+			left -= n
+		default:
+			o = o.Insert(randString(rnd, 1+rnd.Intn(3)))
+		}
+	}
+	if rnd.Intn(2) == 0 {
+		o = o.Insert(randString(rnd, 1+rnd.Intn(3)))
+	}
+	return o
+}
+
+func TestBuilders(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Operation
+		want Operation
+	}{
+		{"合并相邻分量", Operation{}.Retain(2).Retain(3).Insert("a").Insert("b").Delete(1).Delete(2), Operation{{Retain: 5}, {Insert: "ab"}, {Delete: 3}}},
+		{"插入在删除之前", Operation{}.Retain(1).Delete(2).Insert("x"), Operation{{Retain: 1}, {Insert: "x"}, {Delete: 2}}},
+		{"删除后插入合并到前面的插入", Operation{}.Insert("a").Delete(1).Insert("b"), Operation{{Insert: "ab"}, {Delete: 1}}},
+		{"忽略空分量", Operation{}.Retain(0).Insert("").Delete(0), Operation{}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	o := Operation{}.Retain(2).Insert("正式").Delete(1).Retain(2)
+	if o.BaseLen() != 5 || o.TargetLen() != 6 {
+		t.Fatalf("len = %d -> %d", o.BaseLen(), o.TargetLen())
+	}
+	got, err := o.Apply("召开😀会议")
+	if err != nil || got != "召开正式会议" {
+		t.Fatalf("Apply = %q, %v", got, err)
+	}
+	if _, err := o.Apply("召开会议"); !errors.Is(err, ErrBaseLength) {
+		t.Fatalf("err = %v, want ErrBaseLength", err)
+	}
+	if !(Operation{}).IsNoop() || !(Operation{}.Retain(3)).IsNoop() || o.IsNoop() {
+		t.Fatal("IsNoop")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	o := Operation{}.Retain(3).Insert("通知").Delete(2)
+	data, err := json.Marshal(o)
+	if err != nil || string(data) != `[3,"通知",-2]` {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+	var got Operation
+	if err := json.Unmarshal([]byte(`[1,2,"a","b",-1,-1]`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := (Operation{{Retain: 3}, {Insert: "ab"}, {Delete: 2}}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Unmarshal = %v, want %v", got, want)
+	}
+	for _, bad := range []string{`[0]`, `[""]`, `[true]`, `{}`} {
+		if err := json.Unmarshal([]byte(bad), &got); err == nil {
+			t.Errorf("Unmarshal(%s) should fail", bad)
+		}
+	}
+	if err := (Operation{{Retain: 1, Insert: "a"}}).Validate(); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("Validate = %v", err)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	const doc = "各部门：请准时参加。"
+	tests := []struct {
+		name string
+		a, b Operation
+		want string
+	}{
+		{"同一位置插入时 a 在前", Operation{}.Retain(4).Insert("甲").Retain(6), Operation{}.Retain(4).Insert("乙").Retain(6), "各部门：甲乙请准时参加。"},
+		{"不同位置插入", Operation{}.Insert("致").Retain(10), Operation{}.Retain(9).Insert("会议").Retain(1), "致各部门：请准时参加会议。"},
+		{"删除重叠的文本只删除一次", Operation{}.Retain(4).Delete(3).Retain(3), Operation{}.Retain(5).Delete(3).Retain(2), "各部门：加。"},
+		{"在对方删除的范围内插入", Operation{}.Retain(4).Delete(5).Retain(1), Operation{}.Retain(6).Insert("务必").Retain(4), "各部门：务必。"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a1, b1, err := Transform(tt.a, tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ab, _ := tt.a.Apply(doc)
+			ab, err = b1.Apply(ab)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ba, _ := tt.b.Apply(doc)
+			ba, err = a1.Apply(ba)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ab != tt.want || ba != tt.want {
+				t.Fatalf("a then b' = %q, b then a' = %q, want %q", ab, ba, tt.want)
+			}
+		})
+	}
+
+	if _, _, err := Transform(Operation{}.Retain(2), Operation{}.Retain(3)); !errors.Is(err, ErrBaseLength) {
+		t.Fatalf("err = %v, want ErrBaseLength", err)
+	}
+}
+
+func TestTransformConvergence(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := range 2000 {
+		doc := randString(rnd, rnd.Intn(20))
+		a, b := randOperation(rnd, doc), randOperation(rnd, doc)
+		a1, b1, err := Transform(a, b)
+		if err != nil {
+			t.Fatalf("#%d Transform(%v, %v): %v", i, a, b, err)
+		}
+		ab, _ := a.Apply(doc)
+		ab, err1 := b1.Apply(ab)
+		ba, _ := b.Apply(doc)
+		ba, err2 := a1.Apply(ba)
+		if err1 != nil || err2 != nil || ab != ba {
+			t.Fatalf("#%d doc %q, a %v, b %v: %q (%v) != %q (%v)", i, doc, a, b, ab, err1, ba, err2)
+		}
+	}
+}
+
+func TestTransformIndex(t *testing.T) {
+	o := Operation{}.Retain(2).Insert("甲乙").Retain(2).Delete(3).Retain(3)
+	tests := []struct{ index, want int }{
+		{0, 0},
+		{1, 1},
+		{2, 4}, // 插入点恰好在光标处时后移
+		{3, 5},
+		{4, 6},
+		{5, 6}, // 被删除的范围内移到删除处
+		{7, 6},
+		{8, 7},
+		{10, 9},
+	}
+	for _, tt := range tests {
+		if got := TransformIndex(o, tt.index); got != tt.want {
+			t.Errorf("TransformIndex(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Package password 负责密码的哈希、校验与强度策略。
+//
+// 新密码使用 argon2id 哈希，存储为带参数的 PHC 编码串：
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//
+// 历史数据中的无盐 MD5 仍可校验通过，但会提示调用方在登录成功后重新哈希。
+package password
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id 参数，调整后旧哈希会在下次登录时自动按新参数重新生成
+const (
+	argonMemory  uint32 = 64 * 1024 // KiB
+	argonTime    uint32 = 3
+	argonThreads uint8  = 2
+	argonKeyLen  uint32 = 32
+	argonSaltLen        = 16
+)
+
+// 密码长度限制
+const (
+	MinLength = 8
+	MaxLength = 64
+)
+
+var (
+	// ErrPolicy 密码不满足强度要求
+	ErrPolicy = errors.New("password does not meet the policy")
+	// ErrMalformedHash 存储的哈希无法解析
+	ErrMalformedHash = errors.New("malformed password hash")
+)
+
+var b64 = base64.RawStdEncoding
+
+// Hash 使用 argon2id 哈希密码
+func Hash(password string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argonMemory, argonTime, argonThreads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// Verify 校验密码是否与存储的哈希匹配。
+// needsRehash 为 true 表示哈希为旧格式（MD5）或参数已过时，调用方应在校验通过后用 Hash 重新生成并保存。
+func Verify(password, encoded string) (ok, needsRehash bool, err error) {
+	if !strings.HasPrefix(encoded, "$argon2id$") {
+		ok = verifyLegacyMD5(password, encoded)
+		return ok, ok, nil
+	}
+
+	// "$argon2id$v=19$m=..,t=..,p=..$salt$hash" 按 $ 切分后共 6 段，首段为空
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return false, false, ErrMalformedHash
+	}
+	var (
+		version      int
+		memory, time uint32
+		threads      uint8
+	)
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false, ErrMalformedHash
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, false, ErrMalformedHash
+	}
+	salt, err := b64.DecodeString(parts[4])
+	if err != nil {
+		return false, false, ErrMalformedHash
+	}
+	want, err := b64.DecodeString(parts[5])
+	if err != nil || len(want) == 0 {
+		return false, false, ErrMalformedHash
+	}
+
+	got := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(want)))
+	if subtle.ConstantTimeCompare(got, want) != 1 {
+		return false, false, nil
+	}
+	needsRehash = memory != argonMemory || time != argonTime || threads != argonThreads || uint32(len(want)) != argonKeyLen
+	return true, needsRehash, nil
+}
+
+// verifyLegacyMD5 兼容历史数据：tool.Md5ByString 生成的 32 位十六进制摘要
+func verifyLegacyMD5(password, encoded string) bool {
+	if len(encoded) != hex.EncodedLen(md5.Size) {
+		return false
+	}
+	sum := md5.Sum([]byte(password))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(strings.ToLower(encoded))) == 1
+}
+
+// Validate 检查密码强度：长度 8-64 位，且同时包含字母和数字，不允许空白字符
+func Validate(password string) error {
+	n := len([]rune(password))
+	if n < MinLength || n > MaxLength {
+		return fmt.Errorf("length must be between %d and %d: %w", MinLength, MaxLength, ErrPolicy)
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsSpace(r):
+			return fmt.Errorf("must not contain whitespace: %w", ErrPolicy)
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return fmt.Errorf("must contain both letters and digits: %w", ErrPolicy)
+	}
+	return nil
+}
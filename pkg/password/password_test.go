@@ -0,0 +1,150 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// hashWith 按指定参数生成 PHC 编码串，模拟参数调整前保存的哈希
+func hashWith(password string, memory, time uint32, threads uint8, keyLen uint32) string {
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, memory, time, threads, b64.EncodeToString(salt), b64.EncodeToString(key))
+}
+
+func TestHashVerify(t *testing.T) {
+	const pwd = "Passw0rd公文"
+	encoded, err := Hash(pwd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, fmt.Sprintf("$argon2id$v=19$m=%d,t=%d,p=%d$", argonMemory, argonTime, argonThreads)) {
+		t.Fatalf("Hash = %q", encoded)
+	}
+	if again, _ := Hash(pwd); again == encoded {
+		t.Fatal("Hash does not use a random salt")
+	}
+
+	if ok, needsRehash, err := Verify(pwd, encoded); !ok || needsRehash || err != nil {
+		t.Fatalf("Verify = %v, %v, %v", ok, needsRehash, err)
+	}
+	if ok, needsRehash, err := Verify("Passw0rd", encoded); ok || needsRehash || err != nil {
+		t.Fatalf("Verify(wrong) = %v, %v, %v", ok, needsRehash, err)
+	}
+}
+
+func TestVerifyLegacyMD5(t *testing.T) {
+	// md5("abc12345")
+	const legacy = "d6b0ab7f1c8ab8f514db9a6d85de160a"
+	tests := []struct {
+		name       string
+		password   string
+		encoded    string
+		wantOK     bool
+		wantRehash bool
+	}{
+		{"匹配时需要重新哈希", "abc12345", legacy, true, true},
+		{"大写十六进制", "abc12345", strings.ToUpper(legacy), true, true},
+		{"密码错误", "abc123456", legacy, false, false},
+		{"长度不是 32", "abc12345", legacy[:31], false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := Verify(tt.password, tt.encoded)
+			if ok != tt.wantOK || needsRehash != tt.wantRehash || err != nil {
+				t.Fatalf("Verify = %v, %v, %v", ok, needsRehash, err)
+			}
+		})
+	}
+}
+
+func TestVerifyOutdatedParams(t *testing.T) {
+	const pwd = "abc12345"
+	tests := []struct {
+		name    string
+		encoded string
+		rehash  bool
+	}{
+		{"当前参数", hashWith(pwd, argonMemory, argonTime, argonThreads, argonKeyLen), false},
+		{"内存参数较小", hashWith(pwd, 16*1024, argonTime, argonThreads, argonKeyLen), true},
+		{"迭代次数不同", hashWith(pwd, argonMemory, 1, argonThreads, argonKeyLen), true},
+		{"并行度不同", hashWith(pwd, argonMemory, argonTime, 1, argonKeyLen), true},
+		{"哈希长度不同", hashWith(pwd, argonMemory, argonTime, argonThreads, 16), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := Verify(pwd, tt.encoded)
+			if !ok || needsRehash != tt.rehash || err != nil {
+				t.Fatalf("Verify = %v, %v, %v", ok, needsRehash, err)
+			}
+			// 密码错误时不提示重新哈希
+			if ok, needsRehash, _ := Verify("abc123456", tt.encoded); ok || needsRehash {
+				t.Fatalf("Verify(wrong) = %v, %v", ok, needsRehash)
+			}
+		})
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	valid := hashWith("abc12345", argonMemory, argonTime, argonThreads, argonKeyLen)
+	parts := strings.Split(valid, "$")
+	replace := func(i int, v string) string {
+		p := append([]string(nil), parts...)
+		p[i] = v
+		return strings.Join(p, "$")
+	}
+	tests := map[string]string{
+		"段数不足":        strings.Join(parts[:5], "$"),
+		"段数过多":        valid + "$x",
+		"版本号不支持":      replace(2, "v=16"),
+		"版本号无法解析":     replace(2, "version"),
+		"参数无法解析":      replace(3, "m=x,t=3,p=2"),
+		"盐不是 base64":  replace(4, "!!"),
+		"哈希不是 base64": replace(5, "!!"),
+		"哈希为空":        replace(5, ""),
+	}
+	for name, encoded := range tests {
+		t.Run(name, func(t *testing.T) {
+			ok, needsRehash, err := Verify("abc12345", encoded)
+			if ok || needsRehash || !errors.Is(err, ErrMalformedHash) {
+				t.Fatalf("Verify(%q) = %v, %v, %v", encoded, ok, needsRehash, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		ok       bool
+	}{
+		{"7 位", "abc1234", false},
+		{"8 位", "abc12345", true},
+		{"64 位", strings.Repeat("a", 63) + "1", true},
+		{"65 位", strings.Repeat("a", 64) + "1", false},
+		{"按字符而不是字节计算长度", "密码密码密码1a", true},
+		{"包含空格", "abc 12345", false},
+		{"包含制表符", "abc\t12345", false},
+		{"包含全角空格", "abc　12345", false},
+		{"只有字母", "abcdefgh", false},
+		{"只有数字", "12345678", false},
+		{"允许符号", "abc-1234!", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.password)
+			if tt.ok && err != nil {
+				t.Fatalf("Validate(%q) = %v", tt.password, err)
+			}
+			if !tt.ok && !errors.Is(err, ErrPolicy) {
+				t.Fatalf("Validate(%q) = %v, want ErrPolicy", tt.password, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// busyRetryAfter 并发已满时建议客户端等待的时间
+const busyRetryAfter = 5 * time.Second
+
+// Middleware 对生成接口限流，需配合 jwt 鉴权使用，放在 @server 的 middleware 中。
+// 超限时以接口统一的 JSON 格式返回错误码，并通过 Retry-After 响应头给出等待秒数；Redis 不可用时放行，避免限流存储故障导致无法生成
+func (l *Limiter) Middleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		userId, _ := ctxdata.GetUidFromCtx(ctx)
+		if !l.cfg.Enable || userId == 0 {
+			next(w, r)
+			return
+		}
+
+		lease, wait, err := l.Acquire(ctx, userId, l.LimitFor(ctxdata.GetRolesFromCtx(ctx)))
+		switch {
+		case err == nil:
+			defer lease.Release(ctx)
+			next(w, r)
+		case errors.Is(err, ErrRateLimited):
+			reject(ctx, w, xerr.ErrTooManyRequests, wait)
+		case errors.Is(err, ErrUserBusy):
+			reject(ctx, w, xerr.ErrTooManyGenerations, busyRetryAfter)
+		case errors.Is(err, ErrGlobalBusy):
+			reject(ctx, w, xerr.ErrGenerationBusy, busyRetryAfter)
+		case ctx.Err() != nil:
+			// 排队期间客户端已断开
+		default:
+			logx.WithContext(ctx).Errorf("acquire generation lease failed, userId: %d, err: %v", userId, err)
+			next(w, r)
+		}
+	}
+}
+
+func reject(ctx context.Context, w http.ResponseWriter, err error, wait time.Duration) {
+	err = xerr.WithRetryAfter(err, max(wait, time.Second))
+	SetRetryAfter(w, err)
+	xerr.WriteJson(ctx, w, err)
+}
+
+// SetRetryAfter 错误（含 RPC 返回的 status error）携带等待时间时设置 Retry-After 响应头，单位为秒并向上取整
+func SetRetryAfter(w http.ResponseWriter, err error) {
+	wait, ok := xerr.RetryAfter(err)
+	if !ok {
+		return
+	}
+	seconds := max(int64(math.Ceil(wait.Seconds())), 1)
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+}
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func newTestLimiter(t *testing.T, limit Limit) *Limiter {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+	return NewLimiter(rds, Config{Enable: true, Default: limit})
+}
+
+func serve(handler http.HandlerFunc, userId string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctxdata.CtxKeyJwtUserId, json.Number(userId)))
+	w := httptest.NewRecorder()
+	handler(w, r)
+	return w
+}
+
+// requireRejected 校验拒绝响应为统一格式的错误码，并带有 Retry-After 响应头
+func requireRejected(t *testing.T, w *httptest.ResponseRecorder, want error, retryAfter string) {
+	t.Helper()
+	var body struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body %q: %v", w.Body.String(), err)
+	}
+	if body.Code != xerr.Code(want) || body.Msg == "" {
+		t.Fatalf("body = %+v, want code %d", body, xerr.Code(want))
+	}
+	if got := w.Header().Get("Retry-After"); got != retryAfter {
+		t.Fatalf("Retry-After = %q, want %q", got, retryAfter)
+	}
+}
+
+func TestMiddlewareRequestsPerMinute(t *testing.T) {
+	l := newTestLimiter(t, Limit{RequestsPerMinute: 1})
+	handler := l.Middleware(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if w := serve(handler, "7"); w.Code != http.StatusNoContent {
+		t.Fatalf("first request status = %d", w.Code)
+	}
+	requireRejected(t, serve(handler, "7"), xerr.ErrTooManyRequests, "60")
+	// 其他用户不受影响
+	if w := serve(handler, "8"); w.Code != http.StatusNoContent {
+		t.Fatalf("other user status = %d", w.Code)
+	}
+}
+
+func TestMiddlewareConcurrency(t *testing.T) {
+	l := newTestLimiter(t, Limit{Concurrency: 1})
+	var nested *httptest.ResponseRecorder
+	var handler http.HandlerFunc
+	handler = l.Middleware(func(w http.ResponseWriter, r *http.Request) {
+		// 持有租约期间同一用户再次请求
+		if nested == nil {
+			nested = serve(handler, "7")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if w := serve(handler, "7"); w.Code != http.StatusNoContent {
+		t.Fatalf("first request status = %d", w.Code)
+	}
+	requireRejected(t, nested, xerr.ErrTooManyGenerations, "5")
+	// 租约释放后可以再次请求
+	if w := serve(handler, "7"); w.Code != http.StatusNoContent {
+		t.Fatalf("request after release status = %d", w.Code)
+	}
+}
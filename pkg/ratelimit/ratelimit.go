@@ -0,0 +1,259 @@
+// Package ratelimit 基于 Redis 的生成接口限流：按用户限制同时进行的生成任务数（并发租约）与每分钟请求数，
+// 并限制全部 API 副本合计的并发数。状态保存在 Redis 中，多个副本共享；限制值可按角色配置。
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "llmcenter:ratelimit:"
+
+// 并发已满时重新尝试获取租约的间隔
+const pollInterval = 500 * time.Millisecond
+
+var (
+	// ErrRateLimited 用户每分钟请求数已达上限
+	ErrRateLimited = errors.New("requests per minute exceeded")
+	// ErrUserBusy 用户同时进行的生成任务数已达上限
+	ErrUserBusy = errors.New("user concurrency exceeded")
+	// ErrGlobalBusy 全部副本合计的生成任务数已达上限
+	ErrGlobalBusy = errors.New("global concurrency exceeded")
+)
+
+// Limit 单个用户的限制，0 表示不限
+type Limit struct {
+	Role              string `json:",optional"` // 角色编码，Default 中无需填写
+	Concurrency       int    `json:",optional"` // 同时进行的生成任务数
+	RequestsPerMinute int    `json:",optional"` // 每分钟可发起的生成请求数
+}
+
+// Config 限流配置，时间单位均为秒，未配置的字段使用括号中的默认值
+type Config struct {
+	Enable            bool    `json:",optional"`
+	GlobalConcurrency int     `json:",optional"` // 全部副本合计同时进行的生成任务数，0 表示不限
+	Default           Limit   `json:",optional"` // 未单独配置的角色使用的限制
+	Roles             []Limit `json:",optional"` // 按角色覆盖；用户有多个角色时每项取最宽松的值
+	QueueTimeout      int     `json:",optional"` // 并发已满时排队等待的时长，0 表示直接拒绝
+	LeaseTTL          int     `json:",optional"` // 并发租约的有效期，持有期间自动续期，进程异常退出后到期自动释放（60）
+}
+
+func (c Config) withDefaults() Config {
+	if c.LeaseTTL <= 0 {
+		c.LeaseTTL = 60
+	}
+	return c
+}
+
+// acquireScript 原子地检查每分钟请求数、用户并发与全局并发，全部通过时写入租约并计数。
+// KEYS[1] 用户租约 zset；KEYS[2] 全局租约 zset；KEYS[3] 用户请求记录 zset
+// ARGV[1] 当前毫秒时间戳；ARGV[2] 租约毫秒数；ARGV[3] 租约ID；ARGV[4] 用户并发上限；ARGV[5] 全局并发上限；ARGV[6] 每分钟请求上限
+// 返回 {状态, 需等待的毫秒数}：0 成功；1 每分钟请求数超限；2 用户并发超限；3 全局并发超限
+var acquireScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local ttl = tonumber(ARGV[2])
+local userLimit = tonumber(ARGV[4])
+local globalLimit = tonumber(ARGV[5])
+local rpm = tonumber(ARGV[6])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 0, now)
+redis.call('ZREMRANGEBYSCORE', KEYS[2], 0, now)
+redis.call('ZREMRANGEBYSCORE', KEYS[3], 0, now - 60000)
+if rpm > 0 and redis.call('ZCARD', KEYS[3]) >= rpm then
+	local oldest = redis.call('ZRANGE', KEYS[3], 0, 0, 'WITHSCORES')
+	return {1, tonumber(oldest[2]) + 60000 - now}
+end
+if userLimit > 0 and redis.call('ZCARD', KEYS[1]) >= userLimit then
+	return {2, 0}
+end
+if globalLimit > 0 and redis.call('ZCARD', KEYS[2]) >= globalLimit then
+	return {3, 0}
+end
+redis.call('ZADD', KEYS[1], now + ttl, ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ttl)
+redis.call('ZADD', KEYS[2], now + ttl, ARGV[3])
+redis.call('PEXPIRE', KEYS[2], ttl)
+if rpm > 0 then
+	redis.call('ZADD', KEYS[3], now, ARGV[3])
+	redis.call('PEXPIRE', KEYS[3], 60000)
+end
+return {0, 0}
+`)
+
+// renewScript 顺延仍被持有的租约，已过期被清理的租约不再恢复
+// KEYS 用户与全局租约 zset；ARGV[1] 新的过期毫秒时间戳；ARGV[2] 租约毫秒数；ARGV[3] 租约ID
+var renewScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call('ZSCORE', key, ARGV[3]) then
+		redis.call('ZADD', key, ARGV[1], ARGV[3])
+		redis.call('PEXPIRE', key, ARGV[2])
+	end
+end
+return 0
+`)
+
+// Limiter 生成接口限流器
+type Limiter struct {
+	rds *redis.Redis
+	cfg Config
+}
+
+// NewLimiter 创建限流器
+func NewLimiter(rds *redis.Redis, cfg Config) *Limiter {
+	return &Limiter{rds: rds, cfg: cfg.withDefaults()}
+}
+
+// LimitFor 计算拥有指定角色的用户的限制：已单独配置的角色使用其配置，其余角色使用 Default，
+// 多个角色时每项取最宽松的值
+func (l *Limiter) LimitFor(roles []string) Limit {
+	if len(roles) == 0 {
+		return l.cfg.Default
+	}
+	var limit Limit
+	for i, role := range roles {
+		roleLimit := l.cfg.Default
+		for _, r := range l.cfg.Roles {
+			if r.Role == role {
+				roleLimit = r
+				break
+			}
+		}
+		if i == 0 {
+			limit = roleLimit
+			continue
+		}
+		limit.Concurrency = looser(limit.Concurrency, roleLimit.Concurrency)
+		limit.RequestsPerMinute = looser(limit.RequestsPerMinute, roleLimit.RequestsPerMinute)
+	}
+	limit.Role = ""
+	return limit
+}
+
+// Acquire 为一次生成请求获取并发租约。
+// 每分钟请求数超限时立即返回 ErrRateLimited 及需要等待的时间；并发已满时在 QueueTimeout 内排队，
+// 超时后返回 ErrUserBusy 或 ErrGlobalBusy。成功时调用方需在请求结束后调用 Lease.Release
+func (l *Limiter) Acquire(ctx context.Context, userId int64, limit Limit) (*Lease, time.Duration, error) {
+	lease := &Lease{
+		rds:  l.rds,
+		id:   strconv.FormatInt(time.Now().UnixMilli(), 10) + "-" + strconv.FormatUint(rand.Uint64(), 36),
+		keys: []string{userLeasesKey(userId), globalLeasesKey()},
+		ttl:  time.Duration(l.cfg.LeaseTTL) * time.Second,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	deadline := time.Now().Add(time.Duration(l.cfg.QueueTimeout) * time.Second)
+
+	for {
+		wait, err := l.tryAcquire(ctx, userId, limit, lease)
+		if err == nil {
+			go lease.keepAlive(ctx)
+			return lease, 0, nil
+		}
+		if errors.Is(err, ErrRateLimited) {
+			return nil, wait, err
+		}
+		if !errors.Is(err, ErrUserBusy) && !errors.Is(err, ErrGlobalBusy) {
+			return nil, 0, err
+		}
+		if time.Now().Add(pollInterval).After(deadline) {
+			return nil, 0, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (l *Limiter) tryAcquire(ctx context.Context, userId int64, limit Limit, lease *Lease) (time.Duration, error) {
+	now := time.Now().UnixMilli()
+	val, err := l.rds.ScriptRunCtx(ctx, acquireScript,
+		[]string{lease.keys[0], lease.keys[1], rpmKey(userId)},
+		now, lease.ttl.Milliseconds(), lease.id, limit.Concurrency, l.cfg.GlobalConcurrency, limit.RequestsPerMinute)
+	if err != nil {
+		return 0, err
+	}
+	fields, ok := val.([]any)
+	if !ok || len(fields) != 2 {
+		return 0, errors.New("unexpected rate limit result")
+	}
+	status, _ := fields[0].(int64)
+	waitMs, _ := fields[1].(int64)
+	switch status {
+	case 0:
+		return 0, nil
+	case 1:
+		return time.Duration(waitMs) * time.Millisecond, ErrRateLimited
+	case 2:
+		return 0, ErrUserBusy
+	default:
+		return 0, ErrGlobalBusy
+	}
+}
+
+// Lease 一次生成请求占用的并发名额
+type Lease struct {
+	rds  *redis.Redis
+	id   string
+	keys []string
+	ttl  time.Duration
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Release 释放租约。请求可能因客户端断开而取消，这里不使用请求的 ctx
+func (s *Lease) Release(ctx context.Context) {
+	close(s.stop)
+	<-s.done
+	ctx = context.WithoutCancel(ctx)
+	for _, key := range s.keys {
+		if _, err := s.rds.ZremCtx(ctx, key, s.id); err != nil {
+			logx.WithContext(ctx).Errorf("release generation lease failed, key: %s, err: %v", key, err)
+		}
+	}
+}
+
+// keepAlive 在持有期间每隔 1/3 有效期续期一次，避免长时间的 SSE 流被当作过期租约清理
+func (s *Lease) keepAlive(ctx context.Context) {
+	defer close(s.done)
+	ctx = context.WithoutCancel(ctx)
+	ticker := time.NewTicker(s.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			expireAt := time.Now().Add(s.ttl).UnixMilli()
+			if _, err := s.rds.ScriptRunCtx(ctx, renewScript, s.keys, expireAt, s.ttl.Milliseconds(), s.id); err != nil {
+				logx.WithContext(ctx).Errorf("renew generation lease failed, lease: %s, err: %v", s.id, err)
+			}
+		}
+	}
+}
+
+func looser(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return max(a, b)
+}
+
+func userLeasesKey(userId int64) string {
+	return keyPrefix + "leases:user:" + strconv.FormatInt(userId, 10)
+}
+
+func globalLeasesKey() string {
+	return keyPrefix + "leases:global"
+}
+
+func rpmKey(userId int64) string {
+	return keyPrefix + "rpm:" + strconv.FormatInt(userId, 10)
+}
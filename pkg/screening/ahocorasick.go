@@ -0,0 +1,118 @@
+package screening
+
+// acNode Aho-Corasick 自动机节点
+type acNode struct {
+	next map[byte]int
+	fail int
+	out  []int // 以该节点结尾的模式串下标
+}
+
+// acMatch 一次模式串命中，Start/End 为字节下标（左闭右开）
+type acMatch struct {
+	Start   int
+	End     int
+	Pattern int
+}
+
+// acMatcher 基于字节的 Aho-Corasick 多模式匹配器。
+// UTF-8 编码自同步，按字节匹配不会产生跨字符的误命中。
+type acMatcher struct {
+	nodes    []acNode
+	patterns []string
+	maxLen   int
+}
+
+// newACMatcher 构建匹配器，ASCII 字母按小写匹配
+func newACMatcher(patterns []string) *acMatcher {
+	m := &acMatcher{
+		nodes:    []acNode{{next: map[byte]int{}}},
+		patterns: patterns,
+	}
+
+	for idx, p := range patterns {
+		p = asciiLower(p)
+		if len(p) > m.maxLen {
+			m.maxLen = len(p)
+		}
+		cur := 0
+		for i := 0; i < len(p); i++ {
+			nxt, ok := m.nodes[cur].next[p[i]]
+			if !ok {
+				m.nodes = append(m.nodes, acNode{next: map[byte]int{}})
+				nxt = len(m.nodes) - 1
+				m.nodes[cur].next[p[i]] = nxt
+			}
+			cur = nxt
+		}
+		m.nodes[cur].out = append(m.nodes[cur].out, idx)
+	}
+
+	// BFS 构建失败指针
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for b, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[b]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[b]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// FindAll 返回文本中所有（可能重叠的）命中
+func (m *acMatcher) FindAll(text string) []acMatch {
+	if m == nil || len(m.patterns) == 0 {
+		return nil
+	}
+	var matches []acMatch
+	cur := 0
+	for i := 0; i < len(text); i++ {
+		b := lowerByte(text[i])
+		for cur > 0 {
+			if _, ok := m.nodes[cur].next[b]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if nxt, ok := m.nodes[cur].next[b]; ok {
+			cur = nxt
+		}
+		for _, idx := range m.nodes[cur].out {
+			matches = append(matches, acMatch{
+				Start:   i + 1 - len(m.patterns[idx]),
+				End:     i + 1,
+				Pattern: idx,
+			})
+		}
+	}
+	return matches
+}
+
+func lowerByte(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b + ('a' - 'A')
+	}
+	return b
+}
+
+func asciiLower(s string) string {
+	buf := []byte(s)
+	for i := range buf {
+		buf[i] = lowerByte(buf[i])
+	}
+	return string(buf)
+}
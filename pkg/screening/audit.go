@@ -0,0 +1,46 @@
+package screening
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 筛查环节
+const (
+	StageInput     = "input"     // 用户输入（基本信息、特殊要求、修改提示等）
+	StageReference = "reference" // 引用文件提取出的文本
+	StageOutput    = "output"    // 大模型流式输出
+)
+
+// Audit 将有命中的筛查结果写入审计日志。
+// 日志中只记录词库命中的敏感词，身份证号等个人信息仅记录类别与数量。
+func Audit(ctx context.Context, stage string, res *Result, fields ...logx.LogField) {
+	if res == nil || len(res.Hits) == 0 {
+		return
+	}
+
+	counts := make(map[string]int)
+	var words []string
+	for _, h := range res.Hits {
+		counts[h.Category+":"+h.Rule+":"+h.Action]++
+		if h.Word != "" {
+			words = append(words, h.Word)
+		}
+	}
+
+	fields = append(fields,
+		logx.Field("audit", "screening"),
+		logx.Field("stage", stage),
+		logx.Field("action", res.Action),
+		logx.Field("hits", counts),
+		logx.Field("words", strings.Join(words, ",")),
+	)
+	logger := logx.WithContext(ctx)
+	if res.Blocked() {
+		logger.Errorw("content screening blocked", fields...)
+		return
+	}
+	logger.Infow("content screening hit", fields...)
+}
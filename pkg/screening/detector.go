@@ -0,0 +1,125 @@
+package screening
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// 内置检测器类别
+const (
+	CategoryWord     = "word"      // 词库命中
+	CategoryIDCard   = "id_card"   // 居民身份证号
+	CategoryMobile   = "mobile"    // 手机号
+	CategoryBankCard = "bank_card" // 银行卡号
+)
+
+// digitRunRe 匹配连续数字（身份证末位允许为 X）。
+// 取最长连续数字串后再按长度分类，天然保证了前后不与其他数字相连。
+var digitRunRe = regexp.MustCompile(`\d+[Xx]?`)
+
+// separatedMobileRe 匹配按 3-4-4 以空格或短横线分隔的手机号，如 “138 1234 5678”“138-1234-5678”
+var separatedMobileRe = regexp.MustCompile(`1[3-9]\d[ -]\d{4}[ -]\d{4}`)
+
+// detection 一次检测器命中
+type detection struct {
+	category   string
+	start, end int
+}
+
+// detectNumbers 在文本中识别身份证号、手机号与银行卡号
+func detectNumbers(text string) []detection {
+	var out []detection
+	for _, loc := range digitRunRe.FindAllStringIndex(text, -1) {
+		run := text[loc[0]:loc[1]]
+		switch {
+		case len(run) == 18 && isIDCard(run):
+			out = append(out, detection{CategoryIDCard, loc[0], loc[1]})
+		case isMobile(run):
+			out = append(out, detection{CategoryMobile, loc[0], loc[1]})
+		case len(run) == 13 && run[:2] == "86" && isMobile(run[2:]):
+			// 带国家码 86 的手机号
+			out = append(out, detection{CategoryMobile, loc[0], loc[1]})
+		case len(run) >= 16 && len(run) <= 19 && isBankCard(run):
+			out = append(out, detection{CategoryBankCard, loc[0], loc[1]})
+		}
+	}
+	for _, loc := range separatedMobileRe.FindAllStringIndex(text, -1) {
+		// 前后与其他数字相连时是更长号码的一部分
+		if loc[0] > 0 && isDigit(text[loc[0]-1]) || loc[1] < len(text) && isDigit(text[loc[1]]) {
+			continue
+		}
+		out = append(out, detection{CategoryMobile, loc[0], loc[1]})
+	}
+	return out
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+var idCardWeights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+const idCardCheckCodes = "10X98765432"
+
+// isIDCard 校验 18 位居民身份证号（出生日期 + GB 11643 校验码）
+func isIDCard(s string) bool {
+	if len(s) != 18 {
+		return false
+	}
+	year, err1 := strconv.Atoi(s[6:10])
+	month, err2 := strconv.Atoi(s[10:12])
+	day, err3 := strconv.Atoi(s[12:14])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return false
+	}
+	if year < 1900 || year > 2100 || month < 1 || month > 12 || day < 1 || day > 31 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 17; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		sum += int(s[i]-'0') * idCardWeights[i]
+	}
+	check := s[17]
+	if check == 'x' {
+		check = 'X'
+	}
+	return idCardCheckCodes[sum%11] == check
+}
+
+// isMobile 校验大陆 11 位手机号
+func isMobile(s string) bool {
+	if len(s) != 11 || s[0] != '1' || s[1] < '3' || s[1] > '9' {
+		return false
+	}
+	for i := 2; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isBankCard 使用 Luhn 算法校验银行卡号
+func isBankCard(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		d := int(s[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
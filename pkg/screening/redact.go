@@ -0,0 +1,168 @@
+package screening
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// CategoryName 人名（仅识别带有“姓名”“申请人”等标签的人名）
+const CategoryName = "name"
+
+// RedactConfig 个人信息脱敏配置
+type RedactConfig struct {
+	Enable     bool     `json:",optional"`
+	NameLabels []string `json:",optional"` // 追加的人名标签，如 “救助对象”
+}
+
+var defaultNameLabels = []string{
+	"姓名", "申请人", "被申请人", "当事人", "联系人", "户主", "法定代表人", "负责人",
+	"经办人", "委托人", "代理人", "原告", "被告", "举报人", "投诉人", "信访人", "监护人",
+}
+
+var placeholderLabels = map[string]string{
+	CategoryName:     "姓名",
+	CategoryIDCard:   "身份证号",
+	CategoryMobile:   "手机号",
+	CategoryBankCard: "银行卡号",
+}
+
+const (
+	placeholderOpen  = "{{"
+	placeholderClose = "}}"
+	// maxPlaceholderLen 占位符的最大字节长度，用于流式还原时的暂存判断
+	maxPlaceholderLen = 32
+)
+
+// Redactor 可逆的个人信息脱敏器。
+// 在单次请求内，同一原文始终映射到同一占位符（如 {{姓名1}}），映射只保存在内存中，随请求结束释放。
+type Redactor struct {
+	nameRe   *regexp.Regexp
+	byValue  map[string]string
+	byToken  map[string]string
+	counters map[string]int
+}
+
+// NewRedactor 创建一个请求级的脱敏器，未启用时返回 nil（nil 脱敏器的所有方法均为空操作）
+func NewRedactor(c RedactConfig) *Redactor {
+	if !c.Enable {
+		return nil
+	}
+	labels := append(append([]string{}, defaultNameLabels...), c.NameLabels...)
+	sort.Slice(labels, func(i, j int) bool { return len(labels[i]) > len(labels[j]) })
+	for i := range labels {
+		labels[i] = regexp.QuoteMeta(labels[i])
+	}
+
+	return &Redactor{
+		nameRe:   regexp.MustCompile(`(?:` + strings.Join(labels, "|") + `)\s*[:：]\s*(\p{Han}{2,4}|\p{Han}{1,6}·\p{Han}{1,6})(?:[^\p{Han}·]|$)`),
+		byValue:  make(map[string]string),
+		byToken:  make(map[string]string),
+		counters: make(map[string]int),
+	}
+}
+
+// Redact 将文本中的个人信息替换为占位符
+func (r *Redactor) Redact(text string) string {
+	if r == nil || text == "" {
+		return text
+	}
+
+	var values []string
+	for _, d := range detectNumbers(text) {
+		value := text[d.start:d.end]
+		r.token(d.category, value)
+		values = append(values, value)
+	}
+	for _, m := range r.nameRe.FindAllStringSubmatch(text, -1) {
+		r.token(CategoryName, m[1])
+		values = append(values, m[1])
+	}
+	if len(values) == 0 {
+		return text
+	}
+
+	// 先替换更长的原文，避免短值截断长值；人名在全文其他位置出现时也一并替换
+	sort.SliceStable(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	pairs := make([]string, 0, len(values)*2)
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		pairs = append(pairs, v, r.byValue[v])
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// token 返回原文对应的占位符，不存在时按类别顺序编号
+func (r *Redactor) token(category, value string) string {
+	if t, ok := r.byValue[value]; ok {
+		return t
+	}
+	r.counters[category]++
+	t := fmt.Sprintf("%s%s%d%s", placeholderOpen, placeholderLabels[category], r.counters[category], placeholderClose)
+	r.byValue[value] = t
+	r.byToken[t] = value
+	return t
+}
+
+// Len 当前映射的条目数
+func (r *Redactor) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.byToken)
+}
+
+// Restore 将模型输出中出现的占位符还原为原文，未出现的占位符不做处理
+func (r *Redactor) Restore(text string) string {
+	if r == nil || len(r.byToken) == 0 || !strings.Contains(text, placeholderOpen) {
+		return text
+	}
+	pairs := make([]string, 0, len(r.byToken)*2)
+	for t, v := range r.byToken {
+		pairs = append(pairs, t, v)
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// RestoreStream 流式还原占位符，暂存可能被分片截断的占位符
+type RestoreStream struct {
+	r       *Redactor
+	pending string
+}
+
+// NewRestoreStream 创建流式还原器
+func (r *Redactor) NewRestoreStream() *RestoreStream {
+	return &RestoreStream{r: r}
+}
+
+// Write 写入一个分片，返回已还原、可以安全输出的文本
+func (s *RestoreStream) Write(chunk string) string {
+	if s.r.Len() == 0 {
+		return chunk
+	}
+	s.pending += chunk
+
+	cut := len(s.pending)
+	if idx := strings.LastIndex(s.pending, placeholderOpen); idx >= 0 &&
+		!strings.Contains(s.pending[idx:], placeholderClose) && len(s.pending)-idx < maxPlaceholderLen {
+		cut = idx
+	} else if strings.HasSuffix(s.pending, placeholderOpen[:1]) {
+		cut = len(s.pending) - 1
+	}
+
+	out := s.r.Restore(s.pending[:cut])
+	s.pending = s.pending[cut:]
+	return out
+}
+
+// Flush 输出全部暂存文本
+func (s *RestoreStream) Flush() string {
+	out := s.r.Restore(s.pending)
+	s.pending = ""
+	return out
+}
@@ -0,0 +1,271 @@
+// Package screening 提供敏感词与涉密信息筛查：基于 Aho-Corasick 的词库匹配、
+// 身份证号/手机号/银行卡号检测，以及拦截（block）、脱敏（mask）、告警（warn）三种处理策略。
+package screening
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// 处理策略
+const (
+	ActionNone  = ""
+	ActionWarn  = "warn"  // 放行，仅记录审计日志
+	ActionMask  = "mask"  // 将命中内容替换为掩码后放行
+	ActionBlock = "block" // 拦截整个请求
+	ActionOff   = "off"   // 关闭该检测器
+)
+
+var actionLevel = map[string]int{ActionNone: 0, ActionWarn: 1, ActionMask: 2, ActionBlock: 3}
+
+// Config 内容筛查配置
+type Config struct {
+	Enable        bool           `json:",optional"`
+	WordLists     []WordListConf `json:",optional"`                                 // 组织维护的敏感词库
+	IDCard        string         `json:",default=mask,options=block|mask|warn|off"` // 身份证号处理策略
+	Mobile        string         `json:",default=mask,options=block|mask|warn|off"` // 手机号处理策略
+	BankCard      string         `json:",default=mask,options=block|mask|warn|off"` // 银行卡号处理策略
+	MaskChar      string         `json:",default=*"`                                // 掩码字符
+	ReloadSeconds int            `json:",optional"`                                 // 词库文件热加载间隔（秒），0 表示不热加载
+}
+
+// WordListConf 单个词库配置，词可以直接写在配置里，也可以放在文件中（一行一个，# 开头为注释）
+type WordListConf struct {
+	Name   string
+	Action string   `json:",default=block,options=block|mask|warn"`
+	File   string   `json:",optional"`
+	Words  []string `json:",optional"`
+}
+
+// Hit 单条命中记录，Start/End 为字节下标（左闭右开）
+type Hit struct {
+	Category string // 见 Category* 常量
+	Rule     string // 词库名称或检测器类别
+	Action   string
+	Word     string // 命中的敏感词，仅词库命中时填写（检测器命中属于个人信息，不记录原文）
+	Start    int
+	End      int
+}
+
+// Result 筛查结果
+type Result struct {
+	Action string // 所有命中中最严格的处理策略
+	Text   string // 处理后的文本（已对 mask 命中脱敏）
+	Hits   []Hit
+}
+
+// Blocked 是否需要拦截
+func (r *Result) Blocked() bool {
+	return r.Action == ActionBlock
+}
+
+type wordRule struct {
+	list   string
+	action string
+}
+
+type dictionary struct {
+	matcher *acMatcher
+	rules   []wordRule // 与 matcher.patterns 下标一一对应
+}
+
+// Screener 内容筛查器，可并发使用
+type Screener struct {
+	c    Config
+	mu   sync.RWMutex
+	dict *dictionary
+}
+
+// NewScreener 创建筛查器并加载词库
+func NewScreener(c Config) (*Screener, error) {
+	if c.MaskChar == "" {
+		c.MaskChar = "*"
+	}
+	s := &Screener{c: c}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// MustNewScreener 创建筛查器，失败时退出进程
+func MustNewScreener(c Config) *Screener {
+	s, err := NewScreener(c)
+	logx.Must(err)
+	return s
+}
+
+// Enabled 是否启用筛查
+func (s *Screener) Enabled() bool {
+	return s != nil && s.c.Enable
+}
+
+// Reload 重新加载词库（包括词库文件）
+func (s *Screener) Reload() error {
+	var (
+		patterns []string
+		rules    []wordRule
+	)
+	for _, wl := range s.c.WordLists {
+		words := append([]string{}, wl.Words...)
+		if wl.File != "" {
+			fileWords, err := readWordFile(wl.File)
+			if err != nil {
+				return fmt.Errorf("load word list %s: %w", wl.Name, err)
+			}
+			words = append(words, fileWords...)
+		}
+		action := wl.Action
+		if action == "" {
+			action = ActionBlock
+		}
+		for _, w := range words {
+			w = strings.TrimSpace(w)
+			if w == "" {
+				continue
+			}
+			patterns = append(patterns, w)
+			rules = append(rules, wordRule{list: wl.Name, action: action})
+		}
+	}
+
+	dict := &dictionary{matcher: newACMatcher(patterns), rules: rules}
+	s.mu.Lock()
+	s.dict = dict
+	s.mu.Unlock()
+	return nil
+}
+
+// StartAutoReload 按配置的间隔定期重新加载词库文件，加载失败时保留旧词库
+func (s *Screener) StartAutoReload() {
+	if !s.Enabled() || s.c.ReloadSeconds <= 0 {
+		return
+	}
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(time.Duration(s.c.ReloadSeconds) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := s.Reload(); err != nil {
+				logx.Errorf("screening reload word lists failed: %v", err)
+			}
+		}
+	})
+}
+
+func readWordFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
+
+// Screen 对一段文本执行全部筛查
+func (s *Screener) Screen(text string) *Result {
+	res := &Result{Text: text}
+	if !s.Enabled() || text == "" {
+		return res
+	}
+	res.Hits = s.findHits(text)
+	for _, h := range res.Hits {
+		if actionLevel[h.Action] > actionLevel[res.Action] {
+			res.Action = h.Action
+		}
+	}
+	if res.Action == ActionMask || res.Action == ActionBlock {
+		res.Text = s.mask(text, res.Hits)
+	}
+	return res
+}
+
+// findHits 返回按起始位置排序的命中
+func (s *Screener) findHits(text string) []Hit {
+	s.mu.RLock()
+	dict := s.dict
+	s.mu.RUnlock()
+
+	var hits []Hit
+	for _, m := range dict.matcher.FindAll(text) {
+		rule := dict.rules[m.Pattern]
+		hits = append(hits, Hit{
+			Category: CategoryWord,
+			Rule:     rule.list,
+			Action:   rule.action,
+			Word:     dict.matcher.patterns[m.Pattern],
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	for _, d := range detectNumbers(text) {
+		action := s.detectorAction(d.category)
+		if action == ActionOff {
+			continue
+		}
+		hits = append(hits, Hit{
+			Category: d.category,
+			Rule:     d.category,
+			Action:   action,
+			Start:    d.start,
+			End:      d.end,
+		})
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Start < hits[j].Start })
+	return hits
+}
+
+func (s *Screener) detectorAction(category string) string {
+	var action string
+	switch category {
+	case CategoryIDCard:
+		action = s.c.IDCard
+	case CategoryMobile:
+		action = s.c.Mobile
+	case CategoryBankCard:
+		action = s.c.BankCard
+	}
+	if action == "" {
+		return ActionMask
+	}
+	return action
+}
+
+// mask 将 mask 命中区间内的每个字符替换为掩码字符
+func (s *Screener) mask(text string, hits []Hit) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	pos := 0
+	for _, h := range hits {
+		if h.Action != ActionMask || h.End <= pos {
+			continue
+		}
+		start := h.Start
+		if start < pos {
+			start = pos
+		}
+		b.WriteString(text[pos:start])
+		b.WriteString(strings.Repeat(s.c.MaskChar, utf8.RuneCountInString(text[start:h.End])))
+		pos = h.End
+	}
+	b.WriteString(text[pos:])
+	return b.String()
+}
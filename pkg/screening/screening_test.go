@@ -0,0 +1,203 @@
+package screening
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestACMatcher(t *testing.T) {
+	m := newACMatcher([]string{"he", "she", "his", "hers", "机密", "绝密文件", "Secret"})
+	tests := []struct {
+		text string
+		want []string // 按结束位置排列的命中
+	}{
+		{"ushers", []string{"she", "he", "hers"}},
+		{"this", []string{"his"}},
+		{"本文件属于绝密文件，非机密", []string{"绝密文件", "机密"}},
+		{"TOP SECRET", []string{"Secret"}}, // ASCII 字母不区分大小写
+		{"秘密", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, match := range m.FindAll(tt.text) {
+			if !strings.EqualFold(tt.text[match.Start:match.End], m.patterns[match.Pattern]) {
+				t.Fatalf("%q: match [%d,%d) is not %q", tt.text, match.Start, match.End, m.patterns[match.Pattern])
+			}
+			got = append(got, m.patterns[match.Pattern])
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("FindAll(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+	if newACMatcher(nil).FindAll("任意文本") != nil {
+		t.Fatal("empty matcher should not match")
+	}
+}
+
+// TestACMatcherRandom 随机词库与文本下与逐位置比较的命中数一致
+func TestACMatcherRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "b", "c", "密", "级"}
+	randString := func(n int) string {
+		var b strings.Builder
+		for range n {
+			b.WriteString(alphabet[rnd.Intn(len(alphabet))])
+		}
+		return b.String()
+	}
+	for range 500 {
+		patterns := make([]string, 1+rnd.Intn(5))
+		for i := range patterns {
+			patterns[i] = randString(1 + rnd.Intn(3))
+		}
+		text := randString(rnd.Intn(20))
+
+		want := 0
+		for _, p := range patterns {
+			for i := 0; i+len(p) <= len(text); i++ {
+				if text[i:i+len(p)] == p {
+					want++
+				}
+			}
+		}
+		if got := len(newACMatcher(patterns).FindAll(text)); got != want {
+			t.Fatalf("patterns %q in %q: %d matches, want %d", patterns, text, got, want)
+		}
+	}
+}
+
+func TestDetectNumbers(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string // 类别:原文
+	}{
+		{"身份证号11010519491231002X。", []string{"id_card:11010519491231002X"}},
+		{"身份证号110105194912310021", nil}, // 校验码错误
+		{"电话13812345678", []string{"mobile:13812345678"}},
+		{"电话8613812345678", []string{"mobile:8613812345678"}},
+		{"电话 138 1234 5678 转分机", []string{"mobile:138 1234 5678"}},
+		{"电话138-1234-5678", []string{"mobile:138-1234-5678"}},
+		{"编号9138-1234-5678", nil},      // 前面连着数字
+		{"编号138-1234-56789", nil},      // 后面连着数字
+		{"电话12812345678", nil},         // 第二位不是 3~9
+		{"卡号6222020200112233445", nil}, // Luhn 校验失败
+		{"卡号4111111111111111", []string{"bank_card:4111111111111111"}},
+		{"2025年7月1日", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, d := range detectNumbers(tt.text) {
+			got = append(got, d.category+":"+tt.text[d.start:d.end])
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("detectNumbers(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func newTestScreener(t *testing.T) *Screener {
+	t.Helper()
+	s, err := NewScreener(Config{
+		Enable: true,
+		WordLists: []WordListConf{
+			{Name: "secret", Action: ActionBlock, Words: []string{"绝密文件"}},
+			{Name: "internal", Action: ActionMask, Words: []string{"内部资料"}},
+			{Name: "watch", Action: ActionWarn, Words: []string{"预算"}},
+		},
+		IDCard:   ActionMask,
+		Mobile:   ActionMask,
+		BankCard: ActionMask,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestScreen(t *testing.T) {
+	s := newTestScreener(t)
+	tests := []struct {
+		text   string
+		action string
+		masked string
+	}{
+		{"普通文本", ActionNone, "普通文本"},
+		{"年度预算说明", ActionWarn, "年度预算说明"},
+		{"这是内部资料，联系138 1234 5678", ActionMask, "这是****，联系*************"},
+		{"附绝密文件一份", ActionBlock, "附绝密文件一份"}, // 拦截的命中整个请求被拒绝，不做脱敏
+	}
+	for _, tt := range tests {
+		res := s.Screen(tt.text)
+		if res.Action != tt.action || res.Text != tt.masked {
+			t.Errorf("Screen(%q) = %q/%q, want %q/%q", tt.text, res.Action, res.Text, tt.action, tt.masked)
+		}
+	}
+
+	var disabled *Screener
+	if res := disabled.Screen("绝密文件"); res.Action != ActionNone || res.Text != "绝密文件" {
+		t.Fatalf("disabled screener = %+v", res)
+	}
+}
+
+func TestStreamFilter(t *testing.T) {
+	s := newTestScreener(t)
+	text := "请查阅内部资料。联系人手机13812345678，备用138-1234-5678，身份证11010519491231002X。"
+	want := s.Screen(text).Text
+
+	// 每种切分方式下，输出拼接后都与整段筛查一致，跨分片的命中不会漏掉
+	for _, size := range []int{1, 2, 3, 5, 7, 11, 64} {
+		f := s.NewStreamFilter()
+		var out strings.Builder
+		hits := 0
+		for start := 0; start < len(text); start += size {
+			chunk, res := f.Write(text[start:min(start+size, len(text))])
+			out.WriteString(chunk)
+			hits += len(res.Hits)
+		}
+		chunk, res := f.Flush()
+		out.WriteString(chunk)
+		hits += len(res.Hits)
+
+		if out.String() != want {
+			t.Fatalf("chunk size %d: %q, want %q", size, out.String(), want)
+		}
+		if hits != 4 {
+			t.Fatalf("chunk size %d: %d hits, want 4", size, hits)
+		}
+	}
+}
+
+func TestRedactor(t *testing.T) {
+	r := NewRedactor(RedactConfig{Enable: true})
+	text := "申请人：张三，手机 138 1234 5678，身份证11010519491231002X。张三已签字。"
+	redacted := r.Redact(text)
+	for _, secret := range []string{"张三", "138 1234 5678", "11010519491231002X"} {
+		if strings.Contains(redacted, secret) {
+			t.Fatalf("%q not redacted in %q", secret, redacted)
+		}
+	}
+	if r.Redact(text) != redacted {
+		t.Fatal("same value should map to the same placeholder")
+	}
+	if got := r.Restore(redacted); got != text {
+		t.Fatalf("Restore = %q", got)
+	}
+
+	// 占位符被分片截断时暂存，还原结果与整段还原一致
+	stream := r.NewRestoreStream()
+	var out strings.Builder
+	for _, c := range redacted {
+		out.WriteString(stream.Write(string(c)))
+	}
+	out.WriteString(stream.Flush())
+	if out.String() != text {
+		t.Fatalf("RestoreStream = %q", out.String())
+	}
+
+	var disabled *Redactor
+	if disabled.Redact(text) != text || disabled.Len() != 0 {
+		t.Fatal("nil redactor should be a no-op")
+	}
+}
@@ -0,0 +1,96 @@
+package screening
+
+import "unicode/utf8"
+
+// maxDigitHoldback 末尾连续数字最多暂存的字节数，超过后按已有内容直接判定
+const maxDigitHoldback = 32
+
+// StreamFilter 对流式输出进行筛查。
+// 为了识别跨分片的敏感词与号码，会暂存末尾可能构成命中的一小段文本，
+// 待后续分片到达或调用 Flush 时再输出。
+type StreamFilter struct {
+	s       *Screener
+	pending string
+}
+
+// NewStreamFilter 创建流式筛查器
+func (s *Screener) NewStreamFilter() *StreamFilter {
+	return &StreamFilter{s: s}
+}
+
+// Write 写入一个分片，返回可以安全输出的（已脱敏）文本及其筛查结果
+func (f *StreamFilter) Write(chunk string) (string, *Result) {
+	if !f.s.Enabled() {
+		return chunk, &Result{Text: chunk}
+	}
+	f.pending += chunk
+	return f.emit(f.safeCut())
+}
+
+// Flush 输出全部暂存文本
+func (f *StreamFilter) Flush() (string, *Result) {
+	if !f.s.Enabled() {
+		return "", &Result{}
+	}
+	return f.emit(len(f.pending))
+}
+
+// emit 筛查整个暂存区，输出 [0, cut) 部分；跨越切分点的命中整体留到下次
+func (f *StreamFilter) emit(cut int) (string, *Result) {
+	full := f.s.Screen(f.pending)
+	for _, h := range full.Hits {
+		if h.Start < cut && h.End > cut {
+			cut = h.Start
+		}
+	}
+
+	res := &Result{}
+	for _, h := range full.Hits {
+		if h.End <= cut {
+			res.Hits = append(res.Hits, h)
+			if actionLevel[h.Action] > actionLevel[res.Action] {
+				res.Action = h.Action
+			}
+		}
+	}
+	res.Text = f.pending[:cut]
+	if res.Action == ActionMask || res.Action == ActionBlock {
+		res.Text = f.s.mask(res.Text, res.Hits)
+	}
+
+	f.pending = f.pending[cut:]
+	return res.Text, res
+}
+
+// safeCut 计算可输出的字节边界：保留足以容纳最长敏感词的尾部，以及末尾未结束的数字串
+func (f *StreamFilter) safeCut() int {
+	f.s.mu.RLock()
+	holdback := f.s.dict.matcher.maxLen - 1
+	f.s.mu.RUnlock()
+	if holdback < 0 {
+		holdback = 0
+	}
+
+	// 分隔手机号的空格与短横线也计入末尾的数字串
+	p := f.pending
+	digits := 0
+	for i := len(p) - 1; i >= 0 && digits < maxDigitHoldback; i-- {
+		c := p[i]
+		if !isDigit(c) && c != 'X' && c != 'x' && c != ' ' && c != '-' {
+			break
+		}
+		digits++
+	}
+	if digits > holdback {
+		holdback = digits
+	}
+
+	cut := len(p) - holdback
+	if cut <= 0 {
+		return 0
+	}
+	for cut > 0 && cut < len(p) && !utf8.RuneStart(p[cut]) {
+		cut--
+	}
+	return cut
+}
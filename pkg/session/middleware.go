@@ -0,0 +1,32 @@
+package session
+
+import (
+	"net/http"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Middleware 拒绝已吊销会话的请求，需通过 server.Use 注册（go-zero 会将其放在 JWT 鉴权之后执行）。
+// 未携带 JWT 的公开接口直接放行；Redis 不可用时同样放行，避免会话存储故障导致全站不可用。
+func (c *Checker) Middleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		userId, _ := ctxdata.GetUidFromCtx(ctx)
+		if userId == 0 {
+			next(w, r)
+			return
+		}
+
+		revoked, err := c.IsRevoked(ctx, userId, ctxdata.GetSessionIdFromCtx(ctx))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("check session revocation failed, userId: %d, err: %v", userId, err)
+		} else if revoked {
+			xerr.WriteJson(ctx, w, xerr.ErrTokenExpire)
+			return
+		}
+		next(w, r)
+	}
+}
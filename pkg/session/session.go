@@ -0,0 +1,221 @@
+// Package session 管理刷新令牌与服务端会话吊销。
+//
+// 每次登录创建一个会话（即刷新令牌家族，sessionId 写入 access token 的 jwtSid 声明）。
+// 刷新令牌只保存 SHA-256 摘要，每次刷新都会轮换为新令牌，旧令牌标记为已使用；
+// 已使用的令牌再次出现说明令牌可能已泄露，此时吊销整个会话。
+// 被吊销的会话在 access token 有效期内记录在吊销列表中，由 API 层中间件拦截。
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "usercenter:session:"
+
+var (
+	// ErrInvalidRefreshToken 刷新令牌不存在、已过期或所属会话已被吊销
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused 已轮换的刷新令牌被再次使用，所属会话已被吊销
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// rotateScript 原子地将刷新令牌标记为已使用，返回 {使用次数, userId, sessionId}，令牌不存在时返回 0
+var rotateScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local used = redis.call('HINCRBY', KEYS[1], 'used', 1)
+return {used, redis.call('HGET', KEYS[1], 'uid'), redis.call('HGET', KEYS[1], 'sid')}
+`)
+
+// Store 会话存储，由 usercenter RPC 使用
+type Store struct {
+	rds           *redis.Redis
+	accessExpire  int64 // access token 有效期（秒），决定吊销记录的保留时长
+	refreshExpire int64 // 刷新令牌有效期（秒），每次轮换后重新计算
+}
+
+// NewStore 创建会话存储
+func NewStore(rds *redis.Redis, accessExpire, refreshExpire int64) *Store {
+	return &Store{rds: rds, accessExpire: accessExpire, refreshExpire: refreshExpire}
+}
+
+// RefreshExpire 刷新令牌有效期（秒）
+func (s *Store) RefreshExpire() int64 {
+	return s.refreshExpire
+}
+
+// Create 为用户创建新会话，返回 sessionId 与首个刷新令牌
+func (s *Store) Create(ctx context.Context, userId int64) (sessionId, refreshToken string, err error) {
+	if sessionId, err = randomToken(16); err != nil {
+		return "", "", err
+	}
+	if refreshToken, err = s.issue(ctx, userId, sessionId); err != nil {
+		return "", "", err
+	}
+	return sessionId, refreshToken, nil
+}
+
+// Rotate 校验刷新令牌并轮换为新令牌。
+// 令牌已被使用过时吊销整个会话并返回 ErrRefreshTokenReused。
+func (s *Store) Rotate(ctx context.Context, refreshToken string) (userId int64, sessionId, newToken string, err error) {
+	if refreshToken == "" {
+		return 0, "", "", ErrInvalidRefreshToken
+	}
+	val, err := s.rds.ScriptRunCtx(ctx, rotateScript, []string{refreshKey(hashToken(refreshToken))})
+	if err != nil {
+		return 0, "", "", err
+	}
+	fields, ok := val.([]any)
+	if !ok || len(fields) != 3 {
+		return 0, "", "", ErrInvalidRefreshToken
+	}
+	used, _ := fields[0].(int64)
+	uid, _ := fields[1].(string)
+	sessionId, _ = fields[2].(string)
+	if userId, err = strconv.ParseInt(uid, 10, 64); err != nil {
+		return 0, "", "", ErrInvalidRefreshToken
+	}
+
+	if used > 1 {
+		if err = s.Revoke(ctx, userId, sessionId); err != nil {
+			return 0, "", "", err
+		}
+		return userId, sessionId, "", ErrRefreshTokenReused
+	}
+
+	// 会话可能已通过登出被吊销，此时旧令牌虽在但不再允许刷新
+	exists, err := s.rds.ExistsCtx(ctx, familyKey(sessionId))
+	if err != nil {
+		return 0, "", "", err
+	}
+	if !exists {
+		return 0, "", "", ErrInvalidRefreshToken
+	}
+
+	newToken, err = s.issue(ctx, userId, sessionId)
+	return userId, sessionId, newToken, err
+}
+
+// Revoke 吊销单个会话：删除当前刷新令牌，并在 access token 有效期内拒绝该会话签发的 token
+func (s *Store) Revoke(ctx context.Context, userId int64, sessionId string) error {
+	current, err := s.rds.HgetCtx(ctx, familyKey(sessionId), "current")
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	keys := []string{familyKey(sessionId)}
+	if current != "" {
+		keys = append(keys, refreshKey(current))
+	}
+	if _, err = s.rds.DelCtx(ctx, keys...); err != nil {
+		return err
+	}
+	if _, err = s.rds.SremCtx(ctx, userSessionsKey(userId), sessionId); err != nil {
+		return err
+	}
+	return s.rds.SetexCtx(ctx, revokedSessionKey(sessionId), "1", int(s.accessExpire))
+}
+
+// RevokeAll 吊销用户的全部会话（退出所有设备、禁用账号时使用）
+func (s *Store) RevokeAll(ctx context.Context, userId int64) error {
+	sessionIds, err := s.rds.SmembersCtx(ctx, userSessionsKey(userId))
+	if err != nil {
+		return err
+	}
+	for _, sid := range sessionIds {
+		if err = s.Revoke(ctx, userId, sid); err != nil {
+			return err
+		}
+	}
+	// 不携带 sessionId 的旧 token 无法按会话吊销，这里按用户整体拒绝
+	return s.rds.SetexCtx(ctx, revokedUserKey(userId), "1", int(s.accessExpire))
+}
+
+// issue 签发会话的新刷新令牌，并顺延会话有效期
+func (s *Store) issue(ctx context.Context, userId int64, sessionId string) (string, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return "", err
+	}
+	hash := hashToken(token)
+	uid := strconv.FormatInt(userId, 10)
+
+	err = s.rds.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		expire := time.Duration(s.refreshExpire) * time.Second
+		pipe.HSet(ctx, refreshKey(hash), "uid", uid, "sid", sessionId, "used", 0)
+		pipe.Expire(ctx, refreshKey(hash), expire)
+		pipe.HSet(ctx, familyKey(sessionId), "uid", uid, "current", hash)
+		pipe.Expire(ctx, familyKey(sessionId), expire)
+		pipe.SAdd(ctx, userSessionsKey(userId), sessionId)
+		pipe.Expire(ctx, userSessionsKey(userId), expire)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Checker 查询吊销列表，由 API 层使用
+type Checker struct {
+	rds *redis.Redis
+}
+
+// NewChecker 创建吊销检查器
+func NewChecker(rds *redis.Redis) *Checker {
+	return &Checker{rds: rds}
+}
+
+// IsRevoked 判断 access token 是否已被吊销。
+// 携带 sessionId 的 token 按会话判断；旧 token 不携带 sessionId，只在用户被整体吊销时拒绝
+func (c *Checker) IsRevoked(ctx context.Context, userId int64, sessionId string) (bool, error) {
+	key := revokedUserKey(userId)
+	if sessionId != "" {
+		key = revokedSessionKey(sessionId)
+	}
+	return c.rds.ExistsCtx(ctx, key)
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func refreshKey(hash string) string {
+	return keyPrefix + "refresh:" + hash
+}
+
+func familyKey(sessionId string) string {
+	return keyPrefix + "family:" + sessionId
+}
+
+func userSessionsKey(userId int64) string {
+	return keyPrefix + "user:" + strconv.FormatInt(userId, 10)
+}
+
+func revokedSessionKey(sessionId string) string {
+	return keyPrefix + "revoked:sid:" + sessionId
+}
+
+func revokedUserKey(userId int64) string {
+	return keyPrefix + "revoked:user:" + strconv.FormatInt(userId, 10)
+}
@@ -0,0 +1,210 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"document_agent/pkg/ctxdata"
+	"document_agent/pkg/xerr"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	testAccessExpire  = 600
+	testRefreshExpire = 3600
+)
+
+func newTestStore(t *testing.T) (*miniredis.Miniredis, *Store, *Checker) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := redis.MustNewRedis(redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType})
+	return mr, NewStore(rds, testAccessExpire, testRefreshExpire), NewChecker(rds)
+}
+
+func TestRotate(t *testing.T) {
+	_, s, c := newTestStore(t)
+	ctx := context.Background()
+	sid, token, err := s.Create(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 每次轮换返回新令牌，会话不变
+	for range 3 {
+		uid, gotSid, next, err := s.Rotate(ctx, token)
+		if err != nil || uid != 7 || gotSid != sid || next == "" || next == token {
+			t.Fatalf("Rotate = %d, %q, %q, %v", uid, gotSid, next, err)
+		}
+		token = next
+	}
+	if revoked, err := c.IsRevoked(ctx, 7, sid); err != nil || revoked {
+		t.Fatalf("IsRevoked = %v, %v", revoked, err)
+	}
+
+	for _, bad := range []string{"", "unknown"} {
+		if _, _, _, err := s.Rotate(ctx, bad); !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Fatalf("Rotate(%q) err = %v", bad, err)
+		}
+	}
+}
+
+func TestRotateReuseRevokesSession(t *testing.T) {
+	_, s, c := newTestStore(t)
+	ctx := context.Background()
+	sid, old, _ := s.Create(ctx, 7)
+	otherSid, otherToken, _ := s.Create(ctx, 7)
+	_, _, current, err := s.Rotate(ctx, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 已轮换的令牌再次出现：吊销整个会话，包括轮换后签发的令牌
+	if uid, gotSid, _, err := s.Rotate(ctx, old); !errors.Is(err, ErrRefreshTokenReused) || uid != 7 || gotSid != sid {
+		t.Fatalf("reuse = %d, %q, %v", uid, gotSid, err)
+	}
+	if _, _, _, err := s.Rotate(ctx, current); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate(current) after reuse err = %v", err)
+	}
+	if revoked, _ := c.IsRevoked(ctx, 7, sid); !revoked {
+		t.Fatal("session not revoked after reuse")
+	}
+
+	// 同一用户的其他会话不受影响，不携带会话ID的旧 token 也不受影响
+	if revoked, _ := c.IsRevoked(ctx, 7, otherSid); revoked {
+		t.Fatal("other session revoked")
+	}
+	if revoked, _ := c.IsRevoked(ctx, 7, ""); revoked {
+		t.Fatal("user revoked by a single session reuse")
+	}
+	if _, _, _, err := s.Rotate(ctx, otherToken); err != nil {
+		t.Fatalf("Rotate(other) err = %v", err)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	_, s, c := newTestStore(t)
+	ctx := context.Background()
+	sid1, token1, _ := s.Create(ctx, 7)
+	sid2, token2, _ := s.Create(ctx, 7)
+	sid3, token3, _ := s.Create(ctx, 8)
+
+	if err := s.Revoke(ctx, 7, sid1); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := s.Rotate(ctx, token1); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate(revoked) err = %v", err)
+	}
+	if revoked, _ := c.IsRevoked(ctx, 7, sid2); revoked {
+		t.Fatal("Revoke affected another session")
+	}
+
+	if err := s.RevokeAll(ctx, 7); err != nil {
+		t.Fatal(err)
+	}
+	for _, sid := range []string{sid1, sid2, ""} {
+		if revoked, _ := c.IsRevoked(ctx, 7, sid); !revoked {
+			t.Fatalf("session %q not revoked by RevokeAll", sid)
+		}
+	}
+	if _, _, _, err := s.Rotate(ctx, token2); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate after RevokeAll err = %v", err)
+	}
+	if revoked, _ := c.IsRevoked(ctx, 8, sid3); revoked {
+		t.Fatal("RevokeAll affected another user")
+	}
+	if _, _, _, err := s.Rotate(ctx, token3); err != nil {
+		t.Fatalf("Rotate(other user) err = %v", err)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	mr, s, c := newTestStore(t)
+	ctx := context.Background()
+	_, token, _ := s.Create(ctx, 7)
+	sid, revokedToken, _ := s.Create(ctx, 7)
+	if err := s.Revoke(ctx, 7, sid); err != nil {
+		t.Fatal(err)
+	}
+
+	// 吊销记录只保留 access token 的有效期
+	mr.FastForward(testAccessExpire * time.Second)
+	if revoked, _ := c.IsRevoked(ctx, 7, sid); revoked {
+		t.Fatal("revocation kept after access token expiry")
+	}
+	if _, _, _, err := s.Rotate(ctx, revokedToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate(revoked) after revocation expiry err = %v", err)
+	}
+
+	// 轮换顺延刷新令牌有效期
+	mr.FastForward((testRefreshExpire - testAccessExpire - 1) * time.Second)
+	_, _, token, err := s.Rotate(ctx, token)
+	if err != nil {
+		t.Fatalf("Rotate before expiry err = %v", err)
+	}
+	mr.FastForward((testRefreshExpire - 1) * time.Second)
+	if _, _, token, err = s.Rotate(ctx, token); err != nil {
+		t.Fatalf("Rotate within extended expiry err = %v", err)
+	}
+	mr.FastForward(testRefreshExpire * time.Second)
+	if _, _, _, err := s.Rotate(ctx, token); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("Rotate after expiry err = %v", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	mr, s, c := newTestStore(t)
+	ctx := context.Background()
+	sid, _, _ := s.Create(ctx, 7)
+	revokedSid, _, _ := s.Create(ctx, 7)
+	if err := s.Revoke(ctx, 7, revokedSid); err != nil {
+		t.Fatal(err)
+	}
+	handler := c.Middleware(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	serve := func(userId int64, sessionId string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		rctx := r.Context()
+		if userId > 0 {
+			rctx = context.WithValue(rctx, ctxdata.CtxKeyJwtUserId, json.Number(fmt.Sprint(userId)))
+			rctx = context.WithValue(rctx, ctxdata.CtxKeyJwtSessionId, sessionId)
+		}
+		w := httptest.NewRecorder()
+		handler(w, r.WithContext(rctx))
+		return w
+	}
+
+	if w := serve(0, ""); w.Code != http.StatusNoContent {
+		t.Fatalf("public request status = %d", w.Code)
+	}
+	if w := serve(7, sid); w.Code != http.StatusNoContent {
+		t.Fatalf("valid session status = %d", w.Code)
+	}
+
+	// 已吊销的会话返回统一格式的 token 失效错误
+	w := serve(7, revokedSid)
+	var body struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body %q: %v", w.Body.String(), err)
+	}
+	if body.Code != xerr.Code(xerr.ErrTokenExpire) {
+		t.Fatalf("revoked session body = %+v", body)
+	}
+
+	// Redis 不可用时放行
+	mr.Close()
+	if w := serve(7, revokedSid); w.Code != http.StatusNoContent {
+		t.Fatalf("status with redis down = %d", w.Code)
+	}
+}
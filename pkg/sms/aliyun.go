@@ -0,0 +1,121 @@
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"document_agent/pkg/tool"
+)
+
+const defaultAliyunEndpoint = "https://dysmsapi.aliyuncs.com"
+
+// AliyunConfig 阿里云短信服务配置，模板需包含 ${code} 变量
+type AliyunConfig struct {
+	AccessKeyId     string `json:",optional"`
+	AccessKeySecret string `json:",optional"`
+	SignName        string `json:",optional"` // 短信签名
+	TemplateCode    string `json:",optional"` // 验证码模板
+	Endpoint        string `json:",optional"` // 默认 https://dysmsapi.aliyuncs.com
+}
+
+// AliyunSender 通过阿里云短信服务（SendSms，签名方式 HMAC-SHA1）发送验证码
+type AliyunSender struct {
+	c      AliyunConfig
+	client *http.Client
+}
+
+// NewAliyunSender 创建阿里云短信发送器
+func NewAliyunSender(c AliyunConfig) (*AliyunSender, error) {
+	if c.AccessKeyId == "" || c.AccessKeySecret == "" || c.SignName == "" || c.TemplateCode == "" {
+		return nil, errors.New("aliyun sms requires AccessKeyId, AccessKeySecret, SignName and TemplateCode")
+	}
+	if c.Endpoint == "" {
+		c.Endpoint = defaultAliyunEndpoint
+	}
+	return &AliyunSender{c: c, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+func (s *AliyunSender) SendCode(ctx context.Context, mobile, code string) error {
+	templateParam, _ := json.Marshal(map[string]string{"code": code})
+	params := map[string]string{
+		"AccessKeyId":      s.c.AccessKeyId,
+		"Action":           "SendSms",
+		"Format":           "JSON",
+		"PhoneNumbers":     mobile,
+		"RegionId":         "cn-hangzhou",
+		"SignName":         s.c.SignName,
+		"SignatureMethod":  "HMAC-SHA1",
+		"SignatureNonce":   tool.GenerateULID(),
+		"SignatureVersion": "1.0",
+		"TemplateCode":     s.c.TemplateCode,
+		"TemplateParam":    string(templateParam),
+		"Timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		"Version":          "2017-05-25",
+	}
+	query := canonicalQuery(params)
+	query += "&Signature=" + percentEncode(s.sign(query))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.c.Endpoint+"/?"+query, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Code      string `json:"Code"`
+		Message   string `json:"Message"`
+		RequestId string `json:"RequestId"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode aliyun sms response (status %d): %w", resp.StatusCode, err)
+	}
+	if result.Code != "OK" {
+		return fmt.Errorf("aliyun sms failed, code: %s, message: %s, requestId: %s", result.Code, result.Message, result.RequestId)
+	}
+	return nil
+}
+
+// sign 计算签名：HMAC-SHA1(AccessKeySecret+"&", "GET&%2F&"+percentEncode(canonicalQuery))
+func (s *AliyunSender) sign(query string) string {
+	stringToSign := "GET&" + percentEncode("/") + "&" + percentEncode(query)
+	mac := hmac.New(sha1.New, []byte(s.c.AccessKeySecret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// canonicalQuery 按参数名排序并编码
+func canonicalQuery(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(params[k]))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// percentEncode 阿里云要求的 RFC 3986 编码
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	return strings.ReplaceAll(s, "%7E", "~")
+}
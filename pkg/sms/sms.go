@@ -0,0 +1,51 @@
+// Package sms 短信发送。业务代码只依赖 SMSSender 接口，具体服务商由配置选择：
+// 本地开发使用 log（只打印验证码，不真正发送），生产环境使用 aliyun。
+package sms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 服务商
+const (
+	ProviderLog    = "log"
+	ProviderAliyun = "aliyun"
+)
+
+// Config 短信配置
+type Config struct {
+	Provider string       `json:",optional"` // log | aliyun，未配置时为 log
+	Aliyun   AliyunConfig `json:",optional"`
+}
+
+// SMSSender 短信发送接口
+type SMSSender interface {
+	// SendCode 向手机号发送验证码
+	SendCode(ctx context.Context, mobile, code string) error
+}
+
+// MustNewSender 按配置创建短信发送器，配置错误时直接退出
+func MustNewSender(c Config) SMSSender {
+	switch c.Provider {
+	case "", ProviderLog:
+		return LogSender{}
+	case ProviderAliyun:
+		sender, err := NewAliyunSender(c.Aliyun)
+		logx.Must(err)
+		return sender
+	default:
+		logx.Must(fmt.Errorf("unknown sms provider: %s", c.Provider))
+		return nil
+	}
+}
+
+// LogSender 只把验证码写入日志，用于本地开发与测试
+type LogSender struct{}
+
+func (LogSender) SendCode(ctx context.Context, mobile, code string) error {
+	logx.WithContext(ctx).Infof("[sms] mobile: %s, code: %s", mobile, code)
+	return nil
+}
@@ -0,0 +1,184 @@
+// Package textanchor 将批注锚定到文档中的一段文本，并在文档修改后重新定位。
+//
+// 锚点记录被引用的文本及其前后各 ContextLen 个字符，位置以 Unicode 字符（rune）计。
+// 文档修改后先按原位置校验，再精确查找全部出现位置并按上下文与原位置择优，
+// 仍找不到时按编辑距离近似匹配，相似度不低于 Threshold 即视为找到；否则锚点失效。
+package textanchor
+
+import "errors"
+
+// 定位结果
+const (
+	StatusAnchored = "anchored" // 找到完全相同的文本
+	StatusFuzzy    = "fuzzy"    // 引用的文本已被修改，按近似匹配定位
+	StatusOrphaned = "orphaned" // 引用的文本已被删除或改动过大
+)
+
+const (
+	ContextLen = 32   // 保存的上下文长度
+	MaxLen     = 1000 // 引用文本的最大长度，限制近似匹配的计算量
+	Threshold  = 0.75 // 近似匹配的最低相似度
+)
+
+var (
+	ErrInvalidRange = errors.New("anchor range out of document")
+	ErrTooLong      = errors.New("anchor text too long")
+)
+
+// Anchor 文本锚点，Start、End 为左闭右开的字符位置
+type Anchor struct {
+	Start  int
+	End    int
+	Text   string
+	Prefix string
+	Suffix string
+}
+
+// New 按字符位置在 content 中创建锚点
+func New(content string, start, end int) (Anchor, error) {
+	return newAnchor([]rune(content), start, end)
+}
+
+func newAnchor(r []rune, start, end int) (Anchor, error) {
+	if start < 0 || end <= start || end > len(r) {
+		return Anchor{}, ErrInvalidRange
+	}
+	if end-start > MaxLen {
+		return Anchor{}, ErrTooLong
+	}
+	return Anchor{
+		Start:  start,
+		End:    end,
+		Text:   string(r[start:end]),
+		Prefix: string(r[max(0, start-ContextLen):start]),
+		Suffix: string(r[end:min(len(r), end+ContextLen)]),
+	}, nil
+}
+
+// Locate 在修改后的 content 中重新定位锚点，返回更新后的锚点与定位结果；失效时返回原锚点
+func Locate(content string, a Anchor) (Anchor, string) {
+	r, t := []rune(content), []rune(a.Text)
+	if len(t) == 0 {
+		return a, StatusOrphaned
+	}
+
+	// 1) 原位置未变
+	if a.Start >= 0 && a.End <= len(r) && a.End-a.Start == len(t) && string(r[a.Start:a.End]) == a.Text {
+		if na, err := newAnchor(r, a.Start, a.End); err == nil {
+			return na, StatusAnchored
+		}
+	}
+
+	// 2) 精确查找，多处出现时取上下文最吻合、离原位置最近的一处
+	if start := bestExact(r, t, []rune(a.Prefix), []rune(a.Suffix), a.Start); start >= 0 {
+		if na, err := newAnchor(r, start, start+len(t)); err == nil {
+			return na, StatusAnchored
+		}
+	}
+
+	// 3) 近似匹配
+	if start, end, ok := bestFuzzy(r, t, a.Start); ok {
+		if na, err := newAnchor(r, start, end); err == nil {
+			return na, StatusFuzzy
+		}
+	}
+	return a, StatusOrphaned
+}
+
+func bestExact(r, t, prefix, suffix []rune, oldStart int) int {
+	best, bestScore, bestDist := -1, -1, 0
+	for i := 0; i+len(t) <= len(r); i++ {
+		if !equalRunes(r[i:i+len(t)], t) {
+			continue
+		}
+		score := commonSuffix(r[:i], prefix) + commonPrefix(r[i+len(t):], suffix)
+		dist := abs(i - oldStart)
+		if score > bestScore || (score == bestScore && dist < bestDist) {
+			best, bestScore, bestDist = i, score, dist
+		}
+	}
+	return best
+}
+
+// bestFuzzy 近似子串匹配（Sellers 算法）：计算 t 与 r 中以每个位置结尾的子串的最小编辑距离，
+// 取距离最小、离原位置最近的一处
+func bestFuzzy(r, t []rune, oldStart int) (start, end int, ok bool) {
+	m := len(t)
+	maxDist := int(float64(m) * (1 - Threshold))
+	if maxDist == 0 {
+		return 0, 0, false
+	}
+
+	// cost[i]、from[i]：t[:i] 与以当前位置结尾的子串的最小编辑距离及该子串的起点
+	cost, from := make([]int, m+1), make([]int, m+1)
+	next, nextFrom := make([]int, m+1), make([]int, m+1)
+	for i := range cost {
+		cost[i] = i
+	}
+	bestDist, bestGap := maxDist+1, 0
+	for j := range r {
+		next[0], nextFrom[0] = 0, j+1
+		for i := 1; i <= m; i++ {
+			c, f := cost[i-1], from[i-1] // 替换或匹配
+			if t[i-1] != r[j] {
+				c++
+			}
+			if cost[i]+1 < c { // 文档中多出的字符
+				c, f = cost[i]+1, from[i]
+			}
+			if next[i-1]+1 < c { // 文档中缺少的字符
+				c, f = next[i-1]+1, nextFrom[i-1]
+			}
+			next[i], nextFrom[i] = c, f
+		}
+		cost, next = next, cost
+		from, nextFrom = nextFrom, from
+
+		d, s := cost[m], from[m]
+		if s > j { // 空匹配
+			continue
+		}
+		gap := abs(s - oldStart)
+		if d < bestDist || (d == bestDist && gap < bestGap) {
+			bestDist, bestGap, start, end = d, gap, s, j+1
+		}
+	}
+	return start, end, bestDist <= maxDist
+}
+
+func equalRunes(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// commonSuffix a、b 末尾相同的字符数
+func commonSuffix(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	return n
+}
+
+// commonPrefix a、b 开头相同的字符数
+func commonPrefix(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,169 @@
+package textanchor
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const doc = "各部门：\n定于下周三召开年度工作会议，请各单位负责人准时参加。\n特此通知。"
+
+// anchorAt 在 content 中第 nth 次（从 0 开始）出现的 text 处创建锚点
+func anchorAt(t *testing.T, content, text string, nth int) Anchor {
+	t.Helper()
+	offset := 0
+	for range nth {
+		offset += strings.Index(content[offset:], text) + len(text)
+	}
+	i := strings.Index(content[offset:], text)
+	if i < 0 {
+		t.Fatalf("%q not found in %q", text, content)
+	}
+	start := utf8.RuneCountInString(content[:offset+i])
+	a, err := New(content, start, start+utf8.RuneCountInString(text))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return a
+}
+
+func runeIndex(content, text string) int {
+	return utf8.RuneCountInString(content[:strings.Index(content, text)])
+}
+
+func TestNew(t *testing.T) {
+	a := anchorAt(t, doc, "召开年度工作会议", 0)
+	if a.Start != 10 || a.End != 18 || a.Text != "召开年度工作会议" {
+		t.Fatalf("anchor = %+v", a)
+	}
+	if a.Prefix != "各部门：\n定于下周三" || a.Suffix != "，请各单位负责人准时参加。\n特此通知。" {
+		t.Fatalf("context = %q / %q", a.Prefix, a.Suffix)
+	}
+
+	long := strings.Repeat("字", MaxLen+1)
+	for _, tt := range []struct {
+		content    string
+		start, end int
+		want       error
+	}{
+		{doc, -1, 3, ErrInvalidRange},
+		{doc, 5, 5, ErrInvalidRange},
+		{doc, 30, 100, ErrInvalidRange},
+		{"", 0, 1, ErrInvalidRange},
+		{long, 0, MaxLen + 1, ErrTooLong},
+	} {
+		if _, err := New(tt.content, tt.start, tt.end); err != tt.want {
+			t.Errorf("New(%d, %d) err = %v, want %v", tt.start, tt.end, err, tt.want)
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	quote := "召开年度工作会议"
+	tests := []struct {
+		name     string
+		content  string
+		want     string // 期望定位到的文本，失效时为空
+		wantAt   int    // 期望的起始字符位置
+		wantStat string
+	}{
+		{"内容未变", doc, quote, 10, StatusAnchored},
+		{"前面插入文字", "关于年度工作会议的通知\n" + doc, quote, 22, StatusAnchored},
+		{"删除前面的文字", strings.Replace(doc, "各部门：\n", "", 1), quote, 5, StatusAnchored},
+		{"上下文被修改", strings.NewReplacer("下周三", "本月底", "各单位负责人", "各部门负责同志").Replace(doc), quote, 10, StatusAnchored},
+		{"引用文本改动两个字", strings.Replace(doc, "工作", "总结", 1), "召开年度总结会议", 10, StatusFuzzy},
+		{"引用文本中插入文字", strings.Replace(doc, "年度工作", "年度重点工作", 1), "召开年度重点工作会议", 10, StatusFuzzy},
+		{"相似度低于阈值", strings.Replace(doc, "度工作", "终总结", 1), "", 0, StatusOrphaned},
+		{"引用文本被删除", strings.Replace(doc, "召开年度工作会议，", "", 1), "", 0, StatusOrphaned},
+		{"文档为空", "", "", 0, StatusOrphaned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := anchorAt(t, doc, quote, 0)
+			got, status := Locate(tt.content, a)
+			if status != tt.wantStat {
+				t.Fatalf("status = %s, want %s (anchor %+v)", status, tt.wantStat, got)
+			}
+			if status == StatusOrphaned {
+				if got != a {
+					t.Fatalf("orphaned anchor changed: %+v", got)
+				}
+				return
+			}
+			if got.Text != tt.want || got.Start != tt.wantAt || got.End != tt.wantAt+utf8.RuneCountInString(tt.want) {
+				t.Fatalf("anchor = %+v, want %q at %d", got, tt.want, tt.wantAt)
+			}
+			if r := []rune(tt.content); string(r[got.Start:got.End]) != got.Text {
+				t.Fatalf("anchor text %q does not match content %q", got.Text, string(r[got.Start:got.End]))
+			}
+		})
+	}
+}
+
+func TestLocateThreshold(t *testing.T) {
+	// 8 个字符允许 2 处改动（相似度 0.75），3 处改动时失效
+	a := anchorAt(t, doc, "召开年度工作会议", 0)
+	if _, status := Locate(strings.Replace(doc, "年度工作", "年终总结", 1), a); status != StatusOrphaned {
+		t.Fatalf("3 edits: status = %s", status)
+	}
+	if _, status := Locate(strings.Replace(doc, "度工作", "度总结", 1), a); status != StatusFuzzy {
+		t.Fatalf("2 edits: status = %s", status)
+	}
+
+	// 少于 4 个字符的引用不做近似匹配
+	short := anchorAt(t, doc, "各部门", 0)
+	if _, status := Locate(strings.Replace(doc, "各部门", "各单位", 1), short); status != StatusOrphaned {
+		t.Fatalf("short quote: status = %s", status)
+	}
+}
+
+func TestLocateDuplicates(t *testing.T) {
+	content := "第一条：按时参会。\n第二条：按时参会。\n第三条：会后提交总结。"
+	second := anchorAt(t, content, "按时参会", 1)
+	if second.Start != 14 {
+		t.Fatalf("second occurrence at %d", second.Start)
+	}
+
+	// 前面插入文字后，按上下文选中原来的第二处
+	edited := "总则\n" + content
+	got, status := Locate(edited, second)
+	if status != StatusAnchored || got.Start != 17 || !strings.HasPrefix(got.Prefix, "总则\n第一条：按时参会。\n第二条：") {
+		t.Fatalf("anchor = %+v, %s", got, status)
+	}
+
+	// 上下文都已改变时取离原位置最近的一处
+	edited = "甲：按时参会。乙：按时参会。丙：按时参会。"
+	got, status = Locate(edited, Anchor{Start: 8, End: 12, Text: "按时参会"})
+	if status != StatusAnchored || got.Start != 9 {
+		t.Fatalf("anchor = %+v, %s", got, status)
+	}
+}
+
+func TestLocateCJKAndEmoji(t *testing.T) {
+	content := "标题😀\n正文内容𠀀第二段"
+	a := anchorAt(t, content, "正文内容", 0)
+	if a.Start != 4 || a.End != 8 || a.Suffix != "𠀀第二段" {
+		t.Fatalf("anchor = %+v", a)
+	}
+
+	// 位置按字符而非字节计算
+	edited := "😀😀" + content
+	got, status := Locate(edited, a)
+	if status != StatusAnchored || got.Start != runeIndex(edited, "正文内容") || got.Start != 6 {
+		t.Fatalf("anchor = %+v, %s", got, status)
+	}
+}
+
+func TestLocateEmptyAnchor(t *testing.T) {
+	a := Anchor{Start: 0, End: 0}
+	if got, status := Locate(doc, a); status != StatusOrphaned || got != a {
+		t.Fatalf("anchor = %+v, %s", got, status)
+	}
+
+	// 原位置超出修改后的文档时按文本重新查找
+	a = anchorAt(t, doc, "特此通知", 0)
+	a.Start, a.End = 1000, 1004
+	if got, status := Locate(doc, a); status != StatusAnchored || got.Start != runeIndex(doc, "特此通知") {
+		t.Fatalf("anchor = %+v, %s", got, status)
+	}
+}
@@ -0,0 +1,10 @@
+package tool
+
+import "regexp"
+
+var mobileRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// IsValidMobile 校验中国大陆 11 位手机号
+func IsValidMobile(mobile string) bool {
+	return mobileRegexp.MatchString(mobile)
+}
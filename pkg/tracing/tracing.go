@@ -0,0 +1,84 @@
+// Package tracing OpenTelemetry 链路追踪的辅助函数。HTTP 服务、zrpc 调用、MySQL 与 Redis 的 span 由 go-zero
+// 根据 Telemetry 配置自动创建并通过 OTLP 导出；这里补充业务阶段的 span、会话属性，以及向星辰 HTTP 请求
+// 和 Pandoc、Tesseract 子进程传递 trace 上下文。
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	ztrace "github.com/zeromicro/go-zero/core/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 业务属性
+const (
+	AttrConversationID = attribute.Key("conversation.id")
+	AttrDocumentType   = attribute.Key("document.type")
+	AttrCallType       = attribute.Key("llm.call_type")
+	AttrProvider       = attribute.Key("llm.provider")
+	AttrModel          = attribute.Key("llm.model")
+	AttrAttempt        = attribute.Key("llm.attempt")
+	AttrErrorCode      = attribute.Key("llm.error_code")
+	AttrHTTPStatus     = attribute.Key("http.response.status_code")
+	AttrReferenceCount = attribute.Key("references.count")
+	AttrFormat         = attribute.Key("document.format")
+)
+
+// Start 在 ctx 中的 span 下创建子 span
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return ztrace.TracerFromContext(ctx).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End 结束 span，出错时记录错误并将状态置为 Error
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetConversation 在当前 span 上记录会话ID与文章类型，为空的值不记录
+func SetConversation(ctx context.Context, conversationID, documentType string) {
+	span := trace.SpanFromContext(ctx)
+	if conversationID != "" {
+		span.SetAttributes(AttrConversationID.String(conversationID))
+	}
+	if documentType != "" {
+		span.SetAttributes(AttrDocumentType.String(documentType))
+	}
+}
+
+// InjectHTTP 将 trace 上下文写入对外 HTTP 请求头（traceparent / tracestate）
+func InjectHTTP(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// CommandEnv 返回带有 trace 上下文的子进程环境变量（TRACEPARENT / TRACESTATE，见 OpenTelemetry 环境变量传播约定），
+// 支持 OpenTelemetry 的子进程可据此延续链路
+func CommandEnv(ctx context.Context) []string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	env := os.Environ()
+	for _, key := range carrier.Keys() {
+		env = append(env, strings.ToUpper(key)+"="+carrier.Get(key))
+	}
+	return env
+}
+
+// SetAttributes 在当前 span 上记录属性
+func SetAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// AddEvent 在当前 span 上记录一个事件
+func AddEvent(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,155 @@
+// Package usage 记录大模型调用用量（按用户、日期、调用类型聚合），并按用户或角色配额判断是否允许继续调用。
+// 统计周期为服务器本地时区的自然日与自然月。
+package usage
+
+import (
+	"context"
+	"time"
+
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/xerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 调用类型
+const (
+	CallGenerate = "generate" // 生成大纲（ChatCompletions）
+	CallResume   = "resume"   // 续写生成最终文档（ChatResume）
+	CallEdit     = "edit"     // 大模型修改文档（EditDocument）
+)
+
+// Call 一次大模型调用的用量
+type Call struct {
+	UserId        int64
+	CallType      string
+	PromptChars   int64 // 提示词字符数（含携带的历史消息）
+	ResponseChars int64 // 模型输出字符数
+	Latency       time.Duration
+	Success       bool
+}
+
+// Recorder 用量记录器
+type Recorder struct {
+	model model.UsageModel
+}
+
+// NewRecorder 创建用量记录器
+func NewRecorder(m model.UsageModel) *Recorder {
+	return &Recorder{model: m}
+}
+
+// Record 将一次调用累加到当天的用量中。
+// 与审计一样，客户端断开后仍需记录；写入失败只记录错误日志，不影响调用本身
+func (r *Recorder) Record(ctx context.Context, c Call) {
+	if c.UserId <= 0 {
+		return
+	}
+	data := &model.Usage{
+		UserId:        c.UserId,
+		UsageDate:     time.Now(),
+		CallType:      c.CallType,
+		RequestCount:  1,
+		PromptChars:   c.PromptChars,
+		ResponseChars: c.ResponseChars,
+		LatencyMs:     c.Latency.Milliseconds(),
+	}
+	if c.Success {
+		data.SuccessCount = 1
+	} else {
+		data.FailureCount = 1
+	}
+	if err := r.model.Add(context.WithoutCancel(ctx), data); err != nil {
+		logx.WithContext(ctx).Errorw("write usage failed",
+			logx.Field("userId", c.UserId),
+			logx.Field("callType", c.CallType),
+			logx.Field("err", err.Error()),
+		)
+	}
+}
+
+// Limits 生效的配额，0 表示不限
+type Limits struct {
+	DailyRequests   int64
+	MonthlyRequests int64
+	DailyChars      int64
+	MonthlyChars    int64
+}
+
+// Unlimited 是否没有任何限制
+func (l Limits) Unlimited() bool {
+	return l == Limits{}
+}
+
+// Check 已用量达到任一上限时返回对应错误
+func (l Limits) Check(today, month *model.UsageTotal) error {
+	if reached(today.RequestCount, l.DailyRequests) || reached(today.Chars, l.DailyChars) {
+		return xerr.ErrDailyQuotaExceeded
+	}
+	if reached(month.RequestCount, l.MonthlyRequests) || reached(month.Chars, l.MonthlyChars) {
+		return xerr.ErrMonthlyQuotaExceeded
+	}
+	return nil
+}
+
+// Resolve 计算生效的配额：用户级配额优先；否则在用户的全部角色 roles 中取最宽松的一项，
+// 任一角色某项不限则该项不限。没有配置配额的角色视为不限，因此用户只要有一个角色未配置配额即不限
+func Resolve(userQuota *model.UsageQuota, roles []string, roleQuotas []*model.UsageQuota) Limits {
+	if userQuota != nil {
+		return limitsOf(userQuota)
+	}
+	byRole := make(map[string]*model.UsageQuota, len(roleQuotas))
+	for _, q := range roleQuotas {
+		byRole[q.Subject] = q
+	}
+
+	var limits Limits
+	for i, role := range roles {
+		q, ok := byRole[role]
+		if !ok {
+			return Limits{}
+		}
+		other := limitsOf(q)
+		if i == 0 {
+			limits = other
+			continue
+		}
+		limits.DailyRequests = looser(limits.DailyRequests, other.DailyRequests)
+		limits.MonthlyRequests = looser(limits.MonthlyRequests, other.MonthlyRequests)
+		limits.DailyChars = looser(limits.DailyChars, other.DailyChars)
+		limits.MonthlyChars = looser(limits.MonthlyChars, other.MonthlyChars)
+	}
+	return limits
+}
+
+// DayStart 当天零点
+func DayStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// MonthStart 当月第一天零点
+func MonthStart(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
+
+func limitsOf(q *model.UsageQuota) Limits {
+	return Limits{
+		DailyRequests:   q.DailyRequests,
+		MonthlyRequests: q.MonthlyRequests,
+		DailyChars:      q.DailyChars,
+		MonthlyChars:    q.MonthlyChars,
+	}
+}
+
+func looser(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return max(a, b)
+}
+
+func reached(used, limit int64) bool {
+	return limit > 0 && used >= limit
+}
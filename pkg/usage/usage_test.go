@@ -0,0 +1,102 @@
+package usage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"document_agent/app/llmcenter/model"
+	"document_agent/pkg/xerr"
+)
+
+func roleQuota(role string, daily, monthly, dailyChars, monthlyChars int64) *model.UsageQuota {
+	return &model.UsageQuota{SubjectType: model.QuotaSubjectRole, Subject: role,
+		DailyRequests: daily, MonthlyRequests: monthly, DailyChars: dailyChars, MonthlyChars: monthlyChars}
+}
+
+func TestResolve(t *testing.T) {
+	userQuota := &model.UsageQuota{SubjectType: model.QuotaSubjectUser, Subject: "7", DailyRequests: 3}
+	userRole := roleQuota("user", 10, 100, 1000, 0)
+	reviewerRole := roleQuota("reviewer", 20, 50, 0, 5000)
+	tests := []struct {
+		name       string
+		userQuota  *model.UsageQuota
+		roles      []string
+		roleQuotas []*model.UsageQuota
+		want       Limits
+	}{
+		{"用户级配额优先于角色", userQuota, []string{"user"}, []*model.UsageQuota{userRole}, Limits{DailyRequests: 3}},
+		{"用户级配额全为 0 表示不限", &model.UsageQuota{}, []string{"user"}, []*model.UsageQuota{userRole}, Limits{}},
+		{"单个角色", nil, []string{"user"}, []*model.UsageQuota{userRole}, Limits{10, 100, 1000, 0}},
+		{"多个角色逐项取最宽松", nil, []string{"user", "reviewer"}, []*model.UsageQuota{userRole, reviewerRole}, Limits{20, 100, 0, 0}},
+		{"角色顺序不影响结果", nil, []string{"reviewer", "user"}, []*model.UsageQuota{userRole, reviewerRole}, Limits{20, 100, 0, 0}},
+		{"有角色未配置配额时不限", nil, []string{"user", "admin"}, []*model.UsageQuota{userRole}, Limits{}},
+		{"其他角色的配额不生效", nil, []string{"user"}, []*model.UsageQuota{userRole, reviewerRole}, Limits{10, 100, 1000, 0}},
+		{"角色全部未配置", nil, []string{"user"}, nil, Limits{}},
+		{"没有角色", nil, nil, []*model.UsageQuota{userRole}, Limits{}},
+		{"角色配额全为 0", nil, []string{"user", "reviewer"}, []*model.UsageQuota{roleQuota("user", 0, 0, 0, 0), reviewerRole}, Limits{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resolve(tt.userQuota, tt.roles, tt.roleQuotas)
+			if got != tt.want {
+				t.Fatalf("Resolve = %+v, want %+v", got, tt.want)
+			}
+			if got.Unlimited() != (tt.want == Limits{}) {
+				t.Fatalf("Unlimited = %v", got.Unlimited())
+			}
+		})
+	}
+}
+
+func TestLooser(t *testing.T) {
+	tests := []struct{ a, b, want int64 }{
+		{0, 0, 0},
+		{0, 5, 0},
+		{5, 0, 0},
+		{3, 5, 5},
+		{5, 3, 5},
+		{4, 4, 4},
+	}
+	for _, tt := range tests {
+		if got := looser(tt.a, tt.b); got != tt.want {
+			t.Errorf("looser(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLimitsCheck(t *testing.T) {
+	limits := Limits{DailyRequests: 5, MonthlyRequests: 20, DailyChars: 1000, MonthlyChars: 0}
+	tests := []struct {
+		name         string
+		limits       Limits
+		today, month model.UsageTotal
+		want         error
+	}{
+		{"未达到上限", limits, model.UsageTotal{RequestCount: 4, Chars: 999}, model.UsageTotal{RequestCount: 19, Chars: 1 << 40}, nil},
+		{"每日次数达到上限", limits, model.UsageTotal{RequestCount: 5}, model.UsageTotal{RequestCount: 5}, xerr.ErrDailyQuotaExceeded},
+		{"每日字符数达到上限", limits, model.UsageTotal{Chars: 1000}, model.UsageTotal{Chars: 1000}, xerr.ErrDailyQuotaExceeded},
+		{"每月次数达到上限", limits, model.UsageTotal{RequestCount: 1}, model.UsageTotal{RequestCount: 20}, xerr.ErrMonthlyQuotaExceeded},
+		{"每日与每月同时达到时返回每日", limits, model.UsageTotal{RequestCount: 5}, model.UsageTotal{RequestCount: 20}, xerr.ErrDailyQuotaExceeded},
+		{"不限", Limits{}, model.UsageTotal{RequestCount: 1 << 40, Chars: 1 << 40}, model.UsageTotal{RequestCount: 1 << 40, Chars: 1 << 40}, nil},
+		{"零用量", limits, model.UsageTotal{}, model.UsageTotal{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.limits.Check(&tt.today, &tt.month); !errors.Is(err, tt.want) {
+				t.Fatalf("Check = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodStart(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2025, 3, 31, 23, 59, 59, 0, loc)
+	if got := DayStart(now); !got.Equal(time.Date(2025, 3, 31, 0, 0, 0, 0, loc)) {
+		t.Fatalf("DayStart = %v", got)
+	}
+	if got := MonthStart(now); !got.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, loc)) {
+		t.Fatalf("MonthStart = %v", got)
+	}
+}
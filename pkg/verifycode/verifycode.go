@@ -0,0 +1,231 @@
+// Package verifycode 短信验证码的生成、发送频率控制与校验。
+// 验证码按场景隔离，只保存 SHA-256 摘要，带有效期与错误次数上限，校验成功后立即作废。
+package verifycode
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"document_agent/pkg/sms"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const keyPrefix = "usercenter:smscode:"
+
+// 使用场景
+const (
+	SceneRegister      = "register"
+	SceneLogin         = "login"
+	SceneResetPassword = "reset_password"
+)
+
+// ValidScene 是否为支持的场景
+func ValidScene(scene string) bool {
+	return scene == SceneRegister || scene == SceneLogin || scene == SceneResetPassword
+}
+
+var (
+	// ErrInvalidCode 验证码错误、已过期或未发送
+	ErrInvalidCode = errors.New("invalid verification code")
+	// ErrTooManyAttempts 错误次数超过上限，验证码已作废
+	ErrTooManyAttempts = errors.New("too many verification attempts")
+)
+
+// SendLimitError 发送过于频繁，RetryAfter 为需要等待的时间
+type SendLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *SendLimitError) Error() string {
+	return fmt.Sprintf("send verification code too frequently, retry after %s", e.RetryAfter)
+}
+
+// Config 验证码配置，时间单位均为秒，未配置的字段使用括号中的默认值
+type Config struct {
+	Length         int `json:",optional"` // 验证码位数（6）
+	Expire         int `json:",optional"` // 有效期（300）
+	MaxAttempts    int `json:",optional"` // 单个验证码允许的错误次数（5）
+	ResendInterval int `json:",optional"` // 同一手机号两次发送的最小间隔（60）
+	MobileDailyMax int `json:",optional"` // 同一手机号每天最多发送次数（10）
+	IPHourlyMax    int `json:",optional"` // 同一 IP 每小时最多发送次数（20）
+}
+
+func (c Config) withDefaults() Config {
+	setDefault := func(v *int, def int) {
+		if *v <= 0 {
+			*v = def
+		}
+	}
+	setDefault(&c.Length, 6)
+	setDefault(&c.Expire, 300)
+	setDefault(&c.MaxAttempts, 5)
+	setDefault(&c.ResendInterval, 60)
+	setDefault(&c.MobileDailyMax, 10)
+	setDefault(&c.IPHourlyMax, 20)
+	return c
+}
+
+// verifyScript 校验验证码：错误次数超限返回 -1，不存在返回 0，匹配返回 1（并删除），不匹配返回 2。
+// KEYS[1] 验证码 hash；ARGV[1] 提交的验证码摘要；ARGV[2] 错误次数上限
+var verifyScript = redis.NewScript(`
+local hash = redis.call('HGET', KEYS[1], 'hash')
+if not hash then
+	return 0
+end
+if hash == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if attempts >= tonumber(ARGV[2]) then
+	redis.call('DEL', KEYS[1])
+	return -1
+end
+return 2
+`)
+
+// Manager 验证码管理
+type Manager struct {
+	rds    *redis.Redis
+	sender sms.SMSSender
+	cfg    Config
+}
+
+// NewManager 创建验证码管理器
+func NewManager(rds *redis.Redis, sender sms.SMSSender, cfg Config) *Manager {
+	return &Manager{rds: rds, sender: sender, cfg: cfg.withDefaults()}
+}
+
+// Send 生成并发送验证码，同一场景下新验证码会覆盖旧验证码。ip 为空时不做 IP 维度限制
+func (m *Manager) Send(ctx context.Context, scene, mobile, ip string) error {
+	if err := m.checkSendLimit(ctx, mobile, ip); err != nil {
+		return err
+	}
+
+	code, err := randomDigits(m.cfg.Length)
+	if err != nil {
+		return err
+	}
+	key := codeKey(scene, mobile)
+	err = m.rds.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "hash", hashCode(code), "attempts", 0)
+		pipe.Expire(ctx, key, time.Duration(m.cfg.Expire)*time.Second)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = m.sender.SendCode(ctx, mobile, code); err != nil {
+		// 发送失败时作废验证码，但保留频率计数，防止借失败重试绕过限制
+		_, _ = m.rds.DelCtx(ctx, key)
+		return err
+	}
+	return nil
+}
+
+// Verify 校验验证码，成功后验证码立即作废
+func (m *Manager) Verify(ctx context.Context, scene, mobile, code string) error {
+	if code == "" {
+		return ErrInvalidCode
+	}
+	val, err := m.rds.ScriptRunCtx(ctx, verifyScript, []string{codeKey(scene, mobile)},
+		hashCode(code), m.cfg.MaxAttempts)
+	if err != nil {
+		return err
+	}
+	switch result, _ := val.(int64); result {
+	case 1:
+		return nil
+	case -1:
+		return ErrTooManyAttempts
+	default:
+		return ErrInvalidCode
+	}
+}
+
+// checkSendLimit 发送间隔、手机号每日上限与 IP 每小时上限
+func (m *Manager) checkSendLimit(ctx context.Context, mobile, ip string) error {
+	ok, err := m.rds.SetnxExCtx(ctx, cooldownKey(mobile), "1", m.cfg.ResendInterval)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		ttl, err := m.rds.TtlCtx(ctx, cooldownKey(mobile))
+		if err != nil {
+			return err
+		}
+		return &SendLimitError{RetryAfter: time.Duration(max(ttl, 1)) * time.Second}
+	}
+
+	now := time.Now()
+	if err = m.incrLimit(ctx, mobileDailyKey(mobile, now), m.cfg.MobileDailyMax, 24*time.Hour); err != nil {
+		return err
+	}
+	if ip != "" {
+		return m.incrLimit(ctx, ipHourlyKey(ip, now), m.cfg.IPHourlyMax, time.Hour)
+	}
+	return nil
+}
+
+// incrLimit 固定窗口计数，超过上限时返回到窗口结束的等待时间
+func (m *Manager) incrLimit(ctx context.Context, key string, limit int, window time.Duration) error {
+	count, err := m.rds.IncrCtx(ctx, key)
+	if err != nil {
+		return err
+	}
+	if count == 1 {
+		if err = m.rds.ExpireCtx(ctx, key, int(window.Seconds())); err != nil {
+			return err
+		}
+	}
+	if count > int64(limit) {
+		ttl, err := m.rds.TtlCtx(ctx, key)
+		if err != nil {
+			return err
+		}
+		return &SendLimitError{RetryAfter: time.Duration(max(ttl, 1)) * time.Second}
+	}
+	return nil
+}
+
+func randomDigits(n int) (string, error) {
+	digits := make([]byte, n)
+	for i := range digits {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", fmt.Errorf("generate verification code: %w", err)
+		}
+		digits[i] = byte('0' + d.Int64())
+	}
+	return string(digits), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func codeKey(scene, mobile string) string {
+	return keyPrefix + "code:" + scene + ":" + mobile
+}
+
+func cooldownKey(mobile string) string {
+	return keyPrefix + "cooldown:" + mobile
+}
+
+func mobileDailyKey(mobile string, now time.Time) string {
+	return keyPrefix + "daily:" + mobile + ":" + now.Format("20060102")
+}
+
+func ipHourlyKey(ip string, now time.Time) string {
+	return keyPrefix + "hourly:" + ip + ":" + now.Format("2006010215")
+}
@@ -0,0 +1,42 @@
+// Package workspace 定义团队空间的成员角色及其访问级别。
+// 团队（organization）与成员关系保存在 usercenter 数据库中，团队ID即 llmcenter 中的 workspace_id，
+// workspace_id 为 0 表示个人空间，只有创建者本人可以访问。
+package workspace
+
+// Personal 个人空间的 workspace_id
+const Personal int64 = 0
+
+// 成员角色，需与 organization_member 表保持一致
+const (
+	RoleOwner  = "owner"  // 负责人：创建团队的用户，可管理成员
+	RoleEditor = "editor" // 编辑：可在团队空间中生成、修改、删除文档并上传文件
+	RoleViewer = "viewer" // 查看：只能查看与导出团队空间中的会话、文档和文件
+)
+
+// Access 访问级别
+type Access int
+
+const (
+	Read   Access = iota // 查看、导出
+	Write                // 生成、修改、删除、上传
+	Manage               // 管理成员
+)
+
+// ValidMemberRole 判断是否为可分配给成员的角色（负责人只能在创建团队时产生）
+func ValidMemberRole(role string) bool {
+	return role == RoleEditor || role == RoleViewer
+}
+
+// Allows 判断角色是否具备指定访问级别，非成员（role 为空）不具备任何访问级别
+func Allows(role string, access Access) bool {
+	switch role {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return access <= Write
+	case RoleViewer:
+		return access == Read
+	default:
+		return false
+	}
+}
@@ -1,6 +1,17 @@
 package xerr
 
-import "github.com/zeromicro/x/errors"
+import (
+	stderrors "errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zeromicro/x/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
 
 var (
 	// 全局错误码 100xxx
@@ -13,13 +24,29 @@ var (
 	ErrInvalidParameter     = errors.New(100007, "非法参数")
 	ErrFileNotFound         = errors.New(100009, "未找到文件")
 	ErrJWTError             = errors.New(100008, "鉴权失败")
+	ErrPermissionDenied     = errors.New(100010, "无权限执行该操作")
 
 	// 用户模块错误码 200xxx
 	ErrUserAlreadyExists  = errors.New(200101, "用户已存在")
 	ErrUserRegisterFailed = errors.New(200102, "用户注册失败,请稍后再试")
 	ErrUserNotFound       = errors.New(200201, "用户不存在")
 	ErrUserPassword       = errors.New(200202, "密码错误")
+	ErrUserDisabled       = errors.New(200203, "账号已被禁用")
+	ErrPasswordPolicy     = errors.New(200204, "密码需为8-64位，且同时包含字母和数字")
+	ErrLoginTooFrequent   = errors.New(200205, "登录失败次数过多，请稍后再试")
+	ErrProfileInvalid     = errors.New(200206, "用户资料格式不正确")
 	ErrGenerateToken      = errors.New(200301, "生成token失败,请稍后再试")
+	ErrRefreshTokenExpire = errors.New(200302, "登录已过期，请重新登录")
+	ErrRefreshTokenReused = errors.New(200303, "登录状态异常，请重新登录")
+	ErrRoleNotFound       = errors.New(200401, "角色不存在")
+	ErrSmsCodeInvalid     = errors.New(200501, "验证码错误或已过期")
+	ErrSmsCodeAttempts    = errors.New(200502, "验证码错误次数过多，请重新获取")
+	ErrSmsSendTooFrequent = errors.New(200503, "验证码发送过于频繁")
+	ErrSmsSendFailed      = errors.New(200504, "验证码发送失败，请稍后再试")
+	ErrOrgNotFound        = errors.New(200601, "团队不存在")
+	ErrOrgMemberExists    = errors.New(200602, "该用户已是团队成员")
+	ErrOrgMemberNotFound  = errors.New(200603, "团队成员不存在")
+	ErrOrgOwnerImmutable  = errors.New(200604, "不能修改或移除团队负责人")
 
 	// llmcenter 模块错误码 300xxx
 	ErrConversationNotFound     = errors.New(300101, "会话不存在")
@@ -30,4 +57,117 @@ var (
 	// 这个错误是需要前端处理的，无法进入下一步了
 	ErrLLMInterruptEventNotSet   = errors.New(300106, "未成功设置中断事件")
 	ErrLLMInterruptEventNotFound = errors.New(300107, "中断事件已过期")
+	ErrContentBlocked            = errors.New(300108, "内容包含敏感或涉密信息，已被拦截")
+	ErrWorkspaceAccessDenied     = errors.New(300109, "无权在该团队空间执行此操作")
+	ErrDailyQuotaExceeded        = errors.New(300110, "今日用量已达上限，请明天再试或联系管理员")
+	ErrMonthlyQuotaExceeded      = errors.New(300111, "本月用量已达上限，请联系管理员")
+	ErrTooManyRequests           = errors.New(300112, "生成请求过于频繁，请稍后再试")
+	ErrTooManyGenerations        = errors.New(300113, "同时进行的生成任务过多，请等待当前任务完成")
+	ErrGenerationBusy            = errors.New(300114, "当前生成任务较多，请稍后再试")
+	ErrLLMUnavailable            = errors.New(300115, "大模型服务暂时不可用，请稍后再试")
+	ErrShareNotFound             = errors.New(300116, "分享链接不存在或文档已删除")
+	ErrShareExpired              = errors.New(300117, "分享链接已过期")
+	ErrShareRevoked              = errors.New(300118, "分享链接已被撤销")
+	ErrSharePasswordRequired     = errors.New(300119, "请输入访问密码")
+	ErrSharePasswordIncorrect    = errors.New(300120, "访问密码错误")
+	ErrSharePasswordLocked       = errors.New(300121, "访问密码错误次数过多，请稍后再试")
+	ErrShareDownloadDisabled     = errors.New(300122, "该分享不允许下载")
+	ErrCommentNotFound           = errors.New(300123, "批注不存在")
+	ErrCommentAnchorInvalid      = errors.New(300124, "批注引用的文本与文档内容不一致")
+	ErrCommentMentionInvalid     = errors.New(300125, "只能提及可以查看该文档的用户")
+	ErrNoOpenComments            = errors.New(300126, "文档没有未解决的批注")
+	ErrDocumentLocked            = errors.New(300127, "文档正在审批或已签发，不能修改")
+	ErrApprovalActionInvalid     = errors.New(300128, "当前审批状态不能执行该操作")
+	ErrApprovalPermissionDenied  = errors.New(300129, "无权执行该审批操作")
+	ErrApprovalAssigneeInvalid   = errors.New(300130, "审批人须为可以查看该文档的其他用户")
+	ErrApprovalConflict          = errors.New(300131, "审批状态已变化，请刷新后重试")
+	ErrApprovalCommentRequired   = errors.New(300132, "请填写审批意见")
+	ErrDocNoNotFound             = errors.New(300133, "发文字号不存在")
+	ErrDocNoStatusInvalid        = errors.New(300134, "发文字号当前状态不能执行该操作")
+	ErrDocNoPrefixInvalid        = errors.New(300135, "发文机关代字须为 1~12 个汉字")
+	ErrCollabRevisionInvalid     = errors.New(300136, "文档版本已过期，请重新连接")
+	ErrCollabOperationInvalid    = errors.New(300137, "编辑操作无效")
+	ErrCollabReadOnly            = errors.New(300138, "只有查看权限，不能编辑")
+	ErrCollabDisconnected        = errors.New(300139, "协同编辑连接已断开，请重新连接")
+	ErrCollabTicketInvalid       = errors.New(300140, "协同编辑凭证无效或已过期")
+	ErrShareTooFrequent          = errors.New(300141, "访问过于频繁，请稍后再试")
+	ErrDocNoPersonal             = errors.New(300142, "个人空间的文档不能分配发文字号")
 )
+
+// RetryAfterError 需要等待一段时间后才能重试的错误。Unwrap 返回原错误，errors.Is 仍可与错误码定义比较
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("%v, retry after %ds", e.Err, retrySeconds(e.RetryAfter))
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// Msg 在错误提示后附加可重试的等待秒数，供转换为 gRPC status 时使用
+func (e *RetryAfterError) Msg() string {
+	msg := e.Err.Error()
+	var codeMsg *errors.CodeMsg
+	if stderrors.As(e.Err, &codeMsg) {
+		msg = codeMsg.Msg
+	}
+	return fmt.Sprintf("%s（%d秒后可重试）", msg, retrySeconds(e.RetryAfter))
+}
+
+// WithRetryAfter 为错误附加可重试的等待时间，错误码保持不变；wait 不大于 0 时原样返回
+func WithRetryAfter(err error, wait time.Duration) error {
+	if wait <= 0 {
+		return err
+	}
+	return &RetryAfterError{Err: err, RetryAfter: wait}
+}
+
+// RetryAfter 返回错误链或 gRPC status 详情中携带的等待时间，RPC 调用方可据此设置 Retry-After 响应头
+func RetryAfter(err error) (time.Duration, bool) {
+	var retryErr *RetryAfterError
+	if stderrors.As(err, &retryErr) {
+		return retryErr.RetryAfter, true
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// StatusError 将业务错误转换为以业务错误码为状态码的 gRPC status error，
+// 错误链中带有等待时间时附加 RetryInfo 详情，并在提示中注明等待秒数
+func StatusError(codeErr *errors.CodeMsg, err error) error {
+	var retryErr *RetryAfterError
+	if !stderrors.As(err, &retryErr) {
+		return status.Error(codes.Code(codeErr.Code), codeErr.Msg)
+	}
+	st := status.New(codes.Code(codeErr.Code), retryErr.Msg())
+	if withDetails, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryErr.RetryAfter)}); detailErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// retrySeconds 等待秒数，向上取整且至少为 1
+func retrySeconds(wait time.Duration) int64 {
+	return max(int64(math.Ceil(wait.Seconds())), 1)
+}
+
+// Code 返回错误码，err 不是 xerr 定义的错误时返回 0。RPC 调用方可与 status.Code 比较以区分业务错误
+func Code(err error) int {
+	codeMsg, ok := err.(*errors.CodeMsg)
+	if !ok {
+		return 0
+	}
+	return codeMsg.Code
+}
@@ -0,0 +1,50 @@
+package xerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	xerror "github.com/zeromicro/x/errors"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithRetryAfter(t *testing.T) {
+	err := fmt.Errorf("login throttled: %w", WithRetryAfter(ErrLoginTooFrequent, 1500*time.Millisecond))
+	if !errors.Is(err, ErrLoginTooFrequent) {
+		t.Fatal("errors.Is should match the wrapped sentinel")
+	}
+	if wait, ok := RetryAfter(err); !ok || wait != 1500*time.Millisecond {
+		t.Fatalf("RetryAfter = %v, %v", wait, ok)
+	}
+	if WithRetryAfter(ErrLoginTooFrequent, 0) != ErrLoginTooFrequent {
+		t.Fatal("zero wait should return the original error")
+	}
+	if _, ok := RetryAfter(ErrLoginTooFrequent); ok {
+		t.Fatal("plain error should not carry retry after")
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	err := fmt.Errorf("login throttled: %w", WithRetryAfter(ErrLoginTooFrequent, 1500*time.Millisecond))
+	var codeErr *xerror.CodeMsg
+	if !errors.As(err, &codeErr) {
+		t.Fatal("errors.As should find the code")
+	}
+
+	// 转换为 status error 后错误码不变，等待时间通过 RetryInfo 详情传给调用方
+	statusErr := StatusError(codeErr, err)
+	st, _ := status.FromError(statusErr)
+	if int(st.Code()) != 200205 || st.Message() != "登录失败次数过多，请稍后再试（2秒后可重试）" {
+		t.Fatalf("status = %d %q", st.Code(), st.Message())
+	}
+	if wait, ok := RetryAfter(statusErr); !ok || wait != 1500*time.Millisecond {
+		t.Fatalf("RetryAfter = %v, %v", wait, ok)
+	}
+
+	st, _ = status.FromError(StatusError(ErrUserPassword.(*xerror.CodeMsg), ErrUserPassword))
+	if int(st.Code()) != 200202 || st.Message() != "密码错误" || len(st.Details()) != 0 {
+		t.Fatalf("status = %d %q %v", st.Code(), st.Message(), st.Details())
+	}
+}
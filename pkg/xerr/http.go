@@ -0,0 +1,23 @@
+package xerr
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/zeromicro/x/errors"
+	xhttp "github.com/zeromicro/x/http"
+)
+
+// WriteJson 以接口统一的 {code, msg} 格式写出业务错误，供在 handler 之前拒绝请求的中间件使用。
+// 与 handler 一致由 xhttp 写出，错误链中带有等待时间时在提示中注明秒数（Retry-After 响应头由调用方设置）
+func WriteJson(ctx context.Context, w http.ResponseWriter, err error) {
+	var retryErr *RetryAfterError
+	var codeMsg *errors.CodeMsg
+	if stderrors.As(err, &retryErr) && stderrors.As(retryErr.Err, &codeMsg) {
+		err = errors.New(codeMsg.Code, retryErr.Msg())
+	} else if stderrors.As(err, &codeMsg) {
+		err = codeMsg
+	}
+	xhttp.JsonBaseResponseCtx(ctx, w, err)
+}
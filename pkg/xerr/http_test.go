@@ -0,0 +1,40 @@
+package xerr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteJson(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantMsg  string
+	}{
+		{"业务错误", ErrPermissionDenied, 100010, "无权限执行该操作"},
+		{"包装后的业务错误", fmt.Errorf("check perm: %w", ErrPermissionDenied), 100010, "无权限执行该操作"},
+		{"附带等待时间", fmt.Errorf("limited: %w", WithRetryAfter(ErrLoginTooFrequent, 1500*time.Millisecond)),
+			200205, "登录失败次数过多，请稍后再试（2秒后可重试）"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			WriteJson(context.Background(), w, tt.err)
+			var body struct {
+				Code int    `json:"code"`
+				Msg  string `json:"msg"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %q: %v", w.Body.String(), err)
+			}
+			if body.Code != tt.wantCode || body.Msg != tt.wantMsg {
+				t.Fatalf("body = %+v", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+// Package xingchenmock 模拟星辰工作流接口的 HTTP 服务，用于本地开发与集成测试。
+// 对话与 Resume 接口按 SSE `data: {json}` 行输出与星辰一致的流式响应，可按请求编排
+// 正常输出、code != 0 的错误、中断事件、慢速流、无法解析的行、非 200 状态码与中途断开等场景；
+// multipart 请求按图片上传接口处理，同样按脚本返回状态码或错误码。
+package xingchenmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scenario 一次请求的响应脚本
+type Scenario struct {
+	Status       int           // 非 0 且不为 200 时直接返回该状态码，响应体为 Body
+	Body         string        // Status 非 200 时的响应体
+	Chunks       []string      // 依次输出的正文增量，最后一段带 finish_reason=stop
+	ChunkDelay   time.Duration // 每段输出前的等待时间，用于模拟慢速流或首包超时
+	Interrupt    *Interrupt    // 不为空时在正文之前输出中断事件
+	ErrorCode    int           // 非 0 时在输出 ErrorAfter 段正文后返回该错误码并结束流
+	ErrorMessage string        // 错误码对应的错误信息
+	ErrorAfter   int           // 返回错误前输出的正文段数
+	Malformed    bool          // 在正文之间插入注释行、非 data 行与无法解析的 data 行
+	NoStop       bool          // 不输出 stop 标记，直接结束响应
+	DropAfter    int           // 大于 0 时输出该段数的正文后中断连接，不结束 chunked 编码
+}
+
+// Reply 逐段输出 chunks 并正常结束的脚本
+func Reply(chunks ...string) Scenario {
+	return Scenario{Chunks: chunks}
+}
+
+// Interrupt 中断事件
+type Interrupt struct {
+	EventID string
+	Type    string // direct 或 option
+	Content string // 向用户提问的内容
+}
+
+// Request 收到的请求
+type Request struct {
+	Path          string
+	Authorization string
+	TraceParent   string    // traceparent 请求头
+	Body          []byte    // 请求体原文，上传请求为空
+	FlowID        string    // flow_id
+	UID           string    // uid
+	ChatID        string    // chat_id
+	Input         string    // parameters.AGENT_USER_INPUT
+	History       []Message // history
+	EventID       string    // Resume 接口的 event_id
+	EventType     string    // Resume 接口的 event_type
+	Upload        string    // 上传请求的文件名
+}
+
+// Message 请求中的历史消息
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Server 模拟星辰接口的 http.Handler。请求依次使用 Enqueue 排队的脚本，队列为空时使用默认脚本
+type Server struct {
+	mu        sync.Mutex
+	def       Scenario
+	queue     []Scenario
+	requests  []Request
+	uploadURL string
+}
+
+// New 创建模拟服务，def 为默认脚本
+func New(def Scenario) *Server {
+	return &Server{def: def, uploadURL: "https://mock.xingchen.local/image/"}
+}
+
+// SetDefault 设置默认脚本
+func (s *Server) SetDefault(sc Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.def = sc
+}
+
+// Enqueue 为后续请求按顺序指定脚本，每个脚本只使用一次
+func (s *Server) Enqueue(scs ...Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queue = append(s.queue, scs...)
+}
+
+// Requests 返回已收到的请求
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset 清空脚本队列与请求记录
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queue = nil
+	s.requests = nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := Request{
+		Path:          r.URL.Path,
+		Authorization: r.Header.Get("Authorization"),
+		TraceParent:   r.Header.Get("traceparent"),
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		s.serveUpload(w, r, req)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.Body = body
+	req.FlowID, req.UID, req.ChatID = p.FlowID, p.UID, p.ChatID
+	req.Input, req.History = p.Parameters.AgentUserInput, p.History
+	req.EventID, req.EventType = p.EventID, p.EventType
+
+	sc := s.next(req)
+	if sc.Status != 0 && sc.Status != http.StatusOK {
+		w.WriteHeader(sc.Status)
+		_, _ = io.WriteString(w, sc.Body)
+		return
+	}
+	s.stream(w, r, sc)
+}
+
+// next 记录请求并取出本次使用的脚本
+func (s *Server) next(req Request) Scenario {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	if len(s.queue) == 0 {
+		return s.def
+	}
+	sc := s.queue[0]
+	s.queue = s.queue[1:]
+	return sc
+}
+
+func (s *Server) stream(w http.ResponseWriter, r *http.Request, sc Scenario) {
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	id := fmt.Sprintf("mock-%d", time.Now().UnixNano())
+	send := func(v any) {
+		data, _ := json.Marshal(v)
+		fmt.Fprintf(w, "data: %s\n\n", data)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	raw := func(line string) {
+		fmt.Fprintf(w, "%s\n\n", line)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	wait := func() bool {
+		if sc.ChunkDelay <= 0 {
+			return true
+		}
+		select {
+		case <-r.Context().Done():
+			return false
+		case <-time.After(sc.ChunkDelay):
+			return true
+		}
+	}
+
+	if sc.Interrupt != nil {
+		ev := eventData{EventID: sc.Interrupt.EventID, EventType: "interrupt"}
+		ev.Value.Type = sc.Interrupt.Type
+		ev.Value.Content = sc.Interrupt.Content
+		send(response{ID: id, EventData: &ev})
+	}
+
+	for i, chunk := range sc.Chunks {
+		if sc.ErrorCode != 0 && i == sc.ErrorAfter {
+			break
+		}
+		if sc.DropAfter > 0 && i == sc.DropAfter {
+			// 中止 handler 会直接关闭连接，客户端读取时得到 unexpected EOF
+			panic(http.ErrAbortHandler)
+		}
+		if !wait() {
+			return
+		}
+		if sc.Malformed {
+			raw(": keep-alive")
+			raw("event: ping")
+			raw("data: {not json")
+		}
+		finish := ""
+		if i == len(sc.Chunks)-1 && !sc.NoStop && sc.ErrorCode == 0 {
+			finish = "stop"
+		}
+		send(response{ID: id, Choices: []choice{{Delta: delta{Role: "assistant", Content: chunk}, FinishReason: finish}}})
+	}
+
+	if sc.ErrorCode != 0 {
+		send(response{Code: sc.ErrorCode, Message: sc.ErrorMessage, ID: id})
+	}
+}
+
+// serveUpload 处理图片上传，脚本中的 Status 与 ErrorCode 同样生效
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request, req Request) {
+	var result uploadResponse
+	file, header, err := r.FormFile("file")
+	if err == nil {
+		file.Close()
+		req.Upload = header.Filename
+	}
+	sc := s.next(req)
+	switch {
+	case sc.Status != 0 && sc.Status != http.StatusOK:
+		http.Error(w, sc.Body, sc.Status)
+		return
+	case err != nil:
+		result.Code, result.Message = 10001, "missing file"
+	case sc.ErrorCode != 0:
+		result.Code, result.Message = sc.ErrorCode, sc.ErrorMessage
+	default:
+		result.Message = "success"
+		result.Data.URL = s.uploadURL + header.Filename
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// 以下为星辰接口的数据结构
+
+type payload struct {
+	FlowID     string `json:"flow_id"`
+	UID        string `json:"uid"`
+	ChatID     string `json:"chat_id"`
+	Parameters struct {
+		AgentUserInput string `json:"AGENT_USER_INPUT"`
+	} `json:"parameters"`
+	History   []Message `json:"history"`
+	EventID   string    `json:"event_id"`
+	EventType string    `json:"event_type"`
+}
+
+type response struct {
+	Code      int        `json:"code"`
+	Message   string     `json:"message"`
+	ID        string     `json:"id"`
+	Choices   []choice   `json:"choices"`
+	EventData *eventData `json:"event_data,omitempty"`
+}
+
+type choice struct {
+	Delta        delta  `json:"delta"`
+	FinishReason string `json:"finish_reason"`
+}
+
+type delta struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type eventData struct {
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	Value     struct {
+		Type    string `json:"type"`
+		Content string `json:"content"`
+	} `json:"value"`
+}
+
+type uploadResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		URL string `json:"url"`
+	} `json:"data"`
+}